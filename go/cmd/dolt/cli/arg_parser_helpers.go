@@ -57,6 +57,8 @@ const (
 
 var branchForceFlagDesc = "Reset {{.LessThan}}branchname{{.GreaterThan}} to {{.LessThan}}startpoint{{.GreaterThan}}, even if {{.LessThan}}branchname{{.GreaterThan}} exists already. Without {{.EmphasisLeft}}-f{{.EmphasisRight}}, {{.EmphasisLeft}}dolt branch{{.EmphasisRight}} refuses to change an existing branch. In combination with {{.EmphasisLeft}}-d{{.EmphasisRight}} (or {{.EmphasisLeft}}--delete{{.EmphasisRight}}), allow deleting the branch irrespective of its merged status. In combination with -m (or {{.EmphasisLeft}}--move{{.EmphasisRight}}), allow renaming the branch even if the new branch name already exists, the same applies for {{.EmphasisLeft}}-c{{.EmphasisRight}} (or {{.EmphasisLeft}}--copy{{.EmphasisRight}})."
 
+var strategyOptionDesc = "Pass the given option to the merge strategy. {{.EmphasisLeft}}ours{{.EmphasisRight}} resolves conflicting changes to a row in favor of our side of the merge, and {{.EmphasisLeft}}theirs{{.EmphasisRight}} resolves them in favor of their side. Changes that don't conflict are still merged from both sides, and schema conflicts and constraint violations are still reported."
+
 // CreateCommitArgParser creates the argparser shared dolt commit cli and DOLT_COMMIT.
 func CreateCommitArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs("commit", 0)
@@ -81,10 +83,7 @@ func CreateConflictsResolveArgParser() *argparser.ArgParser {
 }
 
 func CreateMergeArgParser() *argparser.ArgParser {
-	ap := argparser.NewArgParserWithMaxArgs("merge", 1)
-	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
-		return errors.New("Error: Dolt does not support merging from multiple commits. You probably meant to checkout one and then merge from the other.")
-	}
+	ap := argparser.NewArgParserWithVariableArgs("merge")
 	ap.SupportsFlag(NoFFParam, "", "Create a merge commit even when the merge resolves as a fast-forward.")
 	ap.SupportsFlag(SquashParam, "", "Merge changes to the working set without updating the commit history")
 	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} as the commit message.")
@@ -93,6 +92,8 @@ func CreateMergeArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(NoCommitFlag, "", "Perform the merge and stop just before creating a merge commit. Note this will not prevent a fast-forward merge; use the --no-ff arg together with the --no-commit arg to prevent both fast-forwards and merge commits.")
 	ap.SupportsFlag(NoEditFlag, "", "Use an auto-generated commit message when creating a merge commit. The default for interactive CLI sessions is to open an editor.")
 	ap.SupportsString(AuthorParam, "", "author", "Specify an explicit author using the standard A U Thor {{.LessThan}}author@example.com{{.GreaterThan}} format.")
+	ap.SupportsString(StrategyParam, "s", "strategy", "Use the given merge strategy. The only supported strategy besides the default is {{.EmphasisLeft}}ours{{.EmphasisRight}}, which records the merge but keeps the current branch's tables unchanged, ignoring all changes from the merged branch.")
+	ap.SupportsString(StrategyOptionParam, "X", "option", strategyOptionDesc)
 
 	return ap
 }
@@ -106,6 +107,7 @@ func CreateRebaseArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(AbortParam, "", "Abort an interactive rebase and return the working set to the pre-rebase state")
 	ap.SupportsFlag(ContinueFlag, "", "Continue an interactive rebase after adjusting the rebase plan")
 	ap.SupportsFlag(InteractiveFlag, "i", "Start an interactive rebase")
+	ap.SupportsString(StrategyOptionParam, "X", "option", strategyOptionDesc)
 	return ap
 }
 
@@ -171,12 +173,20 @@ func CreateCheckoutArgParser() *argparser.ArgParser {
 	return ap
 }
 
+func CreateRestoreArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("restore")
+	ap.SupportsString(SourceParam, "s", "revision", "Restore the tables from the given revision instead of the staged tables.")
+	ap.SupportsString(WhereParam, "", "predicate", "Restore only the rows of the table that match the predicate, either in the working set or in the source.")
+	return ap
+}
+
 func CreateCherryPickArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs("cherrypick", 1)
 	ap.SupportsFlag(AbortParam, "", "Abort the current conflict resolution process, and revert all changes from the in-process cherry-pick operation.")
 	ap.SupportsFlag(AllowEmptyFlag, "", "Allow empty commits to be cherry-picked. "+
 		"Note that use of this option only keeps commits that were initially empty. "+
 		"Commits which become empty, due to a previous commit, will cause cherry-pick to fail.")
+	ap.SupportsString(StrategyOptionParam, "X", "option", strategyOptionDesc)
 	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
 		return errors.New("cherry-picking multiple commits is not supported yet.")
 	}
@@ -212,6 +222,8 @@ func CreatePullArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(NoEditFlag, "", "Use an auto-generated commit message when creating a merge commit. The default for interactive CLI sessions is to open an editor.")
 	ap.SupportsString(UserFlag, "", "user", "User name to use when authenticating with the remote. Gets password from the environment variable {{.EmphasisLeft}}DOLT_REMOTE_PASSWORD{{.EmphasisRight}}.")
 	ap.SupportsFlag(SilentFlag, "", "Suppress progress information.")
+	ap.SupportsString(StrategyParam, "s", "strategy", "Use the given merge strategy. The only supported strategy besides the default is {{.EmphasisLeft}}ours{{.EmphasisRight}}, which records the merge but keeps the current branch's tables unchanged, ignoring all changes from the merged branch.")
+	ap.SupportsString(StrategyOptionParam, "X", "option", strategyOptionDesc)
 	return ap
 }
 
@@ -250,6 +262,24 @@ func CreateTagArgParser() *argparser.ArgParser {
 	return ap
 }
 
+func CreateNotesArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("notes")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"commit", "The commit the note is attached to. Defaults to HEAD."})
+	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} as the note.")
+	ap.SupportsFlag(ForceFlag, "f", "Replace the note of a commit that already has one.")
+	ap.SupportsString(NotesRefParam, "", "name", "Use the notes ref {{.LessThan}}name{{.GreaterThan}} rather than refs/notes/commits.")
+	return ap
+}
+
+func CreateStashArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs("stash")
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "One of push, pop, drop or clear. Defaults to push."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"stash", "The stash entry to pop or drop, e.g. stash@{1}. Defaults to the latest entry."})
+	ap.SupportsFlag(IncludeUntrackedFlag, "u", "Untracked tables are also stashed.")
+	ap.SupportsFlag(AllFlag, "a", "All tables are stashed, including untracked and ignored tables.")
+	return ap
+}
+
 func CreateBackupArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithVariableArgs("backup")
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"region", "cloud provider region associated with this backup."})
@@ -287,6 +317,8 @@ func CreateLogArgParser(isTableFunction bool) *argparser.ArgParser {
 		ap.SupportsFlag(OneLineFlag, "", "Shows logs in a compact format.")
 		ap.SupportsFlag(StatFlag, "", "Shows the diffstat for each commit.")
 		ap.SupportsFlag(GraphFlag, "", "Shows the commit graph.")
+		ap.SupportsString(RowFlag, "", "table", "Shows only the commits that changed the row of the table identified by --pk, with the row's values before and after each change.")
+		ap.SupportsString(PrimaryKeyFlag, "", "column=value[,column=value...]", "The primary key of the row whose history --row shows.")
 	}
 	return ap
 }
@@ -298,6 +330,15 @@ func CreateGCArgParser() *argparser.ArgParser {
 	return ap
 }
 
+func CreateUserLimitArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs("user_limit", 3)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"name", "The user, or role if --role is given, to set the limit for."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"limit", "One of max_connections, max_execution_time_millis, max_rows or max_working_set_rows."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"value", "The new value of the limit. 0 removes the limit."})
+	ap.SupportsFlag(RoleFlag, "", "Set the limit for a role rather than a user.")
+	return ap
+}
+
 func CreateCountCommitsArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs("gc", 0)
 	ap.SupportsString("from", "f", "commit id", "commit to start counting from")
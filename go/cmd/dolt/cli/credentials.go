@@ -28,6 +28,7 @@ type UserPassword struct {
 	Username  string
 	Password  string
 	Specified bool // If true, the user and password were provided by the user.
+	Token     bool // If true, the password is a bearer token which must be sent to the server in cleartext.
 }
 
 // BuildUserPasswordPrompt builds a UserPassword struct from the parsed args. The user is prompted for a password if one
@@ -41,6 +41,8 @@ const (
 	GraphFlag            = "graph"
 	HardResetParam       = "hard"
 	HostFlag             = "host"
+	HunksFlag            = "hunks"
+	IncludeUntrackedFlag = "include-untracked"
 	InteractiveFlag      = "interactive"
 	ListFlag             = "list"
 	MergesFlag           = "merges"
@@ -54,6 +56,7 @@ const (
 	NoTLSFlag            = "no-tls"
 	NoJsonMergeFlag      = "dont-merge-json"
 	NotFlag              = "not"
+	NotesRefParam        = "ref"
 	NumberFlag           = "number"
 	OneLineFlag          = "oneline"
 	OursFlag             = "ours"
@@ -62,9 +65,12 @@ const (
 	PatchFlag            = "patch"
 	PasswordFlag         = "password"
 	PortFlag             = "port"
+	PrimaryKeyFlag       = "pk"
 	PruneFlag            = "prune"
 	QuietFlag            = "quiet"
 	RemoteParam          = "remote"
+	RoleFlag             = "role"
+	RowFlag              = "row"
 	SetUpstreamFlag      = "set-upstream"
 	ShallowFlag          = "shallow"
 	ShowIgnoredFlag      = "ignored"
@@ -74,13 +80,17 @@ const (
 	SingleBranchFlag     = "single-branch"
 	SkipEmptyFlag        = "skip-empty"
 	SoftResetParam       = "soft"
+	SourceParam          = "source"
 	SquashParam          = "squash"
 	StagedFlag           = "staged"
 	StatFlag             = "stat"
+	StrategyParam        = "strategy"
+	StrategyOptionParam  = "strategy-option"
 	SystemFlag           = "system"
 	TablesFlag           = "tables"
 	TheirsFlag           = "theirs"
 	TrackFlag            = "track"
 	UpperCaseAllFlag     = "ALL"
 	UserFlag             = "user"
+	WhereParam           = "where"
 )
@@ -21,12 +21,15 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/ishell"
 	"github.com/fatih/color"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 	"golang.org/x/exp/slices"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -46,9 +49,17 @@ This command updates the list of tables using the current content found in the w
 
 This command can be performed multiple times before a commit. It only adds the content of the specified table(s) at the time the add command is run; if you want subsequent changes included in the next commit, then you must run dolt add again to add the new content to the index.
 
-The dolt status command can be used to obtain a summary of which tables have changes that are staged for the next commit.`,
+The dolt status command can be used to obtain a summary of which tables have changes that are staged for the next commit.
+
+With {{.EmphasisLeft}}--patch{{.EmphasisRight}}, the unstaged row changes of the tables are shown one row at a time, and you choose which rows to stage. Modified rows are shown as their old and new values, with the changed cells highlighted.
+
+With {{.EmphasisLeft}}--patch --hunks{{.EmphasisRight}}, the changes are shown one hunk at a time instead. A hunk is a run of changed rows which are adjacent in primary key order. A hunk can be split into its single rows to stage them separately.
+
+With {{.EmphasisLeft}}--where{{.EmphasisRight}}, only the unstaged changes to the rows of the table which match the predicate are staged. The predicate is a SQL expression over the table's columns, which is evaluated against the new values of added and modified rows, and the old values of removed rows.`,
 	Synopsis: []string{
 		`[{{.LessThan}}table{{.GreaterThan}}...]`,
+		`-p [--hunks] [{{.LessThan}}table{{.GreaterThan}}...]`,
+		`--where {{.LessThan}}predicate{{.GreaterThan}} {{.LessThan}}table{{.GreaterThan}}`,
 	},
 }
 
@@ -114,8 +125,10 @@ func generateAddSql(apr *argparser.ArgParseResults) string {
 func (cmd AddCmd) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
 	ap := cli.CreateAddArgParser()
 
-	// This flag is only supported in a CLI context, not the in the dolt procedure.
+	// These flags are only supported in a CLI context, not the in the dolt procedure.
 	ap.SupportsFlag(cli.PatchFlag, "p", "Interactively select changes to add to the staged set.")
+	ap.SupportsFlag(cli.HunksFlag, "", "With --patch, select changes a hunk of adjacent rows at a time.")
+	ap.SupportsString(cli.WhereParam, "", "predicate", "Stage only the changed rows of the table that match the predicate.")
 
 	apr, _, terminate, status := ParseArgsOrPrintHelp(ap, commandStr, args, addDocs)
 	if terminate {
@@ -138,8 +151,18 @@ func (cmd AddCmd) Exec(ctx context.Context, commandStr string, args []string, _
 		return 1
 	}
 
+	if apr.Contains(cli.PatchFlag) && apr.Contains(cli.WhereParam) {
+		return HandleVErrAndExitCode(errhand.BuildDError("--%s and --%s are mutually exclusive", cli.PatchFlag, cli.WhereParam).Build(), nil)
+	}
+
+	if apr.Contains(cli.HunksFlag) && !apr.Contains(cli.PatchFlag) {
+		return HandleVErrAndExitCode(errhand.BuildDError("--%s requires --%s", cli.HunksFlag, cli.PatchFlag).Build(), nil)
+	}
+
 	if apr.Contains(cli.PatchFlag) {
-		return patchWorkflow(sqlCtx, queryist, apr.Args)
+		return patchWorkflow(sqlCtx, queryist, apr.Args, apr.Contains(cli.HunksFlag))
+	} else if predicate, ok := apr.GetValue(cli.WhereParam); ok {
+		return whereWorkflow(sqlCtx, queryist, apr.Args, predicate)
 	} else {
 		for _, tableName := range apr.Args {
 			if tableName != "." && !doltdb.IsValidTableName(tableName) {
@@ -163,7 +186,7 @@ func (cmd AddCmd) Exec(ctx context.Context, commandStr string, args []string, _
 	return 0
 }
 
-func patchWorkflow(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) int {
+func patchWorkflow(sqlCtx *sql.Context, queryist cli.Queryist, tables []string, byHunk bool) int {
 	if len(tables) == 0 || (len(tables) == 1 && tables[0] == ".") {
 		tables = tables[:0] // in the event that the user specified '.' as the only argument, we want to clear the list of tables
 
@@ -192,20 +215,107 @@ func patchWorkflow(sqlCtx *sql.Context, queryist cli.Queryist, tables []string)
 	}
 
 	sort.Strings(tables)
-	runAddPatchShell(sqlCtx, queryist, tables)
+	return runAddPatchShell(sqlCtx, queryist, tables, byHunk)
+}
+
+// whereWorkflow stages the unstaged changes to the rows of the table given which match |predicate|. The predicate is
+// evaluated against the values of each row after the change, or before it if the row was removed.
+func whereWorkflow(sqlCtx *sql.Context, queryist cli.Queryist, tables []string, predicate string) int {
+	if len(tables) != 1 {
+		return HandleVErrAndExitCode(errhand.BuildDError("--%s requires exactly one table", cli.WhereParam).Build(), nil)
+	}
+	tableName := tables[0]
+	if !doltdb.IsValidTableName(tableName) {
+		return HandleVErrAndExitCode(errhand.BuildDError("'%s' is not a valid table name", tableName).Build(), nil)
+	}
 
+	ids, err := queryForMatchingChanges(sqlCtx, queryist, tableName, predicate)
+	if err != nil {
+		cli.PrintErrln(errhand.VerboseErrorFromError(err))
+		return 1
+	}
+	if err = stageChanges(sqlCtx, queryist, tableName, ids); err != nil {
+		cli.PrintErrln(errhand.VerboseErrorFromError(err))
+		return 1
+	}
 	return 0
 }
 
+// queryForMatchingChanges returns the IDs of the unstaged rows of the dolt_workspace_* table for |tableName| whose
+// changes match |predicate|. The predicate is written in terms of the user table's columns, so the workspace table's
+// to_ and from_ columns are folded back into them.
+func queryForMatchingChanges(sqlCtx *sql.Context, queryist cli.Queryist, tableName, predicate string) ([]int, error) {
+	workspaceTable := sql.QuoteIdentifier("dolt_workspace_" + tableName)
+	workspaceSchema, _, _, err := queryist.Query(sqlCtx, fmt.Sprintf("SELECT * FROM %s LIMIT 0", workspaceTable))
+	if err != nil {
+		return nil, err
+	}
+	if len(workspaceSchema) <= 3 {
+		// The table has no changes, so its workspace table has no row columns.
+		return nil, nil
+	}
+	schema, err := reconstructSchema(workspaceSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := make([]string, len(schema))
+	for i, col := range schema {
+		cols[i] = fmt.Sprintf("CASE WHEN diff_type = 'removed' THEN %s ELSE %s END AS %s",
+			sql.QuoteIdentifier("from_"+col.Name), sql.QuoteIdentifier("to_"+col.Name), sql.QuoteIdentifier(col.Name))
+	}
+	qry := fmt.Sprintf("SELECT dolt_workspace_id FROM (SELECT id AS dolt_workspace_id, %s FROM %s WHERE NOT staged) AS changes WHERE (%s)",
+		strings.Join(cols, ", "), workspaceTable, predicate)
+	rows, err := GetRowsForSql(queryist, sqlCtx, qry)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		if ids[i], err = coerceToInt(row[0]); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// stageChanges stages the rows of the dolt_workspace_* table for |tableName| with the given IDs. They're staged with a
+// single statement, since staging a row changes the IDs of the others.
+func stageChanges(sqlCtx *sql.Context, queryist cli.Queryist, tableName string, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.Itoa(id)
+	}
+	qry := fmt.Sprintf("UPDATE %s SET staged = TRUE WHERE id IN (%s)", sql.QuoteIdentifier("dolt_workspace_"+tableName), strings.Join(idStrs, ", "))
+	_, iter, _, err := queryist.Query(sqlCtx, qry)
+	if err != nil {
+		return err
+	}
+
+	// The Update operation doesn't return any rows, but we need to iterate over it to ensure that the update
+	// is made when the queryist we are using for a local query engine.
+	for {
+		_, err = iter.Next(sqlCtx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return iter.Close(sqlCtx)
+}
+
 // tablePatchInfo is a struct that holds the summary of details for a single table's unstaged changes.
-// This includes the number of added, modified, and removed rows, and the ID of the first and last rows where
-// staged == false.
+// This includes the number of added, modified, and removed rows, and the schema of the table.
 type tablePatchInfo struct {
 	add      int
 	modifies int
 	removes  int
-	firstId  int
-	lastId   int
 	schema   sql.Schema
 }
 
@@ -213,12 +323,15 @@ func (tpi *tablePatchInfo) total() int {
 	return tpi.add + tpi.modifies + tpi.removes
 }
 
-func runAddPatchShell(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) int {
-	state, err := newState(sqlCtx, queryist, tables)
+func runAddPatchShell(sqlCtx *sql.Context, queryist cli.Queryist, tables []string, byHunk bool) int {
+	state, err := newState(sqlCtx, queryist, tables, byHunk)
 	if err != nil {
 		cli.PrintErrln(errhand.VerboseErrorFromError(err))
 		return 1
 	}
+	if state.done {
+		return 0
+	}
 
 	shell := ishell.New()
 	shell.AutoHelp(false) // This doesn't seem to actually disable the "help" command.
@@ -231,40 +344,41 @@ func runAddPatchShell(sqlCtx *sql.Context, queryist cli.Queryist, tables []strin
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "y",
-		Help: "stage the current change",
-		Func: state.stageCurrentChange,
+		Help: "stage this hunk",
+		Func: state.withPrompt(state.stageCurrentHunk),
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "n",
-		Help: "do not stage the current change",
-		Func: state.skipCurrentChange,
+		Help: "do not stage this hunk",
+		Func: state.withPrompt(state.skipCurrentHunk),
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "q",
 		Help: "quit",
-		Func: func(c *ishell.Context) {
-			c.Stop()
-		},
+		Func: state.quit,
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "a",
-		Help: "add all changes in this table",
-		Func: state.addRemainingInTable,
+		Help: "stage this hunk and all later hunks in this table",
+		Func: state.withPrompt(state.addRemainingInTable),
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "d",
-		Help: "do not stage any further changes in this table",
-		Func: state.skipRemainingInTable,
+		Help: "do not stage this hunk or any later hunks in this table",
+		Func: state.withPrompt(state.skipRemainingInTable),
 	})
 	shell.AddCmd(&ishell.Cmd{
 		Name: "s",
 		Help: "show summary of unstaged changes and start over",
-		Func: state.reset,
+		Func: state.withPrompt(state.reset),
+	})
+	shell.AddCmd(&ishell.Cmd{
+		Name: "b",
+		Help: "break this hunk into single rows",
+		Func: state.withPrompt(state.splitCurrentHunk),
 	})
 
-	prompt := "Stage this row [y,n,q,a,d,s,?]? "
-	prompt = color.HiGreenString(prompt)
-	shell.SetPrompt(prompt)
+	shell.SetPrompt(state.prompt())
 
 	// run shell. This blocks until The stop() function is called on the ishell context.
 	shell.Run()
@@ -277,8 +391,8 @@ func runAddPatchShell(sqlCtx *sql.Context, queryist cli.Queryist, tables []strin
 }
 
 // queryForUnstagedChanges queries the dolt_workspace_* tables for details required for the patch workflow. This
-// includes the add/modify/remove counts for each table, the schema for each table, and the first and last row IDs.
-// We do this with three separate queries, which isn't ideal, but this is not a performance critical path.
+// includes the add/modify/remove counts for each table, and the schema for each table.
+// We do this with two separate queries, which isn't ideal, but this is not a performance critical path.
 func queryForUnstagedChanges(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) (map[string]*tablePatchInfo, error) {
 	changeCounts := make(map[string]*tablePatchInfo)
 	for _, tableName := range tables {
@@ -330,31 +444,6 @@ func queryForUnstagedChanges(sqlCtx *sql.Context, queryist cli.Queryist, tables
 			return nil, err
 		}
 		changeCounts[tableName].schema = reconstructedSchema
-
-		// Finally, get the first and last row IDs for the table.
-		qry = fmt.Sprintf("SELECT min(id) as first_id, max(id) as last_id FROM dolt_workspace_%s WHERE NOT staged", tableName)
-		_, rowIter, _, err = queryist.Query(sqlCtx, qry)
-		if err != nil {
-			return nil, err
-		}
-		rows, err = sql.RowIterToRows(sqlCtx, rowIter)
-		if err != nil {
-			return nil, err
-		}
-		if len(rows) != 1 {
-			return nil, errors.New("Expected one row")
-		}
-		firstId, err := coerceToInt(rows[0][0])
-		if err != nil {
-			return nil, err
-		}
-		changeCounts[tableName].firstId = firstId
-		lastId, err := coerceToInt(rows[0][1])
-		if err != nil {
-			return nil, err
-		}
-		changeCounts[tableName].lastId = lastId
-
 	}
 
 	return changeCounts, nil
@@ -368,149 +457,226 @@ func coerceToInt(val interface{}) (int, error) {
 	return int(val.(int32)), nil
 }
 
-// queryForSingleChange queries the dolt_workspace_* table for the row with the given ID.
-func queryForSingleChange(sqlCtx *sql.Context, queryist cli.Queryist, tableName string, rowId int) (sql.Row, error) {
-	qry := fmt.Sprintf("SELECT * FROM dolt_workspace_%s WHERE ID = %d LIMIT 1", tableName, rowId)
-	_, rowIter, _, err := queryist.Query(sqlCtx, qry)
+// queryForHunks queries the dolt_workspace_* table for the unstaged changes to |tableName|, and groups them into hunks.
+// If |byHunk| is true, a hunk is a run of changes to rows which are adjacent in primary key order, meaning that there is
+// no unchanged row of the working table between them. Otherwise, and for keyless tables, every change is its own hunk.
+func queryForHunks(sqlCtx *sql.Context, queryist cli.Queryist, tableName string, schema sql.Schema, byHunk bool) ([][]sql.Row, error) {
+	qry := fmt.Sprintf("SELECT * FROM dolt_workspace_%s WHERE NOT staged ORDER BY id", tableName)
+	rows, err := GetRowsForSql(queryist, sqlCtx, qry)
 	if err != nil {
 		return nil, err
 	}
-	rows, err := sql.RowIterToRows(sqlCtx, rowIter)
+	var pkOrds []int
+	if byHunk {
+		pkOrds, err = queryForPrimaryKey(sqlCtx, queryist, tableName, schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var hunks [][]sql.Row
+	for i, row := range rows {
+		if i > 0 && len(pkOrds) > 0 {
+			adjacent, err := changesAreAdjacent(sqlCtx, queryist, tableName, schema, pkOrds, rows[i-1], row)
+			if err != nil {
+				return nil, err
+			}
+			if adjacent {
+				hunks[len(hunks)-1] = append(hunks[len(hunks)-1], row)
+				continue
+			}
+		}
+		hunks = append(hunks, []sql.Row{row})
+	}
+	return hunks, nil
+}
+
+// queryForPrimaryKey returns the ordinals in |schema| of the primary key columns of |tableName|, in key order. The
+// result is empty for keyless tables.
+func queryForPrimaryKey(sqlCtx *sql.Context, queryist cli.Queryist, tableName string, schema sql.Schema) ([]int, error) {
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, `SELECT column_name FROM information_schema.key_column_usage
+WHERE table_schema = database() AND table_name = ? AND constraint_name = 'PRIMARY' ORDER BY ordinal_position`, tableName)
 	if err != nil {
 		return nil, err
 	}
-	if len(rows) == 0 || len(rows) > 1 {
-		return nil, errors.New("Expected exactly one row")
+
+	ords := make([]int, len(rows))
+	for i, row := range rows {
+		colName := fmt.Sprintf("%v", row[0])
+		ords[i] = schema.IndexOfColName(colName)
+		if ords[i] < 0 {
+			return nil, fmt.Errorf("primary key column %s not found in table %s", colName, tableName)
+		}
+	}
+	return ords, nil
+}
+
+// changesAreAdjacent returns whether the rows changed by the workspace rows |prev| and |next| are adjacent in the
+// working table, meaning that there is no row between them in primary key order.
+func changesAreAdjacent(sqlCtx *sql.Context, queryist cli.Queryist, tableName string, schema sql.Schema, pkOrds []int, prev, next sql.Row) (bool, error) {
+	pkCols := make([]string, len(pkOrds))
+	for i, ord := range pkOrds {
+		pkCols[i] = sql.QuoteIdentifier(schema[ord].Name)
+	}
+	key := "(" + strings.Join(pkCols, ", ") + ")"
+	placeholders := "(" + buildPlaceholdersString(len(pkOrds)) + ")"
+
+	qryTemplate := fmt.Sprintf("SELECT 1 FROM %s WHERE %s > %s AND %s < %s LIMIT 1",
+		sql.QuoteIdentifier(tableName), key, placeholders, key, placeholders)
+	params := append(changeKey(prev, schema, pkOrds), changeKey(next, schema, pkOrds)...)
+	qry, err := dbr.InterpolateForDialect(qryTemplate, params, dialect.MySQL)
+	if err != nil {
+		// Keys of some types can't be written as literals. Their changes are shown as separate hunks.
+		return false, nil
+	}
+
+	rows, err := GetRowsForSql(queryist, sqlCtx, qry)
+	if err != nil {
+		return false, err
+	}
+	return len(rows) == 0, nil
+}
+
+// changeKey returns the primary key values of the row changed by |workspaceRow|.
+func changeKey(workspaceRow sql.Row, schema sql.Schema, pkOrds []int) []interface{} {
+	row := workspaceRow[3 : 3+len(schema)]
+	if workspaceRow[2].(string) == "removed" {
+		row = workspaceRow[3+len(schema):]
+	}
+
+	key := make([]interface{}, len(pkOrds))
+	for i, ord := range pkOrds {
+		key[i] = row[ord]
 	}
-	return rows[0], nil
+	return key
 }
 
 func opHelp(_ *ishell.Context) {
-	help := `y - stage the current change
-n - do not stage the current change
-q - quit
-a - add all changes in this table
-d - do not stage any further changes in this table
+	help := `y - stage this hunk
+n - do not stage this hunk
+q - quit; do not stage this hunk or any of the remaining ones
+a - stage this hunk and all later hunks in this table
+d - do not stage this hunk or any later hunks in this table
 s - show summary of unstaged changes and start over
+b - break this hunk into single rows
 ? - show this help`
 	help = color.CyanString(help)
 	cli.Println(help)
 }
 
-// patchState is the state for the patch workflow.
+// patchState is the state for the patch workflow. The changes chosen for a table are staged when the workflow moves
+// on from it, so that the IDs of the table's workspace rows don't change while the user is choosing.
 type patchState struct {
-	sqlCtx                *sql.Context
-	queryist              cli.Queryist
-	tables                []string
-	changeCounts          map[string]*tablePatchInfo
-	currentTable          string
-	currentTableSchema    sql.Schema
-	currentTableLastRowId int
-	currentRowId          int
-	currentRow            sql.Row
-	err                   error
-}
-
-// stageCurrentChange stages the current change. "a" command.
-func (ps *patchState) stageCurrentChange(c *ishell.Context) {
-	qry := fmt.Sprintf("UPDATE dolt_workspace_%s SET staged = TRUE WHERE id = %d", ps.currentTable, ps.currentRowId)
-	_, iter, _, err := ps.queryist.Query(ps.sqlCtx, qry)
-	if err != nil {
-		ps.err = err
-		c.Stop()
-		return
+	sqlCtx             *sql.Context
+	queryist           cli.Queryist
+	tables             []string
+	byHunk             bool
+	changeCounts       map[string]*tablePatchInfo
+	currentTable       string
+	currentTableSchema sql.Schema
+	// hunks are the hunks of the current table, and currentHunk is the index of the one being shown.
+	hunks       [][]sql.Row
+	currentHunk int
+	// toStage are the IDs of the workspace rows of the current table which have been chosen to be staged.
+	toStage []int
+	done    bool
+	err     error
+}
+
+// prompt returns the prompt for the current hunk. Only hunks of more than one row can be split.
+func (ps *patchState) prompt() string {
+	prompt := "Stage this row [y,n,q,a,d,s,?]? "
+	if ps.currentHunk < len(ps.hunks) && len(ps.hunks[ps.currentHunk]) > 1 {
+		prompt = "Stage this hunk [y,n,q,a,d,s,b,?]? "
 	}
+	return color.HiGreenString(prompt)
+}
 
-	// The Update operation doesn't return any rows, but we need to iterate over it to ensure that the update
-	// is made when the queryist we are using for a local query engine.
-	for {
-		_, err = iter.Next(ps.sqlCtx)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			ps.err = err
-			c.Stop()
-			return
-		}
+// withPrompt wraps a command so that the prompt is updated for the hunk shown after it runs.
+func (ps *patchState) withPrompt(f func(c *ishell.Context)) func(c *ishell.Context) {
+	return func(c *ishell.Context) {
+		f(c)
+		c.SetPrompt(ps.prompt())
 	}
-	err = iter.Close(ps.sqlCtx)
-	if err != nil {
-		ps.err = err
+}
+
+// stop stops the shell. The context is nil if the shell has not been started yet.
+func (ps *patchState) stop(c *ishell.Context) {
+	ps.done = true
+	if c != nil {
 		c.Stop()
-		return
 	}
+}
 
-	// Move to the next row. Note that when we stage the current row, the sequence ID of the row will change. This is OK
-	// since staged rows are always earlier in the sequence than unstaged rows.
-	ps.currentRowId++
-	if ps.currentRowId <= ps.currentTableLastRowId {
-		ps.setCurrentRowState(c)
-	} else {
-		ps.nextTable(c)
-	}
+// fail records |err| and stops the shell.
+func (ps *patchState) fail(c *ishell.Context, err error) {
+	ps.err = err
+	ps.stop(c)
 }
 
-// skipCurrentChange does not stage the current change. "n" command.
-func (ps *patchState) skipCurrentChange(c *ishell.Context) {
-	ps.currentRowId++
-	if ps.currentRowId <= ps.currentTableLastRowId {
-		ps.setCurrentRowState(c)
-	} else {
-		ps.nextTable(c)
-	}
+// stageCurrentHunk stages the current hunk. "y" command.
+func (ps *patchState) stageCurrentHunk(c *ishell.Context) {
+	ps.chooseHunk(ps.currentHunk)
+	ps.nextHunk(c)
 }
 
-// skipRemainingInTable skips the current table. "d" command.
+// skipCurrentHunk does not stage the current hunk. "n" command.
+func (ps *patchState) skipCurrentHunk(c *ishell.Context) {
+	ps.nextHunk(c)
+}
+
+// skipRemainingInTable skips the rest of the current table. "d" command.
 func (ps *patchState) skipRemainingInTable(c *ishell.Context) {
 	ps.nextTable(c)
 }
 
-// addRemainingInTable adds all changes in the current table. "a" command.
+// addRemainingInTable stages the current hunk and all later hunks in the current table. "a" command.
 func (ps *patchState) addRemainingInTable(c *ishell.Context) {
-	// grab the row id.
-	id, err := coerceToInt(ps.currentRow[0])
-	if err != nil {
-		ps.err = err
-		c.Stop()
-		return
+	for i := ps.currentHunk; i < len(ps.hunks); i++ {
+		ps.chooseHunk(i)
 	}
+	ps.nextTable(c)
+}
 
-	qry := fmt.Sprintf("UPDATE dolt_workspace_%s SET staged = TRUE WHERE id >= %d", ps.currentTable, id)
-	_, iter, _, err := ps.queryist.Query(ps.sqlCtx, qry)
-	if err != nil {
-		ps.err = err
-		c.Stop()
+// splitCurrentHunk splits the current hunk into hunks of a single row, and shows the first of them. "b" command.
+func (ps *patchState) splitCurrentHunk(c *ishell.Context) {
+	hunk := ps.hunks[ps.currentHunk]
+	if len(hunk) == 1 {
+		cli.Println(color.RedString("Sorry, cannot split this hunk"))
 		return
 	}
 
-	for {
-		_, err = iter.Next(ps.sqlCtx)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			ps.err = err
-			c.Stop()
-			return
-		}
+	split := make([][]sql.Row, len(hunk))
+	for i, row := range hunk {
+		split[i] = []sql.Row{row}
 	}
-	err = iter.Close(ps.sqlCtx)
-	if err != nil {
-		ps.err = err
-		c.Stop()
+	ps.hunks = slices.Replace(ps.hunks, ps.currentHunk, ps.currentHunk+1, split...)
+	cli.Println(color.CyanString("Split into %d hunks.", len(split)))
+	ps.showCurrentHunk(c)
+}
+
+// quit stages the hunks chosen in the current table, then stops the shell. "q" command.
+func (ps *patchState) quit(c *ishell.Context) {
+	if err := ps.stageChosen(); err != nil {
+		ps.fail(c, err)
 		return
 	}
-	ps.nextTable(c)
+	ps.stop(c)
 }
 
-// reset resets the state to the beginning of the workflow. This is done by querying the dolt_workspace_* tables for
-// the details required for the patch workflow, then reseting the appropriate state variables.
+// reset stages the hunks chosen in the current table, then resets the state to the beginning of the workflow. This
+// is done by querying the dolt_workspace_* tables for the details required for the patch workflow, then reseting the
+// appropriate state variables. "s" command.
 func (ps *patchState) reset(c *ishell.Context) {
+	if err := ps.stageChosen(); err != nil {
+		ps.fail(c, err)
+		return
+	}
+
 	changeCounts, err := queryForUnstagedChanges(ps.sqlCtx, ps.queryist, ps.tables)
 	if err != nil {
-		ps.err = err
-		if c != nil {
-			c.Stop()
-		}
+		ps.fail(c, err)
+		return
 	}
 	ps.changeCounts = changeCounts
 
@@ -519,37 +685,58 @@ func (ps *patchState) reset(c *ishell.Context) {
 	ps.nextTable(c)
 }
 
-// setCurrentRowState sets the current row state. This has the side effect of printing the current row.
-func (ps *patchState) setCurrentRowState(c *ishell.Context) {
-	if ps.currentRowId > ps.currentTableLastRowId {
-		ps.nextTable(c)
-		return
+// chooseHunk marks the hunk at index |i| of the current table to be staged.
+func (ps *patchState) chooseHunk(i int) {
+	for _, row := range ps.hunks[i] {
+		id, err := coerceToInt(row[0])
+		if err != nil {
+			ps.err = err
+			return
+		}
+		ps.toStage = append(ps.toStage, id)
 	}
+}
 
-	newRow, err := queryForSingleChange(ps.sqlCtx, ps.queryist, ps.currentTable, ps.currentRowId)
-	if err != nil {
-		ps.err = err
-		if c != nil {
-			c.Stop()
-		}
-		return
+// stageChosen stages the hunks chosen in the current table.
+func (ps *patchState) stageChosen() error {
+	if ps.err != nil {
+		return ps.err
 	}
+	toStage := ps.toStage
+	ps.toStage = nil
+	return stageChanges(ps.sqlCtx, ps.queryist, ps.currentTable, toStage)
+}
 
-	ps.currentRow = newRow
+// nextHunk moves the state to the next hunk of the current table, and prints it. If there are no more hunks in the
+// current table, the state moves to the next table.
+func (ps *patchState) nextHunk(c *ishell.Context) {
+	ps.currentHunk++
+	if ps.currentHunk < len(ps.hunks) {
+		ps.showCurrentHunk(c)
+	} else {
+		ps.nextTable(c)
+	}
+}
 
-	err = printSingleChange(ps.sqlCtx, ps.currentRow, ps.currentTableSchema)
+// showCurrentHunk prints the current hunk.
+func (ps *patchState) showCurrentHunk(c *ishell.Context) {
+	err := printHunk(ps.sqlCtx, ps.hunks[ps.currentHunk], ps.currentTableSchema)
 	if err != nil {
-		ps.err = err
-		if c != nil {
-			c.Stop()
-		}
+		ps.fail(c, err)
 	}
 }
 
-// nextTable moves the state to work on the next table. Also prints the header for the table, and as a result
-// of calling ps.setCurrentRowState, the first row will be printed.
+// nextTable stages the hunks chosen in the current table, then moves the state to work on the next table. Also prints
+// the header for the table, and its first hunk.
 // If there are no more tables waiting to be processed, the shell will be stopped (no error).
 func (ps *patchState) nextTable(c *ishell.Context) {
+	if ps.currentTable != "" {
+		if err := ps.stageChosen(); err != nil {
+			ps.fail(c, err)
+			return
+		}
+	}
+
 	tblIdx := -1
 	if ps.currentTable != "" {
 		// The currentTable is always in the tables slice. No need to check if == -1.
@@ -565,18 +752,25 @@ func (ps *patchState) nextTable(c *ishell.Context) {
 			return
 		}
 
-		changes := ps.changeCounts[ps.currentTable]
-		ps.currentRowId = changes.firstId
-		ps.currentTableLastRowId = changes.lastId
-		ps.currentTableSchema = changes.schema
+		ps.currentTableSchema = ps.changeCounts[ps.currentTable].schema
+		hunks, err := queryForHunks(ps.sqlCtx, ps.queryist, ps.currentTable, ps.currentTableSchema, ps.byHunk)
+		if err != nil {
+			ps.fail(c, err)
+			return
+		}
+		ps.hunks = hunks
+		ps.currentHunk = 0
+		if len(ps.hunks) == 0 {
+			ps.nextTable(c)
+			return
+		}
 
 		cli.Printf("%s", tableHeader(ps.currentTable))
 
-		ps.setCurrentRowState(c)
+		ps.showCurrentHunk(c)
 	} else {
-		if c != nil {
-			c.Stop()
-		}
+		ps.currentTable = ""
+		ps.stop(c)
 	}
 }
 
@@ -598,8 +792,8 @@ func tableHeader(tableName string) string {
 
 // newState returns a new patchState for the given tables. This is the starting point for the patch workflow,
 // and is reset with a nil ishell.Context. The nil context is allowed because the shell has not been started yet.
-func newState(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) (*patchState, error) {
-	ans := &patchState{sqlCtx: sqlCtx, queryist: queryist, tables: tables}
+func newState(sqlCtx *sql.Context, queryist cli.Queryist, tables []string, byHunk bool) (*patchState, error) {
+	ans := &patchState{sqlCtx: sqlCtx, queryist: queryist, tables: tables, byHunk: byHunk}
 	ans.reset(nil)
 
 	if ans.err != nil {
@@ -608,26 +802,33 @@ func newState(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) (*pat
 	return ans, nil
 }
 
-func printSingleChange(sqlCtx *sql.Context, workspaceRow sql.Row, schema sql.Schema) (err error) {
-	writer := tabular.NewFixedWidthDiffTableWriter(schema, iohelp.NopWrCloser(cli.CliOut), len(workspaceRow)/2)
+// printHunk prints the changes of a hunk as a single table. Modified rows are printed as the old and new row, with
+// the changed cells highlighted.
+func printHunk(sqlCtx *sql.Context, hunk []sql.Row, schema sql.Schema) (err error) {
+	writer := tabular.NewFixedWidthDiffTableWriter(schema, iohelp.NopWrCloser(cli.CliOut), 2*len(hunk))
 	defer writer.Close(sqlCtx.Context)
 
-	toRow := workspaceRow[3 : 3+len(schema)]
-	fromRow := workspaceRow[3+len(schema):]
-
-	diffType := workspaceRow[2].(string)
-	switch diffType {
-	case "added":
-		err = writer.WriteRow(sqlCtx.Context, toRow, diff.Added, colDiffType(diff.Added, len(toRow)))
-	case "modified":
-		err = writer.WriteCombinedRow(sqlCtx.Context, fromRow, toRow, diff.ModeContext)
-	case "removed":
-		err = writer.WriteRow(sqlCtx.Context, fromRow, diff.Removed, colDiffType(diff.Removed, len(fromRow)))
-	default:
-		err = errors.New(fmt.Sprintf("Unexpected diff type: %s", diffType))
+	for _, workspaceRow := range hunk {
+		toRow := workspaceRow[3 : 3+len(schema)]
+		fromRow := workspaceRow[3+len(schema):]
+
+		diffType := workspaceRow[2].(string)
+		switch diffType {
+		case "added":
+			err = writer.WriteRow(sqlCtx.Context, toRow, diff.Added, colDiffType(diff.Added, len(toRow)))
+		case "modified":
+			err = writer.WriteCombinedRow(sqlCtx.Context, fromRow, toRow, diff.ModeContext)
+		case "removed":
+			err = writer.WriteRow(sqlCtx.Context, fromRow, diff.Removed, colDiffType(diff.Removed, len(fromRow)))
+		default:
+			err = errors.New(fmt.Sprintf("Unexpected diff type: %s", diffType))
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	return err
+	return nil
 }
 
 // reconstructSchema takes the workspace schema and returns the schema of the user table. There is probably
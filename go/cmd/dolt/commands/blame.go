@@ -19,7 +19,8 @@ import (
 	"fmt"
 	"regexp"
 
-	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
@@ -32,14 +33,19 @@ import (
 )
 
 const (
-	blameQueryTemplate = "SELECT * FROM dolt_blame_%s AS OF '%s'"
+	blameQueryTemplate      = "SELECT * FROM dolt_blame_%s AS OF '%s'"
+	blameCellsQueryTemplate = "SELECT * FROM dolt_blame_cells(?, ?)"
+
+	cellsFlag = "cells"
 )
 
 var blameDocs = cli.CommandDocumentationContent{
 	ShortDesc: `Show what revision and author last modified each row of a table`,
-	LongDesc:  `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.`,
+	LongDesc: `Annotates each row in the given table with information from the revision which last modified the row. Optionally, start annotating from the given revision.
+
+With {{.EmphasisLeft}}--cells{{.EmphasisRight}}, annotates each column of each row with information from the revision which last modified that column's value.`,
 	Synopsis: []string{
-		`[{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
+		`[--cells] [{{.LessThan}}rev{{.GreaterThan}}] {{.LessThan}}tablename{{.GreaterThan}}`,
 	},
 }
 
@@ -62,6 +68,7 @@ func (cmd BlameCmd) Docs() *cli.CommandDocumentation {
 
 func (cmd BlameCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 2)
+	ap.SupportsFlag(cellsFlag, "", "Annotate each column of each row with the revision which last modified its value.")
 	return ap
 }
 
@@ -91,6 +98,8 @@ func (cmd BlameCmd) EventType() eventsapi.ClientEventType {
 // changed between the commits. If so, mark it with `new` as the blame origin and continue to the next node without blame.
 //
 // When all nodes have blame information, stop iterating through commits and print the blame graph.
+//
+// With --cells, the same walk is done by the dolt_blame_cells table function for each column of each row.
 // Exec executes the command
 func (cmd BlameCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
 	ap := cmd.ArgParser()
@@ -111,20 +120,26 @@ func (cmd BlameCmd) Exec(ctx context.Context, commandStr string, args []string,
 		defer closeFunc()
 	}
 
-	var schema sql.Schema
-	var ri sql.RowIter
-	if apr.NArg() == 1 {
-		schema, ri, _, err = queryist.Query(sqlCtx, fmt.Sprintf(blameQueryTemplate, apr.Arg(0), "HEAD"))
-	} else {
+	ref, tableName := "HEAD", apr.Arg(0)
+	if apr.NArg() == 2 {
 		// validate input
-		ref := apr.Arg(0)
+		ref, tableName = apr.Arg(0), apr.Arg(1)
 		if !ref2.IsValidTagName(ref) && !doltdb.IsValidCommitHash(ref) && !isValidHeadRef(ref) {
 			iohelp.WriteLine(cli.CliOut, "Invalid reference provided")
 			return 1
 		}
+	}
 
-		schema, ri, _, err = queryist.Query(sqlCtx, fmt.Sprintf(blameQueryTemplate, apr.Arg(1), apr.Arg(0)))
+	query := fmt.Sprintf(blameQueryTemplate, tableName, ref)
+	if apr.Contains(cellsFlag) {
+		query, err = dbr.InterpolateForDialect(blameCellsQueryTemplate, []interface{}{tableName, ref}, dialect.MySQL)
+		if err != nil {
+			iohelp.WriteLine(cli.CliOut, err.Error())
+			return 1
+		}
 	}
+
+	schema, ri, _, err := queryist.Query(sqlCtx, query)
 	if err != nil {
 		iohelp.WriteLine(cli.CliOut, err.Error())
 		return 1
@@ -38,6 +38,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
@@ -72,6 +73,7 @@ const (
 	MergeBase    = "merge-base"
 	DiffMode     = "diff-mode"
 	ReverseFlag  = "reverse"
+	FindRenames  = "find-renames"
 )
 
 var diffDocs = cli.CommandDocumentationContent{
@@ -98,6 +100,8 @@ The diffs displayed can be limited to show the first N by providing the paramete
 
 To filter which data rows are displayed, use {{.EmphasisLeft}}--where <SQL expression>{{.EmphasisRight}}. Table column names in the filter expression must be prefixed with {{.EmphasisLeft}}from_{{.EmphasisRight}} or {{.EmphasisLeft}}to_{{.EmphasisRight}}, e.g. {{.EmphasisLeft}}to_COLUMN_NAME > 100{{.EmphasisRight}} or {{.EmphasisLeft}}from_COLUMN_NAME + to_COLUMN_NAME = 0{{.EmphasisRight}}.
 
+Tables and columns are matched between revisions by their tags, which are preserved by {{.EmphasisLeft}}RENAME TABLE{{.EmphasisRight}} and {{.EmphasisLeft}}RENAME COLUMN{{.EmphasisRight}}. With {{.EmphasisLeft}}--find-renames{{.EmphasisRight}}, a table that was dropped and recreated under a new name is shown as a rename when its schema and data are similar enough to the dropped table, and columns that were dropped and recreated are matched with the columns they replaced.
+
 The {{.EmphasisLeft}}--diff-mode{{.EmphasisRight}} argument controls how modified rows are presented when the format output is set to {{.EmphasisLeft}}tabular{{.EmphasisRight}}. When set to {{.EmphasisLeft}}row{{.EmphasisRight}}, modified rows are presented as old and new rows. When set to {{.EmphasisLeft}}line{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented using "+" and "-" within the column. When set to {{.EmphasisLeft}}in-place{{.EmphasisRight}}, modified rows are presented as a single row, and changes are presented side-by-side with a color distinction (requires a color-enabled terminal). When set to {{.EmphasisLeft}}context{{.EmphasisRight}}, rows that contain at least one column that spans multiple lines uses {{.EmphasisLeft}}line{{.EmphasisRight}}, while all other rows use {{.EmphasisLeft}}row{{.EmphasisRight}}. The default value is {{.EmphasisLeft}}context{{.EmphasisRight}}.
 `,
 	Synopsis: []string{
@@ -123,7 +127,8 @@ type diffDatasets struct {
 type diffArgs struct {
 	*diffDisplaySettings
 	*diffDatasets
-	tableSet *set.StrSet
+	tableSet    *set.StrSet
+	findRenames bool
 }
 
 type diffStatistics struct {
@@ -179,6 +184,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(DiffMode, "", "diff mode", "Determines how to display modified rows with tabular output. Valid values are row, line, in-place, context. Defaults to context.")
 	ap.SupportsFlag(ReverseFlag, "R", "Reverses the direction of the diff.")
 	ap.SupportsFlag(NameOnlyFlag, "", "Only shows table names.")
+	ap.SupportsFlag(FindRenames, "", "Detect tables and columns that were dropped and recreated under a new name.")
 	return ap
 }
 
@@ -287,6 +293,7 @@ func parseDiffDisplaySettings(apr *argparser.ArgParseResults) *diffDisplaySettin
 func parseDiffArgs(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults) (*diffArgs, error) {
 	dArgs := &diffArgs{
 		diffDisplaySettings: parseDiffDisplaySettings(apr),
+		findRenames:         apr.Contains(FindRenames),
 	}
 
 	staged := apr.Contains(cli.StagedFlag) || apr.Contains(cli.CachedFlag)
@@ -611,8 +618,14 @@ func printDiffSummary(ctx context.Context, diffSummaries []diff.TableDeltaSummar
 	return nil
 }
 
-func getDeltasBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fromRef, toRef string) ([]diff.TableDeltaSummary, error) {
-	diffSummaries, err := getDiffSummariesBetweenRefs(queryist, sqlCtx, fromRef, toRef)
+func getDeltasBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fromRef, toRef string, findRenames bool) ([]diff.TableDeltaSummary, error) {
+	var diffSummaries []diff.TableDeltaSummary
+	var err error
+	if findRenames {
+		diffSummaries, err = getDiffSummariesBetweenRefs(queryist, sqlCtx, fromRef, toRef, "--"+FindRenames)
+	} else {
+		diffSummaries, err = getDiffSummariesBetweenRefs(queryist, sqlCtx, fromRef, toRef)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -628,6 +641,14 @@ func getDeltasBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fromRef, t
 	for _, schemaSummary := range schemaSummaries {
 		deltaExists := false
 		for i, summary := range allSummaries {
+			if summary.IsRename() && (schemaSummary.IsAdd() && schemaSummary.ToTableName == summary.ToTableName ||
+				schemaSummary.IsDrop() && schemaSummary.FromTableName == summary.FromTableName) {
+				// a detected rename of a table that was dropped and recreated
+				deltaExists = true
+				allSummaries[i].SchemaChange = true
+				allSummaries[i].AlterStmts = []string{sqlfmt.RenameTableStmt(summary.FromTableName.Name, summary.ToTableName.Name)}
+				break
+			}
 			deltaExists = summary.FromTableName == schemaSummary.FromTableName && summary.ToTableName == schemaSummary.ToTableName
 			if deltaExists {
 				existingSummary := allSummaries[i]
@@ -714,8 +735,16 @@ func getSchemaDiffSummariesBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Contex
 	return summaries, nil
 }
 
-func getDiffSummariesBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fromRef, toRef string) ([]diff.TableDeltaSummary, error) {
-	q, err := dbr.InterpolateForDialect("select * from dolt_diff_summary(?, ?)", []interface{}{fromRef, toRef}, dialect.MySQL)
+func getDiffSummariesBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fromRef, toRef string, options ...string) ([]diff.TableDeltaSummary, error) {
+	query := "select * from dolt_diff_summary(?, ?" + strings.Repeat(", ?", len(options)) + ")"
+	params := []interface{}{fromRef, toRef}
+	for _, opt := range options {
+		params = append(params, opt)
+	}
+	q, err := dbr.InterpolateForDialect(query, params, dialect.MySQL)
+	if err != nil {
+		return nil, fmt.Errorf("error: unable to interpolate query: %w", err)
+	}
 	dataDiffRows, err := GetRowsForSql(queryist, sqlCtx, q)
 	if err != nil {
 		return nil, fmt.Errorf("error: unable to get diff summary from %s to %s: %w", fromRef, toRef, err)
@@ -759,7 +788,7 @@ func getDiffSummariesBetweenRefs(queryist cli.Queryist, sqlCtx *sql.Context, fro
 func diffUserTables(queryist cli.Queryist, sqlCtx *sql.Context, dArgs *diffArgs) errhand.VerboseError {
 	var err error
 
-	deltas, err := getDeltasBetweenRefs(queryist, sqlCtx, dArgs.fromRef, dArgs.toRef)
+	deltas, err := getDeltasBetweenRefs(queryist, sqlCtx, dArgs.fromRef, dArgs.toRef, dArgs.findRenames)
 	if err != nil {
 		return errhand.BuildDError("error: unable to get diff summary").AddCause(err).Build()
 	}
@@ -1465,6 +1494,10 @@ func diffRows(
 		params = append(params, dbr.I(col))
 	}
 	params = append(params, dbr.I("diff_type"), dArgs.fromRef, dArgs.toRef, tableName)
+	if dArgs.findRenames {
+		query = fmt.Sprintf("select %s ? from dolt_diff(?, ?, ?, ?)", format)
+		params = append(params, "--"+FindRenames)
+	}
 
 	if len(dArgs.where) > 0 {
 		query += " where ?"
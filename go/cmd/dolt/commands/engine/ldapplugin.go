@@ -0,0 +1,492 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+// LDAPAuthPluginName is the auth plugin that accounts authenticated against an LDAP directory are created with, e.g.
+// CREATE USER alice IDENTIFIED WITH authentication_dolt_ldap. An account may give its DN as the identity, as in
+// IDENTIFIED WITH authentication_dolt_ldap AS 'uid=alice,ou=people,dc=example,dc=com', which takes precedence over the
+// user_dn_template and user search of the server config.
+const LDAPAuthPluginName = "authentication_dolt_ldap"
+
+// ldapConn is the subset of *ldap.Conn used by the LDAP plugin.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close() error
+}
+
+type ldapDialFunc func(config servercfg.LDAPConfig) (ldapConn, error)
+
+func dialLDAP(config servercfg.LDAPConfig) (ldapConn, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify()}
+	if u, err := url.Parse(config.URL()); err == nil {
+		tlsConfig.ServerName = u.Hostname()
+	}
+
+	conn, err := ldap.DialURL(config.URL(), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, err
+	}
+	if config.StartTLS() {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// ldapLookup is the cached result of looking up a user in the directory.
+type ldapLookup struct {
+	dn      string
+	groups  []string
+	expires time.Time
+}
+
+// ldapGrants are the roles and branch permissions the plugin has granted to an account.
+type ldapGrants struct {
+	roles    []mysql_db.RoleEdgesPrimaryKey
+	branches map[ldapBranch]branch_control.Permissions
+}
+
+type ldapBranch struct {
+	database string
+	branch   string
+}
+
+// ldapLogin is an account which has authenticated, but has not yet had its group mappings applied.
+type ldapLogin struct {
+	user   string
+	host   string
+	groups []string
+}
+
+// LDAPAuthPlugin authenticates accounts created with the authentication_dolt_ldap plugin by binding to an LDAP
+// directory as the user, either at a DN built from a template or at the DN found by searching the directory. The
+// members of configured LDAP groups are granted roles and dolt_branch_control entries when they connect. Grants made
+// this way only live in memory, and are revoked on a later login if the user has left the group. Roles and branch
+// permissions which the account was given outside of LDAP are never changed.
+type LDAPAuthPlugin struct {
+	config servercfg.LDAPConfig
+	db     *mysql_db.MySQLDb
+	bc     *branch_control.Controller
+	dial   ldapDialFunc
+	now    func() time.Time
+
+	mu      sync.Mutex
+	lookups map[string]ldapLookup
+	logins  map[string]ldapLogin // account key -> the most recent login of the account
+	granted map[string]ldapGrants
+}
+
+var _ mysql_db.PlaintextAuthPlugin = (*LDAPAuthPlugin)(nil)
+
+func NewLDAPAuthPlugin(config servercfg.LDAPConfig, db *mysql_db.MySQLDb, bc *branch_control.Controller) *LDAPAuthPlugin {
+	return newLDAPAuthPlugin(config, db, bc, dialLDAP)
+}
+
+func newLDAPAuthPlugin(config servercfg.LDAPConfig, db *mysql_db.MySQLDb, bc *branch_control.Controller, dial ldapDialFunc) *LDAPAuthPlugin {
+	return &LDAPAuthPlugin{
+		config:  config,
+		db:      db,
+		bc:      bc,
+		dial:    dial,
+		now:     time.Now,
+		lookups: make(map[string]ldapLookup),
+		logins:  make(map[string]ldapLogin),
+		granted: make(map[string]ldapGrants),
+	}
+}
+
+// Authenticate implements mysql_db.PlaintextAuthPlugin.
+func (p *LDAPAuthPlugin) Authenticate(db *mysql_db.MySQLDb, user string, userEntry *mysql_db.User, pass string) (bool, error) {
+	// A simple bind with an empty password is an unauthenticated bind, which most directories allow
+	if pass == "" {
+		return false, nil
+	}
+
+	conn, err := p.dial(p.config)
+	if err != nil {
+		return false, fmt.Errorf("unable to connect to LDAP server: %w", err)
+	}
+	defer conn.Close()
+
+	key := accountKey(userEntry.User, userEntry.Host)
+	lookup, cached := p.cachedLookup(key)
+	if !cached {
+		if lookup.dn, err = p.userDN(conn, user, userEntry.Identity); err != nil {
+			return false, err
+		}
+	}
+
+	if err = conn.Bind(lookup.dn, pass); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !cached {
+		if lookup.groups, err = p.userGroups(conn, lookup.dn); err != nil {
+			return false, err
+		}
+		p.cacheLookup(key, lookup)
+	}
+
+	logrus.Infof("Authenticated %s with LDAP as %s", user, lookup.dn)
+	p.mu.Lock()
+	p.logins[key] = ldapLogin{user: userEntry.User, host: userEntry.Host, groups: lookup.groups}
+	p.mu.Unlock()
+	return true, nil
+}
+
+func (p *LDAPAuthPlugin) cachedLookup(key string) (ldapLookup, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lookup, ok := p.lookups[key]
+	if !ok || p.now().After(lookup.expires) {
+		return ldapLookup{}, false
+	}
+	return lookup, true
+}
+
+func (p *LDAPAuthPlugin) cacheLookup(key string, lookup ldapLookup) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lookup.expires = p.now().Add(time.Duration(p.config.CacheTTLMillis()) * time.Millisecond)
+	p.lookups[key] = lookup
+}
+
+// bindServiceAccount binds |conn| as the configured service account, if there is one.
+func (p *LDAPAuthPlugin) bindServiceAccount(conn ldapConn) error {
+	if p.config.BindDN() == "" {
+		return nil
+	}
+	if err := conn.Bind(p.config.BindDN(), p.config.BindPassword()); err != nil {
+		return fmt.Errorf("unable to bind to LDAP server as %s: %w", p.config.BindDN(), err)
+	}
+	return nil
+}
+
+// userDN returns the DN that |user| binds as.
+func (p *LDAPAuthPlugin) userDN(conn ldapConn, user, identity string) (string, error) {
+	if identity != "" {
+		return identity, nil
+	}
+	if p.config.UserDNTemplate() != "" {
+		return fmt.Sprintf(p.config.UserDNTemplate(), ldap.EscapeDN(user)), nil
+	}
+
+	if err := p.bindServiceAccount(conn); err != nil {
+		return "", err
+	}
+	res, err := conn.Search(ldap.NewSearchRequest(
+		p.config.UserSearchBase(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf(p.config.UserSearchFilter(), ldap.EscapeFilter(user)), []string{"dn"}, nil))
+	if err != nil {
+		return "", fmt.Errorf("unable to search LDAP for user %s: %w", user, err)
+	}
+	if len(res.Entries) != 1 {
+		return "", fmt.Errorf("expected 1 LDAP entry for user %s, found %d", user, len(res.Entries))
+	}
+	return res.Entries[0].DN, nil
+}
+
+// userGroups returns the names of the groups the user with |dn| is a member of.
+func (p *LDAPAuthPlugin) userGroups(conn ldapConn, dn string) ([]string, error) {
+	if p.config.GroupSearchBase() == "" {
+		return nil, nil
+	}
+
+	if err := p.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+	attr := p.config.GroupNameAttribute()
+	res, err := conn.Search(ldap.NewSearchRequest(
+		p.config.GroupSearchBase(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf(p.config.GroupSearchFilter(), ldap.EscapeFilter(dn)), []string{attr}, nil))
+	if err != nil {
+		return nil, fmt.Errorf("unable to search LDAP for the groups of %s: %w", dn, err)
+	}
+
+	var groups []string
+	for _, entry := range res.Entries {
+		if name := entry.GetAttributeValue(attr); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// WrapSessionBuilder returns a session builder which applies the group mappings of users authenticated by this plugin.
+// This can't be done from Authenticate, which is called while holding a read lock on the MySQLDb.
+func (p *LDAPAuthPlugin) WrapSessionBuilder(sb server.SessionBuilder) server.SessionBuilder {
+	return func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		if key, ok := p.connAccountKey(conn); ok {
+			p.mu.Lock()
+			login, ok := p.logins[key]
+			delete(p.logins, key)
+			p.mu.Unlock()
+
+			if ok {
+				p.applyGroupMappings(login.user, login.host, login.groups)
+			}
+		}
+		return sb(ctx, conn, addr)
+	}
+}
+
+// connAccountKey returns the key of the account that |conn| authenticated as. This is the same account whose entry was
+// given to Authenticate, so a login is only ever applied to a connection of the account that made it, even when
+// several accounts share a user name. The DN and groups of an account don't depend on the connection, so it doesn't
+// matter which of the account's concurrent connections applies a login.
+func (p *LDAPAuthPlugin) connAccountKey(conn *mysql.Conn) (string, bool) {
+	connUser, ok := conn.UserData.(sql.MysqlConnectionUser)
+	if !ok {
+		return "", false
+	}
+	rd := p.db.Reader()
+	defer rd.Close()
+	userEntry := p.db.GetUser(rd, connUser.User, connUser.Host, false)
+	if userEntry == nil || userEntry.Plugin != LDAPAuthPluginName {
+		return "", false
+	}
+	return accountKey(userEntry.User, userEntry.Host), true
+}
+
+// applyGroupMappings grants the account |user|@|host| the roles and branch permissions mapped from |groups|, and
+// revokes those it was previously granted by this plugin which are no longer mapped.
+func (p *LDAPAuthPlugin) applyGroupMappings(user, host string, groups []string) {
+	roles := make(map[string]struct{})
+	branches := make(map[ldapBranch]branch_control.Permissions)
+	for _, m := range p.config.GroupMappings() {
+		if !containsFold(groups, m.Group()) {
+			continue
+		}
+		for _, role := range m.Roles() {
+			roles[role] = struct{}{}
+		}
+		for _, bc := range m.BranchControl() {
+			b := ldapBranch{database: bc.Database(), branch: bc.Branch()}
+			branches[b] |= parseLDAPBranchPermissions(bc.Permissions())
+		}
+	}
+
+	// The MySQLDb is locked before |p.mu|, in the same order as when it's persisted
+	ed := p.db.Editor()
+	key := accountKey(user, host)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	prev := p.granted[key]
+	next := ldapGrants{branches: branches}
+
+	existing := make(map[mysql_db.RoleEdgesPrimaryKey]struct{})
+	for _, edge := range ed.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: host, ToUser: user}) {
+		existing[mysql_db.RoleEdgesPrimaryKey{FromHost: edge.FromHost, FromUser: edge.FromUser, ToHost: edge.ToHost, ToUser: edge.ToUser}] = struct{}{}
+	}
+	for _, pk := range prev.roles {
+		if _, ok := roles[pk.FromUser]; ok {
+			next.roles = append(next.roles, pk)
+			delete(roles, pk.FromUser)
+		} else {
+			ed.RemoveRoleEdge(pk)
+		}
+	}
+	for role := range roles {
+		roleHost, ok := findRole(ed, role)
+		if !ok {
+			logrus.Warnf("LDAP group mapping for %s names role %s, which does not exist", user, role)
+			continue
+		}
+		pk := mysql_db.RoleEdgesPrimaryKey{FromHost: roleHost, FromUser: role, ToHost: host, ToUser: user}
+		if _, ok := existing[pk]; ok {
+			// Granted outside of LDAP, so this plugin must never revoke it
+			continue
+		}
+		ed.PutRoleEdge(&mysql_db.RoleEdge{FromHost: roleHost, FromUser: role, ToHost: host, ToUser: user})
+		next.roles = append(next.roles, pk)
+	}
+	ed.Close()
+
+	if p.bc != nil {
+		p.bc.Access.RWMutex.Lock()
+		for b := range prev.branches {
+			if _, ok := branches[b]; !ok {
+				p.bc.Access.DeleteTransient(b.database, b.branch, user, host)
+			}
+		}
+		for b, perms := range branches {
+			if _, ok := prev.branches[b]; !ok && p.bc.Access.Contains(b.database, b.branch, user, host) {
+				// Added outside of LDAP, so this plugin must never change or remove it
+				delete(next.branches, b)
+				continue
+			}
+			p.bc.Access.InsertTransient(b.database, b.branch, user, host, perms)
+		}
+		p.bc.Access.RWMutex.Unlock()
+	}
+
+	p.granted[key] = next
+}
+
+// WrapPersister returns a persister which leaves out the roles granted by this plugin, so that they only live in
+// memory. A role which is granted again with GRANT while this plugin has it granted becomes an explicit grant, which
+// is persisted and never revoked by this plugin.
+func (p *LDAPAuthPlugin) WrapPersister(persister mysql_db.MySQLDbPersistence) mysql_db.MySQLDbPersistence {
+	return &ldapPersister{MySQLDbPersistence: persister, p: p}
+}
+
+// releaseGrantedRoles stops tracking the roles this plugin has granted that are granted again by |query|, if it's a
+// GRANT statement for roles. The MySQLDb is locked while it's persisted, so the statement is the only record of
+// which edges an administrator has granted.
+func (p *LDAPAuthPlugin) releaseGrantedRoles(query string) {
+	if query == "" {
+		return
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return
+	}
+	grant, ok := stmt.(*sqlparser.GrantRole)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, to := range grant.To {
+		host := to.Host
+		if to.AnyHost || host == "" {
+			host = "%"
+		}
+		key := accountKey(to.Name, host)
+		grants, ok := p.granted[key]
+		if !ok {
+			continue
+		}
+		var kept []mysql_db.RoleEdgesPrimaryKey
+		for _, pk := range grants.roles {
+			if !grantsRole(grant.Roles, pk.FromUser) {
+				kept = append(kept, pk)
+			}
+		}
+		grants.roles = kept
+		p.granted[key] = grants
+	}
+}
+
+// grantsRole returns whether |roles| names the role |name|.
+func grantsRole(roles []sqlparser.AccountName, name string) bool {
+	for _, role := range roles {
+		if role.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// grantedRoles returns the role edges this plugin has granted to every account.
+func (p *LDAPAuthPlugin) grantedRoles() []mysql_db.RoleEdgesPrimaryKey {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var roles []mysql_db.RoleEdgesPrimaryKey
+	for _, grants := range p.granted {
+		roles = append(roles, grants.roles...)
+	}
+	return roles
+}
+
+type ldapPersister struct {
+	mysql_db.MySQLDbPersistence
+	p *LDAPAuthPlugin
+}
+
+// Persist implements mysql_db.MySQLDbPersistence.
+func (lp *ldapPersister) Persist(ctx *sql.Context, data []byte) error {
+	lp.p.releaseGrantedRoles(ctx.Query())
+	roles := lp.p.grantedRoles()
+	if len(roles) == 0 {
+		return lp.MySQLDbPersistence.Persist(ctx, data)
+	}
+
+	// Role edges can't be left out of a MySQLDb as it's serialized, so the data is loaded into a copy which is
+	// serialized again without them
+	scratch := mysql_db.CreateEmptyMySQLDb()
+	if err := scratch.LoadData(ctx, data); err != nil {
+		return err
+	}
+	scratch.SetPersister(lp.MySQLDbPersistence)
+	ed := scratch.Editor()
+	defer ed.Close()
+	for _, pk := range roles {
+		ed.RemoveRoleEdge(pk)
+	}
+	return scratch.Persist(ctx, ed)
+}
+
+// findRole returns the host of the role named |name|.
+func findRole(ed *mysql_db.Editor, name string) (string, bool) {
+	for _, u := range ed.GetUsersByUsername(name) {
+		if u.IsRole {
+			return u.Host, true
+		}
+	}
+	return "", false
+}
+
+func parseLDAPBranchPermissions(perms string) branch_control.Permissions {
+	switch strings.ToLower(perms) {
+	case "admin":
+		return branch_control.Permissions_Admin
+	case "write":
+		return branch_control.Permissions_Write
+	default:
+		return branch_control.Permissions_Read
+	}
+}
+
+func containsFold(strs []string, str string) bool {
+	for _, s := range strs {
+		if strings.EqualFold(s, str) {
+			return true
+		}
+	}
+	return false
+}
+
+func accountKey(user, host string) string {
+	return user + "@" + host
+}
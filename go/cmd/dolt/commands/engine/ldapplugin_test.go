@@ -0,0 +1,332 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+// fakeDirectory is an in-process stand-in for an LDAP server, which understands just the filters the plugin sends.
+type fakeDirectory struct {
+	passwords map[string]string   // dn -> password
+	uids      map[string]string   // uid -> dn
+	groups    map[string][]string // group cn -> member dns
+	searches  int
+}
+
+type fakeLDAPConn struct {
+	dir *fakeDirectory
+}
+
+func (c *fakeLDAPConn) Bind(dn, password string) error {
+	if pw, ok := c.dir.passwords[dn]; !ok || pw != password {
+		return &ldap.Error{Err: errors.New("invalid credentials"), ResultCode: ldap.LDAPResultInvalidCredentials}
+	}
+	return nil
+}
+
+func (c *fakeLDAPConn) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.dir.searches++
+	res := &ldap.SearchResult{}
+	filter := strings.TrimSuffix(req.Filter, ")")
+	switch {
+	case strings.HasPrefix(filter, "(uid="):
+		if dn, ok := c.dir.uids[strings.TrimPrefix(filter, "(uid=")]; ok {
+			res.Entries = append(res.Entries, ldap.NewEntry(dn, nil))
+		}
+	case strings.HasPrefix(filter, "(member="):
+		member := strings.TrimPrefix(filter, "(member=")
+		for cn, members := range c.dir.groups {
+			for _, m := range members {
+				if m == member {
+					res.Entries = append(res.Entries, ldap.NewEntry("cn="+cn+",ou=groups,dc=example,dc=com", map[string][]string{"cn": {cn}}))
+				}
+			}
+		}
+	default:
+		return nil, errors.New("unsupported filter " + req.Filter)
+	}
+	return res, nil
+}
+
+func (c *fakeLDAPConn) Close() error {
+	return nil
+}
+
+const (
+	aliceDN   = "uid=alice,ou=people,dc=example,dc=com"
+	serviceDN = "cn=dolt,dc=example,dc=com"
+)
+
+func newLDAPTestPlugin(t *testing.T, config *servercfg.LDAPYAMLConfig) (*LDAPAuthPlugin, *fakeDirectory) {
+	dir := &fakeDirectory{
+		passwords: map[string]string{aliceDN: "alicepass", serviceDN: "servicepass"},
+		uids:      map[string]string{"alice": aliceDN},
+		groups:    map[string][]string{"dba": {aliceDN}},
+	}
+
+	db := mysql_db.CreateEmptyMySQLDb()
+	ed := db.Editor()
+	ed.PutUser(&mysql_db.User{User: "alice", Host: "%", Plugin: LDAPAuthPluginName, PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.PutUser(&mysql_db.User{User: "admins", Host: "%", IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.PutUser(&mysql_db.User{User: "readers", Host: "%", IsRole: true, PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.Close()
+
+	bc := branch_control.CreateDefaultController(context.Background())
+	return newLDAPAuthPlugin(config, db, bc, func(servercfg.LDAPConfig) (ldapConn, error) {
+		return &fakeLDAPConn{dir: dir}, nil
+	}), dir
+}
+
+func ldapTestConfig() *servercfg.LDAPYAMLConfig {
+	url, bindDN, bindPassword := "ldap://localhost", serviceDN, "servicepass"
+	userBase, groupBase := "ou=people,dc=example,dc=com", "ou=groups,dc=example,dc=com"
+	dba, db, perms := "dba", "mydb", "admin"
+	return &servercfg.LDAPYAMLConfig{
+		URL_:             &url,
+		BindDN_:          &bindDN,
+		BindPassword_:    &bindPassword,
+		UserSearchBase_:  &userBase,
+		GroupSearchBase_: &groupBase,
+		GroupMappings_: []servercfg.LDAPGroupMapping{{
+			Group_:         &dba,
+			Roles_:         []string{"admins", "missing_role"},
+			BranchControl_: []servercfg.LDAPBranchControlConfig{{Database_: &db, Permissions_: &perms}},
+		}},
+	}
+}
+
+func authenticateLDAP(t *testing.T, p *LDAPAuthPlugin, user, pass string) (bool, error) {
+	rd := p.db.Reader()
+	userEntry := p.db.GetUser(rd, user, "%", false)
+	rd.Close()
+	require.NotNil(t, userEntry)
+	return p.Authenticate(p.db, user, userEntry, pass)
+}
+
+// connect builds a session for |user| connecting from 127.0.0.1 through the plugin's session builder.
+func connect(t *testing.T, p *LDAPAuthPlugin, user string) {
+	connectFrom(t, p, user, "127.0.0.1")
+}
+
+func connectFrom(t *testing.T, p *LDAPAuthPlugin, user, host string) {
+	sb := p.WrapSessionBuilder(func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		return nil, nil
+	})
+	conn := &mysql.Conn{User: user, UserData: sql.MysqlConnectionUser{User: user, Host: host}}
+	_, err := sb(context.Background(), conn, "")
+	require.NoError(t, err)
+}
+
+func roleNames(p *LDAPAuthPlugin, user string) []string {
+	rd := p.db.Reader()
+	defer rd.Close()
+	var roles []string
+	for _, edge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: "%", ToUser: user}) {
+		roles = append(roles, edge.FromUser)
+	}
+	return roles
+}
+
+func branchPerms(p *LDAPAuthPlugin, user string) branch_control.Permissions {
+	p.bc.Access.RWMutex.RLock()
+	defer p.bc.Access.RWMutex.RUnlock()
+	_, perms := p.bc.Access.Match("mydb", "main", user, "%")
+	return perms
+}
+
+func TestLDAPAuthSearchThenBind(t *testing.T) {
+	p, dir := newLDAPTestPlugin(t, ldapTestConfig())
+
+	authed, err := authenticateLDAP(t, p, "alice", "wrong")
+	require.NoError(t, err)
+	assert.False(t, authed)
+
+	authed, err = authenticateLDAP(t, p, "alice", "")
+	require.NoError(t, err)
+	assert.False(t, authed)
+
+	authed, err = authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	connect(t, p, "alice")
+	assert.Equal(t, []string{"admins"}, roleNames(p, "alice"))
+	assert.Equal(t, branch_control.Permissions_Admin, branchPerms(p, "alice"))
+
+	// The user's DN and groups are cached, so the directory is only searched again once the cache expires
+	searches := dir.searches
+	authed, err = authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	assert.Equal(t, searches, dir.searches)
+
+	// Leaving the group revokes what it granted on the next login, but not roles granted outside of LDAP
+	dir.groups["dba"] = nil
+	ed := p.db.Editor()
+	ed.PutRoleEdge(&mysql_db.RoleEdge{FromHost: "%", FromUser: "readers", ToHost: "%", ToUser: "alice"})
+	ed.Close()
+	p.now = func() time.Time { return time.Now().Add(time.Hour) }
+	authed, err = authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	assert.Greater(t, dir.searches, searches)
+	connect(t, p, "alice")
+	assert.Equal(t, []string{"readers"}, roleNames(p, "alice"))
+	assert.Equal(t, branch_control.Permissions_Write, branchPerms(p, "alice"))
+}
+
+func TestLDAPAuthSimpleBind(t *testing.T) {
+	config := ldapTestConfig()
+	template := "uid=%s,ou=people,dc=example,dc=com"
+	config.UserSearchBase_ = nil
+	config.UserDNTemplate_ = &template
+	p, _ := newLDAPTestPlugin(t, config)
+
+	authed, err := authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	assert.True(t, authed)
+
+	// An account's identity takes precedence over the template
+	ed := p.db.Editor()
+	ed.PutUser(&mysql_db.User{User: "bob", Host: "%", Plugin: LDAPAuthPluginName, Identity: aliceDN, PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.Close()
+	authed, err = authenticateLDAP(t, p, "bob", "alicepass")
+	require.NoError(t, err)
+	assert.True(t, authed)
+
+	authed, err = authenticateLDAP(t, p, "bob", "bobpass")
+	require.NoError(t, err)
+	assert.False(t, authed)
+}
+
+func TestLDAPAuthLoginsAreAppliedToTheirAccount(t *testing.T) {
+	p, _ := newLDAPTestPlugin(t, ldapTestConfig())
+	ed := p.db.Editor()
+	ed.PutUser(&mysql_db.User{User: "alice", Host: "10.0.0.1", Plugin: "mysql_native_password", PrivilegeSet: mysql_db.NewPrivilegeSet()})
+	ed.Close()
+
+	authed, err := authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+
+	// A connection of the other alice account doesn't pick up the login of alice@%
+	connectFrom(t, p, "alice", "10.0.0.1")
+	assert.Empty(t, roleNames(p, "alice"))
+
+	connect(t, p, "alice")
+	assert.Equal(t, []string{"admins"}, roleNames(p, "alice"))
+}
+
+func TestLDAPAuthGrantsAreNotPersisted(t *testing.T) {
+	p, _ := newLDAPTestPlugin(t, ldapTestConfig())
+	ed := p.db.Editor()
+	ed.PutRoleEdge(&mysql_db.RoleEdge{FromHost: "%", FromUser: "readers", ToHost: "%", ToUser: "alice"})
+	ed.Close()
+
+	// A branch permission given outside of LDAP is neither changed nor revoked by the group mappings
+	p.bc.Access.RWMutex.Lock()
+	p.bc.Access.Insert("mydb", "%", "alice", "%", branch_control.Permissions_Read)
+	binlogRows := len(p.bc.Access.GetBinlog().Rows())
+	p.bc.Access.RWMutex.Unlock()
+
+	authed, err := authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	connect(t, p, "alice")
+	assert.ElementsMatch(t, []string{"admins", "readers"}, roleNames(p, "alice"))
+	assert.Equal(t, branch_control.Permissions_Read, branchPerms(p, "alice"))
+	assert.Len(t, p.bc.Access.GetBinlog().Rows(), binlogRows)
+
+	var persisted []byte
+	p.db.SetPersister(p.WrapPersister(persistFunc(func(ctx *sql.Context, data []byte) error {
+		persisted = data
+		return nil
+	})))
+	ed = p.db.Editor()
+	require.NoError(t, p.db.Persist(sql.NewEmptyContext(), ed))
+	ed.Close()
+
+	loaded := mysql_db.CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(sql.NewEmptyContext(), persisted))
+	rd := loaded.Reader()
+	defer rd.Close()
+	var roles []string
+	for _, edge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: "%", ToUser: "alice"}) {
+		roles = append(roles, edge.FromUser)
+	}
+	assert.Equal(t, []string{"readers"}, roles)
+
+	// The in memory grants are unaffected by persisting
+	assert.ElementsMatch(t, []string{"admins", "readers"}, roleNames(p, "alice"))
+}
+
+func TestLDAPAuthRegrantedRolesArePersisted(t *testing.T) {
+	p, dir := newLDAPTestPlugin(t, ldapTestConfig())
+	var persisted []byte
+	p.db.SetPersister(p.WrapPersister(persistFunc(func(ctx *sql.Context, data []byte) error {
+		persisted = data
+		return nil
+	})))
+
+	authed, err := authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	connect(t, p, "alice")
+	require.Equal(t, []string{"admins"}, roleNames(p, "alice"))
+
+	// An administrator grants the role the plugin already granted, as GRANT does
+	ed := p.db.Editor()
+	ed.PutRoleEdge(&mysql_db.RoleEdge{FromHost: "%", FromUser: "admins", ToHost: "%", ToUser: "alice"})
+	ctx := sql.NewContext(context.Background(), sql.WithQuery("GRANT admins TO alice"))
+	require.NoError(t, p.db.Persist(ctx, ed))
+	ed.Close()
+
+	loaded := mysql_db.CreateEmptyMySQLDb()
+	require.NoError(t, loaded.LoadData(sql.NewEmptyContext(), persisted))
+	rd := loaded.Reader()
+	edges := rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: "%", ToUser: "alice"})
+	rd.Close()
+	require.Len(t, edges, 1)
+	assert.Equal(t, "admins", edges[0].FromUser)
+
+	// The role is no longer revoked when alice leaves the group
+	dir.groups["dba"] = nil
+	p.now = func() time.Time { return time.Now().Add(time.Hour) }
+	authed, err = authenticateLDAP(t, p, "alice", "alicepass")
+	require.NoError(t, err)
+	require.True(t, authed)
+	connect(t, p, "alice")
+	assert.Equal(t, []string{"admins"}, roleNames(p, "alice"))
+}
+
+type persistFunc func(ctx *sql.Context, data []byte) error
+
+func (f persistFunc) Persist(ctx *sql.Context, data []byte) error {
+	return f(ctx, data)
+}
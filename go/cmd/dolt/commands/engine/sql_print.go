@@ -31,6 +31,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/typed/parquet"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/csv"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/sqlexport"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
 	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
 )
@@ -44,6 +45,11 @@ const (
 	FormatNull // used for profiling
 	FormatVertical
 	FormatParquet
+	FormatMarkdown
+	FormatHtml
+	FormatNdjson
+	FormatXml
+	FormatInsert
 )
 
 type PrintSummaryBehavior byte
@@ -105,6 +111,24 @@ func prettyPrintResultsWithSummary(ctx *sql.Context, resultFormat PrintResultFor
 		if err != nil {
 			return err
 		}
+	case FormatMarkdown:
+		wr = newMarkdownRowWriter(iohelp.NopWrCloser(cli.CliOut), sqlSch)
+	case FormatHtml:
+		wr = newHtmlRowWriter(iohelp.NopWrCloser(cli.CliOut), sqlSch)
+	case FormatNdjson:
+		var err error
+		wr, err = json.NewNDJSONSqlWriter(iohelp.NopWrCloser(cli.CliOut), sqlSch)
+		if err != nil {
+			return err
+		}
+	case FormatXml:
+		wr = newXmlRowWriter(iohelp.NopWrCloser(cli.CliOut), sqlSch)
+	case FormatInsert:
+		var err error
+		wr, err = sqlexport.NewSqlResultWriter(iohelp.NopWrCloser(cli.CliOut), sqlSch)
+		if err != nil {
+			return err
+		}
 	}
 
 	numRows, err := writeResultSet(ctx, rowIter, wr)
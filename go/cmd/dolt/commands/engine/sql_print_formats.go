@@ -0,0 +1,252 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+)
+
+// cellStrings returns the string form of each value of |r|, which has the schema |sch|. NULL values are returned as
+// nil.
+func cellStrings(sch sql.Schema, r sql.Row) ([]*string, error) {
+	strs := make([]*string, len(r))
+	for i := range r {
+		if r[i] == nil {
+			continue
+		}
+		str, err := sqlutil.SqlColToStr(sch[i].Type, r[i])
+		if err != nil {
+			return nil, err
+		}
+		strs[i] = &str
+	}
+	return strs, nil
+}
+
+// markdownRowWriter writes a result set as a GitHub flavored markdown table.
+type markdownRowWriter struct {
+	wr            io.WriteCloser
+	sch           sql.Schema
+	headerWritten bool
+}
+
+var _ table.SqlRowWriter = (*markdownRowWriter)(nil)
+
+func newMarkdownRowWriter(wr io.WriteCloser, sch sql.Schema) *markdownRowWriter {
+	return &markdownRowWriter{wr: wr, sch: sch}
+}
+
+// markdownEscaper escapes the characters that would break a markdown table cell.
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "\r\n", "<br>", "\n", "<br>", "\r", "<br>")
+
+func (m *markdownRowWriter) writeLine(cells []string) error {
+	return iohelp.WriteLine(m.wr, "| "+strings.Join(cells, " | ")+" |")
+}
+
+func (m *markdownRowWriter) maybeWriteHeader() error {
+	if m.headerWritten {
+		return nil
+	}
+	m.headerWritten = true
+
+	names := make([]string, len(m.sch))
+	rule := make([]string, len(m.sch))
+	for i, col := range m.sch {
+		names[i] = markdownEscaper.Replace(col.Name)
+		rule[i] = "---"
+	}
+	if err := m.writeLine(names); err != nil {
+		return err
+	}
+	return m.writeLine(rule)
+}
+
+func (m *markdownRowWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	if err := m.maybeWriteHeader(); err != nil {
+		return err
+	}
+
+	strs, err := cellStrings(m.sch, r)
+	if err != nil {
+		return err
+	}
+	cells := make([]string, len(strs))
+	for i, str := range strs {
+		cells[i] = "NULL"
+		if str != nil {
+			cells[i] = markdownEscaper.Replace(*str)
+		}
+	}
+	return m.writeLine(cells)
+}
+
+func (m *markdownRowWriter) Close(ctx context.Context) error {
+	// an empty result set is still printed as a table with a header
+	if err := m.maybeWriteHeader(); err != nil {
+		return err
+	}
+	return m.wr.Close()
+}
+
+// htmlRowWriter writes a result set as an HTML table.
+type htmlRowWriter struct {
+	wr            io.WriteCloser
+	sch           sql.Schema
+	headerWritten bool
+}
+
+var _ table.SqlRowWriter = (*htmlRowWriter)(nil)
+
+func newHtmlRowWriter(wr io.WriteCloser, sch sql.Schema) *htmlRowWriter {
+	return &htmlRowWriter{wr: wr, sch: sch}
+}
+
+func (h *htmlRowWriter) maybeWriteHeader() error {
+	if h.headerWritten {
+		return nil
+	}
+	h.headerWritten = true
+
+	var b strings.Builder
+	b.WriteString("<table>\n<tr>")
+	for _, col := range h.sch {
+		b.WriteString("<th>")
+		b.WriteString(html.EscapeString(col.Name))
+		b.WriteString("</th>")
+	}
+	b.WriteString("</tr>")
+	return iohelp.WriteLine(h.wr, b.String())
+}
+
+func (h *htmlRowWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	if err := h.maybeWriteHeader(); err != nil {
+		return err
+	}
+
+	strs, err := cellStrings(h.sch, r)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("<tr>")
+	for _, str := range strs {
+		b.WriteString("<td>")
+		if str == nil {
+			b.WriteString("NULL")
+		} else {
+			b.WriteString(html.EscapeString(*str))
+		}
+		b.WriteString("</td>")
+	}
+	b.WriteString("</tr>")
+	return iohelp.WriteLine(h.wr, b.String())
+}
+
+func (h *htmlRowWriter) Close(ctx context.Context) error {
+	if err := h.maybeWriteHeader(); err != nil {
+		return err
+	}
+	if err := iohelp.WriteLine(h.wr, "</table>"); err != nil {
+		return err
+	}
+	return h.wr.Close()
+}
+
+// xmlRowWriter writes a result set as XML, in the same layout as the mysql client's --xml option. NULL values are
+// marked with xsi:nil.
+type xmlRowWriter struct {
+	wr            io.WriteCloser
+	sch           sql.Schema
+	headerWritten bool
+}
+
+var _ table.SqlRowWriter = (*xmlRowWriter)(nil)
+
+func newXmlRowWriter(wr io.WriteCloser, sch sql.Schema) *xmlRowWriter {
+	return &xmlRowWriter{wr: wr, sch: sch}
+}
+
+func xmlEscape(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (x *xmlRowWriter) maybeWriteHeader() error {
+	if x.headerWritten {
+		return nil
+	}
+	x.headerWritten = true
+	return iohelp.WriteLine(x.wr, "<?xml version=\"1.0\"?>\n\n<resultset xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\">")
+}
+
+func (x *xmlRowWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	if err := x.maybeWriteHeader(); err != nil {
+		return err
+	}
+
+	strs, err := cellStrings(x.sch, r)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("  <row>\n")
+	for i, str := range strs {
+		name, err := xmlEscape(x.sch[i].Name)
+		if err != nil {
+			return err
+		}
+		b.WriteString("\t<field name=\"")
+		b.WriteString(name)
+		if str == nil {
+			b.WriteString("\" xsi:nil=\"true\" />\n")
+			continue
+		}
+		val, err := xmlEscape(*str)
+		if err != nil {
+			return err
+		}
+		b.WriteString("\">")
+		b.WriteString(val)
+		b.WriteString("</field>\n")
+	}
+	b.WriteString("  </row>")
+	return iohelp.WriteLine(x.wr, b.String())
+}
+
+func (x *xmlRowWriter) Close(ctx context.Context) error {
+	if err := x.maybeWriteHeader(); err != nil {
+		return err
+	}
+	if err := iohelp.WriteLine(x.wr, "</resultset>"); err != nil {
+		return err
+	}
+	return x.wr.Close()
+}
@@ -15,10 +15,17 @@
 package engine
 
 import (
+	"context"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 )
 
 func TestSecondsSince(t *testing.T) {
@@ -38,3 +45,74 @@ func TestSecondsSince(t *testing.T) {
 		require.Equal(t, 1.000, secondsSince(start, stop))
 	})
 }
+
+type stringBuilderCloser struct {
+	strings.Builder
+}
+
+func (*stringBuilderCloser) Close() error {
+	return nil
+}
+
+func TestResultFormatWriters(t *testing.T) {
+	sch := sql.Schema{
+		{Name: "id", Type: types.Int64},
+		{Name: "name", Type: types.LongText, Nullable: true},
+	}
+	rows := []sql.Row{{int64(1), "a|b <c>"}, {int64(2), nil}}
+
+	tests := []struct {
+		name     string
+		newWr    func(wr io.WriteCloser) table.SqlRowWriter
+		rows     []sql.Row
+		expected string
+	}{
+		{
+			name:  "markdown",
+			newWr: func(wr io.WriteCloser) table.SqlRowWriter { return newMarkdownRowWriter(wr, sch) },
+			rows:  rows,
+			expected: "| id | name |\n" +
+				"| --- | --- |\n" +
+				"| 1 | a\\|b <c> |\n" +
+				"| 2 | NULL |\n",
+		},
+		{
+			name:  "markdown with no rows",
+			newWr: func(wr io.WriteCloser) table.SqlRowWriter { return newMarkdownRowWriter(wr, sch) },
+			expected: "| id | name |\n" +
+				"| --- | --- |\n",
+		},
+		{
+			name:  "html",
+			newWr: func(wr io.WriteCloser) table.SqlRowWriter { return newHtmlRowWriter(wr, sch) },
+			rows:  rows,
+			expected: "<table>\n" +
+				"<tr><th>id</th><th>name</th></tr>\n" +
+				"<tr><td>1</td><td>a|b &lt;c&gt;</td></tr>\n" +
+				"<tr><td>2</td><td>NULL</td></tr>\n" +
+				"</table>\n",
+		},
+		{
+			name:  "xml",
+			newWr: func(wr io.WriteCloser) table.SqlRowWriter { return newXmlRowWriter(wr, sch) },
+			rows:  rows,
+			expected: "<?xml version=\"1.0\"?>\n\n" +
+				"<resultset xmlns:xsi=\"http://www.w3.org/2001/XMLSchema-instance\">\n" +
+				"  <row>\n\t<field name=\"id\">1</field>\n\t<field name=\"name\">a|b &lt;c&gt;</field>\n  </row>\n" +
+				"  <row>\n\t<field name=\"id\">2</field>\n\t<field name=\"name\" xsi:nil=\"true\" />\n  </row>\n" +
+				"</resultset>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb stringBuilderCloser
+			wr := tt.newWr(&sb)
+			for _, r := range tt.rows {
+				require.NoError(t, wr.WriteSqlRow(context.Background(), r))
+			}
+			require.NoError(t, wr.Close(context.Background()))
+			require.Equal(t, tt.expected, sb.String())
+		})
+	}
+}
@@ -55,6 +55,7 @@ type SqlEngine struct {
 	contextFactory contextFactory
 	dsessFactory   sessionFactory
 	engine         *gms.Engine
+	ldapPlugin     *LDAPAuthPlugin
 }
 
 type sessionFactory func(mysqlSess *sql.BaseSession, pro sql.DatabaseProvider) (*dsess.DoltSession, error)
@@ -75,6 +76,7 @@ type SqlEngineConfig struct {
 	DoltTransactionCommit   bool
 	Bulk                    bool
 	JwksConfig              []servercfg.JwksConfig
+	LDAPConfig              servercfg.LDAPConfig
 	SystemVariables         SystemVariables
 	ClusterController       *cluster.Controller
 	BinlogReplicaController binlogreplication.BinlogReplicaController
@@ -178,11 +180,17 @@ func NewSqlEngine(
 	config.ClusterController.HookBranchControlPersistence(bcController, mrEnv.FileSystem())
 
 	// Setup the engine.
-	engine.Analyzer.Catalog.MySQLDb.SetPersister(persister)
-
-	engine.Analyzer.Catalog.MySQLDb.SetPlugins(map[string]mysql_db.PlaintextAuthPlugin{
+	plugins := map[string]mysql_db.PlaintextAuthPlugin{
 		"authentication_dolt_jwt": NewAuthenticateDoltJWTPlugin(config.JwksConfig),
-	})
+	}
+	if config.LDAPConfig != nil {
+		sqlEngine.ldapPlugin = NewLDAPAuthPlugin(config.LDAPConfig, engine.Analyzer.Catalog.MySQLDb, bcController)
+		plugins[LDAPAuthPluginName] = sqlEngine.ldapPlugin
+		engine.Analyzer.Catalog.MySQLDb.SetPersister(sqlEngine.ldapPlugin.WrapPersister(persister))
+	} else {
+		engine.Analyzer.Catalog.MySQLDb.SetPersister(persister)
+	}
+	engine.Analyzer.Catalog.MySQLDb.SetPlugins(plugins)
 
 	statsPro := statspro.NewProvider(pro, statsnoms.NewNomsStatsFactory(mrEnv.RemoteDialProvider()))
 	engine.Analyzer.Catalog.StatsProvider = statsPro
@@ -309,6 +317,12 @@ func (se *SqlEngine) Analyze(ctx *sql.Context, n sql.Node, qFlags *sql.QueryFlag
 	return se.engine.Analyzer.Analyze(ctx, n, nil, qFlags)
 }
 
+// LDAPAuthPlugin returns the plugin which authenticates users against an LDAP directory, or nil if LDAP
+// authentication is not configured.
+func (se *SqlEngine) LDAPAuthPlugin() *LDAPAuthPlugin {
+	return se.ldapPlugin
+}
+
 func (se *SqlEngine) GetUnderlyingEngine() *gms.Engine {
 	return se.engine
 }
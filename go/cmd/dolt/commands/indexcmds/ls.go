@@ -109,7 +109,21 @@ func (cmd LsCmd) Exec(ctx context.Context, commandStr string, args []string, dEn
 				output = append(output, fmt.Sprintf("%s:", tableName))
 			}
 			for _, index := range sch.Indexes().AllIndexes() {
-				output = append(output, fmt.Sprintf("    %s(%s)", index.Name(), strings.Join(index.ColumnNames(), ", ")))
+				line := fmt.Sprintf("    %s(%s)", index.Name(), strings.Join(index.ColumnNames(), ", "))
+				if index.IsColumnar() {
+					line += " columnar"
+				}
+				if tags := index.IncludedColumnTags(); len(tags) > 0 {
+					included := make([]string, len(tags))
+					for i, tag := range tags {
+						included[i] = sch.GetAllCols().TagToCol[tag].Name
+					}
+					line += fmt.Sprintf(" include(%s)", strings.Join(included, ", "))
+				}
+				if index.Predicate() != "" {
+					line += " where " + index.Predicate()
+				}
+				output = append(output, line)
 				if index.IsFullText() {
 					props := index.FullTextProperties()
 					output = append(output, fmt.Sprintf("        %s", props.ConfigTable))
@@ -17,6 +17,7 @@ package commands
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
@@ -57,9 +58,13 @@ The command takes options to control what is shown and how.
 	
 {{.EmphasisLeft}}dolt log <revisionB>...<revisionA>{{.EmphasisRight}}
 {{.EmphasisLeft}}dolt log <revisionA> <revisionB> --not $(dolt merge-base <revisionA> <revisionB>){{.EmphasisRight}}
-  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.`,
+  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.
+
+{{.EmphasisLeft}}dolt log [<revision>] --row <table> --pk <column>=<value>[,<column>=<value>...]{{.EmphasisRight}}
+  Lists only the commits reachable from revision that changed the row of table with the given primary key, showing the row before and after each change. A merge commit is only listed if the row differs from the row in each of its parents.`,
 	Synopsis: []string{
 		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] [{{.LessThan}}revision-range{{.GreaterThan}}] [[--] {{.LessThan}}table{{.GreaterThan}}]`,
+		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] [{{.LessThan}}revision{{.GreaterThan}}] --row {{.LessThan}}table{{.GreaterThan}} --pk {{.LessThan}}column{{.GreaterThan}}={{.LessThan}}value{{.GreaterThan}}[,...]`,
 	},
 }
 
@@ -113,6 +118,10 @@ func (cmd LogCmd) logWithLoggerFunc(ctx context.Context, commandStr string, args
 		defer closeFunc()
 	}
 
+	if apr.Contains(cli.RowFlag) {
+		return handleErrAndExit(logRow(apr, queryist, sqlCtx))
+	}
+
 	query, err := constructInterpolatedDoltLogQuery(apr, queryist, sqlCtx)
 	if err != nil {
 		return handleErrAndExit(err)
@@ -300,6 +309,97 @@ func logCommits(apr *argparser.ArgParseResults, commitHashes []sql.Row, queryist
 	return logToStdOut(apr, commitsInfo, sqlCtx, queryist)
 }
 
+// logRow prints the commits that changed the row given by --row and --pk, with the row before and after each change
+func logRow(apr *argparser.ArgParseResults, queryist cli.Queryist, sqlCtx *sql.Context) error {
+	if apr.Contains(cli.GraphFlag) || apr.Contains(cli.OneLineFlag) || apr.Contains(cli.StatFlag) {
+		return fmt.Errorf("--row cannot be used with --%s, --%s or --%s", cli.GraphFlag, cli.OneLineFlag, cli.StatFlag)
+	}
+	if apr.NArg() > 1 {
+		return fmt.Errorf("--row accepts at most one revision")
+	}
+	pkStr, ok := apr.GetValue(cli.PrimaryKeyFlag)
+	if !ok {
+		return fmt.Errorf("--row requires the primary key of the row to be given with --pk")
+	}
+	pk := make(map[string]string)
+	for _, kv := range strings.Split(pkStr, ",") {
+		col, v, ok := strings.Cut(kv, "=")
+		if !ok || col == "" {
+			return fmt.Errorf("invalid --pk '%s', expected <column>=<value>[,<column>=<value>...]", pkStr)
+		}
+		pk[strings.TrimSpace(col)] = v
+	}
+	pkJson, err := json.Marshal(pk)
+	if err != nil {
+		return err
+	}
+
+	params := []interface{}{apr.MustGetValue(cli.RowFlag), string(pkJson)}
+	query := "SELECT commit_hash, from_row, to_row FROM dolt_row_log(?, ?"
+	if apr.NArg() == 1 {
+		query += ", ?"
+		params = append(params, apr.Arg(0))
+	}
+	query += ")"
+	if numLines, hasNumLines := apr.GetInt(cli.NumberFlag); hasNumLines {
+		query += fmt.Sprintf(" LIMIT %d", numLines)
+	}
+	query, err = dbr.InterpolateForDialect(query, params, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+	rows, err := GetRowsForSql(queryist, sqlCtx, query)
+	if err != nil {
+		return err
+	}
+
+	opts := commitInfoOptions{
+		showSignature: apr.Contains(cli.ShowSignatureFlag),
+	}
+	var commits []CommitInfo
+	var changes [][2]string
+	for _, row := range rows {
+		cmHash := row[0].(string)
+		commit, err := getCommitInfoWithOptions(queryist, sqlCtx, cmHash, opts)
+		if err != nil {
+			return err
+		}
+		if commit == nil {
+			return fmt.Errorf("no commits found for ref %s", cmHash)
+		}
+		var change [2]string
+		for i, v := range row[1:] {
+			if v == nil {
+				continue
+			}
+			if change[i], err = getJsonAsString(sqlCtx, v); err != nil {
+				return err
+			}
+		}
+		commits = append(commits, *commit)
+		changes = append(changes, change)
+	}
+
+	if cli.ExecuteWithStdioRestored == nil {
+		return nil
+	}
+	cli.ExecuteWithStdioRestored(func() {
+		pager := outputpager.Start()
+		defer pager.Stop()
+		for i := range commits {
+			PrintCommitInfo(pager, apr.GetIntOrDefault(cli.MinParentsFlag, 0), apr.Contains(cli.ParentsFlag), apr.Contains(cli.ShowSignatureFlag), apr.GetValueOrDefault(cli.DecorateFlag, "auto"), &commits[i])
+			if from := changes[i][0]; from != "" {
+				pager.Writer.Write([]byte(color.RedString("- %s", from) + "\n"))
+			}
+			if to := changes[i][1]; to != "" {
+				pager.Writer.Write([]byte(color.GreenString("+ %s", to) + "\n"))
+			}
+			pager.Writer.Write([]byte("\n"))
+		}
+	})
+	return nil
+}
+
 func logCompact(pager *outputpager.Pager, apr *argparser.ArgParseResults, commits []CommitInfo, sqlCtx *sql.Context, queryist cli.Queryist) error {
 	color.NoColor = false
 	for _, comm := range commits {
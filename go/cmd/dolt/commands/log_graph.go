@@ -501,6 +501,7 @@ func drawCommitDotsAndBranchPaths(commits []*commitInfoWithChildren, commitsMap
 		row := commit.Row
 		graph[row][col] = color.WhiteString("*")
 
+		isOctopus := len(commit.Commit.parentHashes) > 2
 		for _, parentHash := range commit.Commit.parentHashes {
 			if parent, ok := commitsMap[parentHash]; ok {
 				parentCol := parent.Col
@@ -541,7 +542,23 @@ func drawCommitDotsAndBranchPaths(commits []*commitInfoWithChildren, commitsMap
 					branchColor := branchColors[parentCol/2%len(branchColors)]
 					horizontalDistance := parentCol - col
 					verticalDistance := parentRow - row
-					if verticalDistance > horizontalDistance {
+					if isOctopus && verticalDistance > 1 {
+						// the paths to the parents of an octopus merge fan out along the commit's row, so they don't
+						// cross each other
+						for i := col + 1; i < parentCol-1; i++ {
+							if graph[row][i] == " " {
+								graph[row][i] = branchColor.Sprintf("-")
+							}
+						}
+						if graph[row+1][parentCol-1] == " " {
+							graph[row+1][parentCol-1] = branchColor.Sprintf("\\")
+						}
+						for i := row + 2; i < parentRow; i++ {
+							if graph[i][parentCol] == " " {
+								graph[i][parentCol] = branchColor.Sprintf("|")
+							}
+						}
+					} else if verticalDistance > horizontalDistance {
 						for i := col + 1; i < parentCol; i++ {
 							graph[row+i-col][i] = branchColor.Sprintf("\\")
 						}
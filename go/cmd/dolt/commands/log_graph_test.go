@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -104,6 +105,64 @@ func TestDrawCommitDotsAndBranchPaths(t *testing.T) {
 
 }
 
+func TestDrawOctopusMergePaths(t *testing.T) {
+	// an octopus merge of three branches, each with one commit on top of a shared base
+	commitInfos := []CommitInfo{
+		{commitHash: "merge", parentHashes: []string{"main", "a", "b", "c"}, commitMeta: &datas.CommitMeta{Description: "merge"}},
+		{commitHash: "main", parentHashes: []string{"base"}, commitMeta: &datas.CommitMeta{Description: "main"}},
+		{commitHash: "c", parentHashes: []string{"base"}, commitMeta: &datas.CommitMeta{Description: "c"}},
+		{commitHash: "b", parentHashes: []string{"base"}, commitMeta: &datas.CommitMeta{Description: "b"}},
+		{commitHash: "a", parentHashes: []string{"base"}, commitMeta: &datas.CommitMeta{Description: "a"}},
+		{commitHash: "base", parentHashes: []string{}, commitMeta: &datas.CommitMeta{Description: "base"}},
+	}
+	commits := mapCommitsWithChildrenAndPosition(commitInfos)
+	commitsMap := make(map[string]*commitInfoWithChildren)
+	for _, commit := range commits {
+		commitsMap[commit.Commit.commitHash] = commit
+	}
+
+	commits, commitsMap = computeColumnEnds(commits, commitsMap)
+	expandGraphBasedOnGraphShape(commits, commitsMap)
+	graph := drawCommitDotsAndBranchPaths(commits, commitsMap)
+	for i, line := range graph {
+		graph[i] = trimTrailing(line)
+	}
+
+	require.Equal(t, "*----", strings.Join(graph[0], ""))
+	require.Equal(t, "*\\ \\ \\", strings.Join(graph[1], ""))
+	require.Equal(t, "| * | |", strings.Join(graph[2], ""))
+}
+
+func TestDrawOctopusMergePathsKeepsExistingEdges(t *testing.T) {
+	// the path from octopus merge c4 to its parent c5 starts in the cell of the edge from c3 to c5
+	commitInfos := []CommitInfo{
+		{commitHash: "c0", parentHashes: []string{"c4"}, commitMeta: &datas.CommitMeta{Description: "c0"}},
+		{commitHash: "c1", parentHashes: []string{"c2", "c3"}, commitMeta: &datas.CommitMeta{Description: "c1"}},
+		{commitHash: "c2", parentHashes: []string{"c5", "c3"}, commitMeta: &datas.CommitMeta{Description: "c2"}},
+		{commitHash: "c3", parentHashes: []string{"c5"}, commitMeta: &datas.CommitMeta{Description: "c3"}},
+		{commitHash: "c4", parentHashes: []string{"c7", "c6", "c5"}, commitMeta: &datas.CommitMeta{Description: "c4"}},
+		{commitHash: "c5", parentHashes: []string{"c7"}, commitMeta: &datas.CommitMeta{Description: "c5"}},
+		{commitHash: "c6", parentHashes: []string{"c7"}, commitMeta: &datas.CommitMeta{Description: "c6"}},
+		{commitHash: "c7", parentHashes: []string{}, commitMeta: &datas.CommitMeta{Description: "c7"}},
+	}
+	commits := mapCommitsWithChildrenAndPosition(commitInfos)
+	commitsMap := make(map[string]*commitInfoWithChildren)
+	for _, commit := range commits {
+		commitsMap[commit.Commit.commitHash] = commit
+	}
+
+	commits, commitsMap = computeColumnEnds(commits, commitsMap)
+	expandGraphBasedOnGraphShape(commits, commitsMap)
+	graph := drawCommitDotsAndBranchPaths(commits, commitsMap)
+	for i, line := range graph {
+		graph[i] = trimTrailing(line)
+	}
+
+	require.Equal(t, "*-| |", strings.Join(graph[5], ""))
+	require.Equal(t, "|\\|/", strings.Join(graph[6], ""))
+	require.Equal(t, "| * |", strings.Join(graph[7], ""))
+}
+
 func TestExpandGraphBasedOnCommitMetaDataHeight(t *testing.T) {
 	commits := []*commitInfoWithChildren{
 		{
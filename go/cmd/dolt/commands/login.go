@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -41,13 +42,20 @@ const (
 	authEndpointParam  = "auth-endpoint"
 	loginURLParam      = "login-url"
 	insecureParam      = "insecure"
+	oidcParam          = "oidc"
+	clientIDParam      = "client-id"
 )
 
 var loginDocs = cli.CommandDocumentationContent{
 	ShortDesc: "Login to DoltHub or DoltLab",
 	LongDesc: `Login into DoltHub or DoltLab using the email in your config so you can pull from private repos and push to those you have permission to.
+
+With {{.EmphasisLeft}}--oidc{{.EmphasisRight}}, login to an OpenID Connect issuer using the device authorization flow instead. The issuer's tokens are cached in the credentials directory and refreshed as needed, and are presented automatically when connecting to a sql-server with {{.EmphasisLeft}}dolt --host{{.EmphasisRight}} unless a user is given explicitly. The sql-server must be configured to accept JWTs from the issuer.
 `,
-	Synopsis: []string{"[--auth-endpoint <endpoint>] [--login-url <url>] [-i | --insecure] [{{.LessThan}}creds{{.GreaterThan}}]"},
+	Synopsis: []string{
+		"[--auth-endpoint <endpoint>] [--login-url <url>] [-i | --insecure] [{{.LessThan}}creds{{.GreaterThan}}]",
+		"--oidc {{.LessThan}}issuer{{.GreaterThan}} [--client-id {{.LessThan}}id{{.GreaterThan}}]",
+	},
 }
 
 // The LoginCmd doesn't handle its own signals, but should stop cancel global context when receiving SIGINT signal
@@ -85,6 +93,8 @@ func (cmd LoginCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsString(authEndpointParam, "e", "hostname:port", fmt.Sprintf("Specify the endpoint used to authenticate this client. Must be used with --%s OR set in the configuration file as `%s`", loginURLParam, config.AddCredsUrlKey))
 	ap.SupportsString(loginURLParam, "url", "url", "Specify the login url where the browser will add credentials.")
 	ap.SupportsFlag(insecureParam, "i", "If set, makes insecure connection to remote authentication server")
+	ap.SupportsString(oidcParam, "", "issuer", "Login to the given OpenID Connect issuer using the device authorization flow.")
+	ap.SupportsString(clientIDParam, "", "id", fmt.Sprintf("The client id registered with the OpenID Connect issuer. Defaults to `%s`, or the value of `%s` in the configuration file.", creds.DefaultOIDCClientID, config.OIDCClientIDKey))
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"creds", "A specific credential to use for login. If omitted, new credentials will be generated."})
 	return ap
 }
@@ -100,6 +110,15 @@ func (cmd LoginCmd) Exec(ctx context.Context, commandStr string, args []string,
 	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, loginDocs, ap))
 	apr := cli.ParseArgsOrDie(ap, args, help)
 
+	if issuer, ok := apr.GetValue(oidcParam); ok {
+		if apr.NArg() != 0 {
+			return HandleVErrAndExitCode(errhand.BuildDError("error: --%s cannot be used with existing credentials", oidcParam).SetPrintUsage().Build(), usage)
+		}
+		clientID := dEnv.Config.GetStringOrDefault(config.OIDCClientIDKey, creds.DefaultOIDCClientID)
+		clientID = apr.GetValueOrDefault(clientIDParam, clientID)
+		return HandleVErrAndExitCode(loginWithOIDC(ctx, dEnv, issuer, clientID), usage)
+	}
+
 	// use config values over defaults, flag values over config values
 	loginUrl := dEnv.Config.GetStringOrDefault(config.AddCredsUrlKey, env.DefaultLoginUrl)
 	loginUrl = apr.GetValueOrDefault(loginURLParam, loginUrl)
@@ -241,6 +260,57 @@ func loginWithCreds(ctx context.Context, dEnv *env.DoltEnv, dc creds.DoltCreds,
 	return nil
 }
 
+// loginWithOIDC logs in to |issuer| using the OAuth 2.0 device authorization flow, caches the resulting token in the
+// credentials dir, and makes the issuer the one used for sql-server connections.
+func loginWithOIDC(ctx context.Context, dEnv *env.DoltEnv, issuer, clientID string) errhand.VerboseError {
+	client := http.DefaultClient
+	p, err := creds.DiscoverOIDCProvider(ctx, client, issuer)
+	if err != nil {
+		return errhand.BuildDError("error: unable to discover OpenID Connect issuer '%s'", issuer).AddCause(err).Build()
+	}
+
+	da, err := creds.StartOIDCDeviceAuth(ctx, client, p, clientID)
+	if err != nil {
+		return errhand.BuildDError("error: unable to start device authorization").AddCause(err).Build()
+	}
+
+	url := da.VerificationURIComplete
+	if url == "" {
+		url = da.VerificationURI
+	}
+	cli.Println("Attempting to automatically open the authorization page in your default browser.")
+	cli.Println("If the browser does not open or you wish to use a different device to authorize this request, open the following URL:")
+	cli.Printf("\t%s\n", url)
+	cli.Printf("and enter the code: %s\n", da.UserCode)
+	open.Start(url)
+
+	cli.Println("Waiting for authorization.")
+	t, err := creds.PollOIDCToken(ctx, client, p, clientID, da)
+	if err != nil {
+		return errhand.BuildDError("error: login failed").AddCause(err).Build()
+	}
+
+	credsDir, verr := actions.EnsureCredsDir(dEnv)
+	if verr != nil {
+		return verr
+	}
+	if _, err = creds.OIDCTokenWriteToDir(dEnv.FS, credsDir, t); err != nil {
+		return errhand.BuildDError("error: failed to save login").AddCause(err).Build()
+	}
+
+	gcfg, hasGCfg := dEnv.Config.GetConfig(env.GlobalConfig)
+	if !hasGCfg {
+		panic("global config not found.  Should create it here if this is a thing.")
+	}
+	err = gcfg.SetStrings(map[string]string{config.OIDCIssuerKey: t.Issuer, config.OIDCClientIDKey: clientID})
+	if err != nil {
+		return errhand.BuildDError("error: failed to update config").AddCause(err).Build()
+	}
+
+	cli.Printf("Logged in to %s as %s\n", t.Issuer, t.Subject)
+	return nil
+}
+
 func openBrowserForCredsAdd(dc creds.DoltCreds, loginUrl string) {
 	url := fmt.Sprintf("%s#%s", loginUrl, dc.PubKeyBase32Str())
 	cli.Println("Attempting to automatically open the credentials page in your default browser.")
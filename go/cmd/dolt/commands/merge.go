@@ -46,13 +46,17 @@ var mergeDocs = cli.CommandDocumentationContent{
 	ShortDesc: "Join two or more development histories together",
 	LongDesc: `Incorporates changes from the named commits (since the time their histories diverged from the current branch) into the current branch.
 
+When more than one branch is named, all of them are merged at once into a single commit with a parent for each branch (an octopus merge). An octopus merge only succeeds if the branches merge without conflicts or constraint violations; otherwise it fails, lists the tables and branches that conflict, and leaves the current branch unchanged, and the branches must be merged one at a time instead.
+
+With {{.EmphasisLeft}}--strategy=ours{{.EmphasisRight}}, the merge is recorded in a new merge commit, but the current branch's tables are kept as they are and all changes from the merged branch are ignored. With {{.EmphasisLeft}}-X ours{{.EmphasisRight}} or {{.EmphasisLeft}}-X theirs{{.EmphasisRight}}, the branches are merged as usual, but rows that both sides changed in conflicting ways are resolved automatically in favor of our or their side, instead of being reported as conflicts.
+
 The second syntax ({{.LessThan}}dolt merge --abort{{.GreaterThan}}) can only be run after the merge has resulted in conflicts. dolt merge {{.EmphasisLeft}}--abort{{.EmphasisRight}} will abort the merge process and try to reconstruct the pre-merge state. However, if there were uncommitted changes when the merge started (and especially if those changes were further modified after the merge was started), dolt merge {{.EmphasisLeft}}--abort{{.EmphasisRight}} will in some cases be unable to reconstruct the original (pre-merge) changes. Therefore: 
 
 {{.LessThan}}Warning{{.GreaterThan}}: Running dolt merge with non-trivial uncommitted changes is discouraged: while possible, it may leave you in a state that is hard to back out of in the case of a conflict.
 `,
 
 	Synopsis: []string{
-		"[--squash] {{.LessThan}}branch{{.GreaterThan}}",
+		"[--squash] [-s {{.LessThan}}strategy{{.GreaterThan}}] [-X {{.LessThan}}option{{.GreaterThan}}] {{.LessThan}}branch{{.GreaterThan}}...",
 		"--no-ff [-m message] {{.LessThan}}branch{{.GreaterThan}}",
 		"--abort",
 	},
@@ -180,10 +184,22 @@ func (cmd MergeCmd) Exec(ctx context.Context, commandStr string, args []string,
 			cli.Println("merge finished, but failed to get hash of HEAD ref")
 			cli.Println(headHashErr.Error())
 		}
-		mergeHash, mergeHashErr := getHashOf(queryist, sqlCtx, apr.Arg(0))
-		if mergeHashErr != nil {
-			cli.Println("merge finished, but failed to get hash of merge ref")
-			cli.Println(mergeHashErr.Error())
+		var mergeHash string
+		if apr.NArg() == 1 {
+			var mergeHashErr error
+			mergeHash, mergeHashErr = getHashOf(queryist, sqlCtx, apr.Arg(0))
+			if mergeHashErr != nil {
+				cli.Println("merge finished, but failed to get hash of merge ref")
+				cli.Println(mergeHashErr.Error())
+			}
+		} else if !apr.Contains(cli.SquashParam) {
+			cli.Println("Merge made by the 'octopus' strategy.")
+		}
+
+		if strategy, ok := apr.GetValue(cli.StrategyParam); ok && strings.EqualFold(strategy, "ours") {
+			// the ours strategy leaves the tables unchanged, so there are no stats to print
+			cli.Println("Merge made by the 'ours' strategy.")
+			return 0
 		}
 
 		fastFwd := getFastforward(mergeResultRow, dprocedures.MergeProcFFIndex)
@@ -214,7 +230,7 @@ func validateDoltMergeArgs(apr *argparser.ArgParseResults, usage cli.UsagePrinte
 	}
 
 	if apr.Contains(cli.SquashParam) {
-		if apr.NArg() != 1 {
+		if apr.NArg() == 0 {
 			usage()
 			return 1
 		}
@@ -267,8 +283,6 @@ func constructInterpolatedDoltMergeQuery(apr *argparser.ArgParseResults, cliCtx
 
 	if apr.Contains(cli.SquashParam) {
 		writeToBuffer("--squash", false)
-		writeToBuffer("?", true)
-		params = append(params, apr.Arg(0))
 	} else if apr.Contains(cli.NoFFParam) {
 		writeToBuffer("--no-ff", false)
 	} else if apr.Contains(cli.AbortParam) {
@@ -318,9 +332,22 @@ func constructInterpolatedDoltMergeQuery(apr *argparser.ArgParseResults, cliCtx
 		params = append(params, msg)
 	}
 
-	if !apr.Contains(cli.AbortParam) && !apr.Contains(cli.SquashParam) {
+	if strategy, ok := apr.GetValue(cli.StrategyParam); ok {
+		writeToBuffer("--strategy", false)
 		writeToBuffer("?", true)
-		params = append(params, apr.Arg(0))
+		params = append(params, strategy)
+	}
+	if option, ok := apr.GetValue(cli.StrategyOptionParam); ok {
+		writeToBuffer("--strategy-option", false)
+		writeToBuffer("?", true)
+		params = append(params, option)
+	}
+
+	if !apr.Contains(cli.AbortParam) {
+		for _, arg := range apr.Args {
+			writeToBuffer("?", true)
+			params = append(params, arg)
+		}
 	}
 
 	buffer.WriteString(")")
@@ -0,0 +1,186 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var notesDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Add or inspect commit notes",
+	LongDesc: `Adds, removes, or reads notes attached to commits. Notes annotate commits without changing them, so adding or removing a note never changes the hash of a commit.
+
+Notes are kept in notes refs, which default to {{.EmphasisLeft}}refs/notes/commits{{.EmphasisRight}}. Use {{.EmphasisLeft}}--ref{{.EmphasisRight}} to use a different notes ref. Each change to a notes ref is recorded as a commit in its own history, and notes refs can be pushed to a remote with {{.EmphasisLeft}}dolt push <remote> refs/notes/commits{{.EmphasisRight}}. Fetching from a remote fetches its notes refs, fast-forwarding local notes refs that haven't diverged from them.
+
+Notes can also be queried with the {{.EmphasisLeft}}dolt_notes{{.EmphasisRight}} system table.
+
+{{.EmphasisLeft}}list{{.EmphasisRight}}
+Lists the commits that have notes, along with the first line of each note. This is the default subcommand. If {{.LessThan}}commit{{.GreaterThan}} is given, only lists the note of that commit.
+
+{{.EmphasisLeft}}add{{.EmphasisRight}}
+Adds a note to {{.LessThan}}commit{{.GreaterThan}}, which defaults to HEAD. Fails if the commit already has a note, unless {{.EmphasisLeft}}-f{{.EmphasisRight}} is given.
+
+{{.EmphasisLeft}}show{{.EmphasisRight}}
+Shows the note of {{.LessThan}}commit{{.GreaterThan}}, which defaults to HEAD.
+
+{{.EmphasisLeft}}remove{{.EmphasisRight}}, {{.EmphasisLeft}}rm{{.EmphasisRight}}
+Removes the note of {{.LessThan}}commit{{.GreaterThan}}, which defaults to HEAD.`,
+	Synopsis: []string{
+		"[list [{{.LessThan}}commit{{.GreaterThan}}]] [--ref {{.LessThan}}name{{.GreaterThan}}]",
+		"add [-f] -m {{.LessThan}}msg{{.GreaterThan}} [--ref {{.LessThan}}name{{.GreaterThan}}] [{{.LessThan}}commit{{.GreaterThan}}]",
+		"show [--ref {{.LessThan}}name{{.GreaterThan}}] [{{.LessThan}}commit{{.GreaterThan}}]",
+		"remove [--ref {{.LessThan}}name{{.GreaterThan}}] [{{.LessThan}}commit{{.GreaterThan}}]",
+	},
+}
+
+const (
+	listNotesId       = "list"
+	addNoteId         = "add"
+	showNoteId        = "show"
+	removeNoteId      = "remove"
+	removeNoteShortId = "rm"
+)
+
+type NotesCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd NotesCmd) Name() string {
+	return "notes"
+}
+
+// Description returns a description of the command
+func (cmd NotesCmd) Description() string {
+	return "Add or inspect commit notes."
+}
+
+func (cmd NotesCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(notesDocs, ap)
+}
+
+func (cmd NotesCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateNotesArgParser()
+}
+
+// Exec executes the command
+func (cmd NotesCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, notesDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	switch {
+	case apr.NArg() == 0, apr.Arg(0) == listNotesId:
+		err = listNotes(sqlCtx, queryist, apr)
+	case apr.Arg(0) == addNoteId, apr.Arg(0) == removeNoteId, apr.Arg(0) == removeNoteShortId:
+		err = callDoltNotes(sqlCtx, queryist, args)
+	case apr.Arg(0) == showNoteId:
+		err = showNote(sqlCtx, queryist, apr)
+	default:
+		return HandleVErrAndExitCode(errhand.BuildDError("").SetPrintUsage().Build(), usage)
+	}
+
+	return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+}
+
+// callDoltNotes runs the dolt_notes procedure with the arguments given to the command.
+func callDoltNotes(sqlCtx *sql.Context, queryist cli.Queryist, args []string) error {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		params[i] = arg
+	}
+	query := "call dolt_notes(" + strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ") + ")"
+	_, err := InterpolateAndRunQuery(queryist, sqlCtx, query, params...)
+	return err
+}
+
+func listNotes(sqlCtx *sql.Context, queryist cli.Queryist, apr *argparser.ArgParseResults) error {
+	if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) || apr.NArg() > 2 {
+		return fmt.Errorf("error: invalid argument")
+	}
+	notesName, err := notesRefName(apr)
+	if err != nil {
+		return err
+	}
+
+	var rows []sql.Row
+	if apr.NArg() == 2 {
+		rows, err = InterpolateAndRunQuery(queryist, sqlCtx, "select commit_hash, note from dolt_notes where ref = ? and commit_hash = hashof(?)", notesName, apr.Arg(1))
+		if err == nil && len(rows) == 0 {
+			return fmt.Errorf("error: no note found for object %s", apr.Arg(1))
+		}
+	} else {
+		rows, err = InterpolateAndRunQuery(queryist, sqlCtx, "select commit_hash, note from dolt_notes where ref = ? order by commit_hash", notesName)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		firstLine, _, _ := strings.Cut(row[1].(string), "\n")
+		cli.Printf("%s %s\n", row[0].(string), firstLine)
+	}
+	return nil
+}
+
+func showNote(sqlCtx *sql.Context, queryist cli.Queryist, apr *argparser.ArgParseResults) error {
+	if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) || apr.NArg() > 2 {
+		return fmt.Errorf("error: invalid argument")
+	}
+	notesName, err := notesRefName(apr)
+	if err != nil {
+		return err
+	}
+	commit := "HEAD"
+	if apr.NArg() == 2 {
+		commit = apr.Arg(1)
+	}
+
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "select note from dolt_notes where ref = ? and commit_hash = hashof(?)", notesName, commit)
+	if err != nil {
+		return err
+	} else if len(rows) == 0 {
+		return fmt.Errorf("error: no note found for object %s", commit)
+	}
+	cli.Println(strings.TrimSuffix(rows[0][0].(string), "\n"))
+	return nil
+}
+
+// notesRefName returns the name of the notes ref given by the --ref argument, as it appears in the dolt_notes system
+// table.
+func notesRefName(apr *argparser.ArgParseResults) (string, error) {
+	notesName := apr.GetValueOrDefault(cli.NotesRefParam, ref.DefaultNotesName)
+	if ref.IsRef(notesName) && !strings.HasPrefix(notesName, ref.PrefixForType(ref.NotesRefType)) {
+		return "", fmt.Errorf("error: refusing to use notes in %s (outside of refs/notes/)", notesName)
+	}
+	return ref.NewNotesRef(notesName).GetPath(), nil
+}
@@ -254,6 +254,14 @@ func constructInterpolatedDoltPullQuery(apr *argparser.ArgParseResults) (string,
 	if apr.Contains(cli.NoEditFlag) {
 		args = append(args, "'--no-edit'")
 	}
+	if strategy, ok := apr.GetValue(cli.StrategyParam); ok {
+		args = append(args, "'--strategy'", "?")
+		params = append(params, strategy)
+	}
+	if option, ok := apr.GetValue(cli.StrategyOptionParam); ok {
+		args = append(args, "'--strategy-option'", "?")
+		params = append(params, option)
+	}
 	if user, hasUser := apr.GetValue(cli.UserFlag); hasUser {
 		args = append(args, "'--user'")
 		args = append(args, "?")
@@ -343,8 +343,13 @@ func insertRebasePlanIntoDoltRebaseTable(plan *rebase.RebasePlan, sqlCtx *sql.Co
 // syncCliBranchToSqlSessionBranch sets the current branch for the CLI (in repo_state.json) to the active branch
 // for the current session. This is needed during rebasing, since any conflicts need to be resolved while the
 // session is on the rebase working branch (e.g. dolt_rebase_t1) and after the rebase finishes, the session needs
-// to be back on the branch being rebased (e.g. t1).
+// to be back on the branch being rebased (e.g. t1). There's nothing to sync when running in the SQL shell, which has
+// no dEnv and keeps using its session's branch.
 func syncCliBranchToSqlSessionBranch(ctx *sql.Context, dEnv *env.DoltEnv) error {
+	if dEnv == nil {
+		return nil
+	}
+
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	currentBranch, err := doltSession.GetBranch()
 	if err != nil {
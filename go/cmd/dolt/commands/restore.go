@@ -0,0 +1,85 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var restoreDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Restore tables or rows in the working set",
+	LongDesc: `Restores the given tables in the working set to their contents in the staged tables, or in {{.LessThan}}revision{{.GreaterThan}} if {{.EmphasisLeft}}--source{{.EmphasisRight}} is given. The staged tables are never changed.
+
+With {{.EmphasisLeft}}--where{{.EmphasisRight}}, only the rows of a single table that match {{.LessThan}}predicate{{.GreaterThan}} are restored, leaving the rest of the table alone. A row is restored if it matches the predicate in either the working set or the source, so rows that a bad update moved out of the predicate's range are restored too. Matching rows that don't exist in the source are deleted, and secondary index entries are updated along with the rows. The table's schema must be the same in the working set and the source.
+
+This is also available as the {{.EmphasisLeft}}DOLT_RESTORE(){{.EmphasisRight}} stored procedure.`,
+	Synopsis: []string{
+		"[--source {{.LessThan}}revision{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}}...",
+		"[--source {{.LessThan}}revision{{.GreaterThan}}] --where {{.LessThan}}predicate{{.GreaterThan}} {{.LessThan}}table{{.GreaterThan}}",
+	},
+}
+
+type RestoreCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd RestoreCmd) Name() string {
+	return "restore"
+}
+
+// Description returns a description of the command
+func (cmd RestoreCmd) Description() string {
+	return "Restore tables or rows in the working set."
+}
+
+func (cmd RestoreCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(restoreDocs, ap)
+}
+
+func (cmd RestoreCmd) ArgParser() *argparser.ArgParser {
+	return cli.CreateRestoreArgParser()
+}
+
+// Exec executes the command
+func (cmd RestoreCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, restoreDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	query, err := interpolateStoredProcedureCall("DOLT_RESTORE", args)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	_, err = GetRowsForSql(queryist, sqlCtx, query)
+	return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+}
@@ -31,7 +31,6 @@ import (
 	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/dolthub/vitess/go/vt/vterrors"
 	"github.com/fatih/color"
-	"github.com/flynn-archive/go-shlex"
 	textunicode "golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/transform"
 	"gopkg.in/src-d/go-errors.v1"
@@ -55,6 +54,8 @@ var sqlDocs = cli.CommandDocumentationContent{
 
 Multiple SQL statements must be separated by semicolons. Use {{.EmphasisLeft}}-b{{.EmphasisRight}} to enable batch mode to speed up large batches of INSERT / UPDATE statements. Pipe SQL files to dolt sql (no {{.EmphasisLeft}}-q{{.EmphasisRight}}) to execute a SQL import or update script. 
 
+Use {{.EmphasisLeft}}-r{{.EmphasisRight}} to choose how results are printed. {{.EmphasisLeft}}-r insert{{.EmphasisRight}} prints an INSERT statement for each row, into the table the result columns come from, or into a table named {{.EmphasisLeft}}result{{.EmphasisRight}} if that table isn't known, as when the columns come from more than one table, or when querying a remote server.
+
 By default this command uses the dolt database in the current working directory. If you would prefer to use a different directory, user the {{.EmphasisLeft}}--data-dir <directory>{{.EmphasisRight}} argument before the sql subcommand.
 
 If a server is running for the database in question, then the query will go through the server automatically. If connecting to a remote server is preferred, used the {{.EmphasisLeft}}--host <host>{{.EmphasisRight}} and {{.EmphasisLeft}}--port <port>{{.EmphasisRight}} global arguments. See 'dolt --help' for more information about global arguments.`,
@@ -134,7 +135,7 @@ func (cmd SqlCmd) Docs() *cli.CommandDocumentation {
 func (cmd SqlCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
 	ap.SupportsString(QueryFlag, "q", "SQL query to run", "Runs a single query and exits.")
-	ap.SupportsString(FormatFlag, "r", "result output format", "How to format result output. Valid values are tabular, csv, json, vertical, and parquet. Defaults to tabular.")
+	ap.SupportsString(FormatFlag, "r", "result output format", "How to format result output. Valid values are tabular, csv, json, vertical, parquet, markdown, html, ndjson, xml, and insert. Defaults to tabular.")
 	ap.SupportsString(saveFlag, "s", "saved query name", "Used with --query, save the query to the query catalog with the name provided. Saved queries can be examined in the dolt_query_catalog system table.")
 	ap.SupportsString(executeFlag, "x", "saved query name", "Executes a saved query with the given name.")
 	ap.SupportsFlag(listSavedFlag, "l", "List all saved queries.")
@@ -303,7 +304,7 @@ func (cmd SqlCmd) handleLegacyArguments(ap *argparser.ArgParser, commandStr stri
 	if err != nil {
 		legacyParser := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
 		legacyParser.SupportsString(QueryFlag, "q", "SQL query to run", "Runs a single query and exits.")
-		legacyParser.SupportsString(FormatFlag, "r", "result output format", "How to format result output. Valid values are tabular, csv, json, vertical, and parquet. Defaults to tabular.")
+		legacyParser.SupportsString(FormatFlag, "r", "result output format", "How to format result output. Valid values are tabular, csv, json, vertical, parquet, markdown, html, ndjson, xml, and insert. Defaults to tabular.")
 		legacyParser.SupportsString(saveFlag, "s", "saved query name", "Used with --query, save the query to the query catalog with the name provided. Saved queries can be examined in the dolt_query_catalog system table.")
 		legacyParser.SupportsString(executeFlag, "x", "saved query name", "Executes a saved query with the given name.")
 		legacyParser.SupportsFlag(listSavedFlag, "l", "List all saved queries.")
@@ -526,8 +527,18 @@ func GetResultFormat(format string) (engine.PrintResultFormat, errhand.VerboseEr
 		return engine.FormatVertical, nil
 	case "parquet":
 		return engine.FormatParquet, nil
+	case "markdown":
+		return engine.FormatMarkdown, nil
+	case "html":
+		return engine.FormatHtml, nil
+	case "ndjson":
+		return engine.FormatNdjson, nil
+	case "xml":
+		return engine.FormatXml, nil
+	case "insert":
+		return engine.FormatInsert, nil
 	default:
-		return engine.FormatTabular, errhand.BuildDError("Invalid argument for --result-format. Valid values are tabular, csv, json").Build()
+		return engine.FormatTabular, errhand.BuildDError("Invalid argument for --result-format. Valid values are tabular, csv, json, vertical, parquet, markdown, html, ndjson, xml, insert").Build()
 	}
 }
 
@@ -626,7 +637,7 @@ func execBatchMode(ctx *sql.Context, qryist cli.Queryist, input io.Reader, conti
 
 		sqlMode := sql.LoadSqlMode(ctx)
 
-		sqlStatement, err := sqlparser.ParseWithOptions(ctx, query, sqlMode.ParserOptions())
+		sqlStatement, _, _, err := sql.GlobalParser.ParseWithOptions(ctx, query, ';', false, sqlMode.ParserOptions())
 		if err == sqlparser.ErrEmpty {
 			continue
 		} else if err != nil {
@@ -725,7 +736,6 @@ func execShell(sqlCtx *sql.Context, qryist cli.Queryist, format engine.PrintResu
 
 	shell := ishell.NewUninterpreted(&shellConf)
 	shell.SetMultiPrompt(initialMultilinePrompt)
-	// TODO: update completer on create / drop / alter statements
 	completer, err := newCompleter(sqlCtx, qryist)
 	if err != nil {
 		return err
@@ -791,6 +801,9 @@ func execShell(sqlCtx *sql.Context, qryist cli.Queryist, format engine.PrintResu
 				if err != nil {
 					shell.Println(color.RedString(err.Error()))
 				}
+			} else if cmdType == TransformCommand && len(newQuery) == 0 {
+				// An edit that leaves nothing to run is a no-op.
+				query = newQuery
 			} else {
 				if cmdType == TransformCommand {
 					query = newQuery
@@ -816,6 +829,11 @@ func execShell(sqlCtx *sql.Context, qryist cli.Queryist, format engine.PrintResu
 				}
 			}
 
+			if cmdType == DoltCliCommand || changesCompletions(query) {
+				// Completion is best effort, so if this fails the shell keeps completing with what it had before.
+				_ = completer.refresh(sqlCtx, qryist)
+			}
+
 			nextPrompt, multiPrompt = postCommandUpdate(sqlCtx, qryist)
 
 			return true
@@ -867,7 +885,7 @@ func preprocessQuery(query, lastQuery string, cliCtx cli.CliContext) (CommandTyp
 	// strip leading whitespace
 	query = strings.TrimLeft(query, " \t\n\r\v\f")
 	if strings.HasPrefix(query, "\\") {
-		if query == "\\edit" {
+		if words := parseSlashCmd(query); len(words) == 1 && words[0] == "edit" {
 			// \edit is a special case. Maybe we'll generalize this in the future.
 			updatedQuery, err := execEditor(lastQuery, ".sql", cliCtx)
 			if err != nil {
@@ -998,125 +1016,10 @@ func isDirty(sqlCtx *sql.Context, qryist cli.Queryist) (bool, error) {
 	return getStrBoolColAsBool(row[0])
 }
 
-// Returns a new auto completer with table names, column names, and SQL keywords.
-// TODO: update the completer on DDL, branch change, etc.
-func newCompleter(
-	ctx *sql.Context,
-	qryist cli.Queryist,
-) (completer *sqlCompleter, rerr error) {
-	subCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
-	defer stop()
-
-	sqlCtx := sql.NewContext(subCtx, sql.WithSession(ctx.Session))
-
-	sqlCtx.Session.LockWarnings()
-	defer sqlCtx.Session.UnlockWarnings()
-	_, iter, _, err := qryist.Query(sqlCtx, "select table_schema, table_name, column_name from information_schema.columns;")
-	if err != nil {
-		return nil, err
-	}
-
-	defer func(iter sql.RowIter, context *sql.Context) {
-		err := iter.Close(context)
-		if err != nil && rerr == nil {
-			rerr = err
-		}
-	}(iter, sqlCtx)
-
-	identifiers := make(map[string]struct{})
-	var columnNames []string
-	for {
-		r, err := iter.Next(sqlCtx)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
-
-		identifiers[r[0].(string)] = struct{}{}
-		identifiers[r[1].(string)] = struct{}{}
-		identifiers[r[2].(string)] = struct{}{}
-		columnNames = append(columnNames, r[2].(string))
-	}
-
-	var completionWords []string
-	for k := range identifiers {
-		completionWords = append(completionWords, k)
-	}
-
-	completionWords = append(completionWords, dsqle.CommonKeywords...)
-
-	return &sqlCompleter{
-		allWords:    completionWords,
-		columnNames: columnNames,
-	}, nil
-}
-
-type sqlCompleter struct {
-	allWords    []string
-	columnNames []string
-}
-
-// Do function for autocompletion, defined by the Readline library. Mostly stolen from ishell.
-func (c *sqlCompleter) Do(line []rune, pos int) (newLine [][]rune, length int) {
-	var words []string
-	if w, err := shlex.Split(string(line)); err == nil {
-		words = w
-	} else {
-		// fall back
-		words = strings.Fields(string(line))
-	}
-
-	var cWords []string
-	prefix := ""
-	lastWord := ""
-	if len(words) > 0 && pos > 0 && line[pos-1] != ' ' {
-		lastWord = words[len(words)-1]
-		prefix = strings.ToLower(lastWord)
-	} else if len(words) > 0 {
-		lastWord = words[len(words)-1]
-	}
-
-	cWords = c.getWords(lastWord)
-
-	var suggestions [][]rune
-	for _, w := range cWords {
-		lowered := strings.ToLower(w)
-		if strings.HasPrefix(lowered, prefix) {
-			suggestions = append(suggestions, []rune(strings.TrimPrefix(lowered, prefix)))
-		}
-	}
-	if len(suggestions) == 1 && prefix != "" && string(suggestions[0]) == "" {
-		suggestions = [][]rune{[]rune(" ")}
-	}
-
-	return suggestions, len(prefix)
-}
-
-// Simple suggestion function. Returns column name suggestions if the last word in the input has exactly one '.' in it,
-// otherwise returns all tables, columns, and reserved words.
-func (c *sqlCompleter) getWords(lastWord string) (s []string) {
-	lastDot := strings.LastIndex(lastWord, ".")
-	if lastDot > 0 && strings.Count(lastWord, ".") == 1 {
-		alias := lastWord[:lastDot]
-		return prepend(alias+".", c.columnNames)
-	}
-
-	return c.allWords
-}
-
-func prepend(s string, ss []string) []string {
-	newSs := make([]string, len(ss))
-	for i := range ss {
-		newSs[i] = s + ss[i]
-	}
-	return newSs
-}
-
 // processQuery processes a single query. The Root of the sqlEngine will be updated if necessary.
 // Returns the schema and the row iterator for the results, which may be nil, and an error if one occurs.
 func processQuery(ctx *sql.Context, query string, qryist cli.Queryist) (sql.Schema, sql.RowIter, *sql.QueryFlags, error) {
-	sqlStatement, err := sqlparser.Parse(query)
+	sqlStatement, err := sql.GlobalParser.ParseSimple(query)
 	if err == sqlparser.ErrEmpty {
 		// silently skip empty statements
 		return nil, nil, nil, nil
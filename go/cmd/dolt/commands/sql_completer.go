@@ -0,0 +1,431 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	dsqle "github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+// completionWordBreaks are the characters that separate the word being completed from the rest of the line.
+const completionWordBreaks = " \t\r\n(),;=<>"
+
+// tableKeywords are the keywords that are followed by a table name.
+var tableKeywords = map[string]struct{}{
+	"from":     {},
+	"join":     {},
+	"into":     {},
+	"update":   {},
+	"table":    {},
+	"describe": {},
+	"desc":     {},
+	"truncate": {},
+}
+
+// callRegex matches an unfinished call to a stored procedure, capturing the procedure's name.
+var callRegex = regexp.MustCompile("(?i)\\bcall\\s+(?:[\\w`]+\\.)?`?(\\w+)`?\\s*\\([^)]*$")
+
+// procedureArgParsers are the arg parsers of the Dolt stored procedures, used to complete their options.
+var procedureArgParsers = map[string]func() *argparser.ArgParser{
+	"dolt_add":               cli.CreateAddArgParser,
+	"dolt_backup":            cli.CreateBackupArgParser,
+	"dolt_branch":            cli.CreateBranchArgParser,
+	"dolt_checkout":          cli.CreateCheckoutArgParser,
+	"dolt_cherry_pick":       cli.CreateCherryPickArgParser,
+	"dolt_clean":             cli.CreateCleanArgParser,
+	"dolt_clone":             cli.CreateCloneArgParser,
+	"dolt_commit":            cli.CreateCommitArgParser,
+	"dolt_conflicts_resolve": cli.CreateConflictsResolveArgParser,
+	"dolt_count_commits":     cli.CreateCountCommitsArgParser,
+	"dolt_fetch":             cli.CreateFetchArgParser,
+	"dolt_gc":                cli.CreateGCArgParser,
+	"dolt_merge":             cli.CreateMergeArgParser,
+	"dolt_notes":             cli.CreateNotesArgParser,
+	"dolt_pull":              cli.CreatePullArgParser,
+	"dolt_push":              cli.CreatePushArgParser,
+	"dolt_rebase":            cli.CreateRebaseArgParser,
+	"dolt_remote":            cli.CreateRemoteArgParser,
+	"dolt_reset":             cli.CreateResetArgParser,
+	"dolt_restore":           cli.CreateRestoreArgParser,
+	"dolt_revert":            cli.CreateRevertArgParser,
+	"dolt_stash":             cli.CreateStashArgParser,
+	"dolt_tag":               cli.CreateTagArgParser,
+}
+
+// procedureRefArgs are the Dolt stored procedures that take branch, tag or commit arguments.
+var procedureRefArgs = map[string]struct{}{
+	"dolt_branch":      {},
+	"dolt_checkout":    {},
+	"dolt_cherry_pick": {},
+	"dolt_merge":       {},
+	"dolt_notes":       {},
+	"dolt_pull":        {},
+	"dolt_push":        {},
+	"dolt_rebase":      {},
+	"dolt_reset":       {},
+	"dolt_restore":     {},
+	"dolt_revert":      {},
+	"dolt_tag":         {},
+}
+
+// procedureTableArgs are the Dolt stored procedures that take table arguments.
+var procedureTableArgs = map[string]struct{}{
+	"dolt_add":                {},
+	"dolt_checkout":           {},
+	"dolt_clean":              {},
+	"dolt_conflicts_resolve":  {},
+	"dolt_reset":              {},
+	"dolt_restore":            {},
+	"dolt_verify_constraints": {},
+}
+
+// sqlCompleter is the tab completer of the SQL shell. It completes keywords, database, table and column names,
+// branch and tag names, and stored procedure names and arguments, depending on where in the statement the word being
+// completed is. Its completion data is loaded by refresh, which the shell calls after statements that may change it.
+type sqlCompleter struct {
+	mu sync.Mutex
+
+	keywords   []string
+	database   string
+	databases  []string
+	procedures []string
+	refs       []string
+	// tables maps lower-cased database names to the names of their tables, including system tables.
+	tables map[string][]string
+	// columns maps lower-cased table names to the names of their columns.
+	columns map[string][]string
+}
+
+// newCompleter returns a new completer loaded with the completion data of the session.
+func newCompleter(ctx *sql.Context, qryist cli.Queryist) (*sqlCompleter, error) {
+	keywords := make([]string, len(dsqle.CommonKeywords))
+	for i, keyword := range dsqle.CommonKeywords {
+		keywords[i] = strings.ToLower(keyword)
+	}
+	c := &sqlCompleter{keywords: keywords}
+	if err := c.refresh(ctx, qryist); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// refresh reloads the completion data of the session: its databases, their tables and columns, the branches and tags
+// of the current database, and the procedures that can be called.
+func (c *sqlCompleter) refresh(ctx *sql.Context, qryist cli.Queryist) error {
+	subCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	sqlCtx := sql.NewContext(subCtx, sql.WithSession(ctx.Session))
+
+	sqlCtx.Session.LockWarnings()
+	defer sqlCtx.Session.UnlockWarnings()
+
+	// System tables are only listed in information_schema when @@dolt_show_system_tables is set.
+	rows, err := GetRowsForSql(qryist, sqlCtx, "select @@dolt_show_system_tables")
+	if err != nil {
+		return err
+	}
+	showSystemTables := fmt.Sprint(rows[0][0])
+	if _, err = GetRowsForSql(qryist, sqlCtx, "set @@dolt_show_system_tables = 1"); err != nil {
+		return err
+	}
+	rows, err = GetRowsForSql(qryist, sqlCtx, "select table_schema, table_name, column_name from information_schema.columns")
+	_, resetErr := GetRowsForSql(qryist, sqlCtx, "set @@dolt_show_system_tables = "+showSystemTables)
+	if err != nil {
+		return err
+	}
+	if resetErr != nil {
+		return resetErr
+	}
+
+	tables := make(map[string][]string)
+	columns := make(map[string][]string)
+	seenTables := make(map[string]struct{})
+	seenColumns := make(map[string]struct{})
+	for _, r := range rows {
+		db, table, column := fmt.Sprint(r[0]), fmt.Sprint(r[1]), fmt.Sprint(r[2])
+		dbKey, tableKey := strings.ToLower(db), strings.ToLower(table)
+		if _, ok := seenTables[dbKey+"."+tableKey]; !ok {
+			seenTables[dbKey+"."+tableKey] = struct{}{}
+			tables[dbKey] = append(tables[dbKey], table)
+		}
+		if _, ok := seenColumns[tableKey+"."+strings.ToLower(column)]; !ok {
+			seenColumns[tableKey+"."+strings.ToLower(column)] = struct{}{}
+			columns[tableKey] = append(columns[tableKey], column)
+		}
+	}
+
+	rows, err = GetRowsForSql(qryist, sqlCtx, "select schema_name from information_schema.schemata")
+	if err != nil {
+		return err
+	}
+	databases := stringColumn(rows, 0)
+
+	database := ""
+	rows, err = GetRowsForSql(qryist, sqlCtx, "select database()")
+	if err != nil {
+		return err
+	}
+	if rows[0][0] != nil {
+		database = fmt.Sprint(rows[0][0])
+	}
+
+	procedures := make([]string, 0, len(dprocedures.DoltProcedures))
+	for _, procedure := range dprocedures.DoltProcedures {
+		procedures = append(procedures, procedure.Name)
+	}
+
+	// The current database may not be a Dolt database, or there may be none, in which case there are no refs or user
+	// procedures to complete.
+	var refs []string
+	if database != "" {
+		if rows, err = GetRowsForSql(qryist, sqlCtx, "select name from dolt_branches union select tag_name from dolt_tags"); err == nil {
+			refs = stringColumn(rows, 0)
+		}
+		if rows, err = GetRowsForSql(qryist, sqlCtx, "select routine_name from information_schema.routines where routine_type = 'PROCEDURE' and routine_schema = database()"); err == nil {
+			procedures = append(procedures, stringColumn(rows, 0)...)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.database = database
+	c.databases = databases
+	c.tables = tables
+	c.columns = columns
+	c.refs = refs
+	c.procedures = procedures
+	return nil
+}
+
+// stringColumn returns the values of column |i| of |rows| as strings.
+func stringColumn(rows []sql.Row, i int) []string {
+	ss := make([]string, 0, len(rows))
+	for _, r := range rows {
+		ss = append(ss, fmt.Sprint(r[i]))
+	}
+	return ss
+}
+
+// Do function for autocompletion, defined by the Readline library. Returns the suffixes that complete the word before
+// |pos|, and the length of that word.
+func (c *sqlCompleter) Do(line []rune, pos int) (newLine [][]rune, length int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	text := string(line[:pos])
+	start := strings.LastIndexAny(text, completionWordBreaks) + 1
+	word := text[start:]
+
+	// A word that starts with a quote is completed inside the quotes, and the quote is closed if there's a single match.
+	quote := ""
+	prefix := word
+	if len(word) > 0 && strings.ContainsRune("'\"`", rune(word[0])) {
+		quote, prefix = word[:1], word[1:]
+	}
+
+	candidates, quoteArgs := c.candidates(text[:start], prefix)
+	if quoteArgs && quote == "" {
+		quote = "'"
+		for i := range candidates {
+			candidates[i] = quote + candidates[i]
+		}
+		prefix = word
+	}
+
+	lowered := strings.ToLower(prefix)
+	seen := make(map[string]struct{})
+	var suggestions []string
+	for _, candidate := range candidates {
+		if !strings.HasPrefix(strings.ToLower(candidate), lowered) {
+			continue
+		}
+		if _, ok := seen[candidate]; ok {
+			continue
+		}
+		seen[candidate] = struct{}{}
+		suggestions = append(suggestions, candidate[len(prefix):])
+	}
+	sort.Strings(suggestions)
+
+	if len(suggestions) == 1 {
+		if quote != "" {
+			suggestions[0] += quote
+		} else if prefix != "" && suggestions[0] == "" {
+			suggestions[0] = " "
+		}
+	}
+
+	newLine = make([][]rune, len(suggestions))
+	for i, suggestion := range suggestions {
+		newLine[i] = []rune(suggestion)
+	}
+	return newLine, len([]rune(word))
+}
+
+// candidates returns the words that could complete |word|, given the text of the statement that precedes it. The
+// boolean result is true when the word is a string argument of a stored procedure.
+func (c *sqlCompleter) candidates(before, word string) ([]string, bool) {
+	if m := callRegex.FindStringSubmatch(before); m != nil {
+		return c.procedureArgs(strings.ToLower(m[1])), true
+	}
+
+	prev := strings.FieldsFunc(strings.ToLower(before), func(r rune) bool {
+		return strings.ContainsRune(completionWordBreaks, r)
+	})
+	last, secondToLast := "", ""
+	if len(prev) > 0 {
+		last = prev[len(prev)-1]
+	}
+	if len(prev) > 1 {
+		secondToLast = prev[len(prev)-2]
+	}
+
+	if dot := strings.LastIndex(word, "."); dot > 0 {
+		return c.qualified(word[:dot]), false
+	}
+
+	switch {
+	case last == "call":
+		return c.procedures, false
+	case last == "use":
+		return c.databases, false
+	case last == "of" && secondToLast == "as":
+		return c.refs, false
+	case isTableKeyword(last):
+		return append(c.currentTables(), c.databases...), false
+	}
+
+	words := append([]string{}, c.keywords...)
+	words = append(words, c.currentTables()...)
+	words = append(words, c.databases...)
+	words = append(words, c.referencedColumns(prev)...)
+	return words, false
+}
+
+// procedureArgs returns the options of the procedure named, along with the refs or tables it takes.
+func (c *sqlCompleter) procedureArgs(procedure string) []string {
+	var args []string
+	if newArgParser, ok := procedureArgParsers[procedure]; ok {
+		for _, opt := range newArgParser().Supported {
+			args = append(args, "--"+opt.Name)
+			if opt.Abbrev != "" {
+				args = append(args, "-"+opt.Abbrev)
+			}
+		}
+	}
+	if _, ok := procedureRefArgs[procedure]; ok {
+		args = append(args, c.refs...)
+	}
+	if _, ok := procedureTableArgs[procedure]; ok {
+		args = append(args, c.currentTables()...)
+	}
+	return args
+}
+
+// qualified returns the completions of a word qualified by |qualifier|: the tables of a database, or the columns of a
+// table. If |qualifier| is neither, it's assumed to be an alias, and every known column is returned.
+func (c *sqlCompleter) qualified(qualifier string) []string {
+	key := strings.ToLower(strings.Trim(qualifier, "`"))
+	names, ok := c.columns[key]
+	if !ok {
+		names, ok = c.tables[key]
+	}
+	if !ok {
+		names = c.allColumns()
+	}
+	return prepend(qualifier+".", names)
+}
+
+// currentTables returns the tables of the current database, or of every database if there is no current database.
+func (c *sqlCompleter) currentTables() []string {
+	if c.database != "" {
+		return c.tables[strings.ToLower(c.database)]
+	}
+	var tables []string
+	for _, dbTables := range c.tables {
+		tables = append(tables, dbTables...)
+	}
+	return tables
+}
+
+// referencedColumns returns the columns of the tables named in |words|, or every known column if none are.
+func (c *sqlCompleter) referencedColumns(words []string) []string {
+	var columns []string
+	for i, w := range words {
+		if i == 0 || !isTableKeyword(words[i-1]) {
+			continue
+		}
+		w = strings.Trim(w, "`")
+		if dot := strings.LastIndex(w, "."); dot >= 0 {
+			w = strings.Trim(w[dot+1:], "`")
+		}
+		columns = append(columns, c.columns[w]...)
+	}
+	if len(columns) == 0 {
+		return c.allColumns()
+	}
+	return columns
+}
+
+func (c *sqlCompleter) allColumns() []string {
+	var columns []string
+	for _, tableColumns := range c.columns {
+		columns = append(columns, tableColumns...)
+	}
+	return columns
+}
+
+func isTableKeyword(word string) bool {
+	_, ok := tableKeywords[word]
+	return ok
+}
+
+func prepend(s string, ss []string) []string {
+	newSs := make([]string, len(ss))
+	for i := range ss {
+		newSs[i] = s + ss[i]
+	}
+	return newSs
+}
+
+// changesCompletions returns whether |query| is a statement that can change the shell's completion data, such as DDL,
+// USE, or a stored procedure call that creates branches or tables.
+func changesCompletions(query string) bool {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return false
+	}
+	switch statement.(type) {
+	case *sqlparser.DDL, *sqlparser.DBDDL, *sqlparser.AlterTable, *sqlparser.Use, *sqlparser.Call:
+		return true
+	default:
+		return false
+	}
+}
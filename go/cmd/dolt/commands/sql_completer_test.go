@@ -0,0 +1,97 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSqlCompleter(t *testing.T) {
+	c := &sqlCompleter{
+		keywords:   []string{"select", "from", "where", "as", "of"},
+		database:   "mydb",
+		databases:  []string{"mydb", "otherdb", "information_schema"},
+		procedures: []string{"dolt_checkout", "dolt_cherry_pick", "dolt_commit", "my_proc"},
+		refs:       []string{"main", "feature", "v1.0"},
+		tables: map[string][]string{
+			"mydb":    {"people", "pets", "dolt_log"},
+			"otherdb": {"orders"},
+		},
+		columns: map[string][]string{
+			"people":   {"id", "name"},
+			"pets":     {"id", "owner", "species"},
+			"dolt_log": {"commit_hash", "committer"},
+			"orders":   {"order_id", "total"},
+		},
+	}
+
+	tests := []struct {
+		line        string
+		suggestions []string
+	}{
+		{line: "sel", suggestions: []string{"ect"}},
+		{line: "select", suggestions: []string{" "}},
+		{line: "select * from p", suggestions: []string{"eople", "ets"}},
+		{line: "select * from dolt_", suggestions: []string{"log"}},
+		{line: "select * from o", suggestions: []string{"therdb"}},
+		{line: "select * from otherdb.", suggestions: []string{"orders"}},
+		{line: "select * from pets where s", suggestions: []string{"elect", "pecies"}},
+		{line: "select * from people where n", suggestions: []string{"ame"}},
+		{line: "select * from people where o", suggestions: []string{"f", "therdb"}},
+		{line: "select pets.o", suggestions: []string{"wner"}},
+		{line: "select p.o", suggestions: []string{"rder_id", "wner"}},
+		{line: "select * from pets as of f", suggestions: []string{"eature"}},
+		{line: "select * from pets AS OF 'v", suggestions: []string{"1.0'"}},
+		{line: "use o", suggestions: []string{"therdb"}},
+		{line: "call dolt_ch", suggestions: []string{"eckout", "erry_pick"}},
+		{line: "call my", suggestions: []string{"_proc"}},
+		{line: "call dolt_checkout('ma", suggestions: []string{"in'"}},
+		{line: "call dolt_checkout('--f", suggestions: []string{"orce'"}},
+		{line: "call dolt_checkout('-b', 'new', 'fe", suggestions: []string{"ature'"}},
+		{line: "call dolt_checkout(pe", suggestions: nil},
+		{line: "call dolt_checkout('pe", suggestions: []string{"ople", "ts"}},
+		{line: "call dolt_commit(", suggestions: []string{"'--ALL", "'--all", "'--allow-empty", "'--amend", "'--author", "'--date", "'--force", "'--gpg-sign", "'--message", "'--skip-empty", "'-A", "'-S", "'-a", "'-f", "'-m"}},
+		{line: "call dolt_commit('--am", suggestions: []string{"end'"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			newLine, length := c.Do([]rune(test.line), len(test.line))
+			var suggestions []string
+			for _, s := range newLine {
+				suggestions = append(suggestions, string(s))
+			}
+			assert.Equal(t, test.suggestions, suggestions)
+
+			start := strings.LastIndexAny(test.line, completionWordBreaks) + 1
+			assert.Equal(t, len(test.line)-start, length)
+		})
+	}
+}
+
+func TestChangesCompletions(t *testing.T) {
+	assert.True(t, changesCompletions("create table t (pk int primary key)"))
+	assert.True(t, changesCompletions("drop table t"))
+	assert.True(t, changesCompletions("alter table t add column c int"))
+	assert.True(t, changesCompletions("create database db"))
+	assert.True(t, changesCompletions("use db"))
+	assert.True(t, changesCompletions("call dolt_checkout('-b', 'br')"))
+	assert.False(t, changesCompletions("select * from t"))
+	assert.False(t, changesCompletions("insert into t values (1)"))
+	assert.False(t, changesCompletions("not sql"))
+}
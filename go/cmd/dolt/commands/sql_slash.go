@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/util/outputpager"
 )
 
 var slashCmds = []cli.Command{
@@ -38,8 +39,15 @@ var slashCmds = []cli.Command{
 	ResetCmd{},
 	BranchCmd{},
 	MergeCmd{},
+	TagCmd{},
+	CherryPickCmd{},
+	RebaseCmd{},
+	SlashStash{},
+	BlameCmd{},
+	SlashConflicts{},
 	SlashHelp{},
 	SlashEdit{},
+	SlashPager{},
 }
 
 // parseSlashCmd parses a command line string into a slice of strings, splitting on spaces, but allowing spaces within
@@ -191,27 +199,87 @@ func (s SlashEdit) Name() string {
 func (s SlashEdit) Description() string {
 	return "Use $EDITOR to edit the last command."
 }
+
+// Exec is only called when \edit is given arguments. \edit itself is handled by the shell, see preprocessQuery.
 func (s SlashEdit) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	cli.PrintErrln("\\edit takes no arguments")
+	return 1
+}
 
-	initialCmd := "select * from my_table;"
+func (s SlashEdit) Docs() *cli.CommandDocumentation {
+	return &cli.CommandDocumentation{
+		CommandStr: "\\edit",
+		ShortDesc:  "Use $EDITOR to edit the last command.",
+		LongDesc: `Opens the last SQL statement run in the shell in an editor, and runs the statement as edited once the editor exits. Nothing is run if the edited statement is empty.
 
-	contents, err := execEditor(initialCmd, ".sql", cliCtx)
-	if err != nil {
-		cli.PrintErrln(err.Error())
-		return 1
+The editor is the one configured with core.editor in dolt config, or $EDITOR, or vim if neither is set.`,
+		Synopsis:  []string{},
+		ArgParser: s.ArgParser(),
 	}
+}
+
+func (s SlashEdit) ArgParser() *argparser.ArgParser {
+	// No arguments.
+	return &argparser.ArgParser{}
+}
 
-	cli.Printf("Edited command: %s", contents)
+var slashPagerDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Configure the pager used by commands.",
+	LongDesc: `Configures how commands like \log and \show page their output for the rest of the session. With no arguments, shows whether output is paged, and with which pager.
 
-	return 0
+{{.EmphasisLeft}}on{{.EmphasisRight}} and {{.EmphasisLeft}}off{{.EmphasisRight}} turn paging on and off. {{.EmphasisLeft}}default{{.EmphasisRight}} turns paging on with the default pager, less, or more if less isn't installed. Any other arguments are the pager program to use and its arguments, which also turns paging on.
+
+Output is only ever paged when it's written to a terminal.`,
+	Synopsis: []string{
+		"",
+		"on|off|default",
+		"{{.LessThan}}program{{.GreaterThan}} [{{.LessThan}}args{{.GreaterThan}}...]",
+	},
 }
 
-func (s SlashEdit) Docs() *cli.CommandDocumentation {
-	//TODO implement me
-	return &cli.CommandDocumentation{}
+type SlashPager struct{}
+
+var _ cli.Command = SlashPager{}
+
+func (s SlashPager) Name() string {
+	return "pager"
 }
 
-func (s SlashEdit) ArgParser() *argparser.ArgParser {
-	// No arguments.
-	return &argparser.ArgParser{}
+func (s SlashPager) Description() string {
+	return "Configure the pager used by commands."
+}
+
+func (s SlashPager) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(slashPagerDocs, s.ArgParser())
+}
+
+func (s SlashPager) ArgParser() *argparser.ArgParser {
+	return argparser.NewArgParserWithVariableArgs(s.Name())
+}
+
+func (s SlashPager) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
+	switch {
+	case len(args) == 0:
+	case len(args) == 1 && strings.EqualFold(args[0], "on"):
+		outputpager.SetEnabled(true)
+	case len(args) == 1 && strings.EqualFold(args[0], "off"):
+		outputpager.SetEnabled(false)
+	case len(args) == 1 && strings.EqualFold(args[0], "default"):
+		outputpager.SetCommand(nil)
+		outputpager.SetEnabled(true)
+	default:
+		outputpager.SetCommand(args)
+		outputpager.SetEnabled(true)
+	}
+
+	pager := "the default pager"
+	if command := outputpager.Command(); len(command) > 0 {
+		pager = strings.Join(command, " ")
+	}
+	if outputpager.Enabled() {
+		cli.Printf("Output is paged with %s.\n", pager)
+	} else {
+		cli.Println("Output is not paged.")
+	}
+	return 0
 }
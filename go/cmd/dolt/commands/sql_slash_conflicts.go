@@ -0,0 +1,633 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/ishell"
+	"github.com/fatih/color"
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/untyped/tabular"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+)
+
+var slashConflictsDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Show and resolve merge conflicts.",
+	LongDesc: `With no arguments, lists the tables with merge conflicts and the number of conflicts in each.
+
+{{.EmphasisLeft}}resolve --ours{{.EmphasisRight}} and {{.EmphasisLeft}}resolve --theirs{{.EmphasisRight}} resolve all the conflicts of the tables given by taking our or their version of every conflicting row.
+
+Otherwise, {{.EmphasisLeft}}resolve{{.EmphasisRight}} resolves the conflicts of the tables given, or of all tables, interactively. Each conflict is shown with the base, our and their version of the row. For each column whose value differs between ours and theirs, you choose to keep our value, or to take their value, the base value, or a new value. When one side deleted the row, you choose which version of the row to keep instead. A conflict is resolved once all choices for it have been made, and skipped conflicts are left as they are.
+
+Conflicts of tables without a primary key can only be resolved with {{.EmphasisLeft}}--ours{{.EmphasisRight}} or {{.EmphasisLeft}}--theirs{{.EmphasisRight}}.`,
+	Synopsis: []string{
+		"",
+		"resolve --ours|--theirs {{.LessThan}}table{{.GreaterThan}}...",
+		"resolve [{{.LessThan}}table{{.GreaterThan}}...]",
+	},
+}
+
+// SlashConflicts is the \conflicts command of the SQL shell. Conflicts are resolved through the dolt_conflicts_$table
+// system tables, so this works against a remote server too.
+type SlashConflicts struct{}
+
+var _ cli.Command = SlashConflicts{}
+
+func (s SlashConflicts) Name() string {
+	return "conflicts"
+}
+
+func (s SlashConflicts) Description() string {
+	return "Show and resolve merge conflicts."
+}
+
+func (s SlashConflicts) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(slashConflictsDocs, s.ArgParser())
+}
+
+func (s SlashConflicts) ArgParser() *argparser.ArgParser {
+	return cli.CreateConflictsResolveArgParser()
+}
+
+func (s SlashConflicts) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := s.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, slashConflictsDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if apr.NArg() == 0 {
+		if apr.ContainsAny(cli.OursFlag, cli.TheirsFlag) {
+			usage()
+			return 1
+		}
+	} else if !strings.EqualFold(apr.Arg(0), "resolve") {
+		usage()
+		return 1
+	}
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	if apr.NArg() == 0 {
+		err = printConflictSummary(sqlCtx, queryist)
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	// Resolving only some of the conflicts leaves the working set with conflicts, which can't be committed unless
+	// @@dolt_allow_commit_conflicts is set.
+	restore, err := allowCommitConflicts(sqlCtx, queryist)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	defer restore()
+
+	if apr.ContainsAny(cli.OursFlag, cli.TheirsFlag) {
+		query, err := interpolateStoredProcedureCall("DOLT_CONFLICTS_RESOLVE", args[1:])
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		_, err = GetRowsForSql(queryist, sqlCtx, query)
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	return runConflictsShell(sqlCtx, queryist, apr.Args[1:])
+}
+
+// getTablesWithConflicts returns the tables with data conflicts, and the number of conflicts in each.
+func getTablesWithConflicts(sqlCtx *sql.Context, queryist cli.Queryist) ([]string, []string, error) {
+	rows, err := GetRowsForSql(queryist, sqlCtx, "SELECT `table`, num_conflicts FROM dolt_conflicts ORDER BY `table`")
+	if err != nil {
+		return nil, nil, err
+	}
+	tables := make([]string, len(rows))
+	counts := make([]string, len(rows))
+	for i, row := range rows {
+		tables[i] = fmt.Sprint(row[0])
+		counts[i] = fmt.Sprint(row[1])
+	}
+	return tables, counts, nil
+}
+
+func printConflictSummary(sqlCtx *sql.Context, queryist cli.Queryist) error {
+	tables, counts, err := getTablesWithConflicts(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+	if len(tables) == 0 {
+		cli.Println("No conflicts.")
+		return nil
+	}
+
+	cli.Println("Tables with conflicts:")
+	for i := range tables {
+		cli.Println(color.RedString("\t%s: %s conflict(s)", tables[i], counts[i]))
+	}
+	cli.Println("Use \\conflicts resolve to resolve them.")
+	return nil
+}
+
+// allowCommitConflicts sets @@dolt_allow_commit_conflicts for the session, and returns a function which restores its
+// previous value.
+func allowCommitConflicts(sqlCtx *sql.Context, queryist cli.Queryist) (func(), error) {
+	rows, err := GetRowsForSql(queryist, sqlCtx, "SELECT @@dolt_allow_commit_conflicts")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = GetRowsForSql(queryist, sqlCtx, "SET @@dolt_allow_commit_conflicts = 1"); err != nil {
+		return nil, err
+	}
+	return func() {
+		_, _ = GetRowsForSql(queryist, sqlCtx, fmt.Sprintf("SET @@dolt_allow_commit_conflicts = %v", rows[0][0]))
+	}, nil
+}
+
+func runConflictsShell(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) int {
+	state, err := newConflictsState(sqlCtx, queryist, tables)
+	if err != nil {
+		cli.PrintErrln(errhand.VerboseErrorFromError(err))
+		return 1
+	}
+
+	if !state.done {
+		shell := ishell.New()
+		shell.AutoHelp(false)
+		shell.NotFound(conflictsHelp)
+
+		shell.AddCmd(&ishell.Cmd{
+			Name: "?",
+			Help: "show this help",
+			Func: conflictsHelp,
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "o",
+			Help: "keep ours",
+			Func: state.withPrompt(state.keepOurs),
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "t",
+			Help: "take theirs",
+			Func: state.withPrompt(state.takeTheirs),
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "b",
+			Help: "take the base value",
+			Func: state.withPrompt(state.takeBase),
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "e",
+			Help: "enter a new value",
+			Func: state.withPrompt(state.enterValue),
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "s",
+			Help: "skip this conflict",
+			Func: state.withPrompt(state.skipConflict),
+		})
+		shell.AddCmd(&ishell.Cmd{
+			Name: "q",
+			Help: "quit",
+			Func: state.stop,
+		})
+
+		shell.SetPrompt(state.prompt())
+
+		// run shell. This blocks until the stop() function is called on the ishell context.
+		shell.Run()
+	}
+
+	if state.err != nil {
+		cli.PrintErrln(errhand.VerboseErrorFromError(state.err))
+		return 1
+	}
+	cli.Printf("Resolved %d conflict(s).\n", state.resolved)
+	return 0
+}
+
+func conflictsHelp(_ *ishell.Context) {
+	help := `o - keep ours: our value of this column, or our version of the row
+t - take theirs: their value of this column, or their version of the row
+b - take the base value of this column
+e - enter a new value for this column, as a SQL expression
+s - skip this conflict, leaving it unresolved
+q - quit; leave this conflict and the remaining ones unresolved
+? - show this help`
+	cli.Println(color.CyanString(help))
+}
+
+// conflictsState is the state of the interactive conflict resolution workflow. The conflicts of a table are loaded
+// when the workflow moves on to it, and each conflict is resolved as soon as the last choice for it is made, by
+// updating our version of the row through the table's dolt_conflicts_$table system table and then deleting the
+// conflict.
+type conflictsState struct {
+	sqlCtx   *sql.Context
+	queryist cli.Queryist
+	tables   []string
+	tableIdx int
+
+	table          string
+	conflictsTable string
+	columns        []string
+	pkColumns      []string
+	// ordinals maps the names of the columns of the conflicts table to their position in its rows.
+	ordinals  map[string]int
+	schema    sql.Schema
+	conflicts []sql.Row
+	current   int
+
+	// rowLevel is true if one side deleted the row of the current conflict, so that a version of the whole row must be
+	// chosen. Otherwise, cells are the columns whose values differ between ours and theirs, and cell is the index of
+	// the one being resolved.
+	rowLevel bool
+	hasBase  bool
+	cells    []string
+	cell     int
+	// assignments are the assignments to the our_ columns of the conflicts table for the values chosen so far.
+	assignments []string
+
+	resolved int
+	done     bool
+	err      error
+}
+
+// newConflictsState returns a new conflictsState for the tables given, or for all tables with conflicts if none are,
+// and shows the first conflict. The state is done if there are no conflicts to resolve.
+func newConflictsState(sqlCtx *sql.Context, queryist cli.Queryist, tables []string) (*conflictsState, error) {
+	withConflicts, _, err := getTablesWithConflicts(sqlCtx, queryist)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		tables = withConflicts
+	} else {
+		for _, table := range tables {
+			found := false
+			for _, t := range withConflicts {
+				found = found || strings.EqualFold(t, table)
+			}
+			if !found {
+				return nil, fmt.Errorf("table %s has no conflicts", table)
+			}
+		}
+	}
+	if len(tables) == 0 {
+		cli.Println("No conflicts.")
+	}
+
+	ans := &conflictsState{sqlCtx: sqlCtx, queryist: queryist, tables: tables, tableIdx: -1}
+	ans.nextTable(nil)
+	if ans.err != nil {
+		return nil, ans.err
+	}
+	return ans, nil
+}
+
+// prompt returns the prompt for the current conflict, or cell of the current conflict.
+func (cs *conflictsState) prompt() string {
+	var prompt string
+	switch {
+	case cs.done:
+	case cs.rowLevel:
+		prompt = "Keep which version of this row [o,t,s,q,?]? "
+	case cs.hasBase:
+		prompt = fmt.Sprintf("Keep which value of %s [o,t,b,e,s,q,?]? ", cs.cells[cs.cell])
+	default:
+		prompt = fmt.Sprintf("Keep which value of %s [o,t,e,s,q,?]? ", cs.cells[cs.cell])
+	}
+	return color.HiGreenString(prompt)
+}
+
+// withPrompt wraps a command so that the prompt is updated for the conflict shown after it runs.
+func (cs *conflictsState) withPrompt(f func(c *ishell.Context)) func(c *ishell.Context) {
+	return func(c *ishell.Context) {
+		f(c)
+		c.SetPrompt(cs.prompt())
+	}
+}
+
+// stop stops the shell. The context is nil if the shell has not been started yet. "q" command.
+func (cs *conflictsState) stop(c *ishell.Context) {
+	cs.done = true
+	if c != nil {
+		c.Stop()
+	}
+}
+
+// fail records |err| and stops the shell.
+func (cs *conflictsState) fail(c *ishell.Context, err error) {
+	cs.err = err
+	cs.stop(c)
+}
+
+// keepOurs keeps our value of the current cell, or our version of the row. "o" command.
+func (cs *conflictsState) keepOurs(c *ishell.Context) {
+	if cs.rowLevel {
+		cs.resolveRow(c, false)
+	} else {
+		cs.chooseValue(c, "")
+	}
+}
+
+// takeTheirs takes their value of the current cell, or their version of the row. "t" command.
+func (cs *conflictsState) takeTheirs(c *ishell.Context) {
+	if cs.rowLevel {
+		cs.resolveRow(c, true)
+	} else {
+		col := cs.cells[cs.cell]
+		cs.chooseValue(c, fmt.Sprintf("%s = %s", sql.QuoteIdentifier("our_"+col), sql.QuoteIdentifier("their_"+col)))
+	}
+}
+
+// takeBase takes the base value of the current cell. "b" command.
+func (cs *conflictsState) takeBase(c *ishell.Context) {
+	if cs.rowLevel || !cs.hasBase {
+		cli.Println(color.RedString("There is no base value to take for this conflict"))
+		return
+	}
+	col := cs.cells[cs.cell]
+	cs.chooseValue(c, fmt.Sprintf("%s = %s", sql.QuoteIdentifier("our_"+col), sql.QuoteIdentifier("base_"+col)))
+}
+
+// enterValue reads a new value for the current cell. The value is a SQL expression, which is checked before it's
+// chosen. "e" command.
+func (cs *conflictsState) enterValue(c *ishell.Context) {
+	if cs.rowLevel {
+		cli.Println(color.RedString("A value can't be entered when a row was deleted"))
+		return
+	}
+	col := cs.cells[cs.cell]
+	c.SetPrompt(color.HiGreenString("New value of %s (e.g. 'text', 42 or NULL): ", col))
+	value, err := c.ReadLineErr()
+	if err != nil {
+		return
+	}
+	value = strings.TrimSuffix(strings.TrimSpace(value), ";")
+	if len(value) == 0 {
+		return
+	}
+	if _, err = GetRowsForSql(cs.queryist, cs.sqlCtx, "SELECT "+value); err != nil {
+		cli.Println(color.RedString("Invalid value: %s", err.Error()))
+		return
+	}
+	cs.chooseValue(c, fmt.Sprintf("%s = (%s)", sql.QuoteIdentifier("our_"+col), value))
+}
+
+// skipConflict leaves the current conflict unresolved. "s" command.
+func (cs *conflictsState) skipConflict(c *ishell.Context) {
+	cs.nextConflict(c)
+}
+
+// chooseValue records the assignment for the value chosen for the current cell, which is empty to keep our value. The
+// conflict is resolved once a value has been chosen for its last cell.
+func (cs *conflictsState) chooseValue(c *ishell.Context, assignment string) {
+	if len(assignment) > 0 {
+		cs.assignments = append(cs.assignments, assignment)
+	}
+	cs.cell++
+	if cs.cell < len(cs.cells) {
+		return
+	}
+
+	if len(cs.assignments) > 0 {
+		query := fmt.Sprintf("UPDATE %s SET %s WHERE dolt_conflict_id = ?", sql.QuoteIdentifier(cs.conflictsTable), strings.Join(cs.assignments, ", "))
+		if err := cs.execForConflict(query); err != nil {
+			cs.fail(c, err)
+			return
+		}
+	}
+	cs.markResolved(c)
+}
+
+// resolveRow resolves the current conflict, in which one side deleted the row, by keeping our version of the row or
+// taking theirs.
+func (cs *conflictsState) resolveRow(c *ishell.Context, theirs bool) {
+	if theirs {
+		var query string
+		if fmt.Sprint(cs.conflicts[cs.current][cs.ordinals["our_diff_type"]]) == "removed" {
+			theirCols := make([]string, len(cs.columns))
+			for i, col := range cs.columns {
+				theirCols[i] = sql.QuoteIdentifier("their_" + col)
+			}
+			query = fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s WHERE dolt_conflict_id = ?", sql.QuoteIdentifier(cs.table),
+				quoteIdentifiers(cs.columns, ""), strings.Join(theirCols, ", "), sql.QuoteIdentifier(cs.conflictsTable))
+		} else {
+			query = fmt.Sprintf("DELETE FROM %s WHERE (%s) IN (SELECT %s FROM %s WHERE dolt_conflict_id = ?)", sql.QuoteIdentifier(cs.table),
+				quoteIdentifiers(cs.pkColumns, ""), quoteIdentifiers(cs.pkColumns, "our_"), sql.QuoteIdentifier(cs.conflictsTable))
+		}
+		if err := cs.execForConflict(query); err != nil {
+			cs.fail(c, err)
+			return
+		}
+	}
+	cs.markResolved(c)
+}
+
+// markResolved deletes the current conflict, and moves on to the next one.
+func (cs *conflictsState) markResolved(c *ishell.Context) {
+	query := fmt.Sprintf("DELETE FROM %s WHERE dolt_conflict_id = ?", sql.QuoteIdentifier(cs.conflictsTable))
+	if err := cs.execForConflict(query); err != nil {
+		cs.fail(c, err)
+		return
+	}
+	cs.resolved++
+	cs.nextConflict(c)
+}
+
+// execForConflict runs |query| with the ID of the current conflict as its parameter.
+func (cs *conflictsState) execForConflict(query string) error {
+	id := cs.conflicts[cs.current][cs.ordinals["dolt_conflict_id"]]
+	query, err := dbr.InterpolateForDialect(query, []interface{}{fmt.Sprint(id)}, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+	_, err = GetRowsForSql(cs.queryist, cs.sqlCtx, query)
+	return err
+}
+
+// nextConflict moves the state to the next conflict of the current table, and shows it. If there are no more
+// conflicts in the current table, the state moves to the next table.
+func (cs *conflictsState) nextConflict(c *ishell.Context) {
+	cs.current++
+	if cs.current < len(cs.conflicts) {
+		cs.showCurrentConflict(c)
+	} else {
+		cs.nextTable(c)
+	}
+}
+
+// nextTable moves the state to the next table, loads its conflicts and shows the first of them. If there are no more
+// tables, the shell is stopped.
+func (cs *conflictsState) nextTable(c *ishell.Context) {
+	cs.tableIdx++
+	if cs.tableIdx >= len(cs.tables) {
+		cs.stop(c)
+		return
+	}
+
+	cs.table = cs.tables[cs.tableIdx]
+	cs.conflictsTable = "dolt_conflicts_" + cs.table
+	if err := cs.loadTable(); err != nil {
+		cs.fail(c, err)
+		return
+	}
+
+	cli.Printf("%s", tableHeader(cs.table))
+	if len(cs.pkColumns) == 0 {
+		cli.Println(color.YellowString("Table %s has no primary key. Use \\conflicts resolve --ours or --theirs to resolve its conflicts.", cs.table))
+		cs.conflicts = nil
+	}
+
+	cs.current = -1
+	cs.nextConflict(c)
+}
+
+// loadTable loads the columns, primary key and conflicts of the current table.
+func (cs *conflictsState) loadTable() error {
+	schema, _, _, err := cs.queryist.Query(cs.sqlCtx, fmt.Sprintf("SELECT * FROM %s LIMIT 0", sql.QuoteIdentifier(cs.table)))
+	if err != nil {
+		return err
+	}
+	cs.columns = make([]string, len(schema))
+	for i, col := range schema {
+		cs.columns[i] = col.Name
+	}
+
+	query, err := dbr.InterpolateForDialect("SELECT column_name FROM information_schema.key_column_usage "+
+		"WHERE table_schema = database() AND table_name = ? AND constraint_name = 'PRIMARY' ORDER BY ordinal_position",
+		[]interface{}{cs.table}, dialect.MySQL)
+	if err != nil {
+		return err
+	}
+	rows, err := GetRowsForSql(cs.queryist, cs.sqlCtx, query)
+	if err != nil {
+		return err
+	}
+	cs.pkColumns = make([]string, len(rows))
+	for i, row := range rows {
+		cs.pkColumns[i] = fmt.Sprint(row[0])
+	}
+
+	cs.schema, _, _, err = cs.queryist.Query(cs.sqlCtx, fmt.Sprintf("SELECT * FROM %s LIMIT 0", sql.QuoteIdentifier(cs.conflictsTable)))
+	if err != nil {
+		return err
+	}
+	cs.ordinals = make(map[string]int)
+	for i, col := range cs.schema {
+		cs.ordinals[col.Name] = i
+	}
+	cs.conflicts, err = GetRowsForSql(cs.queryist, cs.sqlCtx, fmt.Sprintf("SELECT * FROM %s", sql.QuoteIdentifier(cs.conflictsTable)))
+	return err
+}
+
+// showCurrentConflict prints the current conflict, and works out the choices to be made to resolve it.
+func (cs *conflictsState) showCurrentConflict(c *ishell.Context) {
+	conflict := cs.conflicts[cs.current]
+	ourDiffType := fmt.Sprint(conflict[cs.ordinals["our_diff_type"]])
+	theirDiffType := fmt.Sprint(conflict[cs.ordinals["their_diff_type"]])
+	cs.rowLevel = ourDiffType == "removed" || theirDiffType == "removed"
+	cs.hasBase = ourDiffType != "added"
+	cs.cells = nil
+	cs.cell = 0
+	cs.assignments = nil
+
+	displaySchema := sql.Schema{{Name: "", Type: types.LongText}}
+	for _, col := range cs.columns {
+		displaySchema = append(displaySchema, &sql.Column{Name: col, Type: cs.schema[cs.ordinals["our_"+col]].Type})
+	}
+	var displayRows []sql.Row
+	for _, version := range []string{"base", "our", "their"} {
+		if version == "base" && !cs.hasBase ||
+			version == "our" && ourDiffType == "removed" ||
+			version == "their" && theirDiffType == "removed" {
+			continue
+		}
+		row := sql.Row{version + "s"}
+		if version == "base" {
+			row[0] = "base"
+		}
+		for _, col := range cs.columns {
+			row = append(row, conflict[cs.ordinals[version+"_"+col]])
+		}
+		displayRows = append(displayRows, row)
+	}
+
+	if !cs.rowLevel {
+		for i, col := range cs.columns {
+			ours, err := sqlCellString(displaySchema[i+1].Type, conflict[cs.ordinals["our_"+col]])
+			if err != nil {
+				cs.fail(c, err)
+				return
+			}
+			theirs, err := sqlCellString(displaySchema[i+1].Type, conflict[cs.ordinals["their_"+col]])
+			if err != nil {
+				cs.fail(c, err)
+				return
+			}
+			if ours != theirs {
+				cs.cells = append(cs.cells, col)
+			}
+		}
+	}
+
+	cli.Printf("Conflict %d of %d (ours %s, theirs %s):\n", cs.current+1, len(cs.conflicts), ourDiffType, theirDiffType)
+	writer := tabular.NewFixedWidthTableWriter(displaySchema, iohelp.NopWrCloser(cli.CliOut), len(displayRows))
+	for _, row := range displayRows {
+		if err := writer.WriteSqlRow(cs.sqlCtx, row); err != nil {
+			cs.fail(c, err)
+			return
+		}
+	}
+	if err := writer.Close(cs.sqlCtx); err != nil {
+		cs.fail(c, err)
+		return
+	}
+
+	// Both sides made the same change to every column, so there's nothing to choose.
+	if !cs.rowLevel && len(cs.cells) == 0 {
+		cs.markResolved(c)
+	}
+}
+
+// sqlCellString returns the string form of a value of the type given, which distinguishes NULL from the string "NULL".
+func sqlCellString(typ sql.Type, val interface{}) (string, error) {
+	if val == nil {
+		return "\x00NULL", nil
+	}
+	return sqlutil.SqlColToStr(typ, val)
+}
+
+// quoteIdentifiers returns the quoted names of |cols|, prefixed by |prefix|, separated by commas.
+func quoteIdentifiers(cols []string, prefix string) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = sql.QuoteIdentifier(prefix + col)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var slashStashDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Stash the changes in a dirty working set away.",
+	LongDesc: `Saves the local changes of the working set and the staged tables as a new stash entry, and reverts the working set to match the HEAD commit. Untracked tables are only stashed with {{.EmphasisLeft}}--include-untracked{{.EmphasisRight}}, and ignored tables only with {{.EmphasisLeft}}--all{{.EmphasisRight}}.
+
+{{.EmphasisLeft}}list{{.EmphasisRight}} lists the stash entries, most recent first. {{.EmphasisLeft}}pop{{.EmphasisRight}} applies a stash entry to the working set and removes it from the list, unless applying it conflicts with the local changes. {{.EmphasisLeft}}drop{{.EmphasisRight}} removes a stash entry without applying it, and {{.EmphasisLeft}}clear{{.EmphasisRight}} removes all of them. Stash entries are named like stash@{1}, and pop and drop use the latest one, stash@{0}, by default.
+
+This is also available as the {{.EmphasisLeft}}DOLT_STASH(){{.EmphasisRight}} stored procedure and the {{.EmphasisLeft}}dolt_stashes{{.EmphasisRight}} system table.`,
+	Synopsis: []string{
+		"[push] [-u] [-a]",
+		"list",
+		"pop [{{.LessThan}}stash{{.GreaterThan}}]",
+		"drop [{{.LessThan}}stash{{.GreaterThan}}]",
+		"clear",
+	},
+}
+
+// SlashStash is the \stash command of the SQL shell. Unlike `dolt stash`, it works through the shell's queryist, so it
+// can be used against a remote server too.
+type SlashStash struct{}
+
+var _ cli.Command = SlashStash{}
+
+func (s SlashStash) Name() string {
+	return "stash"
+}
+
+func (s SlashStash) Description() string {
+	return "Stash the changes in a dirty working set away."
+}
+
+func (s SlashStash) Docs() *cli.CommandDocumentation {
+	return cli.NewCommandDocumentation(slashStashDocs, s.ArgParser())
+}
+
+func (s SlashStash) ArgParser() *argparser.ArgParser {
+	return cli.CreateStashArgParser()
+}
+
+func (s SlashStash) Exec(ctx context.Context, commandStr string, args []string, _ *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := s.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, slashStashDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	subcommand := "push"
+	if apr.NArg() > 0 {
+		subcommand = strings.ToLower(apr.Arg(0))
+	}
+
+	switch subcommand {
+	case "list":
+		if apr.NArg() > 1 {
+			usage()
+			return 1
+		}
+		err = printStashes(sqlCtx, queryist)
+	case "push":
+		err = pushStash(sqlCtx, queryist, args)
+	case "pop", "drop":
+		err = removeStash(sqlCtx, queryist, apr, args)
+		if subcommand == "pop" {
+			if err != nil {
+				cli.Println("The stash entry is kept in case you need it again.")
+			} else {
+				return StatusCmd{}.Exec(sqlCtx, "status", []string{}, nil, cliCtx)
+			}
+		}
+	default:
+		err = callStash(sqlCtx, queryist, args)
+	}
+
+	return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+}
+
+// stashEntry is a row of the dolt_stashes system table.
+type stashEntry struct {
+	name, branch, commitHash, commitMessage string
+}
+
+func (se stashEntry) String() string {
+	return fmt.Sprintf("WIP on %s: %s %s", se.branch, se.commitHash, se.commitMessage)
+}
+
+// getStashes returns the stash entries of the current database, most recent first.
+func getStashes(sqlCtx *sql.Context, queryist cli.Queryist) ([]stashEntry, error) {
+	rows, err := GetRowsForSql(queryist, sqlCtx, "SELECT name, branch, commit_hash, commit_message FROM dolt_stashes")
+	if err != nil {
+		return nil, err
+	}
+	stashes := make([]stashEntry, len(rows))
+	for i, row := range rows {
+		stashes[i] = stashEntry{
+			name:          fmt.Sprint(row[0]),
+			branch:        fmt.Sprint(row[1]),
+			commitHash:    fmt.Sprint(row[2]),
+			commitMessage: fmt.Sprint(row[3]),
+		}
+	}
+	return stashes, nil
+}
+
+func printStashes(sqlCtx *sql.Context, queryist cli.Queryist) error {
+	stashes, err := getStashes(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+	for _, stash := range stashes {
+		cli.Printf("%s: %s\n", stash.name, stash)
+	}
+	return nil
+}
+
+// pushStash stashes the local changes, and prints the new stash entry. The procedure does nothing when there are no
+// local changes to save, which is detected by the number of stash entries staying the same.
+func pushStash(sqlCtx *sql.Context, queryist cli.Queryist, args []string) error {
+	before, err := getStashes(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+	if err = callStash(sqlCtx, queryist, args); err != nil {
+		return err
+	}
+	after, err := getStashes(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+
+	if len(after) == len(before) {
+		cli.Println("No local changes to save")
+	} else {
+		cli.Printf("Saved working directory and index state %s\n", after[0])
+	}
+	return nil
+}
+
+// removeStash pops or drops the stash entry named in |apr|, and prints the entry that was removed.
+func removeStash(sqlCtx *sql.Context, queryist cli.Queryist, apr *argparser.ArgParseResults, args []string) error {
+	name := "stash@{0}"
+	if apr.NArg() > 1 {
+		name = apr.Arg(1)
+		if _, err := strconv.Atoi(name); err == nil {
+			name = fmt.Sprintf("stash@{%s}", name)
+		}
+	}
+
+	stashes, err := getStashes(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+	if err = callStash(sqlCtx, queryist, args); err != nil {
+		return err
+	}
+
+	for _, stash := range stashes {
+		if stash.name == name {
+			cli.Printf("Dropped %s (%s)\n", stash.name, stash)
+		}
+	}
+	return nil
+}
+
+func callStash(sqlCtx *sql.Context, queryist cli.Queryist, args []string) error {
+	query, err := interpolateStoredProcedureCall("DOLT_STASH", args)
+	if err != nil {
+		return err
+	}
+	_, err = GetRowsForSql(queryist, sqlCtx, query)
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+)
+
+// queryStrings runs |query| and returns the first column of each of its rows as a string.
+func queryStrings(t *testing.T, queryist cli.Queryist, sqlCtx *sql.Context, query string) []string {
+	rows, err := GetRowsForSql(queryist, sqlCtx, query)
+	require.NoError(t, err)
+	ans := make([]string, len(rows))
+	for i, row := range rows {
+		ans[i] = fmt.Sprint(row[0])
+	}
+	return ans
+}
+
+// Tests the choices of the \conflicts resolve workflow, which resolve conflicts through the dolt_conflicts_$table
+// system tables.
+func TestSlashConflictsResolve(t *testing.T) {
+	ctx := context.Background()
+	dEnv := dtestutils.CreateTestEnv()
+	defer dEnv.DoltDB.Close()
+	cliCtx, verr := NewArgFreeCliContext(ctx, dEnv, dEnv.FS)
+	require.NoError(t, verr)
+	queryist, sqlCtx, closer, err := cliCtx.QueryEngine(ctx)
+	require.NoError(t, err)
+	defer closer()
+
+	for _, query := range []string{
+		"SET @@dolt_allow_commit_conflicts = 1",
+		"CREATE TABLE t (pk int primary key, a int, b int)",
+		"INSERT INTO t VALUES (1, 0, 0), (2, 0, 0), (3, 0, 0), (4, 0, 0)",
+		"CALL DOLT_COMMIT('-Am', 'base')",
+		"CALL DOLT_BRANCH('other')",
+		"UPDATE t SET a = 1 WHERE pk = 1",
+		"DELETE FROM t WHERE pk = 2",
+		"UPDATE t SET a = 4, b = 4 WHERE pk = 3",
+		"UPDATE t SET a = 5 WHERE pk = 4",
+		"CALL DOLT_COMMIT('-am', 'ours')",
+		"CALL DOLT_CHECKOUT('other')",
+		"UPDATE t SET a = 2, b = 5 WHERE pk = 1",
+		"UPDATE t SET a = 3, b = 3 WHERE pk = 2",
+		"DELETE FROM t WHERE pk = 3",
+		"UPDATE t SET a = 6 WHERE pk = 4",
+		"CALL DOLT_COMMIT('-am', 'theirs')",
+		"CALL DOLT_CHECKOUT('main')",
+		"CALL DOLT_MERGE('other')",
+	} {
+		_, err = GetRowsForSql(queryist, sqlCtx, query)
+		require.NoError(t, err, query)
+	}
+
+	state, err := newConflictsState(sqlCtx, queryist, nil)
+	require.NoError(t, err)
+	require.False(t, state.done)
+
+	// pk 1 was updated by both sides, so a value is chosen for each column that differs
+	require.False(t, state.rowLevel)
+	require.Equal(t, []string{"a", "b"}, state.cells)
+	state.takeTheirs(nil)
+	state.takeBase(nil)
+
+	// pk 2 was deleted by us, and pk 3 by them, so a version of the whole row is chosen
+	require.True(t, state.rowLevel)
+	state.takeTheirs(nil)
+	require.True(t, state.rowLevel)
+	state.takeTheirs(nil)
+
+	// pk 4 is left unresolved
+	require.False(t, state.rowLevel)
+	require.Equal(t, []string{"a"}, state.cells)
+	state.skipConflict(nil)
+
+	require.True(t, state.done)
+	require.NoError(t, state.err)
+	assert.Equal(t, 3, state.resolved)
+	assert.Equal(t, []string{"1,2,0", "2,3,3", "4,5,0"}, queryStrings(t, queryist, sqlCtx, "SELECT concat(pk, ',', a, ',', b) FROM t ORDER BY pk"))
+	assert.Equal(t, []string{"4"}, queryStrings(t, queryist, sqlCtx, "SELECT our_pk FROM dolt_conflicts_t"))
+}
+
+// Tests pushing, listing and popping stash entries with \stash.
+func TestSlashStash(t *testing.T) {
+	ctx := context.Background()
+	dEnv := dtestutils.CreateTestEnv()
+	defer dEnv.DoltDB.Close()
+	cliCtx, verr := NewArgFreeCliContext(ctx, dEnv, dEnv.FS)
+	require.NoError(t, verr)
+	queryist, sqlCtx, closer, err := cliCtx.QueryEngine(ctx)
+	require.NoError(t, err)
+	defer closer()
+
+	for _, query := range []string{
+		"CREATE TABLE t (pk int primary key)",
+		"CALL DOLT_COMMIT('-Am', 'created table')",
+		"INSERT INTO t VALUES (1)",
+	} {
+		_, err = GetRowsForSql(queryist, sqlCtx, query)
+		require.NoError(t, err, query)
+	}
+
+	tests := []struct {
+		args     []string
+		exitCode int
+		stashes  []string
+		rows     []string
+	}{
+		{[]string{"list"}, 0, []string{}, []string{"1"}},
+		{[]string{}, 0, []string{"stash@{0}"}, []string{}},
+		{[]string{"push"}, 0, []string{"stash@{0}"}, []string{}},
+		{[]string{"pop", "stash@{1}"}, 1, []string{"stash@{0}"}, []string{}},
+		{[]string{"pop"}, 0, []string{}, []string{"1"}},
+		{[]string{"drop"}, 1, []string{}, []string{"1"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.exitCode, SlashStash{}.Exec(ctx, "\\stash", test.args, dEnv, cliCtx), "%v", test.args)
+		assert.Equal(t, test.stashes, queryStrings(t, queryist, sqlCtx, "SELECT name FROM dolt_stashes"), "%v", test.args)
+		assert.Equal(t, test.rows, queryStrings(t, queryist, sqlCtx, "SELECT pk FROM t"), "%v", test.args)
+	}
+}
@@ -295,6 +295,22 @@ func (cfg *commandLineServerConfig) ClusterConfig() servercfg.ClusterConfig {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) TracingConfig() servercfg.TracingConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) AuditLogConfig() servercfg.AuditLogConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) SlowQueryLogConfig() servercfg.SlowQueryLogConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) LDAPConfig() servercfg.LDAPConfig {
+	return nil
+}
+
 // PrivilegeFilePath returns the path to the file which contains all needed privilege information in the form of a
 // JSON string.
 func (cfg *commandLineServerConfig) PrivilegeFilePath() string {
@@ -311,6 +327,10 @@ func (cfg *commandLineServerConfig) UserVars() []servercfg.UserSessionVars {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) UserLimits() []servercfg.UserLimits {
+	return nil
+}
+
 func (cfg *commandLineServerConfig) SystemVars() map[string]interface{} {
 	return nil
 }
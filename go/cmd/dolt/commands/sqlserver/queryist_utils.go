@@ -17,6 +17,7 @@ package sqlserver
 import (
 	"context"
 	sql2 "database/sql"
+	"errors"
 	"fmt"
 	"io"
 
@@ -34,9 +35,17 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 )
 
+// ErrTokenRequiresTLS is returned when a bearer token would be sent to a server over a connection without TLS. The
+// token is sent in cleartext, so anyone able to observe the connection could replay it.
+var ErrTokenRequiresTLS = errors.New("token authentication requires TLS, and cannot be used with --no-tls")
+
 // BuildConnectionStringQueryist returns a Queryist that connects to the server specified by the given server config. Presence in this
 // module isn't ideal, but it's the only way to get the server config into the queryist.
 func BuildConnectionStringQueryist(ctx context.Context, cwdFS filesys.Filesys, creds *cli.UserPassword, apr *argparser.ArgParseResults, host string, port int, useTLS bool, dbRev string) (cli.LateBindQueryist, error) {
+	if creds.Token && !useTLS {
+		return nil, ErrTokenRequiresTLS
+	}
+
 	clientConfig, err := GetClientConfig(cwdFS, creds, apr)
 	if err != nil {
 		return nil, err
@@ -55,6 +64,11 @@ func BuildConnectionStringQueryist(ctx context.Context, cwdFS filesys.Filesys, c
 	if useTLS {
 		parsedMySQLConfig.TLSConfig = "true"
 	}
+	if creds.Token {
+		// Tokens are verified by the server's authentication_dolt_jwt plugin, which requires the cleartext token. This is
+		// only safe because the connection is encrypted, which is checked above.
+		parsedMySQLConfig.AllowCleartextPasswords = true
+	}
 
 	mysqlConnector, err := mysql.NewConnector(parsedMySQLConfig)
 	if err != nil {
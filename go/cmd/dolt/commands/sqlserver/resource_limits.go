@@ -0,0 +1,275 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/mysql_file_handler"
+	"github.com/dolthub/dolt/go/libraries/utils/file"
+)
+
+// resourceLimits enforces the per-user resource limits of a sql-server. Connection and working set limits are enforced
+// by the sessions it builds, and execution time and row limits by the handler returned from newResourceLimitsHandler.
+type resourceLimits struct {
+	limiter *dsess.ResourceLimiter
+	db      *mysql_db.MySQLDb
+	roles   sync.Map // connection id -> []string
+}
+
+// newResourceLimits returns the resource limits of a sql-server with the user limits |limits| from its config. If
+// |overridesPath| is set, the limits set with the dolt_user_limit() procedure are persisted to that file, and those
+// already in it are applied on top of |limits|.
+func newResourceLimits(limits []servercfg.UserLimits, db *mysql_db.MySQLDb, overridesPath string) (*resourceLimits, error) {
+	limiter := dsess.NewResourceLimiter()
+	for _, l := range limits {
+		rl := dsess.ResourceLimits{
+			MaxConnections:    l.MaxConnections(),
+			MaxExecutionTime:  time.Duration(l.MaxExecutionTimeMillis()) * time.Millisecond,
+			MaxRows:           l.MaxRows(),
+			MaxWorkingSetRows: l.MaxWorkingSetRows(),
+		}
+		if l.Role() != "" {
+			limiter.SetRoleLimits(l.Role(), rl)
+		} else {
+			limiter.SetUserLimits(l.User(), rl)
+		}
+	}
+
+	if overridesPath != "" {
+		overrides, err := loadResourceLimitOverrides(overridesPath)
+		if err != nil {
+			return nil, err
+		}
+		if err = limiter.ApplyOverrides(overrides); err != nil {
+			return nil, fmt.Errorf("error applying user limits from %s: %w", overridesPath, err)
+		}
+		limiter.SetPersister(func(o dsess.ResourceLimitOverrides) error {
+			return persistResourceLimitOverrides(overridesPath, o)
+		})
+	}
+	return &resourceLimits{limiter: limiter, db: db}, nil
+}
+
+// userLimitsFilePath returns the path of the file the limits set with the dolt_user_limit() procedure are persisted
+// to, which is kept next to the privileges file.
+func userLimitsFilePath(serverConfig servercfg.ServerConfig) string {
+	return filepath.Join(filepath.Dir(serverConfig.PrivilegeFilePath()), userLimitsFileName)
+}
+
+const userLimitsFileName = "user_limits.json"
+
+func loadResourceLimitOverrides(path string) (dsess.ResourceLimitOverrides, error) {
+	var overrides dsess.ResourceLimitOverrides
+	buf, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && len(buf) == 0) {
+		return overrides, nil
+	} else if err != nil {
+		return overrides, err
+	}
+	if err = json.Unmarshal(buf, &overrides); err != nil {
+		return overrides, fmt.Errorf("error reading user limits from %s: %w", path, err)
+	}
+	return overrides, nil
+}
+
+func persistResourceLimitOverrides(path string, overrides dsess.ResourceLimitOverrides) error {
+	buf, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	return file.WriteFileAtomically(path, bytes.NewReader(buf), mysql_file_handler.PermsFileMode)
+}
+
+// WrapSessionBuilder returns a session builder which counts each new session against its user's connection limit and
+// applies the user's limits to it.
+func (r *resourceLimits) WrapSessionBuilder(sb server.SessionBuilder) server.SessionBuilder {
+	return func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		sess, err := sb(ctx, conn, addr)
+		if err != nil {
+			return nil, err
+		}
+		account, roles := accountFor(conn), r.rolesFor(conn)
+		if err = r.limiter.AcquireConnection(conn.ConnectionID, account, roles); err != nil {
+			return nil, err
+		}
+		if doltSess, ok := sess.(*dsess.DoltSession); ok {
+			doltSess.SetResourceLimiter(r.limiter, account, roles)
+		}
+		return sess, nil
+	}
+}
+
+// accountFor returns the name of the account |c| authenticated as.
+func accountFor(c *mysql.Conn) string {
+	if account, ok := c.UserData.(sql.MysqlConnectionUser); ok {
+		return dsess.AccountName(account.User, account.Host)
+	}
+	return dsess.AccountName(c.User, "")
+}
+
+// rolesFor returns the accounts of the roles granted to the account |c| authenticated as.
+func (r *resourceLimits) rolesFor(c *mysql.Conn) []string {
+	if roles, ok := r.roles.Load(c.ConnectionID); ok {
+		return roles.([]string)
+	}
+
+	var roles []string
+	if account, ok := c.UserData.(sql.MysqlConnectionUser); ok && r.db != nil {
+		rd := r.db.Reader()
+		for _, edge := range rd.GetToUserRoleEdges(mysql_db.RoleEdgesToKey{ToHost: account.Host, ToUser: account.User}) {
+			roles = append(roles, dsess.AccountName(edge.FromUser, edge.FromHost))
+		}
+		rd.Close()
+	}
+	r.roles.Store(c.ConnectionID, roles)
+	return roles
+}
+
+func (r *resourceLimits) release(c *mysql.Conn) {
+	r.limiter.ReleaseConnection(c.ConnectionID)
+	r.roles.Delete(c.ConnectionID)
+}
+
+// resourceLimitsHandler is a mysql.Handler which interrupts statements that run longer than their user's
+// max_execution_time_millis limit or return more than their max_rows limit.
+type resourceLimitsHandler struct {
+	mysql.Handler
+	limits *resourceLimits
+}
+
+var _ mysql.Handler = (*resourceLimitsHandler)(nil)
+var _ mysql.BinlogReplicaHandler = (*resourceLimitsHandler)(nil)
+
+func newResourceLimitsHandler(h mysql.Handler, limits *resourceLimits) *resourceLimitsHandler {
+	return &resourceLimitsHandler{Handler: h, limits: limits}
+}
+
+// limitedStatement tracks the resources used by a single statement against the limits of the user running it.
+type limitedStatement struct {
+	user    string
+	maxRows uint64
+	sent    uint64
+	ctx     context.Context
+}
+
+func (h *resourceLimitsHandler) start(ctx context.Context, c *mysql.Conn) (*limitedStatement, context.CancelFunc) {
+	account := accountFor(c)
+	limits := h.limits.limiter.Limits(account, h.limits.rolesFor(c))
+	cancel := func() {}
+	if limits.MaxExecutionTime > 0 {
+		ctx, cancel = context.WithTimeout(ctx, limits.MaxExecutionTime)
+	}
+	return &limitedStatement{user: account, maxRows: limits.MaxRows, ctx: ctx}, cancel
+}
+
+func (s *limitedStatement) count(res *sqltypes.Result) error {
+	s.sent += uint64(len(res.Rows))
+	if s.maxRows > 0 && s.sent > s.maxRows {
+		return dsess.ErrUserLimitReached(s.user, dsess.MaxRowsLimit, s.maxRows)
+	}
+	return nil
+}
+
+// finish returns the error a statement which completed with |err| should report to the client.
+func (s *limitedStatement) finish(err error) error {
+	if err != nil && errors.Is(s.ctx.Err(), context.DeadlineExceeded) {
+		return dsess.ErrMaxExecutionTimeExceeded
+	}
+	return err
+}
+
+func (h *resourceLimitsHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) error {
+	s, cancel := h.start(ctx, c)
+	defer cancel()
+	err := h.Handler.ComQuery(s.ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		if err := s.count(res); err != nil {
+			return err
+		}
+		return callback(res, more)
+	})
+	return s.finish(err)
+}
+
+func (h *resourceLimitsHandler) ComMultiQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (string, error) {
+	s, cancel := h.start(ctx, c)
+	defer cancel()
+	rem, err := h.Handler.ComMultiQuery(s.ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		if err := s.count(res); err != nil {
+			return err
+		}
+		return callback(res, more)
+	})
+	return rem, s.finish(err)
+}
+
+func (h *resourceLimitsHandler) ComStmtExecute(ctx context.Context, c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	s, cancel := h.start(ctx, c)
+	defer cancel()
+	err := h.Handler.ComStmtExecute(s.ctx, c, prepare, func(res *sqltypes.Result) error {
+		if err := s.count(res); err != nil {
+			return err
+		}
+		return callback(res)
+	})
+	return s.finish(err)
+}
+
+func (h *resourceLimitsHandler) ConnectionClosed(c *mysql.Conn) {
+	defer h.limits.release(c)
+	h.Handler.ConnectionClosed(c)
+}
+
+// ConnectionAborted releases the resources of a connection which failed part way through being established, since the
+// session for it may already have been counted against its user's connection limit.
+func (h *resourceLimitsHandler) ConnectionAborted(c *mysql.Conn, reason string) error {
+	defer h.limits.release(c)
+	return h.Handler.ConnectionAborted(c, reason)
+}
+
+func (h *resourceLimitsHandler) ComRegisterReplica(c *mysql.Conn, replicaHost string, replicaPort uint16, replicaUser string, replicaPassword string) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComRegisterReplica(c, replicaHost, replicaPort, replicaUser, replicaPassword)
+}
+
+func (h *resourceLimitsHandler) ComBinlogDumpGTID(c *mysql.Conn, logFile string, logPos uint64, gtidSet mysql.GTIDSet) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComBinlogDumpGTID(c, logFile, logPos, gtidSet)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+// closeOnlyHandler is a mysql.Handler which only supports the connection lifecycle callbacks.
+type closeOnlyHandler struct {
+	mysql.Handler
+	aborted []uint32
+	closed  []uint32
+}
+
+func (h *closeOnlyHandler) ConnectionAborted(c *mysql.Conn, reason string) error {
+	h.aborted = append(h.aborted, c.ConnectionID)
+	return nil
+}
+
+func (h *closeOnlyHandler) ConnectionClosed(c *mysql.Conn) {
+	h.closed = append(h.closed, c.ConnectionID)
+}
+
+func TestResourceLimitsReleaseConnections(t *testing.T) {
+	serverConfig, err := servercfg.NewYamlConfig([]byte(`
+user_limits:
+  - user: alice
+    max_connections: 1
+`))
+	require.NoError(t, err)
+
+	limits, err := newResourceLimits(serverConfig.UserLimits(), nil, "")
+	require.NoError(t, err)
+	inner := &closeOnlyHandler{}
+	h := newResourceLimitsHandler(inner, limits)
+	sb := limits.WrapSessionBuilder(func(ctx context.Context, conn *mysql.Conn, addr string) (sql.Session, error) {
+		return sql.NewBaseSession(), nil
+	})
+
+	ctx := context.Background()
+	conn := func(id uint32) *mysql.Conn {
+		return &mysql.Conn{ConnectionID: id, User: "alice"}
+	}
+
+	_, err = sb(ctx, conn(1), "")
+	require.NoError(t, err)
+	_, err = sb(ctx, conn(2), "")
+	require.Error(t, err)
+
+	// A connection aborted after its session was built no longer counts against the limit
+	require.NoError(t, h.ConnectionAborted(conn(1), "handshake failed"))
+	assert.Equal(t, []uint32{1}, inner.aborted)
+	assert.Equal(t, uint64(0), limits.limiter.Connections("alice@%"))
+	_, err = sb(ctx, conn(2), "")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), limits.limiter.Connections("alice@%"))
+
+	// Closing a connection which was already aborted is a no-op
+	h.ConnectionClosed(conn(1))
+	assert.Equal(t, uint64(1), limits.limiter.Connections("alice@%"))
+	h.ConnectionClosed(conn(2))
+	assert.Equal(t, []uint32{1, 2}, inner.closed)
+	assert.Equal(t, uint64(0), limits.limiter.Connections("alice@%"))
+}
@@ -37,6 +37,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	goerrors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
@@ -151,6 +153,65 @@ func ConfigureServices(
 	}
 	controller.Register(InitLogging)
 
+	var tracingProv *tracingProvider
+	InitTracing := &svcs.AnonService{
+		InitF: func(ctx context.Context) (err error) {
+			if serverConfig.TracingConfig() == nil {
+				return nil
+			}
+			tracingProv, err = newTracingProvider(ctx, serverConfig.TracingConfig(), version)
+			if err != nil {
+				return err
+			}
+			otel.SetTracerProvider(tracingProv.tp)
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+			return nil
+		},
+		StopF: func() error {
+			if tracingProv == nil {
+				return nil
+			}
+			return tracingProv.Shutdown(context.Background())
+		},
+	}
+	controller.Register(InitTracing)
+
+	var auditLg *auditLog
+	InitAuditLog := &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			if serverConfig.AuditLogConfig() == nil {
+				return nil
+			}
+			auditLg, err = newAuditLog(serverConfig.AuditLogConfig())
+			return err
+		},
+		StopF: func() error {
+			if auditLg == nil {
+				return nil
+			}
+			return auditLg.Close()
+		},
+	}
+	controller.Register(InitAuditLog)
+
+	var slowQueryLg *slowQueryLog
+	InitSlowQueryLog := &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			if serverConfig.SlowQueryLogConfig() == nil {
+				return nil
+			}
+			slowQueryLg, err = newSlowQueryLog(serverConfig.SlowQueryLogConfig())
+			return err
+		},
+		StopF: func() error {
+			if slowQueryLg == nil {
+				return nil
+			}
+			return slowQueryLg.Close()
+		},
+	}
+	controller.Register(InitSlowQueryLog)
+
 	controller.Register(newHeartbeatService(version, dEnv))
 
 	fs := dEnv.FS
@@ -229,6 +290,7 @@ func ConfigureServices(
 				Autocommit:              serverConfig.AutoCommit(),
 				DoltTransactionCommit:   serverConfig.DoltTransactionCommit(),
 				JwksConfig:              serverConfig.JwksConfig(),
+				LDAPConfig:              serverConfig.LDAPConfig(),
 				SystemVariables:         serverConfig.SystemVars(),
 				ClusterController:       clusterController,
 				BinlogReplicaController: binlogreplication.DoltBinlogReplicaController,
@@ -604,25 +666,50 @@ func ConfigureServices(
 	var mySQLServer *server.Server
 	InitSQLServer := &svcs.AnonService{
 		InitF: func(context.Context) (err error) {
-			v, ok := serverConfig.(servercfg.ValidatingServerConfig)
-			if ok && v.GoldenMysqlConnectionString() != "" {
-				mySQLServer, err = server.NewServerWithHandler(
-					serverConf,
-					sqlEngine.GetUnderlyingEngine(),
-					newSessionBuilder(sqlEngine, serverConfig),
-					metListener,
-					func(h mysql.Handler) (mysql.Handler, error) {
-						return golden.NewValidatingHandler(h, v.GoldenMysqlConnectionString(), logrus.StandardLogger())
-					},
-				)
-			} else {
-				mySQLServer, err = server.NewServer(
-					serverConf,
-					sqlEngine.GetUnderlyingEngine(),
-					newSessionBuilder(sqlEngine, serverConfig),
-					metListener,
-				)
+			var goldenConn string
+			if v, ok := serverConfig.(servercfg.ValidatingServerConfig); ok {
+				goldenConn = v.GoldenMysqlConnectionString()
+			}
+			tracingEnabled := serverConfig.TracingConfig() != nil
+			limits, err := newResourceLimits(serverConfig.UserLimits(), sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.MySQLDb, userLimitsFilePath(serverConfig))
+			if err != nil {
+				return err
+			}
+			sessionBuilder := newSessionBuilder(sqlEngine, serverConfig)
+			if ldapPlugin := sqlEngine.LDAPAuthPlugin(); ldapPlugin != nil {
+				// Group mappings must be applied before the resource limits look up the user's roles
+				sessionBuilder = ldapPlugin.WrapSessionBuilder(sessionBuilder)
+			}
+			sessionBuilder = limits.WrapSessionBuilder(sessionBuilder)
+			if auditLg != nil {
+				sessionBuilder = auditLg.WrapSessionBuilder(sessionBuilder)
 			}
+			mySQLServer, err = server.NewServerWithHandler(
+				serverConf,
+				sqlEngine.GetUnderlyingEngine(),
+				sessionBuilder,
+				metListener,
+				func(h mysql.Handler) (mysql.Handler, error) {
+					if goldenConn != "" {
+						validator, err := golden.NewValidatingHandler(h, goldenConn, logrus.StandardLogger())
+						if err != nil {
+							return nil, err
+						}
+						h = validator
+					}
+					h = newResourceLimitsHandler(h, limits)
+					if auditLg != nil {
+						h = newAuditHandler(h, auditLg)
+					}
+					if slowQueryLg != nil {
+						h = newSlowQueryHandler(h, slowQueryLg)
+					}
+					if tracingEnabled {
+						h = newTracingHandler(h)
+					}
+					return h, nil
+				},
+			)
 			if errors.Is(err, server.UnixSocketInUseError) {
 				lgr.Warn("unix socket set up failed: file already in use: ", serverConf.Socket)
 				err = nil
@@ -953,6 +1040,9 @@ func getConfigFromServerConfig(serverConfig servercfg.ServerConfig) (server.Conf
 	serverConf.RequireSecureTransport = serverConfig.RequireSecureTransport()
 	serverConf.MaxLoggedQueryLen = serverConfig.MaxLoggedQueryLen()
 	serverConf.EncodeLoggedQuery = serverConfig.ShouldEncodeLoggedQuery()
+	if serverConfig.TracingConfig() != nil {
+		serverConf.Tracer = tracer
+	}
 
 	return serverConf, nil
 }
@@ -15,11 +15,16 @@
 package sqlserver
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/gocraft/dbr/v2"
@@ -27,12 +32,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils/testcommands"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/kvexec"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/libraries/utils/svcs"
 )
 
@@ -258,6 +267,300 @@ func TestServerSelect(t *testing.T) {
 	}
 }
 
+func TestServerTracingFileExport(t *testing.T) {
+	dEnv, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, dEnv.DoltDB.Close())
+	}()
+
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+listener:
+  host: localhost
+  port: 15320
+tracing:
+  file: %s
+`, traceFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, dEnv)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	conn, err := dbr.Open("mysql", servercfg.ConnectionString(serverConfig, ""), nil)
+	require.NoError(t, err)
+	_, err = conn.Exec("select 1 /*traceparent='" + testTraceParent + "'*/")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	contents, err := os.ReadFile(traceFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.Contains(t, string(contents), `"Name":"sqlserver.ComQuery"`)
+	assert.Contains(t, string(contents), `"Name":"query"`)
+}
+
+func TestServerAuditLog(t *testing.T) {
+	dEnv, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, dEnv.DoltDB.Close())
+	}()
+
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+listener:
+  host: localhost
+  port: 15321
+audit_log:
+  file: %s
+  statement_classes: [write, ddl, procedure]
+`, auditFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, dEnv)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	conn, err := dbr.Open("mysql", servercfg.ConnectionString(serverConfig, "dolt"), nil)
+	require.NoError(t, err)
+	for _, q := range []string{
+		"create table audited (pk int primary key)",
+		"insert into audited values (1), (2)",
+		"select * from audited",
+	} {
+		_, err = conn.Exec(q)
+		require.NoError(t, err)
+	}
+	var commitHash string
+	require.NoError(t, conn.QueryRow("call dolt_commit('-Am', 'audited commit')").Scan(&commitHash))
+	require.NoError(t, conn.Close())
+
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	contents, err := os.ReadFile(auditFile)
+	require.NoError(t, err)
+	var records []auditRecord
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		var rec auditRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		records = append(records, rec)
+	}
+	require.Len(t, records, 3)
+
+	assert.Equal(t, "create", records[0].StatementType)
+	assert.Equal(t, servercfg.AuditClassDDL, records[0].StatementClass)
+	assert.Equal(t, []string{"audited"}, records[0].Tables)
+	assert.Equal(t, "dolt", records[0].Database)
+	assert.Equal(t, "main", records[0].Branch)
+	assert.Equal(t, "root", records[0].User)
+
+	assert.Equal(t, "insert", records[1].StatementType)
+	assert.Equal(t, servercfg.AuditClassWrite, records[1].StatementClass)
+	assert.Equal(t, uint64(2), records[1].RowsAffected)
+	assert.Empty(t, records[1].Commit)
+
+	assert.Equal(t, "call", records[2].StatementType)
+	assert.Equal(t, "dolt_commit", records[2].Procedure)
+	assert.Equal(t, commitHash, records[2].Commit)
+}
+
+func TestServerSlowQueryLog(t *testing.T) {
+	dEnv, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, dEnv.DoltDB.Close())
+	}()
+
+	slowFile := filepath.Join(t.TempDir(), "slow.log")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+listener:
+  host: localhost
+  port: 15322
+slow_query_log:
+  threshold_millis: 0
+  file: %s
+  max_entries: 10
+`, slowFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, dEnv)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	conn, err := dbr.Open("mysql", servercfg.ConnectionString(serverConfig, "dolt"), nil)
+	require.NoError(t, err)
+	for _, q := range []string{
+		"create table slow (pk int primary key, c int)",
+		"insert into slow values (1, 1), (2, 2), (3, 3)",
+		"select count(c) from slow",
+		"select c from slow where pk = 2",
+	} {
+		_, err = conn.Exec(q)
+		require.NoError(t, err)
+	}
+
+	sess := conn.NewSession(nil)
+	var entries []struct {
+		Query     string
+		RowsSent  uint64         `db:"rows_sent"`
+		Indexes   sql.NullString `db:"indexes"`
+		FastPaths sql.NullString `db:"fast_paths"`
+		Plan      sql.NullString `db:"query_plan"`
+	}
+	_, err = sess.Select("query", "rows_sent", "indexes", "fast_paths", "query_plan").From("dolt_slow_queries").
+		Where("query like 'select%'").Load(&entries)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, "select count(c) from slow", entries[0].Query)
+	assert.Equal(t, uint64(1), entries[0].RowsSent)
+	assert.Equal(t, kvexec.FastPathCountAgg, entries[0].FastPaths.String)
+	assert.Equal(t, "select c from slow where pk = 2", entries[1].Query)
+	assert.Equal(t, "slow.PRIMARY", entries[1].Indexes.String)
+	assert.Contains(t, entries[1].Plan.String, "IndexedTableAccess(slow)")
+
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	contents, err := os.ReadFile(slowFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	require.GreaterOrEqual(t, len(lines), 4)
+	var entry slowlog.Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &entry))
+	assert.Equal(t, "select count(c) from slow", entry.Query)
+	assert.Equal(t, "dolt", entry.Database)
+	assert.Equal(t, []string{kvexec.FastPathCountAgg}, entry.FastPaths)
+}
+
+func TestServerUserLimits(t *testing.T) {
+	dEnv, err := sqle.CreateEnvWithSeedData()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, dEnv.DoltDB.Close())
+	}()
+
+	privsFile := filepath.Join(t.TempDir(), "privileges.db")
+	serverConfig, err := servercfg.NewYamlConfig([]byte(fmt.Sprintf(`
+log_level: fatal
+privilege_file: %s
+listener:
+  host: localhost
+  port: 15323
+user_limits:
+  - user: root
+    max_connections: 2
+    max_rows: 2
+    max_working_set_rows: 3
+`, privsFile)))
+	require.NoError(t, err)
+
+	sc := svcs.NewController()
+	go func() {
+		_, _ = Serve(context.Background(), "0.0.0", serverConfig, sc, dEnv)
+	}()
+	require.NoError(t, sc.WaitForStart())
+
+	ctx := context.Background()
+	db, err := sql.Open("mysql", servercfg.ConnectionString(serverConfig, "dolt"))
+	require.NoError(t, err)
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	other, err := db.Conn(ctx)
+	require.NoError(t, err)
+	_, err = other.ExecContext(ctx, "select 1")
+	require.NoError(t, err)
+
+	// A third connection is over root's limit
+	rejected, err := db.Conn(ctx)
+	if err == nil {
+		_, err = rejected.ExecContext(ctx, "select 1")
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error 1203")
+
+	countRows := func(query string) (int, error) {
+		rows, err := conn.QueryContext(ctx, query)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		n := 0
+		for rows.Next() {
+			n++
+		}
+		return n, rows.Err()
+	}
+
+	for _, q := range []string{
+		"call dolt_commit('-Am', 'seed data')",
+		"create table limited (pk int primary key)",
+		"insert into limited values (1), (2), (3)",
+	} {
+		_, err = conn.ExecContext(ctx, q)
+		require.NoError(t, err)
+	}
+
+	_, err = countRows("select * from limited")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error 1226")
+	assert.Contains(t, err.Error(), "'max_rows'")
+
+	_, err = conn.ExecContext(ctx, "insert into limited values (4)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error 1226")
+	assert.Contains(t, err.Error(), "'max_working_set_rows'")
+
+	// Committed rows no longer count against the working set
+	_, err = conn.ExecContext(ctx, "call dolt_commit('-Am', 'limited rows')")
+	require.NoError(t, err)
+	_, err = conn.ExecContext(ctx, "insert into limited values (4)")
+	require.NoError(t, err)
+
+	_, err = conn.ExecContext(ctx, "call dolt_user_limit('root', 'max_execution_time_millis', '100')")
+	require.NoError(t, err)
+	_, err = countRows("select sleep(2)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Error 3024")
+
+	_, err = conn.ExecContext(ctx, "call dolt_user_limit('root', 'max_rows', '0')")
+	require.NoError(t, err)
+	n, err := countRows("select * from limited")
+	require.NoError(t, err)
+	assert.Equal(t, 4, n)
+
+	require.NoError(t, other.Close())
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+	sc.Stop()
+	require.NoError(t, sc.WaitForStop())
+
+	// The limits set with dolt_user_limit are persisted next to the privileges, and survive a restart
+	persisted, err := os.ReadFile(filepath.Join(filepath.Dir(privsFile), userLimitsFileName))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"users":{"root@%":{"max_execution_time_millis":100,"max_rows":0}}}`, string(persisted))
+	limits, err := newResourceLimits(serverConfig.UserLimits(), nil, userLimitsFilePath(serverConfig))
+	require.NoError(t, err)
+	assert.Equal(t, dsess.ResourceLimits{MaxConnections: 2, MaxExecutionTime: 100 * time.Millisecond, MaxWorkingSetRows: 3},
+		limits.limiter.Limits("root@localhost", nil))
+}
+
 // If a port is already in use, throw error "Port XXXX already in use."
 func TestServerFailsIfPortInUse(t *testing.T) {
 	controller := svcs.NewController()
@@ -510,3 +813,9 @@ func TestReadReplica(t *testing.T) {
 		assert.ElementsMatch(t, res, []int{0})
 	})
 }
+
+func TestTokenAuthRequiresTLS(t *testing.T) {
+	creds := &cli.UserPassword{Username: "alice", Password: "token", Specified: true, Token: true}
+	_, err := BuildConnectionStringQueryist(context.Background(), filesys.LocalFS, creds, nil, "localhost", 3306, false, "dolt")
+	require.ErrorIs(t, err, ErrTokenRequiresTLS)
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/kvexec"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+// slowQueryLog records statements which run for at least |threshold| to a file, or the server log if no file is
+// configured, and to the buffer behind the dolt_slow_queries system table.
+type slowQueryLog struct {
+	threshold time.Duration
+	out       *rotatingFile
+	recent    *slowlog.Buffer
+}
+
+func newSlowQueryLog(cfg servercfg.SlowQueryLogConfig) (*slowQueryLog, error) {
+	l := &slowQueryLog{
+		threshold: time.Duration(cfg.ThresholdMillis()) * time.Millisecond,
+		recent:    slowlog.NewBuffer(cfg.MaxEntries()),
+	}
+	if cfg.FilePath() != "" {
+		out, err := openRotatingFile(cfg.FilePath(), int64(cfg.MaxSizeMB())*1024*1024, cfg.MaxBackups())
+		if err != nil {
+			return nil, fmt.Errorf("could not open slow query log: %w", err)
+		}
+		l.out = out
+	}
+	slowlog.SetRecent(l.recent)
+	return l, nil
+}
+
+// Close closes the slow query log file, if any, and clears the dolt_slow_queries system table.
+func (l *slowQueryLog) Close() error {
+	slowlog.SetRecent(nil)
+	if l.out == nil {
+		return nil
+	}
+	return l.out.Close()
+}
+
+func (l *slowQueryLog) write(e slowlog.Entry) {
+	l.recent.Add(e)
+	if l.out == nil {
+		logrus.WithFields(logrus.Fields{
+			"connectionID": e.ConnectionID,
+			"user":         e.User,
+			"database":     e.Database,
+			"duration_ms":  e.DurationMs,
+			"rows_sent":    e.RowsSent,
+			"rows":         e.RowsAffected,
+			"indexes":      strings.Join(e.Indexes, ","),
+			"fast_paths":   strings.Join(e.FastPaths, ","),
+			"query":        e.Query,
+		}).Warn("slow query")
+		return
+	}
+	buf, err := json.Marshal(e)
+	if err != nil {
+		logrus.Errorf("could not encode slow query: %s", err.Error())
+		return
+	}
+	buf = append(buf, '\n')
+	if _, err = l.out.Write(buf); err != nil {
+		logrus.Errorf("could not write slow query: %s", err.Error())
+	}
+}
+
+// slowQueryHandler wraps a mysql.Handler, timing every statement it executes and recording those which are slow to
+// a slowQueryLog.
+type slowQueryHandler struct {
+	mysql.Handler
+	log *slowQueryLog
+}
+
+var _ mysql.Handler = (*slowQueryHandler)(nil)
+var _ mysql.BinlogReplicaHandler = (*slowQueryHandler)(nil)
+
+func newSlowQueryHandler(h mysql.Handler, log *slowQueryLog) *slowQueryHandler {
+	return &slowQueryHandler{Handler: h, log: log}
+}
+
+// slowQueryExec tracks the execution of a single statement.
+type slowQueryExec struct {
+	start    time.Time
+	stats    *kvexec.ExecStats
+	sent     uint64
+	affected uint64
+}
+
+func startSlowQueryExec(ctx context.Context) (context.Context, *slowQueryExec) {
+	ctx, stats := kvexec.WithExecStats(ctx)
+	return ctx, &slowQueryExec{start: time.Now(), stats: stats}
+}
+
+func (e *slowQueryExec) count(res *sqltypes.Result) {
+	e.sent += uint64(len(res.Rows))
+	e.affected += res.RowsAffected
+}
+
+func (h *slowQueryHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) error {
+	ctx, e := startSlowQueryExec(ctx)
+	err := h.Handler.ComQuery(ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		e.count(res)
+		return callback(res, more)
+	})
+	h.finish(c, query, e, err)
+	return err
+}
+
+func (h *slowQueryHandler) ComMultiQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (string, error) {
+	ctx, e := startSlowQueryExec(ctx)
+	rem, err := h.Handler.ComMultiQuery(ctx, c, query, func(res *sqltypes.Result, more bool) error {
+		e.count(res)
+		return callback(res, more)
+	})
+	stmt := query
+	if err == nil && len(rem) <= len(query) {
+		stmt = query[:len(query)-len(rem)]
+	}
+	h.finish(c, stmt, e, err)
+	return rem, err
+}
+
+func (h *slowQueryHandler) ComStmtExecute(ctx context.Context, c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
+	ctx, e := startSlowQueryExec(ctx)
+	err := h.Handler.ComStmtExecute(ctx, c, prepare, func(res *sqltypes.Result) error {
+		e.count(res)
+		return callback(res)
+	})
+	h.finish(c, prepare.PrepareStmt, e, err)
+	return err
+}
+
+func (h *slowQueryHandler) ComRegisterReplica(c *mysql.Conn, replicaHost string, replicaPort uint16, replicaUser string, replicaPassword string) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComRegisterReplica(c, replicaHost, replicaPort, replicaUser, replicaPassword)
+}
+
+func (h *slowQueryHandler) ComBinlogDumpGTID(c *mysql.Conn, logFile string, logPos uint64, gtidSet mysql.GTIDSet) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComBinlogDumpGTID(c, logFile, logPos, gtidSet)
+}
+
+func (h *slowQueryHandler) finish(c *mysql.Conn, query string, e *slowQueryExec, err error) {
+	dur := time.Since(e.start)
+	if dur < h.log.threshold {
+		return
+	}
+
+	entry := slowlog.Entry{
+		Time:         e.start.UTC(),
+		ConnectionID: c.ConnectionID,
+		User:         c.User,
+		Host:         connectionHost(c),
+		Database:     e.stats.Database(),
+		Query:        redactQuery(strings.TrimSpace(query)),
+		DurationMs:   float64(dur.Microseconds()) / 1000,
+		RowsSent:     e.sent,
+		RowsAffected: e.affected,
+		FastPaths:    e.stats.FastPaths(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if n := e.stats.Plan(); n != nil {
+		entry.Plan = sql.Describe(n, sql.DescribeOptions{Analyze: true, Estimates: true, Plan: true})
+		entry.Indexes = planIndexes(n)
+	}
+	h.log.write(entry)
+}
+
+// planIndexes returns the sorted, distinct indexes read by |n|, as table.index.
+func planIndexes(n sql.Node) []string {
+	seen := make(map[string]struct{})
+	transform.Inspect(n, func(n sql.Node) bool {
+		if ita, ok := n.(*plan.IndexedTableAccess); ok && ita.Index() != nil {
+			seen[ita.Index().Table()+"."+ita.Index().ID()] = struct{}{}
+		}
+		return true
+	})
+	if len(seen) == 0 {
+		return nil
+	}
+	indexes := make([]string, 0, len(seen))
+	for idx := range seen {
+		indexes = append(indexes, idx)
+	}
+	sort.Strings(indexes)
+	return indexes
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+func TestSlowQueryLogRedactsCredentials(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	l := &slowQueryLog{recent: slowlog.NewBuffer(10)}
+	h := newSlowQueryHandler(nil, l)
+	_, e := startSlowQueryExec(context.Background())
+	h.finish(&mysql.Conn{Conn: server, User: "root"}, "  create user 'u'@'%' identified by 'secret'  ", e, nil)
+
+	entries := l.recent.Entries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "create user 'u'@'%' identified by '***'", entries[0].Query)
+	assert.Equal(t, "root", entries[0].User)
+}
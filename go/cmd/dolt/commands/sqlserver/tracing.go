@@ -0,0 +1,286 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+const tracerName = "github.com/dolthub/dolt/go/cmd/dolt/commands/sqlserver"
+
+var tracer = otel.Tracer(tracerName)
+
+// tracingProvider owns the exporters configured for a running sql-server.
+type tracingProvider struct {
+	tp   *sdktrace.TracerProvider
+	file *os.File
+}
+
+// newTracingProvider creates a tracer provider which exports spans to the destinations in |cfg|.
+func newTracingProvider(ctx context.Context, cfg servercfg.TracingConfig, version string) (*tracingProvider, error) {
+	ret := &tracingProvider{}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate()))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(cfg.ServiceName()),
+			semconv.ServiceVersionKey.String(version),
+		)),
+	}
+
+	if cfg.Endpoint() != "" {
+		exOpts, err := otlpEndpointOptions(cfg.Endpoint())
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Insecure() {
+			exOpts = append(exOpts, otlptracehttp.WithInsecure())
+		}
+		exp, err := otlptracehttp.New(ctx, exOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("could not create OTLP trace exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	}
+
+	if cfg.FilePath() != "" {
+		f, err := os.OpenFile(cfg.FilePath(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("could not open trace file: %w", err)
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("could not create file trace exporter: %w", err)
+		}
+		ret.file = f
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	}
+
+	ret.tp = sdktrace.NewTracerProvider(opts...)
+	return ret, nil
+}
+
+// otlpEndpointOptions returns the exporter options for |endpoint|, which is either a host:port, or a URL whose scheme
+// and path are used as well.
+func otlpEndpointOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	if !strings.Contains(endpoint, "://") {
+		return []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing endpoint %s: %w", endpoint, err)
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Path != "" && u.Path != "/" {
+		opts = append(opts, otlptracehttp.WithURLPath(u.Path))
+	}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	return opts, nil
+}
+
+// Shutdown flushes any buffered spans and releases the exporters.
+func (p *tracingProvider) Shutdown(ctx context.Context) error {
+	err := p.tp.Shutdown(ctx)
+	if p.file != nil {
+		err = errors.Join(err, p.file.Close())
+	}
+	return err
+}
+
+// traceContextFromQuery returns |ctx| with the remote span context carried in |query|'s comments, if any. Clients
+// propagate their trace context using the sqlcommenter convention of appending a comment such as
+//
+//	/*traceparent='00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01',tracestate='congo=t61rcWkgMzE'*/
+//
+// to each statement.
+func traceContextFromQuery(ctx context.Context, query string) context.Context {
+	carrier := propagation.MapCarrier{}
+	for {
+		start := strings.Index(query, "/*")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(query[start+2:], "*/")
+		if end < 0 {
+			break
+		}
+		parseCommentAttributes(query[start+2:start+2+end], carrier)
+		query = query[start+2+end+2:]
+	}
+	if carrier.Get("traceparent") == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
+
+// parseCommentAttributes parses the comma separated key='value' pairs of a sqlcommenter comment into |carrier|.
+func parseCommentAttributes(comment string, carrier propagation.MapCarrier) {
+	for _, pair := range strings.Split(comment, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			continue
+		}
+		k, err := url.QueryUnescape(strings.TrimSpace(k))
+		if err != nil {
+			continue
+		}
+		v = strings.TrimSpace(v)
+		if len(v) < 2 || v[0] != '\'' || v[len(v)-1] != '\'' {
+			continue
+		}
+		v, err = url.QueryUnescape(v[1 : len(v)-1])
+		if err != nil {
+			continue
+		}
+		carrier.Set(strings.ToLower(k), v)
+	}
+}
+
+// tracingHandler wraps a mysql.Handler, recording a span for the lifetime of every connection and for every
+// statement it receives. Statement spans become children of any trace context the client propagated in a query
+// comment, and are the parents of the query, parse, analyze and execution spans recorded by the engine.
+type tracingHandler struct {
+	mysql.Handler
+	conns sync.Map // connection id -> trace.Span
+}
+
+var _ mysql.Handler = (*tracingHandler)(nil)
+var _ mysql.BinlogReplicaHandler = (*tracingHandler)(nil)
+
+func newTracingHandler(h mysql.Handler) *tracingHandler {
+	return &tracingHandler{Handler: h}
+}
+
+func (h *tracingHandler) NewConnection(c *mysql.Conn) {
+	_, span := tracer.Start(context.Background(), "sqlserver.connection", trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(connectionAttributes(c)...))
+	h.conns.Store(c.ConnectionID, span)
+	h.Handler.NewConnection(c)
+}
+
+func (h *tracingHandler) ConnectionClosed(c *mysql.Conn) {
+	h.Handler.ConnectionClosed(c)
+	if span, ok := h.conns.LoadAndDelete(c.ConnectionID); ok {
+		span.(trace.Span).End()
+	}
+}
+
+func (h *tracingHandler) ConnectionAborted(c *mysql.Conn, reason string) error {
+	err := h.Handler.ConnectionAborted(c, reason)
+	if span, ok := h.conns.LoadAndDelete(c.ConnectionID); ok {
+		span.(trace.Span).SetStatus(codes.Error, reason)
+		span.(trace.Span).End()
+	}
+	return err
+}
+
+func (h *tracingHandler) ComQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (err error) {
+	ctx, span := h.startStatementSpan(ctx, c, "sqlserver.ComQuery", query)
+	defer func() { endStatementSpan(span, err) }()
+	return h.Handler.ComQuery(ctx, c, query, callback)
+}
+
+func (h *tracingHandler) ComMultiQuery(ctx context.Context, c *mysql.Conn, query string, callback mysql.ResultSpoolFn) (rem string, err error) {
+	ctx, span := h.startStatementSpan(ctx, c, "sqlserver.ComMultiQuery", query)
+	defer func() { endStatementSpan(span, err) }()
+	return h.Handler.ComMultiQuery(ctx, c, query, callback)
+}
+
+func (h *tracingHandler) ComPrepare(ctx context.Context, c *mysql.Conn, query string, prepare *mysql.PrepareData) (fields []*querypb.Field, err error) {
+	ctx, span := h.startStatementSpan(ctx, c, "sqlserver.ComPrepare", query)
+	defer func() { endStatementSpan(span, err) }()
+	return h.Handler.ComPrepare(ctx, c, query, prepare)
+}
+
+func (h *tracingHandler) ComStmtExecute(ctx context.Context, c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) (err error) {
+	ctx, span := h.startStatementSpan(ctx, c, "sqlserver.ComStmtExecute", prepare.PrepareStmt)
+	defer func() { endStatementSpan(span, err) }()
+	return h.Handler.ComStmtExecute(ctx, c, prepare, callback)
+}
+
+func (h *tracingHandler) ComRegisterReplica(c *mysql.Conn, replicaHost string, replicaPort uint16, replicaUser string, replicaPassword string) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComRegisterReplica(c, replicaHost, replicaPort, replicaUser, replicaPassword)
+}
+
+func (h *tracingHandler) ComBinlogDumpGTID(c *mysql.Conn, logFile string, logPos uint64, gtidSet mysql.GTIDSet) error {
+	brh, ok := h.Handler.(mysql.BinlogReplicaHandler)
+	if !ok {
+		return fmt.Errorf("handler does not support binlog replication")
+	}
+	return brh.ComBinlogDumpGTID(c, logFile, logPos, gtidSet)
+}
+
+func (h *tracingHandler) startStatementSpan(ctx context.Context, c *mysql.Conn, name, query string) (context.Context, trace.Span) {
+	ctx = traceContextFromQuery(ctx, query)
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(connectionAttributes(c)...),
+	}
+	// Statements without a propagated trace context are linked to, rather than parented by, their connection's
+	// span so that each statement is sampled as its own trace.
+	if span, ok := h.conns.Load(c.ConnectionID); ok && !trace.SpanContextFromContext(ctx).IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: span.(trace.Span).SpanContext()}))
+	}
+	return tracer.Start(ctx, name, opts...)
+}
+
+func endStatementSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func connectionAttributes(c *mysql.Conn) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.Int64("connection_id", int64(c.ConnectionID)),
+		attribute.String("db.user", c.User),
+	}
+	if addr := c.RemoteAddr(); addr != nil {
+		attrs = append(attrs, attribute.String("net.peer.addr", addr.String()))
+	}
+	return attrs
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestTraceContextFromQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		traceID string
+	}{
+		{
+			name:  "no comment",
+			query: "select 1",
+		},
+		{
+			name:  "unrelated comment",
+			query: "select /* hello */ 1",
+		},
+		{
+			name:    "sqlcommenter suffix",
+			query:   "select 1 /*traceparent='" + testTraceParent + "'*/",
+			traceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:    "multiple attributes",
+			query:   "select 1 /*application='app',traceparent='" + testTraceParent + "',tracestate='congo%3Dt61rcWkgMzE'*/",
+			traceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:    "leading comment",
+			query:   "/*traceparent='" + testTraceParent + "'*/ select 1",
+			traceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name:  "malformed traceparent",
+			query: "select 1 /*traceparent='00-zzz-yyy-01'*/",
+		},
+		{
+			name:  "unterminated comment",
+			query: "select 1 /*traceparent='" + testTraceParent + "'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := traceContextFromQuery(context.Background(), test.query)
+			sc := trace.SpanContextFromContext(ctx)
+			if test.traceID == "" {
+				assert.False(t, sc.IsValid())
+				return
+			}
+			require.True(t, sc.IsValid())
+			assert.True(t, sc.IsRemote())
+			assert.Equal(t, test.traceID, sc.TraceID().String())
+		})
+	}
+}
@@ -868,6 +868,9 @@ func HandleVErrAndExitCode(verr errhand.VerboseError, usage cli.UsagePrinter) in
 // interpolateStoredProcedureCall returns an interpolated query to call |storedProcedureName| with the arguments
 // |args|.
 func interpolateStoredProcedureCall(storedProcedureName string, args []string) (string, error) {
+	if len(args) == 0 {
+		return fmt.Sprintf("CALL %s();", storedProcedureName), nil
+	}
 	query := fmt.Sprintf("CALL %s(%s);", storedProcedureName, buildPlaceholdersString(len(args)))
 	return dbr.InterpolateForDialect(query, stringSliceToInterfaceSlice(args), dialect.MySQL)
 }
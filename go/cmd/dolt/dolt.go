@@ -54,10 +54,12 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/stashcmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/tblcmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/doltversion"
+	dcreds "github.com/dolthub/dolt/go/libraries/doltcore/creds"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/events"
@@ -77,6 +79,7 @@ var doltSubCommands = []cli.Command{
 	commands.AddCmd{},
 	commands.DiffCmd{},
 	commands.ResetCmd{},
+	commands.RestoreCmd{},
 	commands.CleanCmd{},
 	commands.CommitCmd{},
 	commands.SqlCmd{VersionStr: doltversion.Version},
@@ -103,6 +106,7 @@ var doltSubCommands = []cli.Command{
 	schcmds.Commands,
 	tblcmds.Commands,
 	commands.TagCmd{},
+	commands.NotesCmd{},
 	commands.BlameCmd{},
 	cvcmds.Commands,
 	commands.SendMetricsCmd{},
@@ -679,6 +683,16 @@ If you're interested in running this command against a remote host, hit us up on
 			port = 3306
 		}
 		useTLS := !apr.Contains(cli.NoTLSFlag)
+		if !creds.Specified && useTLS {
+			// Present the token from `dolt login --oidc`, if there is one, in place of the default root user. Tokens are
+			// sent in cleartext, so they are never presented over a connection without TLS.
+			token, err := actions.LoadOIDCToken(ctx, rootEnv)
+			if err == nil {
+				creds = &cli.UserPassword{Username: token.Subject, Password: token.BearerToken(), Specified: true, Token: true}
+			} else if !errors.Is(err, dcreds.ErrOIDCTokenNotFound) {
+				return nil, err
+			}
+		}
 		return sqlserver.BuildConnectionStringQueryist(ctx, cwdFS, creds, apr, host, port, useTLS, useDb)
 	} else {
 		_, hasPort := apr.GetInt(cli.PortFlag)
@@ -364,7 +364,38 @@ func (rcv *MergeState) MutateIsCherryPick(n bool) bool {
 	return rcv._tab.MutateBoolSlot(12, n)
 }
 
-const MergeStateNumFields = 5
+func (rcv *MergeState) TryResolvedSchemaConflicts(obj *SchemaConflictResolution, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if SchemaConflictResolutionNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *MergeState) ResolvedSchemaConflictsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *MergeState) StrategyOption() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const MergeStateNumFields = 7
 
 func MergeStateStart(builder *flatbuffers.Builder) {
 	builder.StartObject(MergeStateNumFields)
@@ -393,10 +424,93 @@ func MergeStateStartUnmergableTablesVector(builder *flatbuffers.Builder, numElem
 func MergeStateAddIsCherryPick(builder *flatbuffers.Builder, isCherryPick bool) {
 	builder.PrependBoolSlot(4, isCherryPick, false)
 }
+func MergeStateAddResolvedSchemaConflicts(builder *flatbuffers.Builder, resolvedSchemaConflicts flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(resolvedSchemaConflicts), 0)
+}
+func MergeStateStartResolvedSchemaConflictsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func MergeStateAddStrategyOption(builder *flatbuffers.Builder, strategyOption flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(strategyOption), 0)
+}
 func MergeStateEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
 
+type SchemaConflictResolution struct {
+	_tab flatbuffers.Table
+}
+
+func InitSchemaConflictResolutionRoot(o *SchemaConflictResolution, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsSchemaConflictResolution(buf []byte, offset flatbuffers.UOffsetT) (*SchemaConflictResolution, error) {
+	x := &SchemaConflictResolution{}
+	return x, InitSchemaConflictResolutionRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsSchemaConflictResolution(buf []byte, offset flatbuffers.UOffsetT) (*SchemaConflictResolution, error) {
+	x := &SchemaConflictResolution{}
+	return x, InitSchemaConflictResolutionRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *SchemaConflictResolution) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if SchemaConflictResolutionNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *SchemaConflictResolution) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *SchemaConflictResolution) TableName() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *SchemaConflictResolution) ColumnName() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *SchemaConflictResolution) Resolution() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const SchemaConflictResolutionNumFields = 3
+
+func SchemaConflictResolutionStart(builder *flatbuffers.Builder) {
+	builder.StartObject(SchemaConflictResolutionNumFields)
+}
+func SchemaConflictResolutionAddTableName(builder *flatbuffers.Builder, tableName flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(tableName), 0)
+}
+func SchemaConflictResolutionAddColumnName(builder *flatbuffers.Builder, columnName flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(columnName), 0)
+}
+func SchemaConflictResolutionAddResolution(builder *flatbuffers.Builder, resolution flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(resolution), 0)
+}
+func SchemaConflictResolutionEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
+
 type RebaseState struct {
 	_tab flatbuffers.Table
 }
@@ -579,7 +693,15 @@ func (rcv *RebaseState) MutateRebasingStarted(n bool) bool {
 	return rcv._tab.MutateBoolSlot(16, n)
 }
 
-const RebaseStateNumFields = 7
+func (rcv *RebaseState) StrategyOption() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const RebaseStateNumFields = 8
 
 func RebaseStateStart(builder *flatbuffers.Builder) {
 	builder.StartObject(RebaseStateNumFields)
@@ -614,6 +736,9 @@ func RebaseStateAddLastAttemptedStep(builder *flatbuffers.Builder, lastAttempted
 func RebaseStateAddRebasingStarted(builder *flatbuffers.Builder, rebasingStarted bool) {
 	builder.PrependBoolSlot(6, rebasingStarted, false)
 }
+func RebaseStateAddStrategyOption(builder *flatbuffers.Builder, strategyOption flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(strategyOption), 0)
+}
 func RebaseStateEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -148,26 +148,22 @@ func (tbl *Access) insertDefaultRow() {
 // existing entries with the new permissions. Requires external synchronization handling, therefore manually manage the
 // RWMutex.
 func (tbl *Access) Insert(database string, branch string, user string, host string, perms Permissions) {
-	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
-	database = strings.ToLower(FoldExpression(database))
-	branch = strings.ToLower(FoldExpression(branch))
-	user = FoldExpression(user)
-	host = strings.ToLower(FoldExpression(host))
-	// Each expression is capped at 2¹⁶-1 values, so we truncate to 2¹⁶-2 and add the any-match character at the end if it's over
-	if len(database) > math.MaxUint16 {
-		database = string(append([]byte(database[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(branch) > math.MaxUint16 {
-		branch = string(append([]byte(branch[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(user) > math.MaxUint16 {
-		user = string(append([]byte(user[:math.MaxUint16-1]), byte('%')))
-	}
-	if len(host) > math.MaxUint16 {
-		host = string(append([]byte(host[:math.MaxUint16-1]), byte('%')))
-	}
+	tbl.insert(database, branch, user, host, perms, true)
+}
+
+// InsertTransient is the same as Insert, except that the entry is not written to the binlog, so it is never persisted
+// and does not survive the table being reloaded. Requires external synchronization handling, therefore manually manage
+// the RWMutex.
+func (tbl *Access) InsertTransient(database string, branch string, user string, host string, perms Permissions) {
+	tbl.insert(database, branch, user, host, perms, false)
+}
+
+func (tbl *Access) insert(database string, branch string, user string, host string, perms Permissions, logged bool) {
+	database, branch, user, host = foldAccessExpressions(database, branch, user, host)
 	// Add the insertion entry to the binlog
-	tbl.binlog.Insert(database, branch, user, host, uint64(perms))
+	if logged {
+		tbl.binlog.Insert(database, branch, user, host, uint64(perms))
+	}
 	// Add to the rows and grab the insertion index
 	var index uint32
 	if len(tbl.freeRows) > 0 {
@@ -206,6 +202,45 @@ func (tbl *Access) Insert(database string, branch string, user string, host stri
 // is important to ensure that the expressions are valid before deletion. Folds all strings that are given. Requires
 // external synchronization handling, therefore manually manage the RWMutex.
 func (tbl *Access) Delete(database string, branch string, user string, host string) {
+	tbl.delete(database, branch, user, host, true)
+}
+
+// DeleteTransient removes an entry added by InsertTransient. As the entry was never written to the binlog, neither is
+// its deletion. Requires external synchronization handling, therefore manually manage the RWMutex.
+func (tbl *Access) DeleteTransient(database string, branch string, user string, host string) {
+	tbl.delete(database, branch, user, host, false)
+}
+
+func (tbl *Access) delete(database string, branch string, user string, host string, logged bool) {
+	database, branch, user, host = foldAccessExpressions(database, branch, user, host)
+	// Add the deletion entry to the binlog
+	if logged {
+		tbl.binlog.Delete(database, branch, user, host, uint64(Permissions_None))
+	}
+	// Remove the entry from the root node
+	removedIndex := tbl.Root.Remove(database, branch, user, host)
+	// Remove from the rows
+	if removedIndex != math.MaxUint32 {
+		tbl.freeRows = append(tbl.freeRows, removedIndex)
+	}
+}
+
+// Contains returns whether the table has an entry with exactly the given expressions, after they have been folded.
+// Unlike Match, the expressions are not matched as patterns. Requires external synchronization handling, therefore
+// manually manage the RWMutex.
+func (tbl *Access) Contains(database string, branch string, user string, host string) bool {
+	database, branch, user, host = foldAccessExpressions(database, branch, user, host)
+	iter := tbl.Iter()
+	for row, ok := iter.Next(); ok; row, ok = iter.Next() {
+		if row.Database == database && row.Branch == branch && row.User == user && row.Host == host {
+			return true
+		}
+	}
+	return false
+}
+
+// foldAccessExpressions folds and truncates the given expressions in the same way as they're stored in the table.
+func foldAccessExpressions(database string, branch string, user string, host string) (string, string, string, string) {
 	// Database, Branch, and Host are case-insensitive, while User is case-sensitive
 	database = strings.ToLower(FoldExpression(database))
 	branch = strings.ToLower(FoldExpression(branch))
@@ -224,14 +259,7 @@ func (tbl *Access) Delete(database string, branch string, user string, host stri
 	if len(host) > math.MaxUint16 {
 		host = string(append([]byte(host[:math.MaxUint16-1]), byte('%')))
 	}
-	// Add the deletion entry to the binlog
-	tbl.binlog.Delete(database, branch, user, host, uint64(Permissions_None))
-	// Remove the entry from the root node
-	removedIndex := tbl.Root.Remove(database, branch, user, host)
-	// Remove from the rows
-	if removedIndex != math.MaxUint32 {
-		tbl.freeRows = append(tbl.freeRows, removedIndex)
-	}
+	return database, branch, user, host
 }
 
 // Iter returns an iterator that goes over all valid rows. The iterator does not acquire a read lock, therefore this
@@ -52,6 +52,15 @@ type CherryPickOptions struct {
 	// and Dolt cherry-pick implementations, the default action is to fail when an empty commit is specified. In Git
 	// and Dolt rebase implementations, the default action is to keep commits that start off as empty.
 	EmptyCommitHandling doltdb.EmptyCommitHandling
+
+	// Favor controls whether data conflicts are automatically resolved in favor of the working set (ours) or the
+	// commit being cherry-picked (theirs). By default, data conflicts are left for the user to resolve.
+	Favor merge.Favor
+
+	// KeepSchemaConflicts controls whether column schema conflicts are recorded in the working set for the user to
+	// resolve, like data conflicts, instead of failing the cherry-pick. Tables modified on one side and deleted on the
+	// other always fail the cherry-pick.
+	KeepSchemaConflicts bool
 }
 
 // NewCherryPickOptions creates a new CherryPickOptions instance, filled out with default values for cherry-pick.
@@ -78,7 +87,7 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 		return "", nil, fmt.Errorf("failed to get roots for current session")
 	}
 
-	mergeResult, commitMsg, err := cherryPick(ctx, doltSession, roots, dbName, commit, options.EmptyCommitHandling)
+	mergeResult, commitMsg, err := cherryPick(ctx, doltSession, roots, dbName, commit, options.EmptyCommitHandling, options.Favor, options.KeepSchemaConflicts)
 	if err != nil {
 		return "", mergeResult, err
 	}
@@ -224,7 +233,7 @@ func AbortCherryPick(ctx *sql.Context, dbName string) error {
 // cherryPick checks that the current working set is clean, verifies the cherry-pick commit is not a merge commit
 // or a commit without parent commit, performs merge and returns the new working set root value and
 // the commit message of cherry-picked commit as the commit message of the new commit created during this command.
-func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName, cherryStr string, emptyCommitHandling doltdb.EmptyCommitHandling) (*merge.Result, string, error) {
+func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName, cherryStr string, emptyCommitHandling doltdb.EmptyCommitHandling, favor merge.Favor, keepSchemaConflicts bool) (*merge.Result, string, error) {
 	// check for clean working set
 	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
 	if err != nil {
@@ -326,7 +335,8 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 
 	mo := merge.MergeOpts{
 		IsCherryPick:        true,
-		KeepSchemaConflicts: false,
+		KeepSchemaConflicts: keepSchemaConflicts,
+		Favor:               favor,
 	}
 	result, err := merge.MergeRoots(ctx, roots.Working, cherryRoot, parentRoot, cherryCommit, parentCommit, dbState.EditOpts(), mo)
 	if err != nil {
@@ -345,7 +355,7 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 		}
 	}
 
-	if headRootHash.Equal(workingRootHash) && !isEmptyCommit {
+	if headRootHash.Equal(workingRootHash) && !isEmptyCommit && !result.HasMergeArtifacts() {
 		return nil, "", fmt.Errorf("no changes were made, nothing to commit")
 	}
 
@@ -362,7 +372,9 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 			if err != nil {
 				return nil, "", err
 			}
-			newWorkingSet := ws.StartCherryPick(cherryCommit, cherryStr)
+			newWorkingSet := ws.StartCherryPick(cherryCommit, cherryStr).
+				WithUnmergableTables(merge.SchemaConflictTableNames(result.SchemaConflicts)).
+				WithMergeStrategyOption(string(favor))
 			err = dSess.SetWorkingSet(ctx, dbName, newWorkingSet)
 			if err != nil {
 				return nil, "", err
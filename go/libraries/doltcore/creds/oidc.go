@@ -0,0 +1,342 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package creds
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/go-jose/go-jose.v2/jwt"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+)
+
+const (
+	OIDCTokenFileExtension = ".oidc"
+	DefaultOIDCClientID    = "dolt"
+
+	deviceCodeGrantType   = "urn:ietf:params:oauth:grant-type:device_code"
+	refreshTokenGrantType = "refresh_token"
+	defaultOIDCScopes     = "openid offline_access"
+	defaultPollInterval   = 5 * time.Second
+	// tokenExpiryLeeway is how long before its expiry a cached token is considered expired, so that it is not
+	// rejected by the time it reaches the server.
+	tokenExpiryLeeway = 30 * time.Second
+)
+
+var ErrOIDCAccessDenied = errors.New("the login request was denied")
+var ErrOIDCDeviceCodeExpired = errors.New("the login request expired before it was approved")
+var ErrOIDCTokenNotFound = errors.New("no oidc login found for issuer")
+
+// OIDCProvider is the subset of an OpenID Connect issuer's discovery document used by the device authorization flow.
+type OIDCProvider struct {
+	Issuer                      string `json:"issuer"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	JwksURI                     string `json:"jwks_uri"`
+}
+
+// DiscoverOIDCProvider fetches the discovery document of |issuer|.
+func DiscoverOIDCProvider(ctx context.Context, client *http.Client, issuer string) (*OIDCProvider, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", discoveryURL, resp.Status)
+	}
+
+	var p OIDCProvider
+	if err = json.NewDecoder(resp.Body).Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid discovery document at %s: %w", discoveryURL, err)
+	}
+	if p.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("issuer %s does not support the device authorization flow", issuer)
+	}
+	if p.TokenEndpoint == "" {
+		return nil, fmt.Errorf("issuer %s has no token endpoint", issuer)
+	}
+	return &p, nil
+}
+
+// OIDCDeviceAuth is a pending device authorization which the user approves by visiting VerificationURI and entering
+// UserCode.
+type OIDCDeviceAuth struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	// Interval is how long to wait between polls of the token endpoint.
+	Interval time.Duration `json:"-"`
+}
+
+// StartOIDCDeviceAuth begins a device authorization for |clientID| with |p|.
+func StartOIDCDeviceAuth(ctx context.Context, client *http.Client, p *OIDCProvider, clientID string) (*OIDCDeviceAuth, error) {
+	var resp struct {
+		OIDCDeviceAuth
+		Interval int `json:"interval"`
+	}
+	err := postOIDCForm(ctx, client, p.DeviceAuthorizationEndpoint, url.Values{
+		"client_id": {clientID},
+		"scope":     {defaultOIDCScopes},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	da := resp.OIDCDeviceAuth
+	da.Interval = time.Duration(resp.Interval) * time.Second
+	if da.Interval <= 0 {
+		da.Interval = defaultPollInterval
+	}
+	return &da, nil
+}
+
+// OIDCToken is a token issued to the dolt CLI by an OpenID Connect issuer. It is cached in the creds dir so that later
+// commands can authenticate with it, and refresh it once it expires.
+type OIDCToken struct {
+	Issuer        string    `json:"issuer"`
+	ClientID      string    `json:"client_id"`
+	TokenEndpoint string    `json:"token_endpoint"`
+	AccessToken   string    `json:"access_token"`
+	IDToken       string    `json:"id_token,omitempty"`
+	RefreshToken  string    `json:"refresh_token,omitempty"`
+	Expiry        time.Time `json:"expiry"`
+	Subject       string    `json:"subject"`
+}
+
+// BearerToken returns the JWT to present to a sql-server. This is the ID token if the issuer provided one, since
+// access tokens are not required to be JWTs.
+func (t *OIDCToken) BearerToken() string {
+	if t.IDToken != "" {
+		return t.IDToken
+	}
+	return t.AccessToken
+}
+
+// Expired returns whether |t| has expired, or is about to, at |now|.
+func (t *OIDCToken) Expired(now time.Time) bool {
+	return !t.Expiry.IsZero() && now.Add(tokenExpiryLeeway).After(t.Expiry)
+}
+
+type oidcTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+type oidcErrorResponse struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// PollOIDCToken polls the token endpoint of |p| until the user approves or denies |da|, or it expires.
+func PollOIDCToken(ctx context.Context, client *http.Client, p *OIDCProvider, clientID string, da *OIDCDeviceAuth) (*OIDCToken, error) {
+	interval := da.Interval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		var resp oidcTokenResponse
+		err := postOIDCForm(ctx, client, p.TokenEndpoint, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {da.DeviceCode},
+			"client_id":   {clientID},
+		}, &resp)
+
+		var oidcErr *oidcError
+		if errors.As(err, &oidcErr) {
+			switch oidcErr.Code {
+			case "authorization_pending":
+				continue
+			case "slow_down":
+				interval += defaultPollInterval
+				continue
+			case "access_denied":
+				return nil, ErrOIDCAccessDenied
+			case "expired_token":
+				return nil, ErrOIDCDeviceCodeExpired
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return newOIDCToken(p.Issuer, clientID, p.TokenEndpoint, resp, "")
+	}
+}
+
+// RefreshOIDCToken exchanges the refresh token of |t| for a new token.
+func RefreshOIDCToken(ctx context.Context, client *http.Client, t *OIDCToken) (*OIDCToken, error) {
+	if t.RefreshToken == "" {
+		return nil, fmt.Errorf("the login for %s has expired and cannot be refreshed; run dolt login --oidc %s", t.Issuer, t.Issuer)
+	}
+	var resp oidcTokenResponse
+	err := postOIDCForm(ctx, client, t.TokenEndpoint, url.Values{
+		"grant_type":    {refreshTokenGrantType},
+		"refresh_token": {t.RefreshToken},
+		"client_id":     {t.ClientID},
+	}, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("could not refresh the login for %s: %w", t.Issuer, err)
+	}
+	return newOIDCToken(t.Issuer, t.ClientID, t.TokenEndpoint, resp, t.RefreshToken)
+}
+
+func newOIDCToken(issuer, clientID, tokenEndpoint string, resp oidcTokenResponse, prevRefreshToken string) (*OIDCToken, error) {
+	if resp.AccessToken == "" {
+		return nil, errors.New("token response did not include an access token")
+	}
+	t := &OIDCToken{
+		Issuer:        issuer,
+		ClientID:      clientID,
+		TokenEndpoint: tokenEndpoint,
+		AccessToken:   resp.AccessToken,
+		IDToken:       resp.IDToken,
+		RefreshToken:  resp.RefreshToken,
+	}
+	// Issuers are not required to rotate refresh tokens
+	if t.RefreshToken == "" {
+		t.RefreshToken = prevRefreshToken
+	}
+	if resp.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+
+	// The token's signature is checked by the server it is presented to. The claims are only read here to name the
+	// user it was issued to.
+	parsed, err := jwt.ParseSigned(t.BearerToken())
+	if err != nil {
+		return nil, fmt.Errorf("issuer returned a token which is not a JWT: %w", err)
+	}
+	var claims jwt.Claims
+	if err = parsed.UnsafeClaimsWithoutVerification(&claims); err != nil {
+		return nil, err
+	}
+	t.Subject = claims.Subject
+	if claims.Expiry != nil && (t.Expiry.IsZero() || claims.Expiry.Time().Before(t.Expiry)) {
+		t.Expiry = claims.Expiry.Time()
+	}
+	return t, nil
+}
+
+type oidcError struct {
+	Code        string
+	Description string
+}
+
+func (e *oidcError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Description)
+	}
+	return e.Code
+}
+
+func postOIDCForm(ctx context.Context, client *http.Client, endpoint string, form url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp oidcErrorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
+			return &oidcError{Code: errResp.Error, Description: errResp.Description}
+		}
+		return fmt.Errorf("unexpected status from %s: %s", endpoint, resp.Status)
+	}
+	return json.Unmarshal(body, out)
+}
+
+// OIDCTokenPath returns the path in |credsDir| at which the token for |issuer| is cached.
+func OIDCTokenPath(credsDir, issuer string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSuffix(issuer, "/")))
+	return filepath.Join(credsDir, hex.EncodeToString(sum[:16])+OIDCTokenFileExtension)
+}
+
+// OIDCTokenWriteToDir caches |t| in |dir|, replacing any previous token for the same issuer.
+func OIDCTokenWriteToDir(fs filesys.Filesys, dir string, t *OIDCToken) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+
+	outFile := OIDCTokenPath(dir, t.Issuer)
+	wr, err := fs.OpenForWrite(outFile, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	err = iohelp.WriteAll(wr, data)
+	if err == nil {
+		err = wr.Close()
+	} else {
+		wr.Close()
+	}
+
+	return outFile, err
+}
+
+// OIDCTokenReadFromDir returns the token cached in |dir| for |issuer|, or ErrOIDCTokenNotFound if there is none.
+func OIDCTokenReadFromDir(fs filesys.Filesys, dir, issuer string) (*OIDCToken, error) {
+	path := OIDCTokenPath(dir, issuer)
+	if exists, _ := fs.Exists(path); !exists {
+		return nil, ErrOIDCTokenNotFound
+	}
+
+	data, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t OIDCToken
+	if err = json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("invalid oidc token file %s: %w", path, err)
+	}
+	return &t, nil
+}
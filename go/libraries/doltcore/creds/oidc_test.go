@@ -0,0 +1,180 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package creds
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+	"gopkg.in/go-jose/go-jose.v2"
+	"gopkg.in/go-jose/go-jose.v2/jwt"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+// fakeIssuer is an OpenID Connect issuer which approves device authorizations after a fixed number of polls.
+type fakeIssuer struct {
+	*httptest.Server
+	signer jose.Signer
+
+	mu           sync.Mutex
+	pendingPolls int
+	deny         bool
+	refreshes    int
+}
+
+func newFakeIssuer(t *testing.T, pendingPolls int) *fakeIssuer {
+	_, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.EdDSA, Key: priv}, nil)
+	require.NoError(t, err)
+
+	f := &fakeIssuer{signer: signer, pendingPolls: pendingPolls}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, OIDCProvider{
+			Issuer:                      f.URL,
+			DeviceAuthorizationEndpoint: f.URL + "/device",
+			TokenEndpoint:               f.URL + "/token",
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": f.URL + "/activate",
+			"expires_in":       600,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		switch r.FormValue("grant_type") {
+		case deviceCodeGrantType:
+			if r.FormValue("device_code") != "device-code" || r.FormValue("client_id") != DefaultOIDCClientID {
+				writeJSON(w, http.StatusBadRequest, oidcErrorResponse{Error: "invalid_grant"})
+			} else if f.deny {
+				writeJSON(w, http.StatusBadRequest, oidcErrorResponse{Error: "access_denied"})
+			} else if f.pendingPolls > 0 {
+				f.pendingPolls--
+				writeJSON(w, http.StatusBadRequest, oidcErrorResponse{Error: "authorization_pending"})
+			} else {
+				f.writeToken(t, w, "refresh-0")
+			}
+		case refreshTokenGrantType:
+			if r.FormValue("refresh_token") != "refresh-0" {
+				writeJSON(w, http.StatusBadRequest, oidcErrorResponse{Error: "invalid_grant"})
+				return
+			}
+			f.refreshes++
+			f.writeToken(t, w, "")
+		default:
+			writeJSON(w, http.StatusBadRequest, oidcErrorResponse{Error: "unsupported_grant_type"})
+		}
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeIssuer) writeToken(t *testing.T, w http.ResponseWriter, refreshToken string) {
+	idToken, err := jwt.Signed(f.signer).Claims(jwt.Claims{
+		Issuer:   f.URL,
+		Subject:  "alice",
+		Audience: jwt.Audience{DefaultOIDCClientID},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).CompactSerialize()
+	require.NoError(t, err)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token":  "opaque-access-token",
+		"id_token":      idToken,
+		"refresh_token": refreshToken,
+		"expires_in":    3600,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestOIDCDeviceFlow(t *testing.T) {
+	ctx := context.Background()
+	issuer := newFakeIssuer(t, 2)
+	client := issuer.Client()
+
+	p, err := DiscoverOIDCProvider(ctx, client, issuer.URL)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/token", p.TokenEndpoint)
+
+	da, err := StartOIDCDeviceAuth(ctx, client, p, DefaultOIDCClientID)
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD-EFGH", da.UserCode)
+	assert.Equal(t, time.Second, da.Interval)
+
+	da.Interval = time.Millisecond
+	tok, err := PollOIDCToken(ctx, client, p, DefaultOIDCClientID, da)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", tok.Subject)
+	assert.Equal(t, "refresh-0", tok.RefreshToken)
+	assert.NotEqual(t, "opaque-access-token", tok.BearerToken())
+	assert.False(t, tok.Expired(time.Now()))
+	assert.True(t, tok.Expired(time.Now().Add(2*time.Hour)))
+
+	refreshed, err := RefreshOIDCToken(ctx, client, tok)
+	require.NoError(t, err)
+	assert.Equal(t, 1, issuer.refreshes)
+	assert.Equal(t, "alice", refreshed.Subject)
+	// The issuer did not rotate the refresh token, so the previous one is kept
+	assert.Equal(t, "refresh-0", refreshed.RefreshToken)
+
+	issuer.deny = true
+	_, err = PollOIDCToken(ctx, client, p, DefaultOIDCClientID, da)
+	assert.ErrorIs(t, err, ErrOIDCAccessDenied)
+}
+
+func TestOIDCTokenCache(t *testing.T) {
+	fs := filesys.NewInMemFS(nil, nil, "/")
+	require.NoError(t, fs.MkDirs("/creds"))
+
+	_, err := OIDCTokenReadFromDir(fs, "/creds", "https://issuer.example.com")
+	assert.ErrorIs(t, err, ErrOIDCTokenNotFound)
+
+	tok := &OIDCToken{
+		Issuer:       "https://issuer.example.com",
+		ClientID:     DefaultOIDCClientID,
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		Subject:      "alice",
+		Expiry:       time.Now().Add(time.Hour).Round(time.Second).UTC(),
+	}
+	_, err = OIDCTokenWriteToDir(fs, "/creds", tok)
+	require.NoError(t, err)
+
+	// Issuers with and without a trailing slash share a cache entry
+	read, err := OIDCTokenReadFromDir(fs, "/creds", "https://issuer.example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, tok, read)
+}
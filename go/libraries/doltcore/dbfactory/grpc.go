@@ -119,6 +119,8 @@ func (fact DoltRemoteFactory) newChunkStore(ctx context.Context, nbf *types.Noms
 
 	opts := append(cfg.DialOptions, grpc.WithChainUnaryInterceptor(remotestorage.EventsUnaryClientInterceptor(events.GlobalCollector())))
 	opts = append(opts, grpc.WithChainUnaryInterceptor(remotestorage.RetryingUnaryClientInterceptor))
+	opts = append(opts, grpc.WithChainUnaryInterceptor(remotestorage.TracingUnaryClientInterceptor))
+	opts = append(opts, grpc.WithChainStreamInterceptor(remotestorage.TracingStreamClientInterceptor))
 
 	conn, err := grpc.Dial(cfg.Endpoint, opts...)
 	if err != nil {
@@ -0,0 +1,355 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+var ErrCellBlameKeyless = errors.New("unable to blame cells of a table without a primary key")
+
+// CellBlame attributes each cell of a table at some commit to the commit that last changed it.
+type CellBlame struct {
+	// Sch is the schema of the table at the blamed commit.
+	Sch schema.Schema
+	// Cols are the blamed columns of Sch. Virtual columns aren't stored, so they're never blamed.
+	Cols []schema.Column
+	// Rows are the rows of the table at the blamed commit.
+	Rows prolly.Map
+
+	cells   map[string][]hash.Hash
+	commits map[hash.Hash]*doltdb.Commit
+}
+
+// pendingCells are the cells that are yet to be blamed at some commit, keyed by row key, along with the table at that
+// commit. A cell is pending at a commit if its value there is the same as at the blamed commit.
+type pendingCells struct {
+	tbl   *doltdb.Table
+	cells map[string][]bool
+}
+
+// BlameCells computes the CellBlame of the table |tableName| at the commit |cm|.
+//
+// The history of the table is walked from |cm| through every parent of each commit, newest first, as with
+// dolt_blame_<table> and dolt log. Each commit is diffed against each of its parents in turn, and every cell still to be
+// blamed whose value is the same in a parent is passed on to that parent, preferring earlier parents. The cells that
+// differ from every parent, including those of rows and columns the commit added or retyped, are blamed on the commit.
+// So a merge is only blamed for the cells it changed from all of its parents, such as those of a resolved conflict.
+// Parents that didn't change the table take all of its cells without diffing, and the walk stops as soon as every cell
+// is blamed. Cells are blamed on the commit that changed the table's primary key, or that added the table.
+func BlameCells(ctx context.Context, cm *doltdb.Commit, tableName doltdb.TableName) (*CellBlame, error) {
+	tbl, ok, err := tableAtCommit(ctx, cm, tableName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, doltdb.ErrTableNotFound
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return nil, errors.New("cell blame is not supported by this storage format")
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if schema.IsKeyless(sch) {
+		return nil, ErrCellBlameKeyless
+	}
+	rows, err := tableRows(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &CellBlame{
+		Sch:     sch,
+		Rows:    rows,
+		cells:   make(map[string][]hash.Hash),
+		commits: make(map[hash.Hash]*doltdb.Commit),
+	}
+	for _, col := range sch.GetAllCols().GetColumns() {
+		if !col.Virtual {
+			b.Cols = append(b.Cols, col)
+		}
+	}
+
+	pending := &pendingCells{tbl: tbl, cells: make(map[string][]bool)}
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		k, _, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		b.cells[string(k)] = make([]hash.Hash, len(b.Cols))
+		slots := make([]bool, len(b.Cols))
+		for i := range slots {
+			slots[i] = true
+		}
+		pending.cells[string(k)] = slots
+	}
+	if len(pending.cells) == 0 {
+		return b, nil
+	}
+
+	q := newCommitQueue[*pendingCells]()
+	if _, err = q.push(ctx, cm, func() (*pendingCells, error) { return pending, nil }); err != nil {
+		return nil, err
+	}
+	for cm, pending := q.pop(); cm != nil; cm, pending = q.pop() {
+		if err = b.blameCommit(ctx, q, cm, tableName, pending); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// blameCommit passes each of the |pending| cells of |cm| that are unchanged in one of its parents on to that parent,
+// queueing the parent, and blames the rest on |cm|.
+func (b *CellBlame) blameCommit(ctx context.Context, q *commitQueue[*pendingCells], cm *doltdb.Commit, tableName doltdb.TableName, pending *pendingCells) error {
+	parents, err := parentCommits(ctx, cm)
+	if err != nil {
+		return err
+	}
+	for _, parent := range parents {
+		if len(pending.cells) == 0 {
+			break
+		}
+		parentTbl, ok, err := tableAtCommit(ctx, parent, tableName)
+		if err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		unchanged, err := b.takeUnchanged(ctx, pending, parentTbl)
+		if err != nil {
+			return err
+		}
+		if len(unchanged) == 0 {
+			continue
+		}
+		parentPending, err := q.push(ctx, parent, func() (*pendingCells, error) {
+			return &pendingCells{tbl: parentTbl, cells: make(map[string][]bool)}, nil
+		})
+		if err != nil {
+			return err
+		}
+		for key, slots := range unchanged {
+			if existing, ok := parentPending.cells[key]; ok {
+				for slot, ok := range slots {
+					existing[slot] = existing[slot] || ok
+				}
+			} else {
+				parentPending.cells[key] = slots
+			}
+		}
+	}
+
+	if len(pending.cells) == 0 {
+		return nil
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+	b.commits[h] = cm
+	for key, slots := range pending.cells {
+		for slot, ok := range slots {
+			if ok {
+				b.cells[key][slot] = h
+			}
+		}
+	}
+	return nil
+}
+
+// takeUnchanged removes the cells of |pending| whose values are the same in |parentTbl| and returns them.
+func (b *CellBlame) takeUnchanged(ctx context.Context, pending *pendingCells, parentTbl *doltdb.Table) (map[string][]bool, error) {
+	if th, err := pending.tbl.HashOf(); err != nil {
+		return nil, err
+	} else if ph, err := parentTbl.HashOf(); err != nil {
+		return nil, err
+	} else if th == ph {
+		unchanged := pending.cells
+		pending.cells = make(map[string][]bool)
+		return unchanged, nil
+	}
+
+	to, err := tableRows(ctx, pending.tbl)
+	if err != nil {
+		return nil, err
+	}
+	from, err := tableRows(ctx, parentTbl)
+	if err != nil {
+		return nil, err
+	}
+	toKd, toVd := to.Descriptors()
+	fromKd, fromVd := from.Descriptors()
+	if !toKd.Equals(fromKd) {
+		// keys can't be compared across a primary key change
+		return nil, nil
+	}
+
+	toSch, err := pending.tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fromSch, err := parentTbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toOrds, fromOrds := valueOrdinals(toSch), valueOrdinals(fromSch)
+
+	// |inBoth| are the columns whose values can be compared between |from| and |to|, and |compared| are the non-primary
+	// key ones among them along with their ordinals in each
+	type comparedCol struct {
+		slot, from, to int
+	}
+	var compared []comparedCol
+	inBoth := make([]bool, len(b.Cols))
+	for slot, col := range b.Cols {
+		if col.IsPartOfPK {
+			inBoth[slot] = true
+			continue
+		}
+		t, ok := toOrds[col.Tag]
+		if !ok {
+			continue
+		}
+		f, ok := fromOrds[col.Tag]
+		if !ok || toVd.Types[t].Enc != fromVd.Types[f].Enc {
+			continue
+		}
+		inBoth[slot] = true
+		compared = append(compared, comparedCol{slot: slot, from: f, to: t})
+	}
+
+	// |changed| are the cells of the pending rows that differ between |from| and |to|
+	changed := make(map[string][]bool)
+	if to.HashOf() != from.HashOf() {
+		err = prolly.DiffMaps(ctx, from, to, false, func(ctx context.Context, d tree.Diff) error {
+			key := string(d.Key)
+			if _, ok := pending.cells[key]; !ok {
+				return nil
+			}
+			slots := make([]bool, len(b.Cols))
+			switch d.Type {
+			case tree.AddedDiff:
+				for slot := range slots {
+					slots[slot] = true
+				}
+			case tree.ModifiedDiff:
+				for _, c := range compared {
+					slots[c.slot] = !bytes.Equal(val.Tuple(d.From).GetField(c.from), val.Tuple(d.To).GetField(c.to))
+				}
+			}
+			changed[key] = slots
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+
+	unchanged := make(map[string][]bool)
+	for key, slots := range pending.cells {
+		var taken []bool
+		remaining := 0
+		for slot, ok := range slots {
+			if !ok {
+				continue
+			}
+			if !inBoth[slot] || (changed[key] != nil && changed[key][slot]) {
+				remaining++
+				continue
+			}
+			if taken == nil {
+				taken = make([]bool, len(b.Cols))
+			}
+			taken[slot], slots[slot] = true, false
+		}
+		if taken != nil {
+			unchanged[key] = taken
+		}
+		if remaining == 0 {
+			delete(pending.cells, key)
+		}
+	}
+	return unchanged, nil
+}
+
+// Iter calls |cb| for each row of Rows in key order, with the commits that last changed each of its Cols.
+func (b *CellBlame) Iter(ctx context.Context, cb func(k val.Tuple, commits []*doltdb.Commit) error) error {
+	iter, err := b.Rows.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	commits := make([]*doltdb.Commit, len(b.Cols))
+	for {
+		k, _, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		for i, h := range b.cells[string(k)] {
+			commits[i] = b.commits[h]
+		}
+		if err = cb(k, commits); err != nil {
+			return err
+		}
+	}
+}
+
+func tableAtCommit(ctx context.Context, cm *doltdb.Commit, tableName doltdb.TableName) (*doltdb.Table, bool, error) {
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return root.GetTable(ctx, tableName)
+}
+
+func tableRows(ctx context.Context, tbl *doltdb.Table) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return durable.ProllyMapFromIndex(idx), nil
+}
+
+// valueOrdinals maps the tags of the stored non-primary key columns of |sch| to their ordinals in its value tuples.
+func valueOrdinals(sch schema.Schema) map[uint64]int {
+	ords := make(map[uint64]int)
+	for _, col := range sch.GetNonPKCols().GetColumns() {
+		if !col.Virtual {
+			ords[col.Tag] = len(ords)
+		}
+	}
+	return ords
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// commitQueue visits the ancestors of a commit newest first, in the same order as dolt log: by descending height, then
+// by descending commit time. Since a commit is always higher than its parents, every child of a commit that is
+// reachable from the start commit is popped before it. Each commit carries a state of type T, which is set when the
+// commit is first pushed and can be updated by later pushes before it's popped.
+type commitQueue[T any] struct {
+	pending []*queuedCommit[T]
+	byHash  map[hash.Hash]*queuedCommit[T]
+}
+
+type queuedCommit[T any] struct {
+	cm     *doltdb.Commit
+	h      hash.Hash
+	height uint64
+	time   int64
+	state  T
+}
+
+func newCommitQueue[T any]() *commitQueue[T] {
+	return &commitQueue[T]{byHash: make(map[hash.Hash]*queuedCommit[T])}
+}
+
+// push queues |cm| with |state|, unless it is already queued. It returns the state of the queued commit.
+func (q *commitQueue[T]) push(ctx context.Context, cm *doltdb.Commit, state func() (T, error)) (T, error) {
+	h, err := cm.HashOf()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if qc, ok := q.byHash[h]; ok {
+		return qc.state, nil
+	}
+	qc := &queuedCommit[T]{cm: cm, h: h}
+	if qc.height, err = cm.Height(); err != nil {
+		return qc.state, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return qc.state, err
+	}
+	qc.time = meta.UserTimestamp
+	if qc.state, err = state(); err != nil {
+		return qc.state, err
+	}
+	q.byHash[h] = qc
+	heap.Push(q, qc)
+	return qc.state, nil
+}
+
+// pop returns the next commit to visit and its state, or a nil commit when there are no more.
+func (q *commitQueue[T]) pop() (*doltdb.Commit, T) {
+	if len(q.pending) == 0 {
+		var zero T
+		return nil, zero
+	}
+	qc := heap.Pop(q).(*queuedCommit[T])
+	return qc.cm, qc.state
+}
+
+func (q *commitQueue[T]) Len() int {
+	return len(q.pending)
+}
+
+func (q *commitQueue[T]) Less(i, j int) bool {
+	if q.pending[i].height != q.pending[j].height {
+		return q.pending[i].height > q.pending[j].height
+	}
+	return q.pending[i].time > q.pending[j].time
+}
+
+func (q *commitQueue[T]) Swap(i, j int) {
+	q.pending[i], q.pending[j] = q.pending[j], q.pending[i]
+}
+
+func (q *commitQueue[T]) Push(x any) {
+	q.pending = append(q.pending, x.(*queuedCommit[T]))
+}
+
+func (q *commitQueue[T]) Pop() any {
+	old := q.pending
+	qc := old[len(old)-1]
+	q.pending = old[:len(old)-1]
+	return qc
+}
+
+// parentCommits returns the parents of |cm|, in order.
+func parentCommits(ctx context.Context, cm *doltdb.Commit) ([]*doltdb.Commit, error) {
+	parents := make([]*doltdb.Commit, cm.NumParents())
+	for i := range parents {
+		optCmt, err := cm.GetParent(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if parents[i], ok = optCmt.ToCommit(); !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+	return parents, nil
+}
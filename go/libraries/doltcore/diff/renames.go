@@ -0,0 +1,456 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// DefaultRenameSimilarity is the similarity at or above which a dropped table and an added table are considered the
+// same table under a new name.
+const DefaultRenameSimilarity = 0.5
+
+// renameSampleRows is the number of rows sampled when comparing the values of two columns.
+const renameSampleRows = 256
+
+// minAgreeingValues is the number of non-NULL values two columns must agree on before they are matched by value. A
+// single matching value is too weak a signal to tell a renamed column from an unrelated one.
+const minAgreeingValues = 2
+
+// DetectRenames finds tables in |deltas| that were dropped and recreated under a new name, as DetectTableRenames
+// does, and columns that were dropped and recreated with a new tag. For every renamed or modified table, columns that
+// only exist on one side are matched by MatchRecreatedColumns, and the matched columns of ToSch and ToTable are given
+// the tags of their FromSch counterparts.
+func DetectRenames(ctx context.Context, deltas []TableDelta, threshold float64) ([]TableDelta, error) {
+	deltas, err := DetectTableRenames(ctx, deltas, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, td := range deltas {
+		if td.FromTable == nil || td.ToTable == nil {
+			continue
+		}
+		tags, err := MatchRecreatedColumns(ctx, td.FromTable, td.FromSch, td.ToTable, td.ToSch)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) == 0 {
+			continue
+		}
+		if deltas[i].ToSch, err = schema.WithColumnTags(td.ToSch, tags); err != nil {
+			return nil, err
+		}
+		if deltas[i].ToTable, err = td.ToTable.UpdateSchema(ctx, deltas[i].ToSch); err != nil {
+			return nil, err
+		}
+	}
+	return deltas, nil
+}
+
+// DetectTableRenames pairs tables dropped in |deltas| with tables added in |deltas| whose similarity to them is at
+// least |threshold|, and returns each pair as a single renamed TableDelta. Similarity is the mean of the fraction of
+// columns the two schemas share and the fraction of leaf chunks their row data shares; since chunk boundaries are
+// content defined, unchanged runs of rows produce identical chunks. Tables are paired greedily, most similar first.
+func DetectTableRenames(ctx context.Context, deltas []TableDelta, threshold float64) ([]TableDelta, error) {
+	var dropped, added []int
+	for i, td := range deltas {
+		if td.IsDrop() {
+			dropped = append(dropped, i)
+		} else if td.IsAdd() {
+			added = append(added, i)
+		}
+	}
+	if len(dropped) == 0 || len(added) == 0 {
+		return deltas, nil
+	}
+
+	type candidate struct {
+		from, to   int
+		similarity float64
+	}
+	var candidates []candidate
+	for _, f := range dropped {
+		for _, t := range added {
+			if deltas[f].FromName.Schema != deltas[t].ToName.Schema {
+				continue
+			}
+			sim, err := TableSimilarity(ctx, deltas[f].FromTable, deltas[f].FromSch, deltas[t].ToTable, deltas[t].ToSch)
+			if err != nil {
+				return nil, err
+			}
+			if sim >= threshold {
+				candidates = append(candidates, candidate{from: f, to: t, similarity: sim})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return deltas, nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].similarity > candidates[j].similarity
+	})
+
+	paired := make(map[int]bool)
+	var renamed []TableDelta
+	for _, c := range candidates {
+		if paired[c.from] || paired[c.to] {
+			continue
+		}
+		paired[c.from], paired[c.to] = true, true
+		f, t := deltas[c.from], deltas[c.to]
+		renamed = append(renamed, TableDelta{
+			FromName:         f.FromName,
+			ToName:           t.ToName,
+			FromTable:        f.FromTable,
+			ToTable:          t.ToTable,
+			FromNodeStore:    f.FromNodeStore,
+			ToNodeStore:      t.ToNodeStore,
+			FromVRW:          f.FromVRW,
+			ToVRW:            t.ToVRW,
+			FromSch:          f.FromSch,
+			ToSch:            t.ToSch,
+			FromFks:          f.FromFks,
+			ToFks:            t.ToFks,
+			FromFksParentSch: f.FromFksParentSch,
+			ToFksParentSch:   t.ToFksParentSch,
+		})
+	}
+
+	result := make([]TableDelta, 0, len(deltas)-len(renamed))
+	for i, td := range deltas {
+		if !paired[i] {
+			result = append(result, td)
+		}
+	}
+	result = append(result, renamed...)
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].FromName == result[j].FromName {
+			return result[i].ToName.Less(result[j].ToName)
+		}
+		return result[i].FromName.Less(result[j].FromName)
+	})
+	return result, nil
+}
+
+// TableSimilarity returns a score between 0 and 1 for how likely it is that table |to| is table |from| under a new
+// name. See DetectTableRenames. Empty tables carry no evidence of being the same table, so they score 0.
+func TableSimilarity(ctx context.Context, from *doltdb.Table, fromSch schema.Schema, to *doltdb.Table, toSch schema.Schema) (float64, error) {
+	for _, tbl := range []*doltdb.Table{from, to} {
+		rows, err := tbl.GetRowData(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if cnt, err := rows.Count(); err != nil || cnt == 0 {
+			return 0, err
+		}
+	}
+
+	tags, err := MatchRecreatedColumns(ctx, from, fromSch, to, toSch)
+	if err != nil {
+		return 0, err
+	}
+	shared := len(tags)
+	for _, col := range toSch.GetAllCols().GetColumns() {
+		if _, ok := fromSch.GetAllCols().GetByTag(col.Tag); ok {
+			shared++
+		}
+	}
+	total := fromSch.GetAllCols().Size()
+	if toSch.GetAllCols().Size() > total {
+		total = toSch.GetAllCols().Size()
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	schemaSim := float64(shared) / float64(total)
+
+	if !types.IsFormat_DOLT(from.Format()) {
+		return schemaSim, nil
+	}
+	fromRows, toRows, err := prollyRowData(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	fromLeaves, err := leafAddresses(ctx, fromRows)
+	if err != nil {
+		return 0, err
+	}
+	toLeaves, err := leafAddresses(ctx, toRows)
+	if err != nil {
+		return 0, err
+	}
+	common := 0
+	for h := range toLeaves {
+		if fromLeaves.Has(h) {
+			common++
+		}
+	}
+	contentSim := float64(common) / float64(len(fromLeaves)+len(toLeaves)-common)
+
+	return (schemaSim + contentSim) / 2, nil
+}
+
+// MatchRecreatedColumns pairs the columns of |toSch| whose tags do not appear in |fromSch| with the columns of
+// |fromSch| whose tags do not appear in |toSch|. Columns are paired first by name and SQL type. The columns left
+// over are paired by the values they hold: two columns of the same type match if they agree on at least half of a
+// sample of rows that exist in both tables, and on at least minAgreeingValues non-NULL values. The result maps each
+// paired tag of |toSch| to the tag of its counterpart in |fromSch|.
+func MatchRecreatedColumns(ctx context.Context, from *doltdb.Table, fromSch schema.Schema, to *doltdb.Table, toSch schema.Schema) (map[uint64]uint64, error) {
+	var fromCols, toCols []schema.Column
+	for _, col := range fromSch.GetAllCols().GetColumns() {
+		if _, ok := toSch.GetAllCols().GetByTag(col.Tag); !ok && !col.Virtual {
+			fromCols = append(fromCols, col)
+		}
+	}
+	for _, col := range toSch.GetAllCols().GetColumns() {
+		if _, ok := fromSch.GetAllCols().GetByTag(col.Tag); !ok && !col.Virtual {
+			toCols = append(toCols, col)
+		}
+	}
+	if len(fromCols) == 0 || len(toCols) == 0 {
+		return nil, nil
+	}
+
+	sameType := func(f, t schema.Column) bool {
+		return f.IsPartOfPK == t.IsPartOfPK && f.TypeInfo.ToSqlType().Equals(t.TypeInfo.ToSqlType())
+	}
+
+	tags := make(map[uint64]uint64)
+	usedFrom := make(map[uint64]bool)
+	for _, t := range toCols {
+		for _, f := range fromCols {
+			if !usedFrom[f.Tag] && strings.EqualFold(f.Name, t.Name) && sameType(f, t) {
+				tags[t.Tag] = f.Tag
+				usedFrom[f.Tag] = true
+				break
+			}
+		}
+	}
+
+	if !types.IsFormat_DOLT(from.Format()) {
+		return tags, nil
+	}
+
+	type pair struct {
+		from, to  schema.Column
+		agreement float64
+	}
+	var pairs []pair
+	for _, t := range toCols {
+		if _, ok := tags[t.Tag]; ok {
+			continue
+		}
+		for _, f := range fromCols {
+			if !usedFrom[f.Tag] && sameType(f, t) {
+				pairs = append(pairs, pair{from: f, to: t})
+			}
+		}
+	}
+	if len(pairs) == 0 {
+		return tags, nil
+	}
+
+	fromRows, toRows, err := prollyRowData(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pairs {
+		pairs[i].agreement, err = columnAgreement(ctx, fromRows, fromSch, pairs[i].from, toRows, toSch, pairs[i].to)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].agreement > pairs[j].agreement
+	})
+	for _, p := range pairs {
+		if p.agreement < 0.5 {
+			break
+		}
+		if _, ok := tags[p.to.Tag]; ok || usedFrom[p.from.Tag] {
+			continue
+		}
+		tags[p.to.Tag] = p.from.Tag
+		usedFrom[p.from.Tag] = true
+	}
+	return tags, nil
+}
+
+// columnAgreement returns the fraction of sampled rows of |toRows| for which column |toCol| holds the same value as
+// column |fromCol| does in the row of |fromRows| with the same key. Rows are only compared when the two tables have
+// the same key encoding, and the result is zero unless at least minAgreeingValues non-NULL values agree.
+func columnAgreement(ctx context.Context, fromRows prolly.Map, fromSch schema.Schema, fromCol schema.Column, toRows prolly.Map, toSch schema.Schema, toCol schema.Column) (float64, error) {
+	if schema.IsKeyless(fromSch) || schema.IsKeyless(toSch) {
+		return 0, nil
+	}
+	fromKD, fromVD := fromRows.Descriptors()
+	toKD, toVD := toRows.Descriptors()
+	if !sameEncodings(fromKD, toKD) {
+		return 0, nil
+	}
+	fromDesc, fromPos, fromKey := columnPosition(fromSch, fromCol, fromKD, fromVD)
+	toDesc, toPos, toKey := columnPosition(toSch, toCol, toKD, toVD)
+	if fromDesc.Types[fromPos].Enc != toDesc.Types[toPos].Enc {
+		return 0, nil
+	}
+
+	cnt, err := toRows.Count()
+	if err != nil || cnt == 0 {
+		return 0, err
+	}
+	step := cnt / renameSampleRows
+	if step == 0 {
+		step = 1
+	}
+
+	var compared, agreed, nonNull int
+	for ord := 0; ord < cnt; ord += step {
+		iter, err := toRows.IterOrdinalRange(ctx, uint64(ord), uint64(ord+1))
+		if err != nil {
+			return 0, err
+		}
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+
+		var fv val.Tuple
+		err = fromRows.Get(ctx, k, func(_, value val.Tuple) error {
+			fv = value
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		if fv == nil {
+			continue
+		}
+
+		fromTup, toTup := fv, v
+		if fromKey {
+			fromTup = k
+		}
+		if toKey {
+			toTup = k
+		}
+		compared++
+		fromNull, toNull := fromDesc.IsNull(fromPos, fromTup), toDesc.IsNull(toPos, toTup)
+		if fromNull || toNull {
+			if fromNull && toNull {
+				agreed++
+			}
+			continue
+		}
+		if bytes.Equal(fromDesc.GetField(fromPos, fromTup), toDesc.GetField(toPos, toTup)) {
+			agreed++
+			nonNull++
+		}
+	}
+	if compared == 0 || nonNull < minAgreeingValues {
+		return 0, nil
+	}
+	return float64(agreed) / float64(compared), nil
+}
+
+// columnPosition returns the descriptor and position of |col| within the rows of a table with schema |sch|, and
+// whether it is stored in the key.
+func columnPosition(sch schema.Schema, col schema.Column, kd, vd val.TupleDesc) (val.TupleDesc, int, bool) {
+	if col.IsPartOfPK {
+		return kd, sch.GetPKCols().TagToIdx[col.Tag], true
+	}
+	pos := 0
+	for _, c := range sch.GetNonPKCols().GetColumns() {
+		if c.Tag == col.Tag {
+			break
+		}
+		if !c.Virtual {
+			pos++
+		}
+	}
+	return vd, pos, false
+}
+
+func sameEncodings(a, b val.TupleDesc) bool {
+	if a.Count() != b.Count() {
+		return false
+	}
+	for i := range a.Types {
+		if a.Types[i].Enc != b.Types[i].Enc {
+			return false
+		}
+	}
+	return true
+}
+
+func prollyRowData(ctx context.Context, from, to *doltdb.Table) (prolly.Map, prolly.Map, error) {
+	fromIdx, err := from.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, prolly.Map{}, err
+	}
+	toIdx, err := to.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, prolly.Map{}, err
+	}
+	return durable.ProllyMapFromIndex(fromIdx), durable.ProllyMapFromIndex(toIdx), nil
+}
+
+// leafAddresses returns the addresses of the leaf chunks of |m|. Only internal nodes are read.
+func leafAddresses(ctx context.Context, m prolly.Map) (hash.HashSet, error) {
+	root := m.Node()
+	leaves := hash.NewHashSet()
+	if root.IsLeaf() {
+		leaves.Insert(root.HashOf())
+		return leaves, nil
+	}
+
+	ns := m.NodeStore()
+	level := []tree.Node{root}
+	for len(level) > 0 {
+		var next []tree.Node
+		for _, nd := range level {
+			for i := 0; i < nd.Count(); i++ {
+				addr := hash.New(nd.GetValue(i))
+				if nd.Level() == 1 {
+					leaves.Insert(addr)
+					continue
+				}
+				child, err := ns.Read(ctx, addr)
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, child)
+			}
+		}
+		level = next
+	}
+	return leaves, nil
+}
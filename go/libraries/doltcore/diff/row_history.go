@@ -0,0 +1,272 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+var ErrRowHistoryKeyless = errors.New("unable to show the history of a row of a table without a primary key")
+
+// RowChange is a change to a single row of a table made by a commit.
+type RowChange struct {
+	Commit *doltdb.Commit
+	Type   tree.DiffType
+
+	// FromSch, FromKey and FromValue are the row before the change. FromKey is nil if the row was added.
+	FromSch            schema.Schema
+	FromKey, FromValue val.Tuple
+	// ToSch, ToKey and ToValue are the row after the change. ToKey is nil if the row was removed.
+	ToSch          schema.Schema
+	ToKey, ToValue val.Tuple
+
+	NodeStore tree.NodeStore
+}
+
+// RowHistory calls |cb| with each change to the row of the table |tableName| whose primary key has the values |pk|,
+// keyed by column tag, walking every ancestor of |cm| from newest to oldest in the same order as dolt log. Values in
+// |pk| are converted to the type of their column in each commit's schema.
+//
+// A commit with a single parent changed the row if it differs from the row in the parent. A merge commit only changed
+// the row if it differs from the row in every parent, as for a resolved conflict, since otherwise the change was made
+// by a commit on the merged branch; its change is reported against its first parent. Only commits that changed the
+// table are inspected, and the row's change in such a commit is found by diffing the key range of the row in the
+// table's primary index against that of the commit's parent, so unchanged rows and commits are never materialized.
+func RowHistory(ctx context.Context, cm *doltdb.Commit, tableName doltdb.TableName, pk map[uint64]interface{}, cb func(RowChange) error) error {
+	tbl, ok, err := tableAtCommit(ctx, cm, tableName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return doltdb.ErrTableNotFound
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return errors.New("row history is not supported by this storage format")
+	}
+	if sch, err := tbl.GetSchema(ctx); err != nil {
+		return err
+	} else if schema.IsKeyless(sch) {
+		return ErrRowHistoryKeyless
+	}
+
+	q := newCommitQueue[*rowVersion]()
+	if _, err = q.push(ctx, cm, func() (*rowVersion, error) { return &rowVersion{tbl: tbl}, nil }); err != nil {
+		return err
+	}
+	for cm, to := q.pop(); cm != nil; cm, to = q.pop() {
+		parents, err := parentCommits(ctx, cm)
+		if err != nil {
+			return err
+		}
+		froms := make([]*rowVersion, len(parents))
+		for i, parent := range parents {
+			froms[i], err = q.push(ctx, parent, func() (*rowVersion, error) {
+				tbl, _, err := tableAtCommit(ctx, parent, tableName)
+				return &rowVersion{tbl: tbl}, err
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		change, ok, err := commitRowChange(ctx, froms, to, pk)
+		if err != nil {
+			return err
+		}
+		if ok {
+			change.Commit = cm
+			if err = cb(change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// commitRowChange returns the change to the row made by a commit whose version of the row is |to| and whose parents'
+// versions are |froms|, and whether there was one.
+func commitRowChange(ctx context.Context, froms []*rowVersion, to *rowVersion, pk map[uint64]interface{}) (RowChange, bool, error) {
+	if len(froms) == 0 {
+		return diffRowVersions(ctx, &rowVersion{}, to, pk)
+	}
+	change, ok, err := diffRowVersions(ctx, froms[0], to, pk)
+	if err != nil || !ok {
+		return RowChange{}, false, err
+	}
+	for _, from := range froms[1:] {
+		if _, ok, err = diffRowVersions(ctx, from, to, pk); err != nil || !ok {
+			return RowChange{}, false, err
+		}
+	}
+	return change, true, nil
+}
+
+// rowVersion is a row of a table at some commit. Its schema, rows and key are loaded on demand, since they're only
+// needed when the table changed.
+type rowVersion struct {
+	// tbl is nil if the table doesn't exist
+	tbl *doltdb.Table
+
+	loaded bool
+	sch    schema.Schema
+	rows   prolly.Map
+	// key is nil if the row can't be keyed in |sch|
+	key val.Tuple
+}
+
+func (r *rowVersion) load(ctx context.Context, pk map[uint64]interface{}) error {
+	if r.loaded || r.tbl == nil {
+		return nil
+	}
+	r.loaded = true
+
+	var err error
+	if r.sch, err = r.tbl.GetSchema(ctx); err != nil {
+		return err
+	}
+	if schema.IsKeyless(r.sch) {
+		return nil
+	}
+	if r.rows, err = tableRows(ctx, r.tbl); err != nil {
+		return err
+	}
+
+	kd, _ := r.rows.Descriptors()
+	kb := val.NewTupleBuilder(kd)
+	for i, col := range r.sch.GetPKCols().GetColumns() {
+		v, ok := pk[col.Tag]
+		if !ok {
+			return nil
+		}
+		v, _, err = col.TypeInfo.ToSqlType().Convert(v)
+		if err != nil {
+			// the row's key can't be represented in this schema
+			return nil
+		}
+		if err = tree.PutField(ctx, r.rows.NodeStore(), kb, i, v); err != nil {
+			return err
+		}
+	}
+	r.key = kb.Build(r.rows.Pool())
+	return nil
+}
+
+// get returns the value of the row, or nil if it doesn't exist.
+func (r *rowVersion) get(ctx context.Context) (value val.Tuple, err error) {
+	if r.key == nil {
+		return nil, nil
+	}
+	err = r.rows.Get(ctx, r.key, func(k, v val.Tuple) error {
+		if k != nil {
+			value = v
+		}
+		return nil
+	})
+	return value, err
+}
+
+// diffRowVersions returns the change to the row between |from| and |to|, and whether there was one.
+func diffRowVersions(ctx context.Context, from, to *rowVersion, pk map[uint64]interface{}) (RowChange, bool, error) {
+	if from.tbl == nil && to.tbl == nil {
+		return RowChange{}, false, nil
+	}
+	if from.tbl != nil && to.tbl != nil {
+		fh, err := from.tbl.HashOf()
+		if err != nil {
+			return RowChange{}, false, err
+		}
+		th, err := to.tbl.HashOf()
+		if err != nil {
+			return RowChange{}, false, err
+		}
+		if fh == th {
+			return RowChange{}, false, nil
+		}
+	}
+	if err := from.load(ctx, pk); err != nil {
+		return RowChange{}, false, err
+	}
+	if err := to.load(ctx, pk); err != nil {
+		return RowChange{}, false, err
+	}
+	if from.key == nil && to.key == nil {
+		return RowChange{}, false, nil
+	}
+
+	change := RowChange{FromSch: from.sch, ToSch: to.sch}
+	if from.key != nil && to.key != nil {
+		change.NodeStore = to.rows.NodeStore()
+		fromKd, _ := from.rows.Descriptors()
+		toKd, _ := to.rows.Descriptors()
+		if fromKd.Equals(toKd) {
+			found := false
+			err := prolly.RangeDiffMaps(ctx, from.rows, to.rows, prolly.PrefixRange(to.key, toKd), func(ctx context.Context, d tree.Diff) error {
+				found = true
+				change.Type = d.Type
+				if d.Type != tree.AddedDiff {
+					change.FromKey, change.FromValue = val.Tuple(d.Key), val.Tuple(d.From)
+				}
+				if d.Type != tree.RemovedDiff {
+					change.ToKey, change.ToValue = val.Tuple(d.Key), val.Tuple(d.To)
+				}
+				return io.EOF
+			})
+			if err != nil && err != io.EOF {
+				return RowChange{}, false, err
+			}
+			return change, found, nil
+		}
+	}
+
+	// the primary key changed, so the row is looked up in each version instead
+	fromValue, err := from.get(ctx)
+	if err != nil {
+		return RowChange{}, false, err
+	}
+	toValue, err := to.get(ctx)
+	if err != nil {
+		return RowChange{}, false, err
+	}
+	switch {
+	case fromValue == nil && toValue == nil:
+		return RowChange{}, false, nil
+	case fromValue == nil:
+		change.Type = tree.AddedDiff
+	case toValue == nil:
+		change.Type = tree.RemovedDiff
+	case bytes.Equal(from.key, to.key) && bytes.Equal(fromValue, toValue):
+		return RowChange{}, false, nil
+	default:
+		change.Type = tree.ModifiedDiff
+	}
+	if fromValue != nil {
+		change.FromKey, change.FromValue = from.key, fromValue
+		change.NodeStore = from.rows.NodeStore()
+	}
+	if toValue != nil {
+		change.ToKey, change.ToValue = to.key, toValue
+		change.NodeStore = to.rows.NodeStore()
+	}
+	return change, true, nil
+}
@@ -70,7 +70,7 @@ func pushDataset(ctx context.Context, destDB, srcDB datas.Database, ds datas.Dat
 		return err
 	}
 
-	ds, err = destDB.GetDataset(ctx, rf.String())
+	ds, err = destDB.GetDataset(ctx, ref.DatasetID(rf))
 	if err != nil {
 		return err
 	}
@@ -519,8 +519,8 @@ func (ddb *DoltDB) ResolveByNomsRoot(ctx *sql.Context, cs *CommitSpec, cwb ref.D
 
 // ResolveCommitRef takes a DoltRef and returns a Commit, or an error if the commit cannot be found. The ref given must
 // point to a Commit.
-func (ddb *DoltDB) ResolveCommitRef(ctx context.Context, ref ref.DoltRef) (*Commit, error) {
-	commitVal, err := getCommitValForRefStr(ctx, ddb, ref.String())
+func (ddb *DoltDB) ResolveCommitRef(ctx context.Context, dref ref.DoltRef) (*Commit, error) {
+	commitVal, err := getCommitValForRefStr(ctx, ddb, ref.DatasetID(dref))
 	if err != nil {
 		return nil, err
 	}
@@ -534,8 +534,8 @@ func (ddb *DoltDB) ResolveCommitRef(ctx context.Context, ref ref.DoltRef) (*Comm
 
 // ResolveCommitRefAtRoot takes a DoltRef and returns a Commit, or an error if the commit cannot be found. The ref given must
 // point to a Commit.
-func (ddb *DoltDB) ResolveCommitRefAtRoot(ctx context.Context, ref ref.DoltRef, nomsRoot hash.Hash) (*Commit, error) {
-	commitVal, err := getCommitValForRefStrByNomsRoot(ctx, ddb, ref.String(), nomsRoot)
+func (ddb *DoltDB) ResolveCommitRefAtRoot(ctx context.Context, dref ref.DoltRef, nomsRoot hash.Hash) (*Commit, error) {
+	commitVal, err := getCommitValForRefStrByNomsRoot(ctx, ddb, ref.DatasetID(dref), nomsRoot)
 	if err != nil {
 		return nil, err
 	}
@@ -730,7 +730,7 @@ func (ddb *DoltDB) FastForward(ctx context.Context, branch ref.DoltRef, commit *
 
 // FastForwardToHash fast-forwards the branch given to the commit hash given.
 func (ddb *DoltDB) FastForwardToHash(ctx context.Context, branch ref.DoltRef, hash hash.Hash) error {
-	ds, err := ddb.db.GetDataset(ctx, branch.String())
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(branch))
 	if err != nil {
 		return err
 	}
@@ -787,8 +787,8 @@ func (ddb *DoltDB) SetHeadAndWorkingSetToCommit(ctx context.Context, rf ref.Dolt
 	return err
 }
 
-func (ddb *DoltDB) SetHead(ctx context.Context, ref ref.DoltRef, addr hash.Hash) error {
-	ds, err := ddb.db.GetDataset(ctx, ref.String())
+func (ddb *DoltDB) SetHead(ctx context.Context, dref ref.DoltRef, addr hash.Hash) error {
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(dref))
 
 	if err != nil {
 		return err
@@ -829,7 +829,7 @@ func (ddb *DoltDB) CommitWithParentCommits(ctx context.Context, valHash hash.Has
 		return nil, errors.New("can't commit a value that is not a valid root value")
 	}
 
-	ds, err := ddb.db.GetDataset(ctx, dref.String())
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(dref))
 
 	if err != nil {
 		return nil, err
@@ -859,7 +859,7 @@ func (ddb *DoltDB) CommitWithParentCommits(ctx context.Context, valHash hash.Has
 }
 
 func (ddb *DoltDB) CommitValue(ctx context.Context, dref ref.DoltRef, val types.Value, commitOpts datas.CommitOptions) (*Commit, error) {
-	ds, err := ddb.db.GetDataset(ctx, dref.String())
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(dref))
 	if err != nil {
 		return nil, err
 	}
@@ -965,7 +965,7 @@ func (ddb *DoltDB) ResolveAllParents(ctx context.Context, commit *Commit) ([]*Op
 
 // HasRef returns whether the branch given exists in this database.
 func (ddb *DoltDB) HasRef(ctx context.Context, doltRef ref.DoltRef) (bool, error) {
-	ds, err := ddb.db.GetDataset(ctx, doltRef.String())
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(doltRef))
 	if err != nil {
 		if errors.Is(err, datas.ErrInvalidDatasetID) {
 			return false, nil
@@ -1361,7 +1361,7 @@ func (ddb *DoltDB) DeleteBranch(ctx context.Context, branch ref.DoltRef, replica
 }
 
 func (ddb *DoltDB) deleteRef(ctx context.Context, dref ref.DoltRef, replicationStatus *ReplicationStatusController, wsPath string) error {
-	ds, err := ddb.db.GetDataset(ctx, dref.String())
+	ds, err := ddb.db.GetDataset(ctx, ref.DatasetID(dref))
 
 	if err != nil {
 		return err
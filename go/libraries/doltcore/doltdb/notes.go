@@ -0,0 +1,243 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+var ErrNoteNotFound = errors.New("no note found")
+
+// Notes annotate commits without changing them. A set of notes is stored as its own history of commits, referenced by
+// a ref.NotesRef, each of whose root values has a single table mapping the hashes of annotated commits to their
+// notes. Adding or removing a note makes a new commit on the notes ref, so notes can be pushed and fetched like
+// branches, and the annotated commits are never rewritten.
+
+// NotesSchema is the schema of the table holding the notes of a notes ref.
+var NotesSchema schema.Schema
+
+var notesTableName = TableName{Name: "notes"}
+
+func init() {
+	noteCol, err := schema.NewColumnWithTypeInfo("note", schema.NotesNoteTag, typeinfo.LongTextType, false, "", false, "")
+	if err != nil {
+		panic(err)
+	}
+	NotesSchema = schema.MustSchemaFromCols(schema.NewColCollection(
+		schema.NewColumn("commit_hash", schema.NotesCommitHashTag, types.StringKind, true, schema.NotNullConstraint{}),
+		noteCol,
+	))
+}
+
+// Note is a note attached to a commit.
+type Note struct {
+	// Commit is the hash of the annotated commit.
+	Commit hash.Hash
+	Note   string
+}
+
+// GetNotesRefs returns the notes refs in the database.
+func (ddb *DoltDB) GetNotesRefs(ctx context.Context) ([]ref.DoltRef, error) {
+	return ddb.GetRefsOfType(ctx, ref.NotesRefTypes)
+}
+
+// GetNotes returns the notes of the notes ref given, ordered by the hash of the annotated commit.
+func (ddb *DoltDB) GetNotes(ctx context.Context, notesRef ref.NotesRef) ([]Note, error) {
+	_, _, rows, err := ddb.loadNotes(ctx, notesRef)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var notes []Note
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			return notes, nil
+		} else if err != nil {
+			return nil, err
+		}
+		note, err := readNote(ctx, rows, k, v)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+}
+
+// GetNote returns the note attached to the commit |cm| in the notes ref given, or ErrNoteNotFound if there isn't one.
+func (ddb *DoltDB) GetNote(ctx context.Context, notesRef ref.NotesRef, cm hash.Hash) (string, error) {
+	_, _, rows, err := ddb.loadNotes(ctx, notesRef)
+	if err != nil {
+		return "", err
+	}
+
+	var note *Note
+	err = rows.Get(ctx, noteKey(rows, cm), func(k, v val.Tuple) error {
+		if k == nil {
+			return nil
+		}
+		n, err := readNote(ctx, rows, k, v)
+		note = &n
+		return err
+	})
+	if err != nil {
+		return "", err
+	} else if note == nil {
+		return "", ErrNoteNotFound
+	}
+	return note.Note, nil
+}
+
+// SetNote attaches |note| to the commit |cm| in the notes ref given, replacing any note it already had, and records
+// the change as a new commit on the notes ref with the metadata given.
+func (ddb *DoltDB) SetNote(ctx context.Context, notesRef ref.NotesRef, cm hash.Hash, note string, meta *datas.CommitMeta) error {
+	root, tbl, rows, err := ddb.loadNotes(ctx, notesRef)
+	if err != nil {
+		return err
+	}
+
+	_, vd := rows.Descriptors()
+	vb := val.NewTupleBuilder(vd)
+	if err = tree.PutField(ctx, rows.NodeStore(), vb, 0, note); err != nil {
+		return err
+	}
+	mut := rows.Mutate()
+	if err = mut.Put(ctx, noteKey(rows, cm), vb.Build(rows.Pool())); err != nil {
+		return err
+	}
+	if rows, err = mut.Map(ctx); err != nil {
+		return err
+	}
+	return ddb.writeNotes(ctx, notesRef, root, tbl, rows, meta)
+}
+
+// RemoveNote removes the note attached to the commit |cm| in the notes ref given, or returns ErrNoteNotFound if there
+// isn't one, and records the change as a new commit on the notes ref with the metadata given.
+func (ddb *DoltDB) RemoveNote(ctx context.Context, notesRef ref.NotesRef, cm hash.Hash, meta *datas.CommitMeta) error {
+	root, tbl, rows, err := ddb.loadNotes(ctx, notesRef)
+	if err != nil {
+		return err
+	}
+
+	key := noteKey(rows, cm)
+	if ok, err := rows.Has(ctx, key); err != nil {
+		return err
+	} else if !ok {
+		return ErrNoteNotFound
+	}
+	mut := rows.Mutate()
+	if err = mut.Delete(ctx, key); err != nil {
+		return err
+	}
+	if rows, err = mut.Map(ctx); err != nil {
+		return err
+	}
+	return ddb.writeNotes(ctx, notesRef, root, tbl, rows, meta)
+}
+
+// loadNotes returns the root value, the notes table and its rows at the head of the notes ref given. If the notes ref
+// doesn't exist yet they're empty.
+func (ddb *DoltDB) loadNotes(ctx context.Context, notesRef ref.NotesRef) (RootValue, *Table, prolly.Map, error) {
+	if !types.IsFormat_DOLT(ddb.Format()) {
+		return nil, nil, prolly.Map{}, errors.New("notes are not supported by this storage format")
+	}
+
+	var root RootValue
+	var tbl *Table
+	hasRef, err := ddb.HasRef(ctx, notesRef)
+	if err != nil {
+		return nil, nil, prolly.Map{}, err
+	}
+	if hasRef {
+		cm, err := ddb.ResolveCommitRef(ctx, notesRef)
+		if err != nil {
+			return nil, nil, prolly.Map{}, err
+		}
+		if root, err = cm.GetRootValue(ctx); err != nil {
+			return nil, nil, prolly.Map{}, err
+		}
+		var ok bool
+		if tbl, ok, err = root.GetTable(ctx, notesTableName); err != nil {
+			return nil, nil, prolly.Map{}, err
+		} else if !ok {
+			tbl = nil
+		}
+	} else if root, err = EmptyRootValue(ctx, ddb.vrw, ddb.ns); err != nil {
+		return nil, nil, prolly.Map{}, err
+	}
+	if tbl == nil {
+		if tbl, err = NewEmptyTable(ctx, ddb.vrw, ddb.ns, NotesSchema); err != nil {
+			return nil, nil, prolly.Map{}, err
+		}
+	}
+
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, nil, prolly.Map{}, err
+	}
+	return root, tbl, durable.ProllyMapFromIndex(idx), nil
+}
+
+// writeNotes commits |rows| as the notes of the notes ref given.
+func (ddb *DoltDB) writeNotes(ctx context.Context, notesRef ref.NotesRef, root RootValue, tbl *Table, rows prolly.Map, meta *datas.CommitMeta) error {
+	tbl, err := tbl.UpdateRows(ctx, durable.IndexFromProllyMap(rows))
+	if err != nil {
+		return err
+	}
+	if root, err = root.PutTable(ctx, notesTableName, tbl); err != nil {
+		return err
+	}
+	_, h, err := ddb.WriteRootValue(ctx, root)
+	if err != nil {
+		return err
+	}
+	_, err = ddb.Commit(ctx, h, notesRef, meta)
+	return err
+}
+
+func noteKey(rows prolly.Map, cm hash.Hash) val.Tuple {
+	kd, _ := rows.Descriptors()
+	kb := val.NewTupleBuilder(kd)
+	kb.PutString(0, cm.String())
+	return kb.Build(rows.Pool())
+}
+
+func readNote(ctx context.Context, rows prolly.Map, k, v val.Tuple) (Note, error) {
+	kd, vd := rows.Descriptors()
+	cm, _ := kd.GetString(0, k)
+	note, err := tree.GetField(ctx, vd, 0, v, rows.NodeStore())
+	if err != nil {
+		return Note{}, err
+	}
+	noteStr, _ := note.(string)
+	return Note{Commit: hash.Parse(cm), Note: noteStr}, nil
+}
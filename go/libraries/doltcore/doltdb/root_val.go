@@ -52,7 +52,7 @@ type FeatureVersion int64
 
 // DoltFeatureVersion is described in feature_version.md.
 // only variable for testing.
-var DoltFeatureVersion FeatureVersion = 7 // last bumped when fixing bug related to GeomAddrs not getting pushed
+var DoltFeatureVersion FeatureVersion = 8 // last bumped when adding columnar, partial and included-column indexes, see feature_version.md
 
 // RootValue is the value of the Database and is the committed value in every Dolt or Doltgres commit.
 type RootValue interface {
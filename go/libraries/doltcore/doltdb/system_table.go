@@ -380,6 +380,16 @@ var GetTagsTableName = func() string {
 	return TagsTableName
 }
 
+// GetNotesTableName returns the notes table name
+var GetNotesTableName = func() string {
+	return NotesTableName
+}
+
+// GetStashesTableName returns the stashes table name
+var GetStashesTableName = func() string {
+	return StashesTableName
+}
+
 const (
 	// LogTableName is the log system table name
 	LogTableName = "dolt_log"
@@ -423,6 +433,12 @@ const (
 	// TagsTableName is the tags table name
 	TagsTableName = "dolt_tags"
 
+	// NotesTableName is the notes table name
+	NotesTableName = "dolt_notes"
+
+	// StashesTableName is the stashes table name
+	StashesTableName = "dolt_stashes"
+
 	// IgnoreTableName is the ignore table name
 	IgnoreTableName = "dolt_ignore"
 
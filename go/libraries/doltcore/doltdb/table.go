@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/types"
 	"github.com/dolthub/dolt/go/store/val"
@@ -290,16 +291,17 @@ func (t *Table) getProllyConflictSchemas(ctx context.Context, tblName TableName)
 		return nil, nil, nil, err
 	}
 
-	baseTbl, baseOk, err := tableFromRootIsh(ctx, t.ValueReadWriter(), t.NodeStore(), art.Metadata.BaseRootIsh, tblName)
+	baseName, theirName := ConflictSourceNames(tblName, art.Metadata)
+	baseTbl, baseOk, err := tableFromRootIsh(ctx, t.ValueReadWriter(), t.NodeStore(), art.Metadata.BaseRootIsh, baseName)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	theirTbl, theirOK, err := tableFromRootIsh(ctx, t.ValueReadWriter(), t.NodeStore(), art.TheirRootIsh, tblName)
+	theirTbl, theirOK, err := tableFromRootIsh(ctx, t.ValueReadWriter(), t.NodeStore(), art.TheirRootIsh, theirName)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	if !theirOK {
-		return nil, nil, nil, fmt.Errorf("could not find tbl %s in right root value", tblName)
+		return nil, nil, nil, fmt.Errorf("could not find tbl %s in right root value", theirName)
 	}
 
 	theirSch, err := theirTbl.GetSchema(ctx)
@@ -322,9 +324,44 @@ func (t *Table) getProllyConflictSchemas(ctx context.Context, tblName TableName)
 		return nil, nil, nil, err
 	}
 
+	// A merge across a primary key change re-keys the rows of the other
+	// versions to our primary key, so conflicts are keyed by our schema.
+	if baseSch, err = withPrimaryKeyOf(t.Format(), baseSch, ourSch); err != nil {
+		return nil, nil, nil, err
+	}
+	if theirSch, err = withPrimaryKeyOf(t.Format(), theirSch, ourSch); err != nil {
+		return nil, nil, nil, err
+	}
+
 	return baseSch, ourSch, theirSch, nil
 }
 
+// ConflictSourceNames returns the names of the base and their versions of |tblName| for a conflict with metadata
+// |meta|. They differ from |tblName| if the table was renamed since the merge base.
+func ConflictSourceNames(tblName TableName, meta prolly.ConflictMetadata) (base, theirs TableName) {
+	base, theirs = tblName, tblName
+	if meta.BaseTable != "" {
+		base.Name = meta.BaseTable
+	}
+	if meta.TheirTable != "" {
+		theirs.Name = meta.TheirTable
+	}
+	return base, theirs
+}
+
+// withPrimaryKeyOf returns |sch| keyed by the primary key of |keySch|, or
+// |sch| unchanged if it already has that key or cannot be re-keyed.
+func withPrimaryKeyOf(format *types.NomsBinFormat, sch, keySch schema.Schema) (schema.Schema, error) {
+	if schema.ArePrimaryKeySetsDiffable(format, sch, keySch) {
+		return sch, nil
+	}
+	rekeyed, ok, err := schema.WithPrimaryKeyOf(sch, keySch)
+	if err != nil || !ok {
+		return sch, err
+	}
+	return rekeyed, nil
+}
+
 func tableFromRootIsh(ctx context.Context, vrw types.ValueReadWriter, ns tree.NodeStore, h hash.Hash, tblName TableName) (*Table, bool, error) {
 	rv, err := LoadRootValueFromRootIshAddr(ctx, vrw, ns, h)
 	if err != nil {
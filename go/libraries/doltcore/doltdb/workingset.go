@@ -75,6 +75,10 @@ type RebaseState struct {
 	// rebasingStarted is true once the rebase plan has been started to execute. Once rebasingStarted is true, the
 	// value in lastAttemptedStep has been initialized and is valid to read.
 	rebasingStarted bool
+
+	// strategyOption is the merge strategy option (e.g. "ours" or "theirs") used to automatically resolve conflicts
+	// when commits are cherry-picked during the rebase. It is empty when conflicts are left for the user to resolve.
+	strategyOption string
 }
 
 // Branch returns the name of the branch being actively rebased. This is the branch that will be updated to point
@@ -102,6 +106,12 @@ func (rs RebaseState) CommitBecomesEmptyHandling() EmptyCommitHandling {
 	return rs.commitBecomesEmptyHandling
 }
 
+// StrategyOption returns the merge strategy option used to automatically resolve conflicts during the rebase, or an
+// empty string if conflicts are not automatically resolved.
+func (rs RebaseState) StrategyOption() string {
+	return rs.strategyOption
+}
+
 func (rs RebaseState) LastAttemptedStep() float32 {
 	return rs.lastAttemptedStep
 }
@@ -128,9 +138,16 @@ type MergeState struct {
 	preMergeWorking  RootValue
 	unmergableTables []TableName
 	mergedTables     []TableName
+	// resolvedSchemaConflicts holds the column schema conflicts that have been resolved in tables that still have
+	// other unresolved schema conflicts.
+	resolvedSchemaConflicts []SchemaConflictResolution
 	// isCherryPick is set to true when the in-progress merge is a cherry-pick. This is needed so that
 	// commit knows to NOT create a commit with multiple parents when creating a commit for a cherry-pick.
 	isCherryPick bool
+	// strategyOption is the merge strategy option (e.g. "ours" or "theirs") the merge was started with, which is used
+	// again to merge the data of tables once their schema conflicts are resolved. Empty when conflicts are left for
+	// the user to resolve.
+	strategyOption string
 }
 
 // todo(andy): this might make more sense in pkg merge
@@ -146,6 +163,14 @@ func (sc SchemaConflict) GetConflictingTables() (ours, theirs *Table) {
 	return sc.toTbl, sc.fromTbl
 }
 
+// SchemaConflictResolution records that the schema conflict for column |Column| of table |Table| was resolved with
+// |Resolution|, one of "ours", "theirs" or "widen".
+type SchemaConflictResolution struct {
+	Table      TableName
+	Column     string
+	Resolution string
+}
+
 // TodoWorkingSetMeta returns an incomplete WorkingSetMeta, suitable for methods that don't have the means to construct
 // a real one. These should be considered temporary and cleaned up when possible, similar to Context.TODO
 func TodoWorkingSetMeta() *datas.WorkingSetMeta {
@@ -195,6 +220,18 @@ func (m MergeState) MergedTables() []TableName {
 	return m.mergedTables
 }
 
+// ResolvedSchemaConflicts returns the column schema conflicts resolved so far in tables that still have unresolved
+// schema conflicts.
+func (m MergeState) ResolvedSchemaConflicts() []SchemaConflictResolution {
+	return m.resolvedSchemaConflicts
+}
+
+// StrategyOption returns the merge strategy option the merge was started with, or an empty string if conflicts are
+// left for the user to resolve.
+func (m MergeState) StrategyOption() string {
+	return m.strategyOption
+}
+
 func (m MergeState) IterSchemaConflicts(ctx context.Context, ddb *DoltDB, cb SchemaConflictFn) (err error) {
 	var to, from RootValue
 
@@ -301,6 +338,18 @@ func (ws WorkingSet) WithUnmergableTables(tables []TableName) *WorkingSet {
 	return &ws
 }
 
+func (ws WorkingSet) WithResolvedSchemaConflicts(resolutions []SchemaConflictResolution) *WorkingSet {
+	ws.mergeState.resolvedSchemaConflicts = resolutions
+	return &ws
+}
+
+// WithMergeStrategyOption returns a copy of |ws| whose merge state records |option| as the merge strategy option the
+// merge was started with.
+func (ws WorkingSet) WithMergeStrategyOption(option string) *WorkingSet {
+	ws.mergeState.strategyOption = option
+	return &ws
+}
+
 func (ws WorkingSet) WithMergedTables(tables []TableName) *WorkingSet {
 	ws.mergeState.mergedTables = tables
 	return &ws
@@ -321,14 +370,16 @@ func (ws WorkingSet) StartMerge(commit *Commit, commitSpecStr string) *WorkingSe
 // commit that serves as the base commit for the new commits that will be created by the rebase process, |branch| is
 // the branch that is being rebased, and |previousRoot| is root value of the branch being rebased. The HEAD and STAGED
 // root values of the branch being rebased must match |previousRoot|; WORKING may be a different root value, but ONLY
-// if it contains only ignored tables.
-func (ws WorkingSet) StartRebase(ctx *sql.Context, ontoCommit *Commit, branch string, previousRoot RootValue, commitBecomesEmptyHandling EmptyCommitHandling, emptyCommitHandling EmptyCommitHandling) (*WorkingSet, error) {
+// if it contains only ignored tables. |strategyOption| is the merge strategy option used to automatically resolve
+// conflicts while rebasing, and may be empty.
+func (ws WorkingSet) StartRebase(ctx *sql.Context, ontoCommit *Commit, branch string, previousRoot RootValue, commitBecomesEmptyHandling EmptyCommitHandling, emptyCommitHandling EmptyCommitHandling, strategyOption string) (*WorkingSet, error) {
 	ws.rebaseState = &RebaseState{
 		ontoCommit:                 ontoCommit,
 		preRebaseWorking:           previousRoot,
 		branch:                     branch,
 		commitBecomesEmptyHandling: commitBecomesEmptyHandling,
 		emptyCommitHandling:        emptyCommitHandling,
+		strategyOption:             strategyOption,
 	}
 
 	ontoRoot, err := ontoCommit.GetRootValue(ctx)
@@ -504,12 +555,27 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 
 		unmergableTableNames := ToTableNames(unmergableTables, DefaultSchemaName)
 
+		resolved, err := dsws.MergeState.ResolvedSchemaConflicts(ctx, vrw)
+		if err != nil {
+			return nil, err
+		}
+		var resolvedSchemaConflicts []SchemaConflictResolution
+		for _, r := range resolved {
+			resolvedSchemaConflicts = append(resolvedSchemaConflicts, SchemaConflictResolution{
+				Table:      TableName{Name: r.TableName, Schema: DefaultSchemaName},
+				Column:     r.ColumnName,
+				Resolution: r.Resolution,
+			})
+		}
+
 		mergeState = &MergeState{
-			commit:           commit,
-			commitSpecStr:    commitSpec,
-			preMergeWorking:  preMergeWorkingRoot,
-			unmergableTables: unmergableTableNames,
-			isCherryPick:     isCherryPick,
+			commit:                  commit,
+			commitSpecStr:           commitSpec,
+			preMergeWorking:         preMergeWorkingRoot,
+			unmergableTables:        unmergableTableNames,
+			resolvedSchemaConflicts: resolvedSchemaConflicts,
+			isCherryPick:            isCherryPick,
+			strategyOption:          dsws.MergeState.StrategyOption(ctx, vrw),
 		}
 	}
 
@@ -548,6 +614,7 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 			emptyCommitHandling:        EmptyCommitHandling(dsws.RebaseState.EmptyCommitHandling(ctx)),
 			lastAttemptedStep:          dsws.RebaseState.LastAttemptedStep(ctx),
 			rebasingStarted:            dsws.RebaseState.RebasingStarted(ctx),
+			strategyOption:             dsws.RebaseState.StrategyOption(ctx),
 		}
 	}
 
@@ -621,7 +688,15 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 		}
 
 		// TODO: Serialize the full TableName
-		mergeState, err = datas.NewMergeState(ctx, db.vrw, preMergeWorking, dCommit, ws.mergeState.commitSpecStr, FlattenTableNames(ws.mergeState.unmergableTables), ws.mergeState.isCherryPick)
+		var resolved []datas.SchemaConflictResolution
+		for _, r := range ws.mergeState.resolvedSchemaConflicts {
+			resolved = append(resolved, datas.SchemaConflictResolution{
+				TableName:  r.Table.Name,
+				ColumnName: r.Column,
+				Resolution: r.Resolution,
+			})
+		}
+		mergeState, err = datas.NewMergeState(ctx, db.vrw, preMergeWorking, dCommit, ws.mergeState.commitSpecStr, FlattenTableNames(ws.mergeState.unmergableTables), resolved, ws.mergeState.isCherryPick, ws.mergeState.strategyOption)
 		if err != nil {
 			return nil, err
 		}
@@ -646,7 +721,7 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 
 		rebaseState = datas.NewRebaseState(preRebaseWorking.TargetHash(), dCommit.Addr(), ws.rebaseState.branch,
 			uint8(ws.rebaseState.commitBecomesEmptyHandling), uint8(ws.rebaseState.emptyCommitHandling),
-			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted)
+			ws.rebaseState.lastAttemptedStep, ws.rebaseState.rebasingStarted, ws.rebaseState.strategyOption)
 	}
 
 	return &datas.WorkingSetSpec{
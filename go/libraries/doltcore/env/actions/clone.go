@@ -37,6 +37,7 @@ import (
 	"github.com/dolthub/dolt/go/store/chunks"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/datas/pull"
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -50,6 +51,7 @@ var ErrFailedToGetBranch = errors.New("could not get branch")
 var ErrFailedToGetRootValue = errors.New("could not find root value")
 var ErrFailedToCreateRemoteRef = errors.New("could not create remote ref")
 var ErrFailedToCreateTagRef = errors.New("could not create tag ref")
+var ErrFailedToCreateNotesRef = errors.New("could not create notes ref")
 var ErrFailedToCreateLocalBranch = errors.New("could not create local branch")
 var ErrFailedToDeleteBranch = errors.New("could not delete local branch after clone")
 var ErrUserNotFound = errors.New("could not determine user name. run dolt config --global --add user.name")
@@ -315,6 +317,17 @@ func fullClone(ctx context.Context, srcDB *doltdb.DoltDB, dEnv *env.DoltEnv, src
 		}
 	}
 
+	// Notes refs are preserved too, so that notes attached to the cloned commits are cloned with them.
+	err = srcDB.VisitRefsOfType(ctx, ref.NotesRefTypes, func(r ref.DoltRef, addr hash.Hash) error {
+		if err := dEnv.DoltDB.SetHead(ctx, r, addr); err != nil {
+			return fmt.Errorf("%w: %s; %s", ErrFailedToCreateNotesRef, r.String(), err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return cm, nil
 }
 
@@ -15,9 +15,14 @@
 package actions
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	"github.com/dolthub/dolt/go/libraries/doltcore/creds"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/config"
 )
 
 func NewCredsFile(dEnv *env.DoltEnv) (string, creds.DoltCreds, errhand.VerboseError) {
@@ -66,3 +71,34 @@ func GenCredsWithVErr() (creds.DoltCreds, errhand.VerboseError) {
 
 	return dCreds, nil
 }
+
+// LoadOIDCToken returns the cached token for the OIDC issuer configured by `dolt login --oidc`, refreshing it first if
+// it has expired. Returns creds.ErrOIDCTokenNotFound if no issuer has been logged in to.
+func LoadOIDCToken(ctx context.Context, dEnv *env.DoltEnv) (*creds.OIDCToken, error) {
+	issuer := dEnv.Config.GetStringOrDefault(config.OIDCIssuerKey, "")
+	if issuer == "" {
+		return nil, creds.ErrOIDCTokenNotFound
+	}
+
+	credsDir, err := dEnv.CredsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := creds.OIDCTokenReadFromDir(dEnv.FS, credsDir, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !t.Expired(time.Now()) {
+		return t, nil
+	}
+
+	t, err = creds.RefreshOIDCToken(ctx, http.DefaultClient, t)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = creds.OIDCTokenWriteToDir(dEnv.FS, credsDir, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
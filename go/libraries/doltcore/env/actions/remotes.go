@@ -142,7 +142,7 @@ func DoPush(ctx context.Context, pushMeta *env.PushOptions, progStarter ProgStar
 	return
 }
 
-// push performs push on a branch or a tag.
+// push performs push on a branch, a tag or a notes ref.
 func push(ctx context.Context, rsr env.RepoStateReader, tmpDir string, src, dest *doltdb.DoltDB, remote *env.Remote, opts *env.PushTarget, progStarter ProgStarter, progStopper ProgStopper) error {
 	switch opts.SrcRef.GetType() {
 	case ref.BranchRefType:
@@ -153,6 +153,8 @@ func push(ctx context.Context, rsr env.RepoStateReader, tmpDir string, src, dest
 		}
 	case ref.TagRefType:
 		return pushTagToRemote(ctx, tmpDir, opts.SrcRef, opts.DestRef, src, dest, progStarter, progStopper)
+	case ref.NotesRefType:
+		return pushNotesToRemote(ctx, tmpDir, opts.Mode, opts.SrcRef, opts.DestRef, src, dest, progStarter, progStopper)
 	default:
 		return fmt.Errorf("%w: %s of type %s", ErrCannotPushRef, opts.SrcRef.String(), opts.SrcRef.GetType())
 	}
@@ -202,6 +204,45 @@ func PushTag(ctx context.Context, tempTableDir string, destRef ref.TagRef, srcDB
 	return destDB.SetHead(ctx, destRef, addr)
 }
 
+// PushNotes pushes the history of a notes ref ending in |cm| from a local source database to a remote destination
+// database. Unless |mode| forces the update, the notes ref in the destination database must be fast-forwardable to
+// |cm|.
+func PushNotes(ctx context.Context, tempTableDir string, mode ref.UpdateMode, destRef ref.NotesRef, srcDB, destDB *doltdb.DoltDB, cm *doltdb.Commit, statsCh chan pull.Stats) error {
+	if !mode.Force {
+		canFF, err := canFastForwardNotes(ctx, destDB, destRef, cm)
+		if err != nil {
+			return err
+		} else if !canFF {
+			return ErrCantFF
+		}
+	}
+
+	h, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+
+	err = destDB.PullChunks(ctx, tempTableDir, srcDB, []hash.Hash{h}, statsCh, nil)
+	if err != nil {
+		return err
+	}
+
+	if mode.Force {
+		return destDB.SetHeadToCommit(ctx, destRef, cm)
+	}
+	return destDB.FastForward(ctx, destRef, cm)
+}
+
+// canFastForwardNotes returns whether the notes ref given can be fast-forwarded to |cm|. Notes refs that were created
+// independently of each other share no history, so they can't be fast-forwarded to each other.
+func canFastForwardNotes(ctx context.Context, ddb *doltdb.DoltDB, notesRef ref.NotesRef, cm *doltdb.Commit) (bool, error) {
+	canFF, err := ddb.CanFastForward(ctx, notesRef, cm)
+	if errors.Is(err, doltdb.ErrNoCommonAncestor) {
+		return false, nil
+	}
+	return canFF, err
+}
+
 func deleteRemoteBranch(ctx context.Context, toDelete, remoteRef ref.DoltRef, localDB, remoteDB *doltdb.DoltDB, remote env.Remote, force bool) error {
 	err := DeleteRemoteBranch(ctx, toDelete.(ref.BranchRef), remoteRef.(ref.RemoteRef), localDB, remoteDB, force)
 
@@ -274,6 +315,28 @@ func pushTagToRemote(ctx context.Context, tempTableDir string, srcRef, destRef r
 	return nil
 }
 
+func pushNotesToRemote(ctx context.Context, tempTableDir string, mode ref.UpdateMode, srcRef, destRef ref.DoltRef, localDB, remoteDB *doltdb.DoltDB, progStarter ProgStarter, progStopper ProgStopper) error {
+	cm, err := localDB.ResolveCommitRef(ctx, srcRef)
+	if err != nil {
+		return fmt.Errorf("%w; refspec not found: '%s'; %s", ref.ErrInvalidRefSpec, srcRef.String(), err.Error())
+	}
+
+	newCtx, cancelFunc := context.WithCancel(ctx)
+	wg, statsCh := progStarter(newCtx)
+	err = PushNotes(ctx, tempTableDir, mode, destRef.(ref.NotesRef), localDB, remoteDB, cm, statsCh)
+	progStopper(cancelFunc, wg, statsCh)
+
+	switch err {
+	case nil:
+		cli.Println()
+		return nil
+	case doltdb.ErrUpToDate, doltdb.ErrIsAhead, ErrCantFF, datas.ErrMergeNeeded:
+		return err
+	default:
+		return fmt.Errorf("%w; %s", ErrUnknownPushErr, err.Error())
+	}
+}
+
 // DeleteRemoteBranch validates targetRef is a branch on the remote database, and then deletes it, then deletes the
 // remote tracking branch from the local database.
 func DeleteRemoteBranch(ctx context.Context, targetRef ref.BranchRef, remoteRef ref.RemoteRef, localDB, remoteDB *doltdb.DoltDB, force bool) error {
@@ -402,6 +465,52 @@ func FetchFollowTags(ctx context.Context, tempTableDir string, srcDB, destDB *do
 	return nil
 }
 
+// FetchFollowNotes fetches all notes refs from the source DB. A notes ref that doesn't exist in the destination DB is
+// created, and one that does is fast-forwarded to the source DB's notes. Notes refs that have diverged from the source
+// DB's notes are left unchanged, with a warning.
+func FetchFollowNotes(ctx context.Context, tempTableDir string, srcDB, destDB *doltdb.DoltDB, progStarter ProgStarter, progStopper ProgStopper) error {
+	notesRefs, err := srcDB.GetNotesRefs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, notesRef := range notesRefs {
+		cm, err := srcDB.ResolveCommitRef(ctx, notesRef)
+		if err != nil {
+			return err
+		}
+
+		canFF, err := canFastForwardNotes(ctx, destDB, notesRef.(ref.NotesRef), cm)
+		if errors.Is(err, doltdb.ErrUpToDate) || errors.Is(err, doltdb.ErrIsAhead) {
+			continue
+		} else if err != nil {
+			return err
+		} else if !canFF {
+			cli.PrintErrf("warning: not updating %s; it has diverged from the remote's notes\n", notesRef.String())
+			continue
+		}
+
+		newCtx, cancelFunc := context.WithCancel(ctx)
+		wg, statsCh := progStarter(newCtx)
+		err = FetchCommit(ctx, tempTableDir, srcDB, destDB, cm, statsCh)
+		progStopper(cancelFunc, wg, statsCh)
+		if err == nil {
+			cli.Println()
+		} else if err == pull.ErrDBUpToDate {
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = destDB.SetHeadToCommit(ctx, notesRef, cm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // FetchRemoteBranch fetches and returns the |Commit| corresponding to the remote ref given. Returns an error if the
 // remote reference doesn't exist or can't be fetched. Blocks until the fetch is complete.
 func FetchRemoteBranch(
@@ -686,6 +795,10 @@ func fetchRefSpecsWithDepth(
 		if err != nil {
 			return err
 		}
+		err = FetchFollowNotes(ctx, tmpDir, srcDB, dbData.Ddb, progStarter, progStopper)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,149 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/datas"
+)
+
+var ErrStashNotSupportedForOldFormat = errors.New("stash is not supported for old storage format")
+
+// StashChanges saves the local changes in |roots| as a new stash entry on top of |headCommit|, the head of the branch
+// |headRef|, and returns |roots| with those changes removed from the staged and working roots. Untracked tables are
+// only stashed if |includeUntracked| is set, and ignored tables only if |all| is set. If there are no local changes
+// to save, no stash entry is added and false is returned.
+func StashChanges(ctx context.Context, ddb *doltdb.DoltDB, roots doltdb.Roots, headRef ref.DoltRef, headCommit *doltdb.Commit, includeUntracked, all bool) (doltdb.Roots, bool, error) {
+	hasChanges, err := hasLocalChanges(ctx, roots, includeUntracked, all)
+	if err != nil || !hasChanges {
+		return roots, false, err
+	}
+
+	roots, err = StageModifiedAndDeletedTables(ctx, roots)
+	if err != nil {
+		return doltdb.Roots{}, false, err
+	}
+
+	// all tables with changes that are going to be stashed are staged at this point
+	allTblsToBeStashed, addedTblsToStage, err := stashedTableSets(ctx, roots)
+	if err != nil {
+		return doltdb.Roots{}, false, err
+	}
+
+	// untracked tables are staged to include them in the stash, but aren't added to the table set to stage when the
+	// stash is popped
+	if includeUntracked || all {
+		allTblsToBeStashed, err = doltdb.UnionTableNames(ctx, roots.Staged, roots.Working)
+		if err != nil {
+			return doltdb.Roots{}, false, err
+		}
+		roots, err = StageTables(ctx, roots, allTblsToBeStashed, !all)
+		if err != nil {
+			return doltdb.Roots{}, false, err
+		}
+	}
+
+	commitMeta, err := headCommit.GetCommitMeta(ctx)
+	if err != nil {
+		return doltdb.Roots{}, false, err
+	}
+	meta := datas.NewStashMeta(headRef.String(), commitMeta.Description, doltdb.FlattenTableNames(addedTblsToStage))
+	if err = ddb.AddStash(ctx, headCommit, roots.Staged, meta); err != nil {
+		return doltdb.Roots{}, false, err
+	}
+
+	// setting the staged root to HEAD leaves the stashed changes in the working set only, where they're checked out
+	roots.Staged = roots.Head
+	roots, err = MoveTablesFromHeadToWorking(ctx, roots, allTblsToBeStashed)
+	if err != nil {
+		return doltdb.Roots{}, false, err
+	}
+	return roots, true, nil
+}
+
+// hasLocalChanges returns whether |roots| has any changes that StashChanges would stash.
+func hasLocalChanges(ctx context.Context, roots doltdb.Roots, includeUntracked, all bool) (bool, error) {
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return false, err
+	}
+	stagedHash, err := roots.Staged.HashOf()
+	if err != nil {
+		return false, err
+	}
+	workingHash, err := roots.Working.HashOf()
+	if err != nil {
+		return false, err
+	}
+
+	if !headHash.Equal(stagedHash) {
+		return true, nil
+	}
+	if headHash.Equal(workingHash) {
+		return false, nil
+	}
+	if all {
+		return true, nil
+	}
+
+	allIgnored, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil || allIgnored {
+		return false, err
+	}
+	if includeUntracked {
+		return true, nil
+	}
+
+	// without |includeUntracked|, only changes to tracked tables are stashed
+	_, unstaged, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return false, err
+	}
+	for _, tableDelta := range unstaged {
+		if !tableDelta.IsAdd() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// stashedTableSets returns the names of all tables with staged changes, which are the tables being stashed, and the
+// names of the staged tables that were added.
+func stashedTableSets(ctx context.Context, roots doltdb.Roots) ([]doltdb.TableName, []doltdb.TableName, error) {
+	var addedTblsInStaged []doltdb.TableName
+	var allTbls []doltdb.TableName
+	staged, _, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tableDelta := range staged {
+		tblName := tableDelta.ToName
+		if tableDelta.IsAdd() {
+			addedTblsInStaged = append(addedTblsInStaged, tableDelta.ToName)
+		}
+		if tableDelta.IsDrop() {
+			tblName = tableDelta.FromName
+		}
+		allTbls = append(allTbls, tblName)
+	}
+
+	return allTbls, addedTblsInStaged, nil
+}
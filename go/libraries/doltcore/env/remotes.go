@@ -43,6 +43,7 @@ var ErrBranchDoesNotMatchUpstream = errors.New("the upstream branch of your curr
 var ErrFailedToReadDb = errors.New("failed to read from the db")
 var ErrUnknownBranch = errors.New("unknown branch")
 var ErrCannotSetUpstreamForTag = errors.New("cannot set upstream for tag")
+var ErrCannotSetUpstreamForNotes = errors.New("cannot set upstream for notes")
 var ErrCannotPushRef = errors.New("cannot push ref")
 var ErrNoRefSpecForRemote = errors.New("no refspec for remote")
 var ErrInvalidFetchSpec = errors.New("invalid fetch spec")
@@ -330,6 +331,10 @@ func getPushTargetFromRefSpec(refSpec ref.RefSpec, currentBranch ref.DoltRef, re
 		if setUpstream {
 			err = ErrCannotSetUpstreamForTag
 		}
+	case ref.NotesRefType:
+		if setUpstream {
+			err = ErrCannotSetUpstreamForNotes
+		}
 	default:
 		err = fmt.Errorf("%w: '%s' of type '%s'", ErrCannotPushRef, src.String(), src.GetType())
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -25,6 +26,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/store/hash"
 )
 
@@ -43,9 +45,13 @@ type MergeSpec struct {
 	NoCommit        bool
 	NoEdit          bool
 	Force           bool
-	Email           string
-	Name            string
-	Date            time.Time
+	// OursStrategy records a merge commit that keeps the tree of HEAD, ignoring the changes of the merged commit.
+	OursStrategy bool
+	// Favor resolves data conflicts in favor of one side of the merge.
+	Favor Favor
+	Email string
+	Name  string
+	Date  time.Time
 }
 
 type MergeSpecOpt func(*MergeSpec)
@@ -80,6 +86,18 @@ func WithSquash(squash bool) MergeSpecOpt {
 	}
 }
 
+func WithOursStrategy(ours bool) MergeSpecOpt {
+	return func(ms *MergeSpec) {
+		ms.OursStrategy = ours
+	}
+}
+
+func WithFavor(favor Favor) MergeSpecOpt {
+	return func(ms *MergeSpec) {
+		ms.Favor = favor
+	}
+}
+
 // NewMergeSpec returns a MergeSpec with the arguments provided.
 func NewMergeSpec(
 	ctx context.Context,
@@ -210,3 +228,38 @@ func AbortMerge(ctx *sql.Context, workingSet *doltdb.WorkingSet, roots doltdb.Ro
 
 	return workingSet, nil
 }
+
+// ApplyStash merges the stash entry at |idx| into the working root of |roots|, and stages the tables that were added
+// in the stash. If applying the stash would conflict with the local changes in |roots|, an error is returned instead.
+func ApplyStash(ctx *sql.Context, ddb *doltdb.DoltDB, roots doltdb.Roots, idx int, opts editor.Options) (doltdb.Roots, error) {
+	stashRoot, parentCommit, meta, err := ddb.GetStashRootAndHeadCommitAtIdx(ctx, idx)
+	if err != nil {
+		return doltdb.Roots{}, err
+	}
+	parentRoot, err := parentCommit.GetRootValue(ctx)
+	if err != nil {
+		return doltdb.Roots{}, err
+	}
+
+	result, err := MergeRoots(ctx, roots.Working, stashRoot, parentRoot, stashRoot, parentCommit, opts, MergeOpts{IsCherryPick: false})
+	if err != nil {
+		return doltdb.Roots{}, err
+	}
+
+	var tablesWithConflict []doltdb.TableName
+	for tbl, stats := range result.Stats {
+		if stats.HasConflicts() {
+			tablesWithConflict = append(tablesWithConflict, tbl)
+		}
+	}
+	if len(tablesWithConflict) > 0 {
+		tblNames := strings.Join(doltdb.FlattenTableNames(tablesWithConflict), "', '")
+		return doltdb.Roots{}, fmt.Errorf("error: Your local changes to the following tables would be overwritten by applying stash %d:\n"+
+			"\t{'%s'}\n"+
+			"Please commit your changes or stash them before you merge.\nAborting", idx, tblNames)
+	}
+
+	// tables added in the stash are staged again. Since they're coming from a stash, ignored table names aren't filtered.
+	roots.Working = result.Root
+	return actions.StageTables(ctx, roots, doltdb.ToTableNames(meta.TablesToStage, doltdb.DefaultSchemaName), false)
+}
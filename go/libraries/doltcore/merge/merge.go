@@ -48,7 +48,9 @@ var ErrMultipleViolationsForRow = errors.New("multiple violations for row not su
 
 var ErrSameTblAddedTwice = goerrors.NewKind("table with same name '%s' added in 2 commits can't be merged")
 
-func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts editor.Options) (*Result, error) {
+// MergeCommits three-way merges |mergeCommit| into |commit|, using their common ancestor as the merge base. Data
+// conflicts are resolved in favor of one side of the merge when |favor| is set.
+func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts editor.Options, favor Favor) (*Result, error) {
 	optCmt, err := doltdb.GetCommitAncestor(ctx, commit, mergeCommit)
 	if err != nil {
 		return nil, err
@@ -77,6 +79,7 @@ func MergeCommits(ctx *sql.Context, commit, mergeCommit *doltdb.Commit, opts edi
 	mo := MergeOpts{
 		IsCherryPick:        false,
 		KeepSchemaConflicts: true,
+		Favor:               favor,
 	}
 	return MergeRoots(ctx, ourRoot, theirRoot, ancRoot, mergeCommit, ancCommit, opts, mo)
 }
@@ -167,6 +170,9 @@ func MergeRoots(
 	opts editor.Options,
 	mergeOpts MergeOpts,
 ) (*Result, error) {
+	span, ctx := ctx.Span("merge.MergeRoots")
+	defer span.End()
+
 	var (
 		conflictStash  *conflictStash
 		violationStash *violationStash
@@ -233,13 +239,24 @@ func MergeRoots(
 
 	tblToStats := make(map[doltdb.TableName]*MergeStats)
 
-	// Merge tables one at a time. This is done based on name. With table names from ourRoot being merged first,
-	// renaming a table will return delete/modify conflict error consistently.
-	// TODO: merge based on a more durable table identity that persists across renames
+	// Merge tables one at a time. This is done based on name, except that a table renamed on one side is merged with
+	// the table under its old name on the other. Renames that cannot be followed, such as a table renamed differently
+	// on each side, return a delete/modify conflict error for the old name consistently, since table names from
+	// ourRoot are merged first.
 	merger, err := NewMerger(ourRoot, theirRoot, ancRoot, theirs, ancestor, ourRoot.VRW(), ourRoot.NodeStore())
 	if err != nil {
 		return nil, err
 	}
+	if types.IsFormat_DOLT(nbf) && !mergeOpts.ReverifyAllConstraints {
+		merger.detectRenames, err = detectRenames(ctx)
+		if err != nil {
+			return nil, err
+		}
+		merger.renames, err = findTableRenames(ctx, ourRoot, theirRoot, ancRoot, merger.detectRenames)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	destSchemaNames, err := getDatabaseSchemaNames(ctx, ourRoot)
 	if err != nil {
@@ -250,6 +267,19 @@ func MergeRoots(
 	visitedTables := make(map[string]struct{})
 	var schConflicts []SchemaConflict
 	for _, tblName := range tblNames {
+		if merger.renames != nil && merger.renames.replaced[tblName] {
+			// merged under the table's new name
+			if ok, err := mergedRoot.HasTable(ctx, tblName); err != nil {
+				return nil, err
+			} else if ok {
+				mergedRoot, err = mergedRoot.RemoveTables(ctx, true, false, tblName)
+				if err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
 		mergedTable, stats, err := merger.MergeTable(ctx, tblName, opts, mergeOpts)
 
 		if errors.Is(ErrTableDeletedAndModified, err) && doltdb.IsFullTextTable(tblName.Name) {
@@ -0,0 +1,396 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// rekeyForPrimaryKeyChange prepares a merge in which one branch changed the primary key of the table. If the
+// ancestor and the other branch contain every column of the new primary key, their rows are re-keyed into the new
+// key layout, and the table is merged cell by cell like any other.
+//
+// Rows that share a new key value, or have a NULL in a new key column, are ambiguous under the new key. Ambiguous
+// ancestor rows cannot be matched to either branch, so they are dropped from the ancestor and any changes to them
+// surface as conflicts. Ambiguous rows on the other branch are left out of the merge: that branch keeps the ancestor's
+// row for the key, if there was one, and each ambiguous key is recorded as a constraint violation holding the first of
+// its rows.
+//
+// If the tables cannot be re-keyed they are left as they are, and the schema merge reports the differing keys.
+func (tm *TableMerger) rekeyForPrimaryKeyChange(ctx *sql.Context) error {
+	if tm.leftTbl == nil || tm.rightTbl == nil || tm.ancTbl == nil {
+		return nil
+	}
+
+	format := tm.vrw.Format()
+	leftChanged := !schema.ArePrimaryKeySetsDiffable(format, tm.ancSch, tm.leftSch)
+	rightChanged := !schema.ArePrimaryKeySetsDiffable(format, tm.ancSch, tm.rightSch)
+	if !leftChanged && !rightChanged {
+		return nil
+	}
+	if leftChanged && rightChanged && !schema.ArePrimaryKeySetsDiffable(format, tm.leftSch, tm.rightSch) {
+		// both branches changed the key, and not in the same way
+		return nil
+	}
+
+	keySch := tm.leftSch
+	if !leftChanged {
+		keySch = tm.rightSch
+	}
+
+	type rekey struct {
+		tbl      **doltdb.Table
+		sch      *schema.Schema
+		to       schema.Schema
+		ancestor bool
+	}
+	rekeys := []*rekey{{tbl: &tm.ancTbl, sch: &tm.ancSch, ancestor: true}}
+	if !leftChanged {
+		rekeys = append(rekeys, &rekey{tbl: &tm.leftTbl, sch: &tm.leftSch})
+	}
+	if !rightChanged {
+		rekeys = append(rekeys, &rekey{tbl: &tm.rightTbl, sch: &tm.rightSch})
+	}
+
+	for _, r := range rekeys {
+		if (*r.sch).Indexes().ContainsFullTextIndex() {
+			return nil
+		}
+		// artifacts are keyed by the old primary key
+		arts, err := (*r.tbl).GetArtifacts(ctx)
+		if err != nil {
+			return err
+		}
+		if cnt, err := arts.Count(); err != nil || cnt > 0 {
+			return err
+		}
+		var ok bool
+		r.to, ok, err = schema.WithPrimaryKeyOf(*r.sch, keySch)
+		if err != nil || !ok {
+			return err
+		}
+	}
+
+	// the ancestor is re-keyed first, so that the branches can fall back to its rows
+	var ancRows prolly.Map
+	for _, r := range rekeys {
+		idx, err := (*r.tbl).GetRowData(ctx)
+		if err != nil {
+			return err
+		}
+		rows, ambiguous, err := rekeyRows(ctx, durable.ProllyMapFromIndex(idx), *r.sch, r.to)
+		if err != nil {
+			return err
+		}
+		if r.ancestor {
+			ancRows = rows
+		} else if len(ambiguous) > 0 {
+			if rows, err = tm.recordAmbiguousRows(ctx, rows, ancRows, r.to, ambiguous); err != nil {
+				return err
+			}
+		}
+		tbl, err := tm.rekeyTable(ctx, *r.tbl, r.to, rows)
+		if err != nil {
+			return err
+		}
+		*r.tbl, *r.sch = tbl, r.to
+	}
+	return nil
+}
+
+// recordAmbiguousRows queues a constraint violation for each of the |ambiguous| rows of a branch, and restores the
+// ancestor's row for each of their keys into the branch's re-keyed |rows|.
+func (tm *TableMerger) recordAmbiguousRows(ctx context.Context, rows, ancRows prolly.Map, sch schema.Schema, ambiguous []ambiguousRow) (prolly.Map, error) {
+	pkNames := sch.GetPKCols().GetColumnNames()
+	mut := rows.Mutate()
+	for _, row := range ambiguous {
+		var meta prolly.ConstraintViolationMeta
+		var artType prolly.ArtifactType
+		var err error
+		if len(row.nullCols) > 0 {
+			nullNames := make([]string, len(row.nullCols))
+			for i, pos := range row.nullCols {
+				nullNames[i] = pkNames[pos]
+			}
+			meta, err = newNotNullViolationMeta(nullNames, row.value)
+			artType = prolly.ArtifactTypeNullViol
+		} else {
+			var info []byte
+			info, err = json.Marshal(UniqCVMeta{Columns: pkNames, Name: "PRIMARY"})
+			meta = prolly.ConstraintViolationMeta{VInfo: info, Value: row.value}
+			artType = prolly.ArtifactTypeUniqueKeyViol
+		}
+		if err != nil {
+			return prolly.Map{}, err
+		}
+		tm.rekeyViolations = append(tm.rekeyViolations, rekeyViolation{key: row.key, artType: artType, meta: meta})
+
+		if len(row.nullCols) > 0 {
+			continue
+		}
+		err = ancRows.Get(ctx, row.key, func(k, v val.Tuple) error {
+			if k == nil {
+				return nil
+			}
+			return mut.Put(ctx, k, v)
+		})
+		if err != nil {
+			return prolly.Map{}, err
+		}
+	}
+	return mut.Map(ctx)
+}
+
+// rekeyTable replaces the schema of |tbl| with |to|, which has a different primary key, and its rows with |rows|,
+// which are keyed by it. Secondary indexes are rebuilt from the new rows.
+func (tm *TableMerger) rekeyTable(ctx *sql.Context, tbl *doltdb.Table, to schema.Schema, rows prolly.Map) (*doltdb.Table, error) {
+	tbl, err := tbl.UpdateSchema(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err = tbl.UpdateRows(ctx, durable.IndexFromProllyMap(rows))
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := durable.NewIndexSet(ctx, tm.vrw, tm.ns)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range to.Indexes().AllIndexes() {
+		built, err := creation.BuildSecondaryProllyIndex(ctx, tm.vrw, tm.ns, to, tm.name.Name, index, rows)
+		if err != nil {
+			return nil, err
+		}
+		indexes, err = indexes.PutIndex(ctx, index.Name(), built)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tbl.SetIndexSet(ctx, indexes)
+}
+
+// rekeyViolation is a constraint violation found while re-keying a branch, which is recorded once the merge has an
+// artifact editor for the table.
+type rekeyViolation struct {
+	key     val.Tuple
+	artType prolly.ArtifactType
+	meta    prolly.ConstraintViolationMeta
+}
+
+// recordRekeyViolations records the violations found while re-keying the table in |edits|, and returns their number.
+func (tm *TableMerger) recordRekeyViolations(ctx context.Context, edits *prolly.ArtifactsEditor) (int, error) {
+	if !tm.recordViolations || len(tm.rekeyViolations) == 0 {
+		return 0, nil
+	}
+	srcHash, err := tm.rightSrc.HashOf()
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range tm.rekeyViolations {
+		if err = edits.ReplaceConstraintViolation(ctx, v.key, srcHash, v.artType, v.meta); err != nil {
+			return 0, err
+		}
+	}
+	return len(tm.rekeyViolations), nil
+}
+
+// RowDataWithPrimaryKeyOf returns the rows of |tbl| keyed by the primary key of |keySch|. This is the layout a merge
+// across a primary key change uses for the versions of the table it re-keys. Rows that are ambiguous under the new
+// key are omitted. If the table already has that key, or cannot be re-keyed, its rows are returned unchanged.
+func RowDataWithPrimaryKeyOf(ctx context.Context, tbl *doltdb.Table, keySch schema.Schema) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	rows := durable.ProllyMapFromIndex(idx)
+
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	if schema.ArePrimaryKeySetsDiffable(tbl.Format(), sch, keySch) {
+		return rows, nil
+	}
+	rekeyed, ok, err := schema.WithPrimaryKeyOf(sch, keySch)
+	if err != nil || !ok {
+		return rows, err
+	}
+	rows, _, err = rekeyRows(ctx, rows, sch, rekeyed)
+	return rows, err
+}
+
+// fieldLoc locates a column within a row.
+type fieldLoc struct {
+	key bool
+	pos int
+}
+
+// ambiguousRow is a row that cannot be re-keyed, because its new key is shared with other rows or has NULL fields.
+type ambiguousRow struct {
+	key, value val.Tuple
+	// nullCols are the positions of the NULL fields in |key|
+	nullCols []int
+}
+
+// rekeyRows converts |rows| from schema |from| to schema |to|, which has the same columns with a different primary
+// key. Rows that are ambiguous under the new key are dropped, and the first row for each ambiguous key is returned.
+func rekeyRows(ctx context.Context, rows prolly.Map, from, to schema.Schema) (prolly.Map, []ambiguousRow, error) {
+	ns := rows.NodeStore()
+	fromKD, fromVD := from.GetMapDescriptors()
+	toKD, toVD := to.GetMapDescriptors()
+	keyless := schema.IsKeyless(from)
+
+	locs := make(map[uint64]fieldLoc)
+	for i, tag := range from.GetPKCols().Tags {
+		locs[tag] = fieldLoc{key: true, pos: i}
+	}
+	pos := 0
+	if keyless {
+		pos = 1 // cardinality
+	}
+	for _, col := range from.GetNonPKCols().GetColumns() {
+		if !col.Virtual {
+			locs[col.Tag] = fieldLoc{pos: pos}
+			pos++
+		}
+	}
+	keyLocs := make([]fieldLoc, 0, toKD.Count())
+	for _, tag := range to.GetPKCols().Tags {
+		keyLocs = append(keyLocs, locs[tag])
+	}
+	valLocs := make([]fieldLoc, 0, toVD.Count())
+	for _, col := range to.GetNonPKCols().GetColumns() {
+		if !col.Virtual {
+			valLocs = append(valLocs, locs[col.Tag])
+		}
+	}
+
+	empty, err := prolly.NewMapFromTuples(ctx, ns, toKD, toVD)
+	if err != nil {
+		return prolly.Map{}, nil, err
+	}
+	mut := empty.Mutate()
+	kb, vb := val.NewTupleBuilder(toKD), val.NewTupleBuilder(toVD)
+
+	// copyFields copies |fields| into |tb|, and returns the positions of NULLs in fields that are not nullable
+	copyFields := func(tb *val.TupleBuilder, fields []fieldLoc, k, v val.Tuple) ([]int, error) {
+		var nulls []int
+		for i, loc := range fields {
+			desc, tup := fromVD, v
+			if loc.key {
+				desc, tup = fromKD, k
+			}
+			if desc.IsNull(loc.pos, tup) {
+				if !tb.Desc.Types[i].Nullable {
+					nulls = append(nulls, i)
+				}
+				continue
+			}
+			if desc.Types[loc.pos].Enc == tb.Desc.Types[i].Enc {
+				tb.PutRaw(i, desc.GetField(loc.pos, tup))
+				continue
+			}
+			f, err := tree.GetField(ctx, desc, loc.pos, tup, ns)
+			if err != nil {
+				return nil, err
+			}
+			if err = tree.PutField(ctx, ns, tb, i, f); err != nil {
+				return nil, err
+			}
+		}
+		return nulls, nil
+	}
+
+	var ambiguous []ambiguousRow
+	seen := make(map[string]bool)
+	addAmbiguous := func(key, value val.Tuple, nulls []int) {
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			ambiguous = append(ambiguous, ambiguousRow{key: key, value: value, nullCols: nulls})
+		}
+	}
+
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return prolly.Map{}, nil, err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return prolly.Map{}, nil, err
+		}
+
+		nulls, err := copyFields(kb, keyLocs, k, v)
+		if err != nil {
+			return prolly.Map{}, nil, err
+		}
+		newKey := kb.BuildPermissive(rows.Pool())
+		if _, err = copyFields(vb, valLocs, k, v); err != nil {
+			return prolly.Map{}, nil, err
+		}
+		newVal := vb.BuildPermissive(rows.Pool())
+
+		if len(nulls) > 0 {
+			addAmbiguous(newKey, newVal, nulls)
+			continue
+		}
+		if keyless && val.ReadKeylessCardinality(v) > 1 {
+			addAmbiguous(newKey, newVal, nil)
+			continue
+		}
+		if seen[string(newKey)] {
+			continue
+		}
+		var prev val.Tuple
+		err = mut.Get(ctx, newKey, func(_, v val.Tuple) error {
+			prev = v
+			return nil
+		})
+		if err != nil {
+			return prolly.Map{}, nil, err
+		}
+		if prev != nil {
+			addAmbiguous(newKey, prev, nil)
+			if err = mut.Delete(ctx, newKey); err != nil {
+				return prolly.Map{}, nil, err
+			}
+			continue
+		}
+		if err = mut.Put(ctx, newKey, newVal); err != nil {
+			return prolly.Map{}, nil, err
+		}
+	}
+
+	m, err := mut.Map(ctx)
+	if err != nil {
+		return prolly.Map{}, nil, err
+	}
+	return m, ambiguous, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
 	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
@@ -87,6 +88,10 @@ func mergeProllySecondaryIndexes(
 			if forceIndexRebuild || rebuildRequired {
 				return buildIndex(ctx, tm.vrw, tm.ns, finalSch, index, mergedM, artifacts, tm.rightSrc, tm.name.Name)
 			}
+			if index.IsColumnar() {
+				// columnar indexes aren't edited during the row merge, so sync the left side's index to the merged rows
+				return syncColumnarIndex(ctx, tm, finalSch, index, left, mergedM)
+			}
 			return durable.IndexFromProllyMap(left), nil
 		}()
 		if err != nil {
@@ -102,6 +107,18 @@ func mergeProllySecondaryIndexes(
 	return mergedIndexSet, nil
 }
 
+func syncColumnarIndex(ctx *sql.Context, tm *TableMerger, finalSch schema.Schema, idx schema.Index, left, merged prolly.Map) (durable.Index, error) {
+	leftRows, err := tm.leftTbl.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := index.SyncColumnarIndex(ctx, tm.ns, finalSch, idx, left, durable.ProllyMapFromIndex(leftRows), merged)
+	if err != nil {
+		return nil, err
+	}
+	return durable.IndexFromProllyMap(m), nil
+}
+
 func buildIndex(
 	ctx *sql.Context,
 	vrw types.ValueReadWriter,
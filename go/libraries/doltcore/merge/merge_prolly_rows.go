@@ -75,6 +75,7 @@ func mergeProllyTable(
 	}
 	leftRows := durable.ProllyMapFromIndex(lr)
 	valueMerger := newValueMerger(mergedSch, tm.leftSch, tm.rightSch, tm.ancSch, leftRows.Pool(), tm.ns)
+	valueMerger.favor = tm.favor
 
 	if !valueMerger.leftMapping.IsIdentityMapping() {
 		mergeInfo.LeftNeedsRewrite = true
@@ -175,6 +176,10 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 	s := &MergeStats{
 		Operation: TableModified,
 	}
+	s.ConstraintViolations, err = tm.recordRekeyViolations(ctx, artEditor)
+	if err != nil {
+		return nil, nil, err
+	}
 	for {
 		diff, err := iter.Next(ctx)
 		if errors.Is(err, io.EOF) {
@@ -182,6 +187,9 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 		} else if err != nil {
 			return nil, nil, err
 		}
+		if tm.favor != FavorNone {
+			diff = favorConflictingDiff(diff, tm.favor)
+		}
 		cnt, err := uniq.validateDiff(ctx, diff)
 		if err != nil {
 			return nil, nil, err
@@ -324,6 +332,33 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 	return finalTbl, s, nil
 }
 
+// favorConflictingDiff rewrites the conflicting three-way diff |diff| as the change of the side of the merge chosen
+// by |favor|, so that it is applied like any other change from that side. Non-conflicting diffs are returned as is.
+func favorConflictingDiff(diff tree.ThreeWayDiff, favor Favor) tree.ThreeWayDiff {
+	switch diff.Op {
+	case tree.DiffOpDivergentModifyConflict:
+		if favor == FavorOurs {
+			diff.Op = tree.DiffOpLeftModify
+		} else {
+			// the left side's row is what's in the secondary indexes, so it's what gets replaced
+			diff.Op, diff.Base = tree.DiffOpRightModify, diff.Left
+		}
+	case tree.DiffOpDivergentDeleteConflict:
+		switch {
+		case favor == FavorOurs && diff.Left == nil:
+			diff.Op = tree.DiffOpLeftDelete
+		case favor == FavorOurs:
+			diff.Op = tree.DiffOpLeftModify
+		case diff.Right == nil:
+			diff.Op = tree.DiffOpRightDelete
+		default:
+			// the left side deleted the row, so their modified row is added back
+			diff.Op, diff.Base = tree.DiffOpRightAdd, nil
+		}
+	}
+	return diff
+}
+
 func threeWayDiffer(ctx context.Context, tm *TableMerger, valueMerger *valueMerger, diffInfo tree.ThreeWayDiffInfo) (*tree.ThreeWayDiffer[val.Tuple, val.TupleDesc], error) {
 	lr, err := tm.leftTbl.GetRowData(ctx)
 	if err != nil {
@@ -705,8 +740,10 @@ type uniqIndex struct {
 	meta             UniqCVMeta
 	prefixDesc       val.TupleDesc
 	secondaryBld     index.SecondaryKeyBuilder
+	secondaryValBld  index.SecondaryValueBuilder
 	clusteredBld     index.ClusteredKeyBuilder
 	clusteredKeyDesc val.TupleDesc
+	predicate        index.IndexPredicate
 }
 
 func newUniqIndex(ctx *sql.Context, sch schema.Schema, tableName string, def schema.Index, clustered, secondary prolly.Map) (uniqIndex, error) {
@@ -725,6 +762,11 @@ func newUniqIndex(ctx *sql.Context, sch schema.Schema, tableName string, def sch
 
 	clusteredBld := index.NewClusteredKeyBuilder(def, sch, clustered.KeyDesc(), p)
 
+	predicate, err := index.NewIndexPredicate(ctx, tableName, sch, def, secondary.NodeStore())
+	if err != nil {
+		return uniqIndex{}, err
+	}
+
 	return uniqIndex{
 		def:              def,
 		secondary:        secondary.Mutate(),
@@ -733,23 +775,30 @@ func newUniqIndex(ctx *sql.Context, sch schema.Schema, tableName string, def sch
 		meta:             meta,
 		prefixDesc:       prefixDesc,
 		secondaryBld:     secondaryBld,
+		secondaryValBld:  index.NewSecondaryValueBuilder(sch, def, p),
 		clusteredBld:     clusteredBld,
+		predicate:        predicate,
 	}, nil
 }
 
 type collisionFn func(key, value val.Tuple) error
 
 func (idx uniqIndex) insertRow(ctx context.Context, key, value val.Tuple) error {
+	if ok, err := idx.predicate.Matches(ctx, key, value); err != nil || !ok {
+		return err
+	}
 	secondaryIndexKey, err := idx.secondaryBld.SecondaryKeyFromRow(ctx, key, value)
 	if err != nil {
 		return err
 	}
 
-	// secondary indexes only use their key tuple
-	return idx.secondary.Put(ctx, secondaryIndexKey, val.EmptyTuple)
+	return idx.secondary.Put(ctx, secondaryIndexKey, idx.secondaryValBld.SecondaryValueFromRow(value))
 }
 
 func (idx uniqIndex) removeRow(ctx context.Context, key, value val.Tuple) error {
+	if ok, err := idx.predicate.Matches(ctx, key, value); err != nil || !ok {
+		return err
+	}
 	secondaryIndexKey, err := idx.secondaryBld.SecondaryKeyFromRow(ctx, key, value)
 	if err != nil {
 		return err
@@ -768,6 +817,9 @@ func (idx uniqIndex) removeRow(ctx context.Context, key, value val.Tuple) error
 // included in the unique constraint. For any matching row, the specified callback, |cb|, is invoked with the key
 // and value for the primary index, representing the conflicting row identified from the unique index.
 func (idx uniqIndex) findCollisions(ctx context.Context, key, value val.Tuple, cb collisionFn) error {
+	if ok, err := idx.predicate.Matches(ctx, key, value); err != nil || !ok {
+		return err
+	}
 	indexKey, err := idx.secondaryBld.SecondaryKeyFromRow(ctx, key, value)
 	if err != nil {
 		return err
@@ -1021,6 +1073,12 @@ func newConflictMerger(ctx context.Context, tm *TableMerger, ae *prolly.Artifact
 	m := prolly.ConflictMetadata{
 		BaseRootIsh: baseHash,
 	}
+	if tm.ancName != tm.name {
+		m.BaseTable = tm.ancName.Name
+	}
+	if tm.rightName != tm.name {
+		m.TheirTable = tm.rightName.Name
+	}
 	meta, err := json.Marshal(m)
 	if err != nil {
 		return nil, err
@@ -1622,6 +1680,8 @@ type valueMerger struct {
 	syncPool                               pool.BuffPool
 	keyless                                bool
 	ns                                     tree.NodeStore
+	// favor is the side whose value is taken for a conflicting cell, or FavorNone to report the conflict
+	favor Favor
 }
 
 func newValueMerger(merged, leftSch, rightSch, baseSch schema.Schema, syncPool pool.BuffPool, ns tree.NodeStore) *valueMerger {
@@ -1713,7 +1773,9 @@ func (m *valueMerger) tryMerge(ctx *sql.Context, left, right, base val.Tuple) (v
 		if err != nil {
 			return nil, false, err
 		}
-		if isConflict {
+		// When a side is favored, a column that was dropped on one side and modified on the other is resolved by the
+		// merged schema. Deleted rows are still reported, so the favored side's row can be kept or deleted.
+		if isConflict && (m.favor == FavorNone || left == nil || right == nil) {
 			return nil, false, nil
 		}
 	}
@@ -1894,7 +1956,7 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 		}
 
 		// conflicting inserts
-		return nil, true, nil
+		return m.favoredCell(leftCol, rightCol)
 	}
 
 	// We can now assume that both left and right contain byte-level changes to an existing column.
@@ -1968,10 +2030,13 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 			return nil, true, err
 		}
 		if _, ok := sqlType.(types.JsonType); ok && !disallowJsonMerge {
-			return m.mergeJSONAddr(ctx, baseCol, leftCol, rightCol)
+			merged, conflict, err := m.mergeJSONAddr(ctx, baseCol, leftCol, rightCol)
+			if err != nil || !conflict {
+				return merged, conflict, err
+			}
 		}
 		// otherwise, this is a conflict.
-		return nil, true, nil
+		return m.favoredCell(leftCol, rightCol)
 	case leftModified:
 		return leftCol, false, nil
 	default:
@@ -1979,6 +2044,19 @@ func (m *valueMerger) processColumn(ctx *sql.Context, i int, left, right, base v
 	}
 }
 
+// favoredCell returns the value of the favored side for a cell whose |left| and |right| values conflict, or reports
+// the conflict if neither side is favored.
+func (m *valueMerger) favoredCell(left, right []byte) ([]byte, bool, error) {
+	switch m.favor {
+	case FavorOurs:
+		return left, false, nil
+	case FavorTheirs:
+		return right, false, nil
+	default:
+		return nil, true, nil
+	}
+}
+
 func (m *valueMerger) mergeJSONAddr(ctx context.Context, baseAddr []byte, leftAddr []byte, rightAddr []byte) (resultAddr []byte, conflict bool, err error) {
 	baseDoc, err := tree.NewJSONDoc(hash.New(baseAddr), m.ns).ToIndexedJSONDocument(ctx)
 	if err != nil {
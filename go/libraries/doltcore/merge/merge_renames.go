@@ -0,0 +1,185 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+)
+
+// tableSources names the versions of a table that are merged together when the table was renamed on one or both
+// sides of a merge.
+type tableSources struct {
+	left, right, anc doltdb.TableName
+}
+
+// tableRenames records the tables renamed since the merge base. |sources| is keyed by the name of the merged table,
+// and |replaced| holds the ancestor names that no longer name a table after the merge.
+type tableRenames struct {
+	sources  map[doltdb.TableName]tableSources
+	replaced map[doltdb.TableName]bool
+}
+
+// detectRenamesSysVar enables the merge heuristics that follow tables and columns which were dropped and recreated
+// under a new name or tag. They are opt-in, since they can pair unrelated tables or columns whose contents happen to
+// agree.
+const detectRenamesSysVar = "dolt_merge_detect_renames"
+
+// detectRenames returns whether |ctx|'s session enabled detectRenamesSysVar.
+func detectRenames(ctx *sql.Context) (bool, error) {
+	if _, _, ok := sql.SystemVariables.GetGlobal(detectRenamesSysVar); !ok {
+		// merging outside of a sql engine
+		return false, nil
+	}
+	v, err := ctx.Session.GetSessionVariable(ctx, detectRenamesSysVar)
+	if err != nil {
+		return false, err
+	}
+	return sql.ConvertToBool(ctx, v)
+}
+
+// findTableRenames detects the tables renamed on each side of a merge, so that edits made to a table on one side are
+// merged with the table renamed on the other. When |detect| is set, this includes tables that were dropped and
+// recreated under a new name (see diff.DetectTableRenames). A rename is only followed when the other side still has the table under its
+// ancestor name and has not created a different table under the new name. If both sides renamed the same table
+// they must agree on its new name; otherwise both names are merged as they are.
+func findTableRenames(ctx context.Context, left, right, anc doltdb.RootValue, detect bool) (*tableRenames, error) {
+	leftRenames, err := renamedTables(ctx, anc, left, detect)
+	if err != nil {
+		return nil, err
+	}
+	rightRenames, err := renamedTables(ctx, anc, right, detect)
+	if err != nil {
+		return nil, err
+	}
+
+	renames := &tableRenames{
+		sources:  make(map[doltdb.TableName]tableSources),
+		replaced: make(map[doltdb.TableName]bool),
+	}
+	for ancName, leftName := range leftRenames {
+		if rightName, ok := rightRenames[ancName]; ok {
+			if rightName == leftName {
+				renames.sources[leftName] = tableSources{left: leftName, right: rightName, anc: ancName}
+			}
+			continue
+		}
+		ok, err := followRename(ctx, right, ancName, leftName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			renames.sources[leftName] = tableSources{left: leftName, right: ancName, anc: ancName}
+			renames.replaced[ancName] = true
+		}
+	}
+	for ancName, rightName := range rightRenames {
+		if _, ok := leftRenames[ancName]; ok {
+			continue
+		}
+		ok, err := followRename(ctx, left, ancName, rightName)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			renames.sources[rightName] = tableSources{left: ancName, right: rightName, anc: ancName}
+			renames.replaced[ancName] = true
+		}
+	}
+	return renames, nil
+}
+
+// followRename returns whether a rename of |ancName| to |newName| on one side of a merge can be merged with |other|,
+// the root of the other side.
+func followRename(ctx context.Context, other doltdb.RootValue, ancName, newName doltdb.TableName) (bool, error) {
+	if ok, err := other.HasTable(ctx, ancName); err != nil || !ok {
+		return false, err
+	}
+	ok, err := other.HasTable(ctx, newName)
+	return !ok, err
+}
+
+// renamedTables returns the new names of the tables of |anc| that were renamed in |root|. Tables dropped and
+// recreated under a new name are only included when |detect| is set.
+func renamedTables(ctx context.Context, anc, root doltdb.RootValue, detect bool) (map[doltdb.TableName]doltdb.TableName, error) {
+	deltas, err := diff.GetTableDeltas(ctx, anc, root)
+	if err != nil {
+		return nil, err
+	}
+	if detect {
+		deltas, err = diff.DetectTableRenames(ctx, deltas, diff.DefaultRenameSimilarity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	renamed := make(map[doltdb.TableName]doltdb.TableName)
+	for _, td := range deltas {
+		if !td.IsRename() || doltdb.IsFullTextTable(td.FromName.Name) || doltdb.IsFullTextTable(td.ToName.Name) {
+			continue
+		}
+		renamed[td.FromName] = td.ToName
+	}
+	return renamed, nil
+}
+
+// sourceNames returns the names of the versions of |tblName| to merge.
+func (rm *RootMerger) sourceNames(tblName doltdb.TableName) (left, right, anc doltdb.TableName) {
+	if rm.renames != nil {
+		if src, ok := rm.renames.sources[tblName]; ok {
+			return src.left, src.right, src.anc
+		}
+	}
+	return tblName, tblName, tblName
+}
+
+// retagRecreatedColumns gives columns that one side of the merge dropped and recreated with a new tag the tag of the
+// ancestor column they replaced, so that the schema merge treats them as the same column. See
+// diff.MatchRecreatedColumns.
+func (tm *TableMerger) retagRecreatedColumns(ctx *sql.Context) error {
+	if tm.ancTbl == nil {
+		return nil
+	}
+	for _, side := range []struct {
+		tbl **doltdb.Table
+		sch *schema.Schema
+	}{{&tm.leftTbl, &tm.leftSch}, {&tm.rightTbl, &tm.rightSch}} {
+		if *side.tbl == nil {
+			continue
+		}
+		tags, err := diff.MatchRecreatedColumns(ctx, tm.ancTbl, tm.ancSch, *side.tbl, *side.sch)
+		if err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			continue
+		}
+		sch, err := schema.WithColumnTags(*side.sch, tags)
+		if err != nil {
+			return err
+		}
+		tbl, err := (*side.tbl).UpdateSchema(ctx, sch)
+		if err != nil {
+			return err
+		}
+		*side.tbl, *side.sch = tbl, sch
+	}
+	return nil
+}
@@ -16,8 +16,12 @@ package merge
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/conflict"
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
@@ -52,10 +56,43 @@ type MergeOpts struct {
 	// dolt_verify_constraints() stored procedure to allow callers to verify constraints for a
 	// subset of tables.
 	RecordViolationsForTables map[doltdb.TableName]struct{}
+	// ColumnResolutions holds, for each table, the resolutions chosen for columns whose schemas conflict. See
+	// SchemaMergeWithResolutions.
+	ColumnResolutions map[doltdb.TableName]ColumnResolutions
+	// Favor, when set, automatically resolves conflicting cells and rows in favor of one side of the merge instead
+	// of recording data conflicts. Cells that don't conflict are still merged as usual.
+	Favor Favor
+}
+
+// Favor identifies the side of a merge whose changes win when both sides change the same cell or row. It
+// corresponds to the ours and theirs strategy options (-X) of git merge.
+type Favor string
+
+const (
+	// FavorNone records conflicting changes as data conflicts.
+	FavorNone Favor = ""
+	// FavorOurs keeps the changes of the left side of the merge.
+	FavorOurs Favor = "ours"
+	// FavorTheirs keeps the changes of the right side of the merge.
+	FavorTheirs Favor = "theirs"
+)
+
+// ParseFavor returns the Favor named by the strategy option |option|.
+func ParseFavor(option string) (Favor, error) {
+	switch f := Favor(strings.ToLower(option)); f {
+	case FavorOurs, FavorTheirs:
+		return f, nil
+	default:
+		return FavorNone, fmt.Errorf("unsupported strategy option '%s'; only 'ours' or 'theirs' are allowed", option)
+	}
 }
 
 type TableMerger struct {
 	name doltdb.TableName
+	// rightName and ancName are the names of the table on the right side and in the ancestor, which differ from
+	// name if the table was renamed
+	rightName doltdb.TableName
+	ancName   doltdb.TableName
 
 	leftTbl  *doltdb.Table
 	rightTbl *doltdb.Table
@@ -76,6 +113,13 @@ type TableMerger struct {
 	// exception is for the dolt_verify_constraints() stored procedure, which allows callers to
 	// only record constraint violations for a specified subset of tables.
 	recordViolations bool
+
+	// favor controls which side's changes are kept for conflicting cells and rows.
+	favor Favor
+
+	// rekeyViolations are the rows that could not be re-keyed across a primary key change, see
+	// rekeyForPrimaryKeyChange
+	rekeyViolations []rekeyViolation
 }
 
 func (tm TableMerger) tableHashes() (left, right, anc hash.Hash, err error) {
@@ -105,6 +149,12 @@ type RootMerger struct {
 	rightSrc doltdb.Rootish
 	ancSrc   doltdb.Rootish
 
+	// renames maps merged tables to the names of their versions on each side, for tables renamed since the merge base
+	renames *tableRenames
+	// detectRenames is set when the merge follows tables and columns that were dropped and recreated under a new
+	// name or tag, see detectRenamesSysVar
+	detectRenames bool
+
 	vrw types.ValueReadWriter
 	ns  tree.NodeStore
 }
@@ -132,6 +182,16 @@ type MergedTable struct {
 	conflict SchemaConflict
 }
 
+// Table returns the merged table, or nil if the table was deleted by the merge.
+func (mt *MergedTable) Table() *doltdb.Table {
+	return mt.table
+}
+
+// SchemaConflict returns the schema conflict found while merging the table, if any.
+func (mt *MergedTable) SchemaConflict() SchemaConflict {
+	return mt.conflict
+}
+
 func getDatabaseSchemaNames(ctx context.Context, dest doltdb.RootValue) (*set.StrSet, error) {
 	dbSchemaNames := set.NewEmptyStrSet()
 	dbSchemas, err := dest.GetDatabaseSchemas(ctx)
@@ -152,6 +212,9 @@ func (rm *RootMerger) MergeTable(
 	opts editor.Options,
 	mergeOpts MergeOpts,
 ) (*MergedTable, *MergeStats, error) {
+	span, ctx := ctx.Span("merge.MergeTable", trace.WithAttributes(attribute.String("table", tblName.String())))
+	defer span.End()
+
 	tm, err := rm.makeTableMerger(ctx, tblName, mergeOpts)
 	if err != nil {
 		return nil, nil, err
@@ -163,8 +226,19 @@ func (rm *RootMerger) MergeTable(
 		return &MergedTable{table: finished}, stats, err
 	}
 
+	if types.IsFormat_DOLT(tm.vrw.Format()) {
+		if rm.detectRenames {
+			if err = tm.retagRecreatedColumns(ctx); err != nil {
+				return nil, nil, err
+			}
+		}
+		if err = tm.rekeyForPrimaryKeyChange(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Calculate a merge of the schemas, but don't apply it yet
-	mergeSch, schConflicts, mergeInfo, diffInfo, err := SchemaMerge(ctx, tm.vrw.Format(), tm.leftSch, tm.rightSch, tm.ancSch, tblName)
+	mergeSch, schConflicts, mergeInfo, diffInfo, err := SchemaMergeWithResolutions(ctx, tm.vrw.Format(), tm.leftSch, tm.rightSch, tm.ancSch, tblName, mergeOpts.ColumnResolutions[tblName])
 	if err != nil {
 		return nil, nil, err
 	}
@@ -211,12 +285,15 @@ func (rm *RootMerger) makeTableMerger(ctx context.Context, tblName doltdb.TableN
 		vrw:              rm.vrw,
 		ns:               rm.ns,
 		recordViolations: recordViolations,
+		favor:            mergeOpts.Favor,
 	}
 
 	var err error
 	var leftSideTableExists, rightSideTableExists, ancTableExists bool
+	leftName, rightName, ancName := rm.sourceNames(tblName)
+	tm.rightName, tm.ancName = rightName, ancName
 
-	tm.leftTbl, leftSideTableExists, err = rm.left.GetTable(ctx, tblName)
+	tm.leftTbl, leftSideTableExists, err = rm.left.GetTable(ctx, leftName)
 	if err != nil {
 		return nil, err
 	}
@@ -226,7 +303,7 @@ func (rm *RootMerger) makeTableMerger(ctx context.Context, tblName doltdb.TableN
 		}
 	}
 
-	tm.rightTbl, rightSideTableExists, err = rm.right.GetTable(ctx, tblName)
+	tm.rightTbl, rightSideTableExists, err = rm.right.GetTable(ctx, rightName)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +335,7 @@ func (rm *RootMerger) makeTableMerger(ctx context.Context, tblName doltdb.TableN
 		}
 	}
 
-	tm.ancTbl, ancTableExists, err = rm.anc.GetTable(ctx, tblName)
+	tm.ancTbl, ancTableExists, err = rm.anc.GetTable(ctx, ancName)
 	if err != nil {
 		return nil, err
 	}
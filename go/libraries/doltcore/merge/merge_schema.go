@@ -161,6 +161,37 @@ func (c ChkConflict) String() string {
 var ErrMergeWithDifferentPks = errorkinds.NewKind("error: cannot merge because table %s has different primary keys")
 var ErrMergeWithDifferentPksFromAncestor = errorkinds.NewKind("error: cannot merge because table %s has different primary keys in its common ancestor")
 
+// ColumnResolution chooses the definition of a column whose schema conflicts between the two sides of a merge.
+type ColumnResolution string
+
+const (
+	// ResolveColumnOurs keeps our definition of the column.
+	ResolveColumnOurs ColumnResolution = "ours"
+	// ResolveColumnTheirs takes their definition of the column.
+	ResolveColumnTheirs ColumnResolution = "theirs"
+	// ResolveColumnWiden keeps our definition of the column with a type that holds the values of both sides. See
+	// WidenTypes.
+	ResolveColumnWiden ColumnResolution = "widen"
+)
+
+// ColumnResolutions maps lower-cased column names to the resolution of their schema conflicts.
+type ColumnResolutions map[string]ColumnResolution
+
+// ColumnResolutionsForTable returns the resolutions in |resolved| of the column schema conflicts of table |tblName|.
+func ColumnResolutionsForTable(resolved []doltdb.SchemaConflictResolution, tblName doltdb.TableName) ColumnResolutions {
+	var resolutions ColumnResolutions
+	for _, r := range resolved {
+		if r.Table != tblName {
+			continue
+		}
+		if resolutions == nil {
+			resolutions = make(ColumnResolutions)
+		}
+		resolutions[strings.ToLower(r.Column)] = ColumnResolution(r.Resolution)
+	}
+	return resolutions
+}
+
 // SchemaMerge performs a three-way merge of |ourSch|, |theirSch|, and |ancSch|, and returns: the merged schema,
 // any schema conflicts identified, whether moving to the new schema requires a full table rewrite, and any
 // unexpected error encountered while merging the schemas.
@@ -169,14 +200,26 @@ func SchemaMerge(
 	format *storetypes.NomsBinFormat,
 	ourSch, theirSch, ancSch schema.Schema,
 	tblName doltdb.TableName,
+) (sch schema.Schema, sc SchemaConflict, mergeInfo MergeInfo, diffInfo tree.ThreeWayDiffInfo, err error) {
+	return SchemaMergeWithResolutions(ctx, format, ourSch, theirSch, ancSch, tblName, nil)
+}
+
+// SchemaMergeWithResolutions is SchemaMerge, except that columns named in |resolutions| take the definition chosen
+// by their resolution instead of being checked for conflicts.
+func SchemaMergeWithResolutions(
+	ctx context.Context,
+	format *storetypes.NomsBinFormat,
+	ourSch, theirSch, ancSch schema.Schema,
+	tblName doltdb.TableName,
+	resolutions ColumnResolutions,
 ) (sch schema.Schema, sc SchemaConflict, mergeInfo MergeInfo, diffInfo tree.ThreeWayDiffInfo, err error) {
 	// (sch - ancSch) ∪ (mergeSch - ancSch) ∪ (sch ∩ mergeSch)
 	sc = SchemaConflict{
 		TableName: tblName,
 	}
 
-	// TODO: We'll remove this once it's possible to get diff and merge on different primary key sets
-	// TODO: decide how to merge different orders of PKS
+	// A primary key changed on one side has already been applied to the other tables where possible (see
+	// rekeyForPrimaryKeyChange), so keys that still differ here cannot be merged.
 	if !schema.ArePrimaryKeySetsDiffable(format, ourSch, theirSch) {
 		return nil, SchemaConflict{}, mergeInfo, diffInfo, ErrMergeWithDifferentPks.New(tblName)
 	}
@@ -185,7 +228,7 @@ func SchemaMerge(
 	}
 
 	var mergedCC *schema.ColCollection
-	mergedCC, sc.ColConflicts, mergeInfo, diffInfo, err = mergeColumns(tblName.Name, format, ourSch.GetAllCols(), theirSch.GetAllCols(), ancSch.GetAllCols())
+	mergedCC, sc.ColConflicts, mergeInfo, diffInfo, err = mergeColumns(tblName.Name, format, ourSch.GetAllCols(), theirSch.GetAllCols(), ancSch.GetAllCols(), resolutions)
 	if err != nil {
 		return nil, SchemaConflict{}, mergeInfo, diffInfo, err
 	}
@@ -391,8 +434,9 @@ type MergeInfo struct {
 // between types, since different storage formats have different restrictions on how much types can change and remain
 // compatible with the current stored format. The merged columns, any column conflicts, and a boolean value stating if
 // a full table rewrite is needed to align the existing table rows with the new, merged schema. If any unexpected error
-// occurs, then that error is returned and the other response fields should be ignored.
-func mergeColumns(tblName string, format *storetypes.NomsBinFormat, ourCC, theirCC, ancCC *schema.ColCollection) (*schema.ColCollection, []ColConflict, MergeInfo, tree.ThreeWayDiffInfo, error) {
+// occurs, then that error is returned and the other response fields should be ignored. Columns named in
+// |resolutions| are not checked for conflicts and take the definition chosen by their resolution.
+func mergeColumns(tblName string, format *storetypes.NomsBinFormat, ourCC, theirCC, ancCC *schema.ColCollection, resolutions ColumnResolutions) (*schema.ColCollection, []ColConflict, MergeInfo, tree.ThreeWayDiffInfo, error) {
 	mergeInfo := MergeInfo{}
 	diffInfo := tree.ThreeWayDiffInfo{}
 	columnMappings, err := mapColumns(ourCC, theirCC, ancCC)
@@ -400,7 +444,20 @@ func mergeColumns(tblName string, format *storetypes.NomsBinFormat, ourCC, their
 		return nil, nil, mergeInfo, diffInfo, err
 	}
 
-	conflicts, err := checkSchemaConflicts(columnMappings)
+	resolved := make(map[int]*schema.Column)
+	var unresolved []columnMapping
+	for i, mapping := range columnMappings {
+		res, ok := resolutions[strings.ToLower(mapping.name())]
+		if !ok {
+			unresolved = append(unresolved, mapping)
+			continue
+		}
+		if resolved[i], err = mapping.resolve(res); err != nil {
+			return nil, nil, mergeInfo, diffInfo, err
+		}
+	}
+
+	conflicts, err := checkSchemaConflicts(unresolved)
 	if err != nil {
 		return nil, nil, mergeInfo, diffInfo, err
 	}
@@ -416,11 +473,31 @@ func mergeColumns(tblName string, format *storetypes.NomsBinFormat, ourCC, their
 	// TODO: We don't currently preserve all column position changes; the returned merged columns are always based on
 	//	     their position in |ourCC|, with any new columns from |theirCC| added at the end of the column collection.
 	var mergedColumns []schema.Column
-	for _, mapping := range columnMappings {
+	for i, mapping := range columnMappings {
 		ours := mapping.ours
 		theirs := mapping.theirs
 		anc := mapping.anc
 
+		if col, ok := resolved[i]; ok {
+			// the rows of each side whose column differs from the resolved column must be rewritten, converting
+			// their values to the resolved type
+			diffInfo.LeftSchemaChange = true
+			diffInfo.RightSchemaChange = true
+			diffInfo.LeftAndRightSchemasDiffer = true
+			if resolvedColumnDiffers(ours, col) {
+				mergeInfo.LeftNeedsRewrite = true
+				mergeInfo.InvalidateSecondaryIndexes = true
+			}
+			if resolvedColumnDiffers(theirs, col) {
+				mergeInfo.RightNeedsRewrite = true
+				mergeInfo.InvalidateSecondaryIndexes = true
+			}
+			if col != nil {
+				mergedColumns = append(mergedColumns, *col)
+			}
+			continue
+		}
+
 		switch {
 		case anc == nil && ours == nil && theirs != nil:
 			// if an ancestor does not exist, and the column exists only on one side, use that side
@@ -536,6 +613,15 @@ func mergeColumns(tblName string, format *storetypes.NomsBinFormat, ourCC, their
 	return schema.NewColCollection(mergedColumns...), nil, mergeInfo, diffInfo, nil
 }
 
+// resolvedColumnDiffers returns whether rows stored with column |side| must be rewritten to store column |resolved|.
+// Either column may be nil if it doesn't exist.
+func resolvedColumnDiffers(side, resolved *schema.Column) bool {
+	if side == nil || resolved == nil {
+		return side != resolved
+	}
+	return !side.TypeInfo.Equals(resolved.TypeInfo)
+}
+
 // checkForColumnConflicts iterates over |mergedColumns|, checks for duplicate column names or column tags, and returns
 // a slice of ColConflicts for any conflicts found.
 func checkForColumnConflicts(mergedColumns []schema.Column) []ColConflict {
@@ -673,6 +759,49 @@ func newColumnMapping(anc, ours, theirs schema.Column) columnMapping {
 	return columnMapping{pAnc, pOurs, pTheirs}
 }
 
+// name returns the name of the column in the mapping, preferring our name when it was renamed.
+func (c columnMapping) name() string {
+	switch {
+	case c.ours != nil:
+		return c.ours.Name
+	case c.theirs != nil:
+		return c.theirs.Name
+	default:
+		return c.anc.Name
+	}
+}
+
+// resolve returns the column chosen by |res| for this mapping, or nil if the resolution drops the column.
+func (c columnMapping) resolve(res ColumnResolution) (*schema.Column, error) {
+	switch res {
+	case ResolveColumnOurs:
+		return c.ours, nil
+	case ResolveColumnTheirs:
+		return c.theirs, nil
+	case ResolveColumnWiden:
+		if c.ours == nil || c.theirs == nil {
+			return nil, fmt.Errorf("cannot widen column '%s' because it was dropped on one side of the merge", c.name())
+		}
+		typ, err := WidenTypes(c.ours.TypeInfo, c.theirs.TypeInfo)
+		if err != nil {
+			return nil, err
+		}
+		col := *c.ours
+		col.TypeInfo, col.Kind = typ, typ.NomsKind()
+		if c.theirs.IsNullable() && !col.IsNullable() {
+			col.Constraints = nil
+			for _, cnst := range c.ours.Constraints {
+				if cnst.GetConstraintType() != schema.NotNullConstraintType {
+					col.Constraints = append(col.Constraints, cnst)
+				}
+			}
+		}
+		return &col, nil
+	default:
+		return nil, fmt.Errorf("unknown column resolution '%s'", res)
+	}
+}
+
 type columnMappings []columnMapping
 
 // DebugString returns a string representation of this columnMappings instance.
@@ -808,7 +937,7 @@ func indexesInCommon(mergedCC *schema.ColCollection, ours, theirs, anc schema.In
 			return false, nil
 		}
 
-		ancIdx, ok := anc.GetIndexByTags(idxTags...)
+		ancIdx, ok := findIndex(ourIdx, anc)
 
 		if !ok {
 			// index added on our branch and their branch with different defs, conflict
@@ -871,7 +1000,17 @@ func indexesInCommon(mergedCC *schema.ColCollection, ours, theirs, anc schema.In
 // index covers was being used as a unique ID for the index, but as our index support has grown and in order to match
 // MySQL's behavior, this isn't guaranteed to be a unique identifier anymore.
 func findIndexInCollectionByTags(idx schema.Index, idxColl schema.IndexCollection) (schema.Index, *IdxConflict) {
-	theirIdxs := idxColl.GetIndexesByTags(idx.IndexedColumnTags()...)
+	if matchIndexByName(idx) {
+		theirIdx, _ := findIndex(idx, idxColl)
+		return theirIdx, nil
+	}
+
+	var theirIdxs []schema.Index
+	for _, theirIdx := range idxColl.GetIndexesByTags(idx.IndexedColumnTags()...) {
+		if !matchIndexByName(theirIdx) {
+			theirIdxs = append(theirIdxs, theirIdx)
+		}
+	}
 	switch len(theirIdxs) {
 	case 0:
 		return nil, nil
@@ -901,7 +1040,7 @@ func indexCollSetDifference(left, right schema.IndexCollection, cc *schema.ColCo
 			}
 		}
 
-		_, ok := right.GetIndexByTags(idxTags...)
+		_, ok := findIndex(idx, right)
 		if !ok {
 			d.AddIndex(idx)
 		}
@@ -910,6 +1049,26 @@ func indexCollSetDifference(left, right schema.IndexCollection, cc *schema.ColCo
 	return d
 }
 
+// findIndex returns the index in |idxColl| that corresponds to |idx|. Indexes are usually matched by the columns
+// they cover, see matchIndexByName for the exceptions.
+func findIndex(idx schema.Index, idxColl schema.IndexCollection) (schema.Index, bool) {
+	if matchIndexByName(idx) {
+		other, ok := idxColl.GetByNameCaseInsensitive(idx.Name())
+		if !ok || !matchIndexByName(other) {
+			return nil, false
+		}
+		return other, true
+	}
+	return idxColl.GetIndexByTags(idx.IndexedColumnTags()...)
+}
+
+// matchIndexByName returns whether |idx| is matched across a merge by its name rather than by its columns. Columnar
+// and partial indexes can cover the same columns as an ordinary index on the same table, so their columns don't
+// identify them.
+func matchIndexByName(idx schema.Index) bool {
+	return idx.IsColumnar() || idx.Predicate() != ""
+}
+
 func foreignKeysInCommon(
 	ourFKs, theirFKs, ancFKs *doltdb.ForeignKeyCollection,
 	ancSchs map[doltdb.TableName]schema.Schema,
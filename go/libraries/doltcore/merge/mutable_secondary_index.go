@@ -28,17 +28,22 @@ import (
 
 // GetMutableSecondaryIdxs returns a MutableSecondaryIdx for each secondary index in |indexes|.
 func GetMutableSecondaryIdxs(ctx *sql.Context, ourSch, sch schema.Schema, tableName string, indexes durable.IndexSet) ([]MutableSecondaryIdx, error) {
-	mods := make([]MutableSecondaryIdx, sch.Indexes().Count())
-	for i, index := range sch.Indexes().AllIndexes() {
+	mods := make([]MutableSecondaryIdx, 0, sch.Indexes().Count())
+	for _, index := range sch.Indexes().AllIndexes() {
+		// columnar indexes are synced from the primary index, not edited row by row
+		if index.IsColumnar() {
+			continue
+		}
 		idx, err := indexes.GetIndex(ctx, sch, nil, index.Name())
 		if err != nil {
 			return nil, err
 		}
 		m := durable.ProllyMapFromIndex(idx)
-		mods[i], err = NewMutableSecondaryIdx(ctx, m, ourSch, sch, tableName, index)
+		mod, err := NewMutableSecondaryIdx(ctx, m, ourSch, sch, tableName, index)
 		if err != nil {
 			return nil, err
 		}
+		mods = append(mods, mod)
 	}
 	return mods, nil
 }
@@ -49,6 +54,10 @@ func GetMutableSecondaryIdxs(ctx *sql.Context, ourSch, sch schema.Schema, tableN
 func GetMutableSecondaryIdxsWithPending(ctx *sql.Context, ourSch, sch schema.Schema, tableName string, indexes durable.IndexSet, pendingSize int) ([]MutableSecondaryIdx, error) {
 	mods := make([]MutableSecondaryIdx, 0, sch.Indexes().Count())
 	for _, index := range sch.Indexes().AllIndexes() {
+		// columnar indexes are synced from the primary index, not edited row by row
+		if index.IsColumnar() {
+			continue
+		}
 
 		// If an index isn't found on the left side, we know it must be a new index added on the right side,
 		// so just skip it, and we'll rebuild the full index at the end of merging when we notice it's missing.
@@ -104,6 +113,8 @@ type MutableSecondaryIdx struct {
 	Name                       string
 	mut                        *prolly.MutableMap
 	leftBuilder, mergedBuilder index.SecondaryKeyBuilder
+	valBuilder                 index.SecondaryValueBuilder
+	leftPred, mergedPred       index.IndexPredicate
 }
 
 // NewMutableSecondaryIdx returns a MutableSecondaryIdx. |m| is the secondary idx data.
@@ -113,25 +124,38 @@ func NewMutableSecondaryIdx(ctx *sql.Context, idx prolly.Map, ourSch, mergedSch
 	if err != nil {
 		return MutableSecondaryIdx{}, err
 	}
+	leftPred, err := index.NewIndexPredicate(ctx, tableName, ourSch, def, idx.NodeStore())
+	if err != nil {
+		return MutableSecondaryIdx{}, err
+	}
+	mergedPred, err := index.NewIndexPredicate(ctx, tableName, mergedSch, def, idx.NodeStore())
+	if err != nil {
+		return MutableSecondaryIdx{}, err
+	}
 
 	return MutableSecondaryIdx{
 		Name:          def.Name(),
 		mut:           idx.Mutate(),
 		leftBuilder:   leftBuilder,
 		mergedBuilder: mergedBuilder,
+		valBuilder:    index.NewSecondaryValueBuilder(mergedSch, def, idx.Pool()),
+		leftPred:      leftPred,
+		mergedPred:    mergedPred,
 	}, nil
 }
 
 // InsertEntry inserts a secondary index entry given the key and new value
 // of the primary row.
 func (m MutableSecondaryIdx) InsertEntry(ctx context.Context, key, newValue val.Tuple) error {
+	if ok, err := m.mergedPred.Matches(ctx, key, newValue); err != nil || !ok {
+		return err
+	}
 	newKey, err := m.mergedBuilder.SecondaryKeyFromRow(ctx, key, newValue)
 	if err != nil {
 		return err
 	}
 
-	// secondary indexes only use their key tuple
-	err = m.mut.Put(ctx, newKey, val.EmptyTuple)
+	err = m.mut.Put(ctx, newKey, m.valBuilder.SecondaryValueFromRow(newValue))
 	if err != nil {
 		return err
 	}
@@ -141,6 +165,14 @@ func (m MutableSecondaryIdx) InsertEntry(ctx context.Context, key, newValue val.
 // UpdateEntry modifies the corresponding secondary index entry given the key
 // and curr/new values of the primary row.
 func (m MutableSecondaryIdx) UpdateEntry(ctx context.Context, key, currValue, newValue val.Tuple) error {
+	if m.mergedPred.IsPartial() {
+		// rows of a partial index may move in or out of it
+		if err := m.DeleteEntry(ctx, key, currValue); err != nil {
+			return err
+		}
+		return m.InsertEntry(ctx, key, newValue)
+	}
+
 	currKey, err := m.leftBuilder.SecondaryKeyFromRow(ctx, key, currValue)
 	if err != nil {
 		return err
@@ -155,11 +187,14 @@ func (m MutableSecondaryIdx) UpdateEntry(ctx context.Context, key, currValue, ne
 	if err != nil {
 		return err
 	}
-	return m.mut.Put(ctx, newKey, val.EmptyTuple)
+	return m.mut.Put(ctx, newKey, m.valBuilder.SecondaryValueFromRow(newValue))
 }
 
 // DeleteEntry deletes a secondary index entry given they key and value of the primary row.
 func (m MutableSecondaryIdx) DeleteEntry(ctx context.Context, key val.Tuple, value val.Tuple) error {
+	if ok, err := m.leftPred.Matches(ctx, key, value); err != nil || !ok {
+		return err
+	}
 	currKey, err := m.leftBuilder.SecondaryKeyFromRow(ctx, key, value)
 	if err != nil {
 		return err
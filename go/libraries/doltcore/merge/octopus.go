@@ -0,0 +1,289 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// OctopusConflict identifies a table that two of the commits in an octopus merge both changed in conflicting ways.
+// |Left| is "HEAD" or the spec of one of the merged commits, and |Right| is the spec of a later merged commit.
+type OctopusConflict struct {
+	Table       doltdb.TableName
+	Left, Right string
+}
+
+// OctopusMergeConflictsError is returned by OctopusMerge when the merged commits conflict with each other. An octopus
+// merge never records conflicts or constraint violations; the commits must be merged one at a time instead.
+type OctopusMergeConflictsError struct {
+	Conflicts []OctopusConflict
+}
+
+var _ error = OctopusMergeConflictsError{}
+
+func (e OctopusMergeConflictsError) Error() string {
+	var b strings.Builder
+	b.WriteString("octopus merge failed; merge these commits one at a time to resolve their conflicts:")
+	for _, c := range e.Conflicts {
+		b.WriteString(fmt.Sprintf("\n\ttable %s: %s and %s", c.Table, c.Left, c.Right))
+	}
+	return b.String()
+}
+
+// OctopusResult is the outcome of a successful OctopusMerge.
+type OctopusResult struct {
+	Result
+	// Parents are the merged commits, in order, that must be recorded as parents of the merge commit after HEAD.
+	// Commits that HEAD or an earlier commit of the merge already contain are left out.
+	Parents []*doltdb.Commit
+	// UpToDate are the specs of the commits that were left out of Parents.
+	UpToDate []string
+}
+
+// OctopusMerge merges each of |commits|, named by |specs|, into |head| in turn, producing a single root that combines
+// all of them. Each commit is three-way merged against the root produced so far, using its latest common ancestor
+// with |head| or any commit merged before it as the merge base (see octopusMergeBase). If merging any commit produces
+// conflicts or constraint violations, it is left out of the merge and the remaining commits are still checked, both
+// against the merge so far and against every conflicting commit. Nothing is merged in that case, and an
+// OctopusMergeConflictsError naming the conflicting commits for each table is returned. Data conflicts are instead
+// resolved automatically when |favor| is set. If every commit is already contained in |head|, doltdb.ErrUpToDate is
+// returned.
+func OctopusMerge(ctx *sql.Context, head *doltdb.Commit, commits []*doltdb.Commit, specs []string, opts editor.Options, favor Favor) (*OctopusResult, error) {
+	root, err := head.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &OctopusResult{Result: Result{Stats: make(map[doltdb.TableName]*MergeStats)}}
+	merged := []*doltdb.Commit{head}
+	mergedSpecs := []string{"HEAD"}
+	var failed []*doltdb.Commit
+	var failedSpecs []string
+	var conflicts []OctopusConflict
+	seen := make(map[hash.Hash]struct{})
+	for i, cm := range commits {
+		h, err := cm.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[h]; ok {
+			continue
+		}
+		seen[h] = struct{}{}
+
+		if contained, err := isContainedIn(ctx, cm, merged); err != nil {
+			return nil, err
+		} else if contained {
+			res.UpToDate = append(res.UpToDate, specs[i])
+			continue
+		}
+
+		ancCommit, err := octopusMergeBase(ctx, merged, cm)
+		if err != nil {
+			return nil, err
+		}
+		ancRoot, err := ancCommit.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+		theirRoot, err := cm.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		mo := MergeOpts{KeepSchemaConflicts: true, Favor: favor}
+		result, err := MergeRoots(ctx, root, theirRoot, ancRoot, cm, ancCommit, opts, mo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", specs[i], err)
+		}
+		if result.HasMergeArtifacts() {
+			found, err := findOctopusConflicts(ctx, result, merged, mergedSpecs, cm, specs[i], opts, favor)
+			if err != nil {
+				return nil, err
+			}
+			conflicts = append(conflicts, found...)
+		}
+		// commits left out of the merge for their conflicts are checked against each later commit on their own, so
+		// that every conflict is reported at once
+		for j, f := range failed {
+			pairResult, err := MergeCommits(ctx, f, cm, opts, favor)
+			if err != nil {
+				return nil, err
+			}
+			for _, tbl := range artifactTables(pairResult) {
+				conflicts = append(conflicts, OctopusConflict{Table: tbl, Left: failedSpecs[j], Right: specs[i]})
+			}
+		}
+		if result.HasMergeArtifacts() {
+			failed = append(failed, cm)
+			failedSpecs = append(failedSpecs, specs[i])
+			continue
+		}
+
+		root = result.Root
+		for tbl, stats := range result.Stats {
+			res.Stats[tbl] = addStats(res.Stats[tbl], stats)
+		}
+		merged = append(merged, cm)
+		mergedSpecs = append(mergedSpecs, specs[i])
+		res.Parents = append(res.Parents, cm)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, OctopusMergeConflictsError{Conflicts: conflicts}
+	}
+	if len(res.Parents) == 0 {
+		return nil, doltdb.ErrUpToDate
+	}
+	res.Root = root
+	return res, nil
+}
+
+// octopusMergeBase returns the merge base of |cm| and the merge of |merged|, whose first commit is HEAD. This is the
+// common ancestor of |cm| and one of |merged| that contains the common ancestors of |cm| and all the others, so that
+// changes |cm| shares with an earlier merged commit are not merged twice. When no such ancestor exists, as when |cm|
+// shares criss-crossing history with the merged commits, the common ancestor of |cm| and HEAD is used.
+func octopusMergeBase(ctx *sql.Context, merged []*doltdb.Commit, cm *doltdb.Commit) (*doltdb.Commit, error) {
+	ancestors := make([]*doltdb.Commit, len(merged))
+	for i, m := range merged {
+		optCmt, err := doltdb.GetCommitAncestor(ctx, m, cm)
+		if err != nil {
+			return nil, err
+		}
+		anc, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitRuntimeFailure
+		}
+		ancestors[i] = anc
+	}
+
+	for _, candidate := range ancestors {
+		containsAll := true
+		for _, anc := range ancestors {
+			contained, err := isContainedIn(ctx, anc, []*doltdb.Commit{candidate})
+			if err != nil {
+				return nil, err
+			}
+			if !contained {
+				containsAll = false
+				break
+			}
+		}
+		if containsAll {
+			return candidate, nil
+		}
+	}
+	return ancestors[0], nil
+}
+
+// isContainedIn returns whether |cm| is one of |commits| or an ancestor of one of them.
+func isContainedIn(ctx *sql.Context, cm *doltdb.Commit, commits []*doltdb.Commit) (bool, error) {
+	for _, c := range commits {
+		_, err := c.CanFastForwardTo(ctx, cm)
+		if err == doltdb.ErrUpToDate || err == doltdb.ErrIsAhead {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// findOctopusConflicts returns the conflicts behind the merge artifacts in |result|, produced by merging |cm| into
+// the merge of |merged|. Each table with artifacts is attributed to the earlier commits that |cm| conflicts with on
+// their own, or to all the earlier commits together if it only conflicts with their combination.
+func findOctopusConflicts(
+	ctx *sql.Context,
+	result *Result,
+	merged []*doltdb.Commit,
+	mergedSpecs []string,
+	cm *doltdb.Commit,
+	spec string,
+	opts editor.Options,
+	favor Favor,
+) ([]OctopusConflict, error) {
+	tables := artifactTables(result)
+
+	attributed := make(map[doltdb.TableName]bool)
+	var conflicts []OctopusConflict
+	for i, prev := range merged {
+		pairResult, err := MergeCommits(ctx, prev, cm, opts, favor)
+		if err != nil {
+			return nil, err
+		}
+		pairTables := doltdb.NewTableNameSet(artifactTables(pairResult))
+		for _, tbl := range tables {
+			if pairTables.Contains(tbl) {
+				conflicts = append(conflicts, OctopusConflict{Table: tbl, Left: mergedSpecs[i], Right: spec})
+				attributed[tbl] = true
+			}
+		}
+	}
+
+	for _, tbl := range tables {
+		if !attributed[tbl] {
+			conflicts = append(conflicts, OctopusConflict{Table: tbl, Left: strings.Join(mergedSpecs, ", "), Right: spec})
+		}
+	}
+	return conflicts, nil
+}
+
+// artifactTables returns the tables with schema conflicts, data conflicts or constraint violations in |result|,
+// sorted by name.
+func artifactTables(result *Result) []doltdb.TableName {
+	set := make(map[string]doltdb.TableName)
+	for _, sc := range result.SchemaConflicts {
+		set[sc.TableName.String()] = sc.TableName
+	}
+	for tbl, stats := range result.Stats {
+		if stats.HasArtifacts() {
+			set[tbl.String()] = tbl
+		}
+	}
+	tables := make([]doltdb.TableName, 0, len(set))
+	for _, tbl := range set {
+		tables = append(tables, tbl)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].String() < tables[j].String()
+	})
+	return tables
+}
+
+// addStats returns the sum of |a| and |b|, either of which may be nil.
+func addStats(a, b *MergeStats) *MergeStats {
+	if a == nil {
+		return b
+	} else if b == nil {
+		return a
+	}
+	return &MergeStats{
+		Operation:            b.Operation,
+		Adds:                 a.Adds + b.Adds,
+		Deletes:              a.Deletes + b.Deletes,
+		Modifications:        a.Modifications + b.Modifications,
+		DataConflicts:        a.DataConflicts + b.DataConflicts,
+		SchemaConflicts:      a.SchemaConflicts + b.SchemaConflicts,
+		ConstraintViolations: a.ConstraintViolations + b.ConstraintViolations,
+	}
+}
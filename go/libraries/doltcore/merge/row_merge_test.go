@@ -222,6 +222,97 @@ func TestRowMerge(t *testing.T) {
 	}
 }
 
+func TestRowMergeFavor(t *testing.T) {
+	if types.Format_Default != types.Format_DOLT {
+		t.Skip()
+	}
+
+	ctx := sql.NewEmptyContext()
+
+	tests := []struct {
+		testCase
+		favor Favor
+	}{
+		{
+			testCase: testCase{
+				name:           "insert different rows favoring ours",
+				row:            build(1, 2),
+				mergeRow:       build(2, 3),
+				rowCnt:         2,
+				mRowCnt:        2,
+				aRowCnt:        2,
+				expectedResult: build(1, 2),
+			},
+			favor: FavorOurs,
+		},
+		{
+			testCase: testCase{
+				name:           "insert different rows favoring theirs",
+				row:            build(1, 2),
+				mergeRow:       build(2, 3),
+				rowCnt:         2,
+				mRowCnt:        2,
+				aRowCnt:        2,
+				expectedResult: build(2, 3),
+			},
+			favor: FavorTheirs,
+		},
+		{
+			testCase: testCase{
+				name:           "modify rows with differing overlapping changes favoring ours",
+				row:            build(2, 2, 128),
+				mergeRow:       build(1, 3, 255),
+				ancRow:         build(1, 2, 0),
+				rowCnt:         3,
+				mRowCnt:        3,
+				aRowCnt:        3,
+				expectedResult: build(2, 3, 128),
+			},
+			favor: FavorOurs,
+		},
+		{
+			testCase: testCase{
+				name:           "modify rows with differing overlapping changes favoring theirs",
+				row:            build(2, 2, 128),
+				mergeRow:       build(1, 3, 255),
+				ancRow:         build(1, 2, 0),
+				rowCnt:         3,
+				mRowCnt:        3,
+				aRowCnt:        3,
+				expectedResult: build(2, 3, 255),
+			},
+			favor: FavorTheirs,
+		},
+		{
+			// deleted rows are resolved by the caller of tryMerge
+			testCase: testCase{
+				name:           "delete a row in one, and modify it in other",
+				mergeRow:       build(1, 3),
+				ancRow:         build(1, 2),
+				rowCnt:         2,
+				mRowCnt:        2,
+				aRowCnt:        2,
+				expectConflict: true,
+			},
+			favor: FavorTheirs,
+		},
+	}
+
+	for _, tc := range tests {
+		test := createRowMergeStruct(tc.testCase)
+		t.Run(test.name, func(t *testing.T) {
+			v := newValueMerger(test.mergedSch, test.leftSch, test.rightSch, test.baseSch, syncPool, nil)
+			v.favor = tc.favor
+
+			merged, ok, err := v.tryMerge(ctx, test.row, test.mergeRow, test.ancRow)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectConflict, !ok)
+			vD := test.mergedSch.GetValueDescriptor()
+			assert.Equal(t, vD.Format(test.expectedResult), vD.Format(merged))
+		})
+	}
+}
+
 func TestNomsRowMerge(t *testing.T) {
 	if types.Format_Default == types.Format_DOLT {
 		t.Skip()
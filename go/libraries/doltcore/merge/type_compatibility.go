@@ -15,10 +15,13 @@
 package merge
 
 import (
+	"math"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
+	errorkinds "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	storetypes "github.com/dolthub/dolt/go/store/types"
@@ -310,3 +313,189 @@ func (s setTypeChangeHandler) isCompatible(fromType, toType sql.Type) (res TypeC
 	res.compatible = true
 	return res
 }
+
+var ErrCannotWidenTypes = errorkinds.NewKind("cannot widen column types %s and %s to a type that holds the values of both")
+
+// WidenTypes returns a type that can hold every value of both |a| and |b| without loss. If one type can already
+// hold every value of the other, that type is returned. String types widen to the longer of the two lengths (moving
+// to TEXT or BLOB when either side is stored out of band), integer types to the smallest integer or DECIMAL type
+// that covers both ranges, DECIMAL types to the precision and scale that cover both, and ENUM and SET types to the
+// union of their values. Types are never widened across character sets or collations.
+func WidenTypes(a, b typeinfo.TypeInfo) (typeinfo.TypeInfo, error) {
+	compatChecker := newDoltTypeCompatibilityChecker()
+	if compatChecker.IsTypeChangeCompatible(a, b).compatible {
+		return b, nil
+	} else if compatChecker.IsTypeChangeCompatible(b, a).compatible {
+		return a, nil
+	}
+
+	aSqlType, bSqlType := a.ToSqlType(), b.ToSqlType()
+	var widened sql.Type
+	var err error
+	switch {
+	case stringTypeChangeHandler{}.canHandle(aSqlType, bSqlType):
+		widened, err = widenStringTypes(aSqlType.(sql.StringType), bSqlType.(sql.StringType))
+	case types.IsEnum(aSqlType) && types.IsEnum(bSqlType):
+		ae, be := aSqlType.(sql.EnumType), bSqlType.(sql.EnumType)
+		if ae.Collation() == be.Collation() {
+			widened, err = types.CreateEnumType(unionValues(ae.Values(), be.Values()), ae.Collation())
+		}
+	case types.IsSet(aSqlType) && types.IsSet(bSqlType):
+		as, bs := aSqlType.(sql.SetType), bSqlType.(sql.SetType)
+		if as.Collation() == bs.Collation() {
+			widened, err = types.CreateSetType(unionValues(as.Values(), bs.Values()), as.Collation())
+		}
+	case types.IsFloat(aSqlType) && types.IsFloat(bSqlType):
+		widened = types.Float64
+	case isExactNumber(aSqlType) && isExactNumber(bSqlType):
+		widened, err = widenExactNumberTypes(aSqlType, bSqlType)
+	}
+	if err != nil || widened == nil {
+		return nil, ErrCannotWidenTypes.New(aSqlType.String(), bSqlType.String())
+	}
+	return typeinfo.FromSqlType(widened)
+}
+
+// widenStringTypes returns a string type with the collation of |a| and |b| that is long enough to hold the values of
+// both. Fixed width CHAR and BINARY types are only kept when both types are fixed width.
+func widenStringTypes(a, b sql.StringType) (sql.Type, error) {
+	if a.Collation() != b.Collation() {
+		return nil, nil
+	}
+	length := a.MaxCharacterLength()
+	if b.MaxCharacterLength() > length {
+		length = b.MaxCharacterLength()
+	}
+
+	binary := a.Type() == sqltypes.VarBinary || a.Type() == sqltypes.Binary || a.Type() == sqltypes.Blob
+	var baseType query.Type
+	switch {
+	case outOfBandType(a) || outOfBandType(b):
+		baseType = sqltypes.Text
+		if binary {
+			baseType = sqltypes.Blob
+		}
+	case a.Type() == b.Type() && (a.Type() == sqltypes.Char || a.Type() == sqltypes.Binary):
+		baseType = a.Type()
+	default:
+		baseType = sqltypes.VarChar
+		if binary {
+			baseType = sqltypes.VarBinary
+		}
+	}
+
+	widened, err := types.CreateString(baseType, length, a.Collation())
+	if err != nil && (baseType == sqltypes.VarChar || baseType == sqltypes.VarBinary) {
+		// too long to be stored inline, so move the values out of band
+		if binary {
+			return types.CreateString(sqltypes.Blob, length, a.Collation())
+		}
+		return types.CreateString(sqltypes.Text, length, a.Collation())
+	}
+	return widened, err
+}
+
+// unionValues returns |a| followed by the values of |b| that are not in |a|.
+func unionValues(a, b []string) []string {
+	union := append([]string{}, a...)
+	seen := make(map[string]bool, len(a))
+	for _, v := range a {
+		seen[v] = true
+	}
+	for _, v := range b {
+		if !seen[v] {
+			union = append(union, v)
+		}
+	}
+	return union
+}
+
+// integerTypes lists the integer types from narrowest to widest, with the number of decimal digits needed to hold
+// any of their values.
+var integerTypes = []struct {
+	typ    sql.Type
+	digits uint8
+}{
+	{types.Int8, 3}, {types.Uint8, 3},
+	{types.Int16, 5}, {types.Uint16, 5},
+	{types.Int24, 7}, {types.Uint24, 8},
+	{types.Int32, 10}, {types.Uint32, 10},
+	{types.Int64, 19}, {types.Uint64, 20},
+}
+
+func isExactNumber(t sql.Type) bool {
+	return types.IsInteger(t) || types.IsDecimal(t)
+}
+
+// widenExactNumberTypes widens two integer or DECIMAL types. Two integer types widen to the narrowest integer type
+// whose range covers both, falling back to DECIMAL when no integer type does.
+func widenExactNumberTypes(a, b sql.Type) (sql.Type, error) {
+	if types.IsInteger(a) && types.IsInteger(b) {
+		for _, it := range integerTypes {
+			if integerTypeCovers(it.typ, a) && integerTypeCovers(it.typ, b) {
+				return it.typ, nil
+			}
+		}
+	}
+
+	aIntDigits, aScale := decimalDigits(a)
+	bIntDigits, bScale := decimalDigits(b)
+	intDigits, scale := aIntDigits, aScale
+	if bIntDigits > intDigits {
+		intDigits = bIntDigits
+	}
+	if bScale > scale {
+		scale = bScale
+	}
+	if uint(intDigits)+uint(scale) > types.DecimalTypeMaxPrecision {
+		return nil, nil
+	}
+	return types.CreateColumnDecimalType(intDigits+scale, scale)
+}
+
+// integerTypeCovers returns whether integer type |wide| can hold every value of integer type |narrow|.
+func integerTypeCovers(wide, narrow sql.Type) bool {
+	wideMin, wideMax := integerRange(wide)
+	narrowMin, narrowMax := integerRange(narrow)
+	return wideMin <= narrowMin && wideMax >= narrowMax
+}
+
+// integerRange returns the smallest and largest values of integer type |t|.
+func integerRange(t sql.Type) (min, max float64) {
+	switch t.Type() {
+	case sqltypes.Int8:
+		return math.MinInt8, math.MaxInt8
+	case sqltypes.Uint8:
+		return 0, math.MaxUint8
+	case sqltypes.Int16:
+		return math.MinInt16, math.MaxInt16
+	case sqltypes.Uint16:
+		return 0, math.MaxUint16
+	case sqltypes.Int24:
+		return -(1 << 23), 1<<23 - 1
+	case sqltypes.Uint24:
+		return 0, 1<<24 - 1
+	case sqltypes.Int32:
+		return math.MinInt32, math.MaxInt32
+	case sqltypes.Uint32:
+		return 0, math.MaxUint32
+	case sqltypes.Int64:
+		return math.MinInt64, math.MaxInt64
+	default:
+		return 0, math.MaxUint64
+	}
+}
+
+// decimalDigits returns the number of integer and fractional decimal digits needed to hold the values of the integer
+// or DECIMAL type |t|.
+func decimalDigits(t sql.Type) (intDigits, scale uint8) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return dt.Precision() - dt.Scale(), dt.Scale()
+	}
+	for _, it := range integerTypes {
+		if it.typ.Type() == t.Type() {
+			return it.digits, 0
+		}
+	}
+	return 0, 0
+}
@@ -316,6 +316,72 @@ func TestDoltIsTypeChangeCompatible(t *testing.T) {
 	})
 }
 
+func TestWidenTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     typeinfo.TypeInfo
+		expected string
+	}{
+		{
+			name:     "compatible types widen to the wider type",
+			a:        varchar20,
+			b:        varchar10,
+			expected: "varchar(20)",
+		}, {
+			name:     "ints widen to the larger type",
+			a:        typeinfo.Int16Type,
+			b:        typeinfo.Int64Type,
+			expected: "bigint",
+		}, {
+			name:     "signed and unsigned ints widen to a type covering both ranges",
+			a:        typeinfo.Int32Type,
+			b:        typeinfo.Uint32Type,
+			expected: "bigint",
+		}, {
+			name:     "unsigned bigint and signed ints widen to decimal",
+			a:        typeinfo.Int8Type,
+			b:        typeinfo.Uint64Type,
+			expected: "decimal(20,0)",
+		}, {
+			name:     "floats widen to double",
+			a:        typeinfo.Float32Type,
+			b:        typeinfo.Float64Type,
+			expected: "double",
+		}, {
+			name:     "enums widen to the union of their values",
+			a:        abcEnum,
+			b:        acdEnum,
+			expected: "enum('a','b','c','d')",
+		}, {
+			name:     "sets widen to the union of their values",
+			a:        acdSet,
+			b:        abcSet,
+			expected: "set('a','c','d','b')",
+		}, {
+			name: "types can't be widened across character sets",
+			a:    varchar10utf16bin,
+			b:    varchar20,
+		}, {
+			name: "unrelated types can't be widened",
+			a:    varchar10,
+			b:    typeinfo.Int32Type,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widened, err := WidenTypes(tt.a, tt.b)
+			if tt.expected == "" {
+				assert.True(t, ErrCannotWidenTypes.Is(err))
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, widened.ToSqlType().String())
+			}
+		})
+	}
+}
+
 func runTypeCompatibilityTests(t *testing.T, compatChecker TypeCompatibilityChecker, tests []typeChangeCompatibilityTest) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
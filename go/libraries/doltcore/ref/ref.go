@@ -60,6 +60,9 @@ const (
 
 	// TupleRefType is a reference to a statistics table
 	TupleRefType RefType = "tuples"
+
+	// NotesRefType is a reference to the history of a set of commit notes
+	NotesRefType RefType = "notes"
 )
 
 // HeadRefTypes are the ref types that point to a HEAD and contain a Commit struct. These are the types that are
@@ -77,6 +80,12 @@ var StashRefTypes = map[RefType]struct{}{
 	StashRefType: {},
 }
 
+// NotesRefTypes point to a Commit, but one whose history is that of a set of notes rather than of a branch, so they
+// aren't HeadRefTypes.
+var NotesRefTypes = map[RefType]struct{}{
+	NotesRefType: {},
+}
+
 // StatsRefTypes point to a table address hash, not a commit hash.
 var StatsRefTypes = map[RefType]struct{}{
 	StatsRefType: {},
@@ -170,6 +179,10 @@ func Parse(str string) (DoltRef, error) {
 		}
 	}
 
+	if strings.HasPrefix(str, notesDatasetPrefix) {
+		return NewNotesRef(str[len(notesDatasetPrefix):]), nil
+	}
+
 	for rType := range HeadRefTypes {
 		prefix := PrefixForType(rType)
 		if strings.HasPrefix(str, prefix) {
@@ -212,5 +225,9 @@ func Parse(str string) (DoltRef, error) {
 		return NewTupleRef(str[len(prefix):]), nil
 	}
 
+	if prefix := PrefixForType(NotesRefType); strings.HasPrefix(str, prefix) {
+		return NewNotesRef(str), nil
+	}
+
 	return nil, ErrUnknownRefType
 }
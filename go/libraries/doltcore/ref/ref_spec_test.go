@@ -87,6 +87,21 @@ func TestRefSpec(t *testing.T) {
 				"refs/tags/v1": "refs/tags/v1",
 			},
 			skip: true,
+		}, {
+			refSpecStr: "refs/notes/commits",
+			isValid:    true,
+			inToExpOut: map[string]string{
+				"refs/notes/commits": "refs/notes/commits",
+				"refs/notes/other":   "refs/nil/",
+			},
+		}, {
+			refSpecStr: "refs/notes/commits:refs/notes/review",
+			isValid:    true,
+			inToExpOut: map[string]string{
+				"refs/notes/commits": "refs/notes/review",
+			},
+		}, {
+			refSpecStr: "refs/notes/commits:refs/heads/main",
 		},
 	}
 
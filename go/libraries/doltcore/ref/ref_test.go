@@ -46,6 +46,10 @@ func TestJsonMarshalAndUnmarshal(t *testing.T) {
 			NewWorkspaceRef("newworkspace"),
 			`{"test":"refs/workspaces/newworkspace"}`,
 		},
+		{
+			NewNotesRef(DefaultNotesName),
+			`{"test":"refs/notes/commits"}`,
+		},
 	}
 
 	for _, test := range tests {
@@ -160,6 +164,21 @@ func TestEqualsStr(t *testing.T) {
 			"refs/remotes/origin/newworkspace",
 			false,
 		},
+		{
+			NewNotesRef(DefaultNotesName),
+			"refs/notes/commits",
+			true,
+		},
+		{
+			NewNotesRef(DefaultNotesName),
+			"refs/internal/notes/commits",
+			true,
+		},
+		{
+			NewNotesRef(DefaultNotesName),
+			"refs/internal/commits",
+			false,
+		},
 	}
 
 	for _, test := range tests {
@@ -170,3 +189,30 @@ func TestEqualsStr(t *testing.T) {
 		}
 	}
 }
+
+func TestDatasetID(t *testing.T) {
+	tests := []struct {
+		dr       DoltRef
+		expected string
+	}{
+		{NewBranchRef(defaultBranch), "refs/heads/main"},
+		{NewTagRef("v1"), "refs/tags/v1"},
+		{NewInternalRef("create"), "refs/internal/create"},
+		{NewNotesRef(DefaultNotesName), "refs/internal/notes/commits"},
+		{NewNotesRef("refs/notes/review"), "refs/internal/notes/review"},
+	}
+
+	for _, test := range tests {
+		actual := DatasetID(test.dr)
+		if actual != test.expected {
+			t.Error(actual, "!=", test.expected)
+		}
+
+		parsed, err := Parse(actual)
+		if err != nil {
+			t.Error(err)
+		} else if !Equals(parsed, test.dr) {
+			t.Error(parsed, "!=", test.dr)
+		}
+	}
+}
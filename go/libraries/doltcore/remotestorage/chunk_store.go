@@ -437,6 +437,12 @@ func (gr *GetRange) GetDownloadFunc(ctx context.Context, stats StatsRecorder, he
 		return func() error { return nil }
 	}
 	return func() error {
+		ctx, span := tracer.Start(ctx, "remotestorage.GetRange", trace.WithAttributes(
+			attribute.String("resource_path", gr.ResourcePath()),
+			attribute.Int("num_chunks", gr.NumChunks()),
+			attribute.Int64("range_len", int64(gr.RangeLen())),
+		))
+		defer span.End()
 		urlF := func(lastError error) (string, error) {
 			url, err := pathToUrl(ctx, lastError, gr.ResourcePath())
 			if err != nil {
@@ -0,0 +1,59 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotestorage
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// TracingUnaryClientInterceptor records a span for every unary remotesapi RPC. The span is a child of whatever
+// span is active in the calling context, so remote fetch time can be attributed to the query which caused it.
+func TracingUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, span := tracer.Start(ctx, "remotestorage.rpc", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.target", cc.Target()),
+	))
+	defer span.End()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	recordRPCError(span, err)
+	return err
+}
+
+// TracingStreamClientInterceptor records a span covering the establishment of every streaming remotesapi RPC.
+func TracingStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := tracer.Start(ctx, "remotestorage.stream", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.target", cc.Target()),
+	))
+	defer span.End()
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	recordRPCError(span, err)
+	return cs, err
+}
+
+func recordRPCError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -402,6 +402,18 @@ func serializeSecondaryIndexes(b *fb.Builder, sch schema.Schema, indexes []schem
 		}
 		ko := b.EndVector(len(tags))
 
+		// serialize included columns, omitted when empty to keep existing schemas byte-identical
+		var vo fb.UOffsetT
+		included := idx.IncludedColumnTags()
+		if len(included) > 0 {
+			serial.IndexStartValueColumnsVector(b, len(included))
+			for j := len(included) - 1; j >= 0; j-- {
+				pos := ordinalMap[included[j]]
+				b.PrependUint16(uint16(pos))
+			}
+			vo = b.EndVector(len(included))
+		}
+
 		// serialize prefix lengths
 		prefixLengths := idx.PrefixLengths()
 		serial.IndexStartPrefixLengthsVector(b, len(prefixLengths))
@@ -410,6 +422,11 @@ func serializeSecondaryIndexes(b *fb.Builder, sch schema.Schema, indexes []schem
 		}
 		po := b.EndVector(len(prefixLengths))
 
+		var pro fb.UOffsetT
+		if idx.Predicate() != "" {
+			pro = b.CreateString(idx.Predicate())
+		}
+
 		var ftInfo fb.UOffsetT
 		if idx.IsFullText() {
 			ftInfo = serializeFullTextInfo(b, idx)
@@ -420,6 +437,9 @@ func serializeSecondaryIndexes(b *fb.Builder, sch schema.Schema, indexes []schem
 		serial.IndexAddComment(b, co)
 		serial.IndexAddIndexColumns(b, ico)
 		serial.IndexAddKeyColumns(b, ko)
+		if len(included) > 0 {
+			serial.IndexAddValueColumns(b, vo)
+		}
 		serial.IndexAddPrimaryKey(b, false)
 		serial.IndexAddUniqueKey(b, idx.IsUnique())
 		serial.IndexAddSystemDefined(b, !idx.IsUserDefined())
@@ -429,6 +449,10 @@ func serializeSecondaryIndexes(b *fb.Builder, sch schema.Schema, indexes []schem
 		if idx.IsFullText() {
 			serial.IndexAddFulltextInfo(b, ftInfo)
 		}
+		serial.IndexAddColumnarKey(b, idx.IsColumnar())
+		if idx.Predicate() != "" {
+			serial.IndexAddPredicate(b, pro)
+		}
 		offs[i] = serial.IndexEnd(b)
 	}
 
@@ -459,8 +483,10 @@ func deserializeSecondaryIndexes(sch schema.Schema, s *serial.TableSchema) error
 			IsUnique:           idx.UniqueKey(),
 			IsSpatial:          idx.SpatialKey(),
 			IsFullText:         idx.FulltextKey(),
+			IsColumnar:         idx.ColumnarKey(),
 			IsUserDefined:      !idx.SystemDefined(),
 			Comment:            string(idx.Comment()),
+			Predicate:          string(idx.Predicate()),
 			FullTextProperties: fti,
 		}
 
@@ -474,6 +500,14 @@ func deserializeSecondaryIndexes(sch schema.Schema, s *serial.TableSchema) error
 			tags[j] = col.Tag()
 		}
 
+		for j := 0; j < idx.ValueColumnsLength(); j++ {
+			_, err := s.TryColumns(&col, int(idx.ValueColumns(j)))
+			if err != nil {
+				return err
+			}
+			props.IncludedTags = append(props.IncludedTags, col.Tag())
+		}
+
 		var prefixLengths []uint16
 		prefixLengthsLength := idx.PrefixLengthsLength()
 		if prefixLengthsLength > 0 {
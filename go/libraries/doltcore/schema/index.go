@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -41,12 +42,19 @@ type Index interface {
 	GetColumn(tag uint64) (Column, bool)
 	// IndexedColumnTags returns the tags of the columns in the index.
 	IndexedColumnTags() []uint64
+	// IncludedColumnTags returns the tags of the non-indexed columns whose values are stored in the index, so that
+	// the index covers them.
+	IncludedColumnTags() []uint64
+	// Predicate returns the filter expression of a partial index, or an empty string if every row is indexed.
+	Predicate() string
 	// IsUnique returns whether the given index has the UNIQUE constraint.
 	IsUnique() bool
 	// IsSpatial returns whether the given index has the SPATIAL constraint.
 	IsSpatial() bool
 	// IsFullText returns whether the given index has the FULLTEXT constraint.
 	IsFullText() bool
+	// IsColumnar returns whether the given index is a columnar projection of its columns, rather than a row index.
+	IsColumnar() bool
 	// IsUserDefined returns whether the given index was created by a user or automatically generated.
 	IsUserDefined() bool
 	// Name returns the name of the index.
@@ -74,10 +82,13 @@ type indexImpl struct {
 	isUnique      bool
 	isSpatial     bool
 	isFullText    bool
+	isColumnar    bool
 	isUserDefined bool
 	comment       string
 	prefixLengths []uint16
 	fullTextProps FullTextProperties
+	includedTags  []uint64
+	predicate     string
 }
 
 func NewIndex(name string, tags, allTags []uint64, indexColl IndexCollection, props IndexProperties) Index {
@@ -94,9 +105,12 @@ func NewIndex(name string, tags, allTags []uint64, indexColl IndexCollection, pr
 		isUnique:      props.IsUnique,
 		isSpatial:     props.IsSpatial,
 		isFullText:    props.IsFullText,
+		isColumnar:    props.IsColumnar,
 		isUserDefined: props.IsUserDefined,
 		comment:       props.Comment,
 		fullTextProps: props.FullTextProperties,
+		includedTags:  props.IncludedTags,
+		predicate:     props.Predicate,
 	}
 }
 
@@ -141,7 +155,10 @@ func (ix *indexImpl) Equals(other Index) bool {
 
 	return ix.IsUnique() == other.IsUnique() &&
 		ix.IsSpatial() == other.IsSpatial() &&
+		ix.IsColumnar() == other.IsColumnar() &&
 		compareUint16Slices(ix.PrefixLengths(), other.PrefixLengths()) &&
+		compareUint64Slices(ix.IncludedColumnTags(), other.IncludedColumnTags()) &&
+		ix.Predicate() == other.Predicate() &&
 		ix.Comment() == other.Comment() &&
 		ix.Name() == other.Name()
 }
@@ -163,7 +180,10 @@ func (ix *indexImpl) DeepEquals(other Index) bool {
 
 	return ix.IsUnique() == other.IsUnique() &&
 		ix.IsSpatial() == other.IsSpatial() &&
+		ix.IsColumnar() == other.IsColumnar() &&
 		compareUint16Slices(ix.PrefixLengths(), other.PrefixLengths()) &&
+		compareUint64Slices(ix.IncludedColumnTags(), other.IncludedColumnTags()) &&
+		ix.Predicate() == other.Predicate() &&
 		ix.Comment() == other.Comment() &&
 		ix.Name() == other.Name()
 }
@@ -184,6 +204,22 @@ func compareUint16Slices(a, b []uint16) bool {
 	return true
 }
 
+// compareUint64Slices returns true if |a| and |b| contain the exact same uint64 values, in the same order; otherwise
+// it returns false.
+func compareUint64Slices(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // GetColumn implements Index.
 func (ix *indexImpl) GetColumn(tag uint64) (Column, bool) {
 	return ix.indexColl.colColl.GetByTag(tag)
@@ -194,6 +230,25 @@ func (ix *indexImpl) IndexedColumnTags() []uint64 {
 	return ix.tags
 }
 
+// IncludedColumnTags implements Index.
+func (ix *indexImpl) IncludedColumnTags() []uint64 {
+	return ix.includedTags
+}
+
+// Predicate implements Index.
+func (ix *indexImpl) Predicate() string {
+	return ix.predicate
+}
+
+// referencedTags returns the tags of every column whose values are stored in the index, not including the table's
+// primary key columns.
+func (ix *indexImpl) referencedTags() []uint64 {
+	if len(ix.includedTags) == 0 {
+		return ix.tags
+	}
+	return append(append(make([]uint64, 0, len(ix.tags)+len(ix.includedTags)), ix.tags...), ix.includedTags...)
+}
+
 // IsUnique implements Index.
 func (ix *indexImpl) IsUnique() bool {
 	return ix.isUnique
@@ -209,6 +264,11 @@ func (ix *indexImpl) IsFullText() bool {
 	return ix.isFullText
 }
 
+// IsColumnar implements Index.
+func (ix *indexImpl) IsColumnar() bool {
+	return ix.isColumnar
+}
+
 // IsUserDefined implements Index.
 func (ix *indexImpl) IsUserDefined() bool {
 	return ix.isUserDefined
@@ -226,6 +286,9 @@ func (ix *indexImpl) PrimaryKeyTags() []uint64 {
 
 // Schema implements Index.
 func (ix *indexImpl) Schema() Schema {
+	if ix.isColumnar {
+		return ix.columnarSchema()
+	}
 	contentHashedFields := make([]uint64, 0)
 	cols := make([]Column, len(ix.allTags))
 	for i, tag := range ix.allTags {
@@ -250,10 +313,26 @@ func (ix *indexImpl) Schema() Schema {
 			contentHashedFields = append(contentHashedFields, tag)
 		}
 	}
-	allCols := NewColCollection(cols...)
+	pkCols := NewColCollection(cols...)
+	allCols := pkCols
 	nonPkCols := NewColCollection()
+	if len(ix.includedTags) > 0 {
+		// included columns are stored in the index's value tuples
+		valCols := make([]Column, len(ix.includedTags))
+		for i, tag := range ix.includedTags {
+			col := ix.indexColl.colColl.TagToCol[tag]
+			valCols[i] = Column{
+				Name:     col.Name,
+				Tag:      tag,
+				Kind:     col.Kind,
+				TypeInfo: col.TypeInfo,
+			}
+		}
+		nonPkCols = NewColCollection(valCols...)
+		allCols = NewColCollection(append(cols, valCols...)...)
+	}
 	return &schemaImpl{
-		pkCols:              allCols,
+		pkCols:              pkCols,
 		nonPKCols:           nonPkCols,
 		allCols:             allCols,
 		indexCollection:     NewIndexCollection(nil, nil),
@@ -262,6 +341,41 @@ func (ix *indexImpl) Schema() Schema {
 	}
 }
 
+// columnarSchema returns the schema of a columnar index map. Each entry of the map is a chunk of consecutive table
+// rows, keyed by the primary key of its first row, whose value holds one blob of encoded values per indexed column.
+func (ix *indexImpl) columnarSchema() Schema {
+	pkCols := make([]Column, len(ix.indexColl.pks))
+	for i, tag := range ix.indexColl.pks {
+		col := ix.indexColl.colColl.TagToCol[tag]
+		pkCols[i] = Column{
+			Name:       col.Name,
+			Tag:        tag,
+			Kind:       col.Kind,
+			IsPartOfPK: true,
+			TypeInfo:   col.TypeInfo,
+		}
+	}
+	valCols := make([]Column, len(ix.tags))
+	for i, tag := range ix.tags {
+		col := ix.indexColl.colColl.TagToCol[tag]
+		valCols[i] = Column{
+			Name:     col.Name,
+			Tag:      tag,
+			Kind:     types.BlobKind,
+			TypeInfo: typeinfo.LongBlobType,
+		}
+	}
+	pkColl := NewColCollection(pkCols...)
+	nonPkColl := NewColCollection(valCols...)
+	return &schemaImpl{
+		pkCols:          pkColl,
+		nonPKCols:       nonPkColl,
+		allCols:         NewColCollection(append(pkCols, valCols...)...),
+		indexCollection: NewIndexCollection(nil, nil),
+		checkCollection: NewCheckCollection(),
+	}
+}
+
 // ToTableTuple implements Index.
 func (ix *indexImpl) ToTableTuple(ctx context.Context, fullKey types.Tuple, format *types.NomsBinFormat) (types.Tuple, error) {
 	pkTags := make(map[uint64]int)
@@ -320,6 +434,10 @@ func (ix *indexImpl) copy() *indexImpl {
 		newIx.prefixLengths = make([]uint16, len(ix.prefixLengths))
 		_ = copy(newIx.prefixLengths, ix.prefixLengths)
 	}
+	if len(ix.includedTags) > 0 {
+		newIx.includedTags = make([]uint64, len(ix.includedTags))
+		_ = copy(newIx.includedTags, ix.includedTags)
+	}
 	if len(newIx.fullTextProps.KeyPositions) > 0 {
 		newIx.fullTextProps.KeyPositions = make([]uint16, len(ix.fullTextProps.KeyPositions))
 		_ = copy(newIx.fullTextProps.KeyPositions, ix.fullTextProps.KeyPositions)
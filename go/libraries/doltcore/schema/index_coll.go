@@ -72,6 +72,8 @@ type IndexCollection interface {
 	SetPks([]uint64) error
 	// ContainsFullTextIndex returns whether the collection contains at least one Full-Text index.
 	ContainsFullTextIndex() bool
+	// ContainsColumnarIndex returns whether the collection contains at least one columnar index.
+	ContainsColumnarIndex() bool
 	// Copy returns a copy of this index collection that can be modified without affecting the original.
 	Copy() IndexCollection
 }
@@ -80,8 +82,13 @@ type IndexProperties struct {
 	IsUnique      bool
 	IsSpatial     bool
 	IsFullText    bool
+	IsColumnar    bool
 	IsUserDefined bool
 	Comment       string
+	// IncludedTags are the tags of non-indexed columns whose values are stored in the index
+	IncludedTags []uint64
+	// Predicate is the filter expression of a partial index, empty for indexes on every row
+	Predicate string
 	FullTextProperties
 }
 
@@ -174,7 +181,7 @@ func (ixc *indexCollectionImpl) AddIndex(indexes ...Index) {
 			ixc.removeIndex(oldNamedIndex)
 		}
 		ixc.indexes[lowerName] = index
-		for _, tag := range index.tags {
+		for _, tag := range index.referencedTags() {
 			ixc.colTagToIndex[tag] = append(ixc.colTagToIndex[tag], index)
 		}
 	}
@@ -199,6 +206,9 @@ func (ixc *indexCollectionImpl) AddIndexByColTags(indexName string, tags []uint6
 	if !ixc.tagsExist(tags...) {
 		return nil, fmt.Errorf("tags %v do not exist on this table", tags)
 	}
+	if len(props.IncludedTags) > 0 && !ixc.tagsExist(props.IncludedTags...) {
+		return nil, fmt.Errorf("tags %v do not exist on this table", props.IncludedTags)
+	}
 
 	for _, tag := range tags {
 		// we already validated the tag exists
@@ -217,13 +227,16 @@ func (ixc *indexCollectionImpl) AddIndexByColTags(indexName string, tags []uint6
 		isUnique:      props.IsUnique,
 		isSpatial:     props.IsSpatial,
 		isFullText:    props.IsFullText,
+		isColumnar:    props.IsColumnar,
 		isUserDefined: props.IsUserDefined,
 		comment:       props.Comment,
 		prefixLengths: prefixLengths,
 		fullTextProps: props.FullTextProperties,
+		includedTags:  props.IncludedTags,
+		predicate:     props.Predicate,
 	}
 	ixc.indexes[lowerName] = index
-	for _, tag := range tags {
+	for _, tag := range index.referencedTags() {
 		ixc.colTagToIndex[tag] = append(ixc.colTagToIndex[tag], index)
 	}
 	return index, nil
@@ -243,13 +256,16 @@ func (ixc *indexCollectionImpl) UnsafeAddIndexByColTags(indexName string, tags [
 		isUnique:      props.IsUnique,
 		isSpatial:     props.IsSpatial,
 		isFullText:    props.IsFullText,
+		isColumnar:    props.IsColumnar,
 		isUserDefined: props.IsUserDefined,
 		comment:       props.Comment,
 		prefixLengths: prefixLengths,
 		fullTextProps: props.FullTextProperties,
+		includedTags:  props.IncludedTags,
+		predicate:     props.Predicate,
 	}
 	ixc.indexes[strings.ToLower(indexName)] = index
-	for _, tag := range tags {
+	for _, tag := range index.referencedTags() {
 		ixc.colTagToIndex[tag] = append(ixc.colTagToIndex[tag], index)
 	}
 	return index, nil
@@ -284,8 +300,8 @@ func (ixc *indexCollectionImpl) Equals(other IndexCollection) bool {
 		return false
 	}
 	for _, index := range ixc.indexes {
-		otherIndex := otherIxc.containsColumnTagCollection(index.tags...)
-		if otherIndex == nil || !index.Equals(otherIndex) {
+		otherIndex, ok := otherIxc.indexes[strings.ToLower(index.name)]
+		if !ok || !index.Equals(otherIndex) {
 			return false
 		}
 	}
@@ -402,7 +418,16 @@ func (ixc *indexCollectionImpl) Iter(cb func(index Index) (stop bool, err error)
 
 func (ixc *indexCollectionImpl) Merge(indexes ...Index) {
 	for _, index := range indexes {
-		if tags, ok := ixc.columnNamesToTags(index.ColumnNames()); ok && !ixc.Contains(index.Name()) {
+		includedNames := make([]string, len(index.IncludedColumnTags()))
+		for i, tag := range index.IncludedColumnTags() {
+			col, _ := index.GetColumn(tag)
+			includedNames[i] = col.Name
+		}
+		includedTags, includedOk := ixc.columnNamesToTags(includedNames)
+		if tags, ok := ixc.columnNamesToTags(index.ColumnNames()); ok && includedOk && !ixc.Contains(index.Name()) {
+			if len(includedTags) == 0 {
+				includedTags = nil
+			}
 			newIndex := &indexImpl{
 				name:          index.Name(),
 				tags:          tags,
@@ -410,10 +435,13 @@ func (ixc *indexCollectionImpl) Merge(indexes ...Index) {
 				isUnique:      index.IsUnique(),
 				isSpatial:     index.IsSpatial(),
 				isFullText:    index.IsFullText(),
+				isColumnar:    index.IsColumnar(),
 				isUserDefined: index.IsUserDefined(),
 				comment:       index.Comment(),
 				prefixLengths: index.PrefixLengths(),
 				fullTextProps: index.FullTextProperties(),
+				includedTags:  includedTags,
+				predicate:     index.Predicate(),
 			}
 			ixc.AddIndex(newIndex)
 		}
@@ -427,7 +455,7 @@ func (ixc *indexCollectionImpl) RemoveIndex(indexName string) (Index, error) {
 	}
 	index := ixc.indexes[lowerName]
 	delete(ixc.indexes, lowerName)
-	for _, tag := range index.tags {
+	for _, tag := range index.referencedTags() {
 		indexesRefThisCol := ixc.colTagToIndex[tag]
 		for i, comparisonIndex := range indexesRefThisCol {
 			if comparisonIndex == index {
@@ -471,6 +499,10 @@ func (ixc *indexCollectionImpl) columnNamesToTags(cols []string) ([]uint64, bool
 func (ixc *indexCollectionImpl) containsColumnTagCollection(tags ...uint64) *indexImpl {
 	tagCount := len(tags)
 	for _, idx := range ixc.indexes {
+		// columnar and partial indexes can't serve every lookup, so they never stand in for an index on |tags|
+		if idx.isColumnar || idx.predicate != "" {
+			continue
+		}
 		if tagCount == len(idx.tags) {
 			allMatch := true
 			for i, idxTag := range idx.tags {
@@ -489,7 +521,7 @@ func (ixc *indexCollectionImpl) containsColumnTagCollection(tags ...uint64) *ind
 
 func (ixc *indexCollectionImpl) removeIndex(index *indexImpl) {
 	delete(ixc.indexes, strings.ToLower(index.name))
-	for _, tag := range index.tags {
+	for _, tag := range index.referencedTags() {
 		var newReferences []*indexImpl
 		for _, referencedIndex := range ixc.colTagToIndex[tag] {
 			if referencedIndex != index {
@@ -530,6 +562,15 @@ func (ixc *indexCollectionImpl) ContainsFullTextIndex() bool {
 	return false
 }
 
+func (ixc *indexCollectionImpl) ContainsColumnarIndex() bool {
+	for _, idx := range ixc.indexes {
+		if idx.isColumnar {
+			return true
+		}
+	}
+	return false
+}
+
 // TableNameSlice returns the table names as a slice, which may be used to easily grab all of the tables using a for loop.
 func (props FullTextProperties) TableNameSlice() []string {
 	return []string{
@@ -525,3 +525,72 @@ func (ixc *indexCollectionImpl) clear(_ *testing.T) {
 		ixc.colTagToIndex[key] = nil
 	}
 }
+
+func TestIndexCollectionIncludedColumns(t *testing.T) {
+	colColl := NewColCollection(
+		NewColumn("pk1", 1, types.IntKind, true, NotNullConstraint{}),
+		NewColumn("v1", 3, types.IntKind, false),
+		NewColumn("v2", 4, types.UintKind, false),
+		NewColumn("v3", 5, types.StringKind, false),
+	)
+	indexColl := NewIndexCollection(colColl, nil)
+
+	_, err := indexColl.AddIndexByColNames("idx_bad", []string{"v1"}, nil, IndexProperties{IncludedTags: []uint64{9}})
+	require.Error(t, err)
+
+	index, err := indexColl.AddIndexByColNames("idx_v1", []string{"v1"}, nil, IndexProperties{IncludedTags: []uint64{5, 4}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3}, index.IndexedColumnTags())
+	assert.Equal(t, []uint64{3, 1}, index.AllTags())
+	assert.Equal(t, []uint64{5, 4}, index.IncludedColumnTags())
+
+	// included columns are stored in the index's values
+	sch := index.Schema()
+	assert.Equal(t, []uint64{3, 1}, sch.GetPKCols().Tags)
+	assert.Equal(t, []uint64{5, 4}, sch.GetNonPKCols().Tags)
+
+	for _, tag := range []uint64{3, 4, 5} {
+		assert.Equal(t, []Index{index}, indexColl.IndexesWithTag(tag))
+	}
+
+	other := NewIndexCollection(colColl, nil)
+	otherIndex, err := other.AddIndexByColNames("idx_v1", []string{"v1"}, nil, IndexProperties{IncludedTags: []uint64{5}})
+	require.NoError(t, err)
+	assert.False(t, index.Equals(otherIndex))
+	assert.False(t, indexColl.Equals(other))
+
+	_, err = indexColl.RemoveIndex("idx_v1")
+	require.NoError(t, err)
+	for _, tag := range []uint64{3, 4, 5} {
+		assert.Empty(t, indexColl.IndexesWithTag(tag))
+	}
+}
+
+func TestIndexCollectionPartialIndexes(t *testing.T) {
+	colColl := NewColCollection(
+		NewColumn("pk1", 1, types.IntKind, true, NotNullConstraint{}),
+		NewColumn("v1", 3, types.IntKind, false),
+		NewColumn("v2", 4, types.StringKind, false),
+	)
+	indexColl := NewIndexCollection(colColl, nil)
+
+	partial, err := indexColl.AddIndexByColNames("idx_v1_open", []string{"v1"}, nil, IndexProperties{Predicate: "`v2` = 'open'"})
+	require.NoError(t, err)
+	assert.Equal(t, "`v2` = 'open'", partial.Predicate())
+
+	// a partial index doesn't hold every row, so it never stands in for an index on its columns
+	_, ok := indexColl.GetIndexByTags(3)
+	assert.False(t, ok)
+	index, err := indexColl.AddIndexByColNames("idx_v1", []string{"v1"}, nil, IndexProperties{})
+	require.NoError(t, err)
+	assert.Equal(t, "", index.Predicate())
+	found, ok := indexColl.GetIndexByTags(3)
+	require.True(t, ok)
+	assert.Equal(t, index, found)
+
+	other := NewIndexCollection(colColl, nil)
+	otherIndex, err := other.AddIndexByColNames("idx_v1_open", []string{"v1"}, nil, IndexProperties{Predicate: "`v2` = 'closed'"})
+	require.NoError(t, err)
+	assert.False(t, partial.Equals(otherIndex))
+	assert.False(t, partial.DeepEquals(otherIndex))
+}
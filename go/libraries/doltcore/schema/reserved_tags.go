@@ -116,6 +116,14 @@ const (
 	DoltIgnoreIgnoredTag
 )
 
+// Tags for the table holding the notes of a notes ref
+const (
+	// NotesCommitHashTag is the tag of the column containing the hash of the annotated commit
+	NotesCommitHashTag = iota + SystemTableReservedMin + uint64(8500)
+	// NotesNoteTag is the tag of the column containing the note
+	NotesNoteTag
+)
+
 // Tags for dolt_ci_workflows table
 const (
 	// WorkflowsNameTag is the tag of the name column in the workflows table
@@ -300,6 +300,114 @@ func ArePrimaryKeySetsDiffable(format *types.NomsBinFormat, fromSch, toSch Schem
 	return true
 }
 
+// WithPrimaryKeyOf returns a copy of |sch| whose primary key is the primary key of |keySch|. Every primary key column
+// of |keySch| must exist in |sch| as a stored column with the same tag and SQL type; if one does not, or if |keySch|
+// is keyless, false is returned. As when a primary key is added to a table, the new key columns are made NOT NULL.
+func WithPrimaryKeyOf(sch, keySch Schema) (Schema, bool, error) {
+	if IsKeyless(keySch) {
+		return nil, false, nil
+	}
+
+	pkTags := keySch.GetPKCols().Tags
+	isKey := make(map[uint64]bool, len(pkTags))
+	for _, keyCol := range keySch.GetPKCols().GetColumns() {
+		col, ok := sch.GetAllCols().GetByTag(keyCol.Tag)
+		if !ok || col.Virtual || !col.TypeInfo.ToSqlType().Equals(keyCol.TypeInfo.ToSqlType()) {
+			return nil, false, nil
+		}
+		isKey[keyCol.Tag] = true
+	}
+
+	cols := make([]Column, 0, sch.GetAllCols().Size())
+	_ = sch.GetAllCols().Iter(func(tag uint64, col Column) (stop bool, err error) {
+		col.IsPartOfPK = isKey[tag]
+		if col.IsPartOfPK && columnMissingNotNullConstraint(col) {
+			col.Constraints = append(col.Constraints[:len(col.Constraints):len(col.Constraints)], NotNullConstraint{})
+		}
+		cols = append(cols, col)
+		return
+	})
+	allCols := NewColCollection(cols...)
+
+	pkCols := make([]Column, len(pkTags))
+	pkOrdinals := make([]int, len(pkTags))
+	for i, tag := range pkTags {
+		pkCols[i] = allCols.TagToCol[tag]
+		pkOrdinals[i] = allCols.TagToIdx[tag]
+	}
+
+	indexes := NewIndexCollection(allCols, NewColCollection(pkCols...))
+	indexes.AddIndex(sch.Indexes().AllIndexes()...)
+
+	rekeyed, err := NewSchema(allCols, pkOrdinals, sch.GetCollation(), indexes, sch.Checks())
+	if err != nil {
+		return nil, false, err
+	}
+	rekeyed.SetComment(sch.GetComment())
+	return rekeyed, true, nil
+}
+
+// WithColumnTags returns a copy of |sch| in which the tag of each column is replaced by its entry in |tags|. Columns
+// without an entry keep their tag. Tags identify columns across versions of a table, so this is used to line up
+// columns that were dropped and recreated with the columns they replaced. Row data does not depend on column tags,
+// so a table's rows are valid under either schema.
+func WithColumnTags(sch Schema, tags map[uint64]uint64) (Schema, error) {
+	retag := func(tag uint64) uint64 {
+		if t, ok := tags[tag]; ok {
+			return t
+		}
+		return tag
+	}
+
+	cols := make([]Column, 0, sch.GetAllCols().Size())
+	_ = sch.GetAllCols().Iter(func(tag uint64, col Column) (stop bool, err error) {
+		col.Tag = retag(tag)
+		cols = append(cols, col)
+		return
+	})
+	allCols := NewColCollection(cols...)
+	if allCols.Size() != sch.GetAllCols().Size() {
+		return nil, fmt.Errorf("duplicate tags when retagging columns of schema")
+	}
+
+	pkCols := make([]Column, 0, sch.GetPKCols().Size())
+	for _, tag := range sch.GetPKCols().Tags {
+		pkCols = append(pkCols, allCols.TagToCol[retag(tag)])
+	}
+	indexes := NewIndexCollection(allCols, NewColCollection(pkCols...))
+	for _, idx := range sch.Indexes().AllIndexes() {
+		idxTags := make([]uint64, len(idx.IndexedColumnTags()))
+		for i, tag := range idx.IndexedColumnTags() {
+			idxTags[i] = retag(tag)
+		}
+		var includedTags []uint64
+		for _, tag := range idx.IncludedColumnTags() {
+			includedTags = append(includedTags, retag(tag))
+		}
+		_, err := indexes.UnsafeAddIndexByColTags(idx.Name(), idxTags, idx.PrefixLengths(), IndexProperties{
+			IsUnique:           idx.IsUnique(),
+			IsSpatial:          idx.IsSpatial(),
+			IsFullText:         idx.IsFullText(),
+			IsColumnar:         idx.IsColumnar(),
+			IsUserDefined:      idx.IsUserDefined(),
+			Comment:            idx.Comment(),
+			IncludedTags:       includedTags,
+			Predicate:          idx.Predicate(),
+			FullTextProperties: idx.FullTextProperties(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	retagged, err := NewSchema(allCols, sch.GetPkOrdinals(), sch.GetCollation(), indexes, sch.Checks())
+	if err != nil {
+		return nil, err
+	}
+	retagged.SetComment(sch.GetComment())
+	return retagged, nil
+}
+
 // MapSchemaBasedOnTagAndName can be used to map column values from one schema
 // to another schema. A primary key column in |inSch| is mapped to |outSch| if
 // they share the same tag. A non-primary key column in |inSch| is mapped to
@@ -60,8 +60,42 @@ const (
 	DefaultMySQLUnixSocketFilePath = "/tmp/mysql.sock"
 	DefaultMaxLoggedQueryLen       = 0
 	DefaultEncodeLoggedQuery       = false
+	DefaultTracingSampleRate       = 1.0
+	DefaultTracingServiceName      = "dolt-sql-server"
+	DefaultAuditLogMaxSizeMB       = 100
+	DefaultAuditLogMaxBackups      = 5
+	DefaultSlowQueryThresholdMs    = 1000
+	DefaultSlowQueryMaxEntries     = 100
+	DefaultSlowQueryLogMaxSizeMB   = 100
+	DefaultSlowQueryLogMaxBackups  = 5
+	DefaultLDAPUserSearchFilter    = "(uid=%s)"
+	DefaultLDAPGroupSearchFilter   = "(member=%s)"
+	DefaultLDAPGroupNameAttribute  = "cn"
+	DefaultLDAPCacheTTLMillis      = 5 * 60 * 1000
 )
 
+// Statement classes which the audit log can be filtered by.
+const (
+	AuditClassRead        = "read"
+	AuditClassWrite       = "write"
+	AuditClassDDL         = "ddl"
+	AuditClassDCL         = "dcl"
+	AuditClassTransaction = "transaction"
+	AuditClassProcedure   = "procedure"
+	AuditClassOther       = "other"
+)
+
+// AuditStatementClasses are all the statement classes which may appear in an audit log's statement_classes filter.
+var AuditStatementClasses = []string{
+	AuditClassRead,
+	AuditClassWrite,
+	AuditClassDDL,
+	AuditClassDCL,
+	AuditClassTransaction,
+	AuditClassProcedure,
+	AuditClassOther,
+}
+
 func ptr[T any](t T) *T {
 	return &t
 }
@@ -110,6 +144,86 @@ type ClusterStandbyRemoteConfig interface {
 	RemoteURLTemplate() string
 }
 
+// TracingConfig configures the export of OpenTelemetry traces from the server.
+type TracingConfig interface {
+	// Endpoint is the OTLP/HTTP collector that spans are exported to, as either host:port or a URL. "" if none.
+	Endpoint() string
+	// Insecure is true if the collector at Endpoint should be contacted without TLS.
+	Insecure() bool
+	// SampleRate is the fraction, in [0, 1], of root spans which are sampled. Spans with a sampled
+	// parent, such as those propagated from a client, are always sampled.
+	SampleRate() float64
+	// FilePath is a local file that spans are appended to as JSON, mostly useful for testing. "" if none.
+	FilePath() string
+	// ServiceName is the service.name resource attribute reported with every span.
+	ServiceName() string
+}
+
+// AuditLogConfig configures the structured audit log, which records one JSON line for every statement executed.
+type AuditLogConfig interface {
+	// FilePath is the file that audit records are appended to.
+	FilePath() string
+	// MaxSizeMB is the size in megabytes at which the audit log file is rotated.
+	MaxSizeMB() int
+	// MaxBackups is the number of rotated audit log files which are retained.
+	MaxBackups() int
+	// Users, if non-empty, restricts the audit log to statements run by these users.
+	Users() []string
+	// ExcludeUsers are users whose statements are never audited.
+	ExcludeUsers() []string
+	// StatementClasses, if non-empty, restricts the audit log to statements in these classes. See
+	// AuditStatementClasses for the valid values.
+	StatementClasses() []string
+}
+
+// SlowQueryLogConfig configures the slow query log, which records the plan and execution details of statements that run
+// for longer than a threshold.
+type SlowQueryLogConfig interface {
+	// ThresholdMillis is the duration, in milliseconds, at or above which a statement is logged.
+	ThresholdMillis() int
+	// FilePath is the file that slow queries are appended to as JSON. "" to write them to the server log instead.
+	FilePath() string
+	// MaxSizeMB is the size in megabytes at which the slow query log file is rotated.
+	MaxSizeMB() int
+	// MaxBackups is the number of rotated slow query log files which are retained.
+	MaxBackups() int
+	// MaxEntries is the number of recent slow queries shown in the dolt_slow_queries system table.
+	MaxEntries() int
+}
+
+// LDAPConfig configures the authentication_dolt_ldap plugin, which authenticates users against an LDAP directory and
+// grants them roles and branch permissions based on their LDAP groups.
+type LDAPConfig interface {
+	// URL is the ldap:// or ldaps:// URL of the directory server.
+	URL() string
+	// StartTLS is true if connections made with an ldap:// URL should be upgraded with StartTLS.
+	StartTLS() bool
+	// InsecureSkipVerify is true if the directory server's certificate should not be verified.
+	InsecureSkipVerify() bool
+	// BindDN is the DN of the service account used to search the directory. "" to search as the authenticating user.
+	BindDN() string
+	// BindPassword is the password of the BindDN service account.
+	BindPassword() string
+	// UserDNTemplate is a template, with a single %s for the escaped user name, for the DN of each user. When set,
+	// users are authenticated with a simple bind to this DN; otherwise the user's DN is found by searching
+	// UserSearchBase.
+	UserDNTemplate() string
+	// UserSearchBase is the base DN searched for users when UserDNTemplate is not set.
+	UserSearchBase() string
+	// UserSearchFilter is the filter, with a single %s for the escaped user name, used to find users.
+	UserSearchFilter() string
+	// GroupSearchBase is the base DN searched for the groups of an authenticated user. "" if groups are not used.
+	GroupSearchBase() string
+	// GroupSearchFilter is the filter, with a single %s for the escaped user DN, used to find a user's groups.
+	GroupSearchFilter() string
+	// GroupNameAttribute is the attribute of a group entry which holds its name.
+	GroupNameAttribute() string
+	// CacheTTLMillis is how long, in milliseconds, a user's DN and groups are cached before being looked up again.
+	CacheTTLMillis() int
+	// GroupMappings are the roles and branch permissions granted to the members of each LDAP group.
+	GroupMappings() []LDAPGroupMapping
+}
+
 type JwksConfig struct {
 	Name        string            `yaml:"name"`
 	LocationUrl string            `yaml:"location_url"`
@@ -175,6 +289,8 @@ type ServerConfig interface {
 	BranchControlFilePath() string
 	// UserVars is an array containing user specific session variables
 	UserVars() []UserSessionVars
+	// UserLimits is an array of the resource limits placed on individual users and roles.
+	UserLimits() []UserLimits
 	// SystemVars is a map setting global SQL system variables. For example, `secure_file_priv`.
 	SystemVars() map[string]interface{}
 	// JwksConfig is an array containing jwks config
@@ -192,6 +308,18 @@ type ServerConfig interface {
 	RemotesapiReadOnly() *bool
 	// ClusterConfig is the configuration for clustering in this sql-server.
 	ClusterConfig() ClusterConfig
+	// TracingConfig is the configuration for exporting OpenTelemetry traces from this sql-server. nil if tracing is
+	// not enabled.
+	TracingConfig() TracingConfig
+	// AuditLogConfig is the configuration for the structured statement audit log of this sql-server. nil if audit
+	// logging is not enabled.
+	AuditLogConfig() AuditLogConfig
+	// SlowQueryLogConfig is the configuration for the slow query log of this sql-server. nil if the slow query log is
+	// not enabled.
+	SlowQueryLogConfig() SlowQueryLogConfig
+	// LDAPConfig is the configuration for authenticating users of this sql-server against an LDAP directory. nil if
+	// LDAP authentication is not enabled.
+	LDAPConfig() LDAPConfig
 	// EventSchedulerStatus is the configuration for enabling or disabling the event scheduler in this server.
 	EventSchedulerStatus() string
 	// ValueSet returns whether the value string provided was explicitly set in the config
@@ -261,9 +389,165 @@ func ValidateConfig(config ServerConfig) error {
 	if config.RequireSecureTransport() && config.TLSCert() == "" && config.TLSKey() == "" {
 		return fmt.Errorf("require_secure_transport can only be `true` when a tls_key and tls_cert are provided.")
 	}
+	if err := ValidateTracingConfig(config.TracingConfig()); err != nil {
+		return err
+	}
+	if err := ValidateAuditLogConfig(config.AuditLogConfig()); err != nil {
+		return err
+	}
+	if err := ValidateSlowQueryLogConfig(config.SlowQueryLogConfig()); err != nil {
+		return err
+	}
+	if err := ValidateUserLimits(config.UserLimits()); err != nil {
+		return err
+	}
+	if err := ValidateLDAPConfig(config.LDAPConfig()); err != nil {
+		return err
+	}
 	return ValidateClusterConfig(config.ClusterConfig())
 }
 
+// ValidateTracingConfig returns an `error` if the tracing configuration is not valid. A nil config is valid.
+func ValidateTracingConfig(config TracingConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.Endpoint() == "" && config.FilePath() == "" {
+		return fmt.Errorf("tracing: at least one of endpoint or file must be provided")
+	}
+	if config.SampleRate() < 0 || config.SampleRate() > 1 {
+		return fmt.Errorf("tracing: sample_rate must be between 0 and 1, got %v", config.SampleRate())
+	}
+	return nil
+}
+
+// ValidateAuditLogConfig returns an `error` if the audit log configuration is not valid. A nil config is valid.
+func ValidateAuditLogConfig(config AuditLogConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.FilePath() == "" {
+		return fmt.Errorf("audit_log: file must be provided")
+	}
+	if config.MaxSizeMB() <= 0 {
+		return fmt.Errorf("audit_log: max_size_mb must be greater than 0, got %d", config.MaxSizeMB())
+	}
+	if config.MaxBackups() < 0 {
+		return fmt.Errorf("audit_log: max_backups must not be negative, got %d", config.MaxBackups())
+	}
+	for _, class := range config.StatementClasses() {
+		valid := false
+		for _, c := range AuditStatementClasses {
+			if strings.EqualFold(class, c) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("audit_log: unknown statement class '%s', expected one of %s", class, strings.Join(AuditStatementClasses, ", "))
+		}
+	}
+	return nil
+}
+
+// ValidateSlowQueryLogConfig returns an `error` if the slow query log configuration is not valid. A nil config is valid.
+func ValidateSlowQueryLogConfig(config SlowQueryLogConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.ThresholdMillis() < 0 {
+		return fmt.Errorf("slow_query_log: threshold_millis must not be negative, got %d", config.ThresholdMillis())
+	}
+	if config.MaxSizeMB() <= 0 {
+		return fmt.Errorf("slow_query_log: max_size_mb must be greater than 0, got %d", config.MaxSizeMB())
+	}
+	if config.MaxBackups() < 0 {
+		return fmt.Errorf("slow_query_log: max_backups must not be negative, got %d", config.MaxBackups())
+	}
+	if config.MaxEntries() < 0 {
+		return fmt.Errorf("slow_query_log: max_entries must not be negative, got %d", config.MaxEntries())
+	}
+	return nil
+}
+
+// ValidateUserLimits returns an `error` if any of the user limits do not name exactly one user or role, or if a user or
+// role is named more than once.
+func ValidateUserLimits(limits []UserLimits) error {
+	users := make(map[string]struct{})
+	roles := make(map[string]struct{})
+	for _, l := range limits {
+		switch {
+		case l.User() == "" && l.Role() == "":
+			return fmt.Errorf("user_limits: each entry must set either user or role")
+		case l.User() != "" && l.Role() != "":
+			return fmt.Errorf("user_limits: entry for user '%s' must not also set role", l.User())
+		case l.User() != "":
+			if _, ok := users[limitsAccount(l.User())]; ok {
+				return fmt.Errorf("user_limits: user '%s' has more than one entry", l.User())
+			}
+			users[limitsAccount(l.User())] = struct{}{}
+		default:
+			if _, ok := roles[limitsAccount(l.Role())]; ok {
+				return fmt.Errorf("user_limits: role '%s' has more than one entry", l.Role())
+			}
+			roles[limitsAccount(l.Role())] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// limitsAccount returns the account named by the user or role |name| of a user_limits entry, so that `name` and
+// `name@%` are recognized as the same account.
+func limitsAccount(name string) string {
+	if !strings.Contains(name, "@") {
+		return name + "@%"
+	}
+	return name
+}
+
+// ValidateLDAPConfig returns an `error` if the LDAP configuration is not valid. A nil config is valid.
+func ValidateLDAPConfig(config LDAPConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.URL() == "" {
+		return fmt.Errorf("ldap: url must be provided")
+	}
+	if (config.UserDNTemplate() == "") == (config.UserSearchBase() == "") {
+		return fmt.Errorf("ldap: exactly one of user_dn_template or user_search_base must be provided")
+	}
+	if config.UserDNTemplate() != "" && strings.Count(config.UserDNTemplate(), "%s") != 1 {
+		return fmt.Errorf("ldap: user_dn_template must contain exactly one %%s, got '%s'", config.UserDNTemplate())
+	}
+	if config.UserSearchBase() != "" && strings.Count(config.UserSearchFilter(), "%s") != 1 {
+		return fmt.Errorf("ldap: user_search_filter must contain exactly one %%s, got '%s'", config.UserSearchFilter())
+	}
+	if config.GroupSearchBase() != "" && strings.Count(config.GroupSearchFilter(), "%s") != 1 {
+		return fmt.Errorf("ldap: group_search_filter must contain exactly one %%s, got '%s'", config.GroupSearchFilter())
+	}
+	if config.CacheTTLMillis() < 0 {
+		return fmt.Errorf("ldap: cache_ttl_millis must not be negative, got %d", config.CacheTTLMillis())
+	}
+	for _, m := range config.GroupMappings() {
+		if m.Group() == "" {
+			return fmt.Errorf("ldap: each group mapping must set group")
+		}
+		if len(m.Roles()) > 0 || len(m.BranchControl()) > 0 {
+			if config.GroupSearchBase() == "" {
+				return fmt.Errorf("ldap: group mappings require group_search_base")
+			}
+		}
+		for _, bc := range m.BranchControl() {
+			switch strings.ToLower(bc.Permissions()) {
+			case "admin", "write", "read":
+			default:
+				return fmt.Errorf("ldap: branch_control permissions for group '%s' must be one of admin, write, read, got '%s'", m.Group(), bc.Permissions())
+			}
+		}
+	}
+	return nil
+}
+
 const (
 	MaxConnectionsKey = "max_connections"
 	ReadTimeoutKey    = "net_read_timeout"
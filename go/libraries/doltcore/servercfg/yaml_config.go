@@ -117,11 +117,359 @@ func (r RemotesapiYAMLConfig) ReadOnly() bool {
 	return *r.ReadOnly_
 }
 
+// TracingYAMLConfig contains the configuration for exporting OpenTelemetry traces
+type TracingYAMLConfig struct {
+	Endpoint_    *string  `yaml:"endpoint,omitempty" minver:"TBD"`
+	Insecure_    *bool    `yaml:"insecure,omitempty" minver:"TBD"`
+	SampleRate_  *float64 `yaml:"sample_rate,omitempty" minver:"TBD"`
+	File_        *string  `yaml:"file,omitempty" minver:"TBD"`
+	ServiceName_ *string  `yaml:"service_name,omitempty" minver:"TBD"`
+}
+
+var _ TracingConfig = (*TracingYAMLConfig)(nil)
+
+func (t *TracingYAMLConfig) Endpoint() string {
+	if t.Endpoint_ == nil {
+		return ""
+	}
+	return *t.Endpoint_
+}
+
+func (t *TracingYAMLConfig) Insecure() bool {
+	if t.Insecure_ == nil {
+		return false
+	}
+	return *t.Insecure_
+}
+
+func (t *TracingYAMLConfig) SampleRate() float64 {
+	if t.SampleRate_ == nil {
+		return DefaultTracingSampleRate
+	}
+	return *t.SampleRate_
+}
+
+func (t *TracingYAMLConfig) FilePath() string {
+	if t.File_ == nil {
+		return ""
+	}
+	return *t.File_
+}
+
+func (t *TracingYAMLConfig) ServiceName() string {
+	if t.ServiceName_ == nil {
+		return DefaultTracingServiceName
+	}
+	return *t.ServiceName_
+}
+
+// AuditLogYAMLConfig contains the configuration for the structured statement audit log
+type AuditLogYAMLConfig struct {
+	File_             *string  `yaml:"file,omitempty" minver:"TBD"`
+	MaxSizeMB_        *int     `yaml:"max_size_mb,omitempty" minver:"TBD"`
+	MaxBackups_       *int     `yaml:"max_backups,omitempty" minver:"TBD"`
+	Users_            []string `yaml:"users,omitempty" minver:"TBD"`
+	ExcludeUsers_     []string `yaml:"exclude_users,omitempty" minver:"TBD"`
+	StatementClasses_ []string `yaml:"statement_classes,omitempty" minver:"TBD"`
+}
+
+var _ AuditLogConfig = (*AuditLogYAMLConfig)(nil)
+
+func (a *AuditLogYAMLConfig) FilePath() string {
+	if a.File_ == nil {
+		return ""
+	}
+	return *a.File_
+}
+
+func (a *AuditLogYAMLConfig) MaxSizeMB() int {
+	if a.MaxSizeMB_ == nil {
+		return DefaultAuditLogMaxSizeMB
+	}
+	return *a.MaxSizeMB_
+}
+
+func (a *AuditLogYAMLConfig) MaxBackups() int {
+	if a.MaxBackups_ == nil {
+		return DefaultAuditLogMaxBackups
+	}
+	return *a.MaxBackups_
+}
+
+func (a *AuditLogYAMLConfig) Users() []string {
+	return a.Users_
+}
+
+func (a *AuditLogYAMLConfig) ExcludeUsers() []string {
+	return a.ExcludeUsers_
+}
+
+func (a *AuditLogYAMLConfig) StatementClasses() []string {
+	return a.StatementClasses_
+}
+
+// SlowQueryLogYAMLConfig contains the configuration for the slow query log
+type SlowQueryLogYAMLConfig struct {
+	ThresholdMillis_ *int    `yaml:"threshold_millis,omitempty" minver:"TBD"`
+	File_            *string `yaml:"file,omitempty" minver:"TBD"`
+	MaxSizeMB_       *int    `yaml:"max_size_mb,omitempty" minver:"TBD"`
+	MaxBackups_      *int    `yaml:"max_backups,omitempty" minver:"TBD"`
+	MaxEntries_      *int    `yaml:"max_entries,omitempty" minver:"TBD"`
+}
+
+var _ SlowQueryLogConfig = (*SlowQueryLogYAMLConfig)(nil)
+
+func (s *SlowQueryLogYAMLConfig) ThresholdMillis() int {
+	if s.ThresholdMillis_ == nil {
+		return DefaultSlowQueryThresholdMs
+	}
+	return *s.ThresholdMillis_
+}
+
+func (s *SlowQueryLogYAMLConfig) FilePath() string {
+	if s.File_ == nil {
+		return ""
+	}
+	return *s.File_
+}
+
+func (s *SlowQueryLogYAMLConfig) MaxSizeMB() int {
+	if s.MaxSizeMB_ == nil {
+		return DefaultSlowQueryLogMaxSizeMB
+	}
+	return *s.MaxSizeMB_
+}
+
+func (s *SlowQueryLogYAMLConfig) MaxBackups() int {
+	if s.MaxBackups_ == nil {
+		return DefaultSlowQueryLogMaxBackups
+	}
+	return *s.MaxBackups_
+}
+
+func (s *SlowQueryLogYAMLConfig) MaxEntries() int {
+	if s.MaxEntries_ == nil {
+		return DefaultSlowQueryMaxEntries
+	}
+	return *s.MaxEntries_
+}
+
+// LDAPYAMLConfig contains the configuration for LDAP authentication
+type LDAPYAMLConfig struct {
+	URL_                *string            `yaml:"url,omitempty" minver:"TBD"`
+	StartTLS_           *bool              `yaml:"start_tls,omitempty" minver:"TBD"`
+	InsecureSkipVerify_ *bool              `yaml:"insecure_skip_verify,omitempty" minver:"TBD"`
+	BindDN_             *string            `yaml:"bind_dn,omitempty" minver:"TBD"`
+	BindPassword_       *string            `yaml:"bind_password,omitempty" minver:"TBD"`
+	UserDNTemplate_     *string            `yaml:"user_dn_template,omitempty" minver:"TBD"`
+	UserSearchBase_     *string            `yaml:"user_search_base,omitempty" minver:"TBD"`
+	UserSearchFilter_   *string            `yaml:"user_search_filter,omitempty" minver:"TBD"`
+	GroupSearchBase_    *string            `yaml:"group_search_base,omitempty" minver:"TBD"`
+	GroupSearchFilter_  *string            `yaml:"group_search_filter,omitempty" minver:"TBD"`
+	GroupNameAttribute_ *string            `yaml:"group_name_attribute,omitempty" minver:"TBD"`
+	CacheTTLMillis_     *int               `yaml:"cache_ttl_millis,omitempty" minver:"TBD"`
+	GroupMappings_      []LDAPGroupMapping `yaml:"group_mappings,omitempty" minver:"TBD"`
+}
+
+var _ LDAPConfig = (*LDAPYAMLConfig)(nil)
+
+func (l *LDAPYAMLConfig) URL() string {
+	if l.URL_ == nil {
+		return ""
+	}
+	return *l.URL_
+}
+
+func (l *LDAPYAMLConfig) StartTLS() bool {
+	if l.StartTLS_ == nil {
+		return false
+	}
+	return *l.StartTLS_
+}
+
+func (l *LDAPYAMLConfig) InsecureSkipVerify() bool {
+	if l.InsecureSkipVerify_ == nil {
+		return false
+	}
+	return *l.InsecureSkipVerify_
+}
+
+func (l *LDAPYAMLConfig) BindDN() string {
+	if l.BindDN_ == nil {
+		return ""
+	}
+	return *l.BindDN_
+}
+
+func (l *LDAPYAMLConfig) BindPassword() string {
+	if l.BindPassword_ == nil {
+		return ""
+	}
+	return *l.BindPassword_
+}
+
+func (l *LDAPYAMLConfig) UserDNTemplate() string {
+	if l.UserDNTemplate_ == nil {
+		return ""
+	}
+	return *l.UserDNTemplate_
+}
+
+func (l *LDAPYAMLConfig) UserSearchBase() string {
+	if l.UserSearchBase_ == nil {
+		return ""
+	}
+	return *l.UserSearchBase_
+}
+
+func (l *LDAPYAMLConfig) UserSearchFilter() string {
+	if l.UserSearchFilter_ == nil {
+		return DefaultLDAPUserSearchFilter
+	}
+	return *l.UserSearchFilter_
+}
+
+func (l *LDAPYAMLConfig) GroupSearchBase() string {
+	if l.GroupSearchBase_ == nil {
+		return ""
+	}
+	return *l.GroupSearchBase_
+}
+
+func (l *LDAPYAMLConfig) GroupSearchFilter() string {
+	if l.GroupSearchFilter_ == nil {
+		return DefaultLDAPGroupSearchFilter
+	}
+	return *l.GroupSearchFilter_
+}
+
+func (l *LDAPYAMLConfig) GroupNameAttribute() string {
+	if l.GroupNameAttribute_ == nil {
+		return DefaultLDAPGroupNameAttribute
+	}
+	return *l.GroupNameAttribute_
+}
+
+func (l *LDAPYAMLConfig) CacheTTLMillis() int {
+	if l.CacheTTLMillis_ == nil {
+		return DefaultLDAPCacheTTLMillis
+	}
+	return *l.CacheTTLMillis_
+}
+
+func (l *LDAPYAMLConfig) GroupMappings() []LDAPGroupMapping {
+	return l.GroupMappings_
+}
+
+// LDAPGroupMapping are the roles and branch permissions granted to the members of an LDAP group.
+type LDAPGroupMapping struct {
+	Group_         *string                   `yaml:"group,omitempty" minver:"TBD"`
+	Roles_         []string                  `yaml:"roles,omitempty" minver:"TBD"`
+	BranchControl_ []LDAPBranchControlConfig `yaml:"branch_control,omitempty" minver:"TBD"`
+}
+
+func (m LDAPGroupMapping) Group() string {
+	if m.Group_ == nil {
+		return ""
+	}
+	return *m.Group_
+}
+
+func (m LDAPGroupMapping) Roles() []string {
+	return m.Roles_
+}
+
+func (m LDAPGroupMapping) BranchControl() []LDAPBranchControlConfig {
+	return m.BranchControl_
+}
+
+// LDAPBranchControlConfig is a dolt_branch_control entry granted to the members of an LDAP group.
+type LDAPBranchControlConfig struct {
+	Database_    *string `yaml:"database,omitempty" minver:"TBD"`
+	Branch_      *string `yaml:"branch,omitempty" minver:"TBD"`
+	Permissions_ *string `yaml:"permissions,omitempty" minver:"TBD"`
+}
+
+func (b LDAPBranchControlConfig) Database() string {
+	if b.Database_ == nil {
+		return "%"
+	}
+	return *b.Database_
+}
+
+func (b LDAPBranchControlConfig) Branch() string {
+	if b.Branch_ == nil {
+		return "%"
+	}
+	return *b.Branch_
+}
+
+func (b LDAPBranchControlConfig) Permissions() string {
+	if b.Permissions_ == nil {
+		return ""
+	}
+	return *b.Permissions_
+}
+
 type UserSessionVars struct {
 	Name string                 `yaml:"name"`
 	Vars map[string]interface{} `yaml:"vars"`
 }
 
+// UserLimits are the resource limits of a single user or role. Users and roles are accounts, named either `name` or
+// `name@host`; a name without a host is the account on the wildcard host `%`, whose limits also apply to that user's
+// accounts on other hosts which have no limits of their own. A limit of 0 means that resource is not limited.
+type UserLimits struct {
+	User_                   *string `yaml:"user,omitempty" minver:"TBD"`
+	Role_                   *string `yaml:"role,omitempty" minver:"TBD"`
+	MaxConnections_         *uint64 `yaml:"max_connections,omitempty" minver:"TBD"`
+	MaxExecutionTimeMillis_ *uint64 `yaml:"max_execution_time_millis,omitempty" minver:"TBD"`
+	MaxRows_                *uint64 `yaml:"max_rows,omitempty" minver:"TBD"`
+	MaxWorkingSetRows_      *uint64 `yaml:"max_working_set_rows,omitempty" minver:"TBD"`
+}
+
+func (u UserLimits) User() string {
+	if u.User_ == nil {
+		return ""
+	}
+	return *u.User_
+}
+
+func (u UserLimits) Role() string {
+	if u.Role_ == nil {
+		return ""
+	}
+	return *u.Role_
+}
+
+func (u UserLimits) MaxConnections() uint64 {
+	if u.MaxConnections_ == nil {
+		return 0
+	}
+	return *u.MaxConnections_
+}
+
+func (u UserLimits) MaxExecutionTimeMillis() uint64 {
+	if u.MaxExecutionTimeMillis_ == nil {
+		return 0
+	}
+	return *u.MaxExecutionTimeMillis_
+}
+
+func (u UserLimits) MaxRows() uint64 {
+	if u.MaxRows_ == nil {
+		return 0
+	}
+	return *u.MaxRows_
+}
+
+func (u UserLimits) MaxWorkingSetRows() uint64 {
+	if u.MaxWorkingSetRows_ == nil {
+		return 0
+	}
+	return *u.MaxWorkingSetRows_
+}
+
 // YAMLConfig is a ServerConfig implementation which is read from a yaml file
 type YAMLConfig struct {
 	LogLevelStr       *string                `yaml:"log_level,omitempty"`
@@ -139,10 +487,15 @@ type YAMLConfig struct {
 	PrivilegeFile     *string                `yaml:"privilege_file,omitempty"`
 	BranchControlFile *string                `yaml:"branch_control_file,omitempty"`
 	// TODO: Rename to UserVars_
-	Vars            []UserSessionVars      `yaml:"user_session_vars"`
-	SystemVars_     map[string]interface{} `yaml:"system_variables,omitempty" minver:"1.11.1"`
-	Jwks            []JwksConfig           `yaml:"jwks"`
-	GoldenMysqlConn *string                `yaml:"golden_mysql_conn,omitempty"`
+	Vars            []UserSessionVars       `yaml:"user_session_vars"`
+	SystemVars_     map[string]interface{}  `yaml:"system_variables,omitempty" minver:"1.11.1"`
+	Jwks            []JwksConfig            `yaml:"jwks"`
+	GoldenMysqlConn *string                 `yaml:"golden_mysql_conn,omitempty"`
+	TracingCfg      *TracingYAMLConfig      `yaml:"tracing,omitempty" minver:"TBD"`
+	AuditLogCfg     *AuditLogYAMLConfig     `yaml:"audit_log,omitempty" minver:"TBD"`
+	SlowQueryLogCfg *SlowQueryLogYAMLConfig `yaml:"slow_query_log,omitempty" minver:"TBD"`
+	UserLimits_     []UserLimits            `yaml:"user_limits,omitempty" minver:"TBD"`
+	LDAPCfg         *LDAPYAMLConfig         `yaml:"ldap,omitempty" minver:"TBD"`
 }
 
 var _ ServerConfig = YAMLConfig{}
@@ -220,6 +573,76 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       systemVars,
 		Vars:              cfg.UserVars(),
 		Jwks:              cfg.JwksConfig(),
+		TracingCfg:        tracingConfigAsYAMLConfig(cfg.TracingConfig()),
+		AuditLogCfg:       auditLogConfigAsYAMLConfig(cfg.AuditLogConfig()),
+		SlowQueryLogCfg:   slowQueryLogConfigAsYAMLConfig(cfg.SlowQueryLogConfig()),
+		UserLimits_:       cfg.UserLimits(),
+		LDAPCfg:           ldapConfigAsYAMLConfig(cfg.LDAPConfig()),
+	}
+}
+
+func ldapConfigAsYAMLConfig(config LDAPConfig) *LDAPYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &LDAPYAMLConfig{
+		URL_:                nillableStrPtr(config.URL()),
+		StartTLS_:           nillableBoolPtr(config.StartTLS()),
+		InsecureSkipVerify_: nillableBoolPtr(config.InsecureSkipVerify()),
+		BindDN_:             nillableStrPtr(config.BindDN()),
+		BindPassword_:       nillableStrPtr(config.BindPassword()),
+		UserDNTemplate_:     nillableStrPtr(config.UserDNTemplate()),
+		UserSearchBase_:     nillableStrPtr(config.UserSearchBase()),
+		UserSearchFilter_:   ptr(config.UserSearchFilter()),
+		GroupSearchBase_:    nillableStrPtr(config.GroupSearchBase()),
+		GroupSearchFilter_:  ptr(config.GroupSearchFilter()),
+		GroupNameAttribute_: ptr(config.GroupNameAttribute()),
+		CacheTTLMillis_:     ptr(config.CacheTTLMillis()),
+		GroupMappings_:      config.GroupMappings(),
+	}
+}
+
+func slowQueryLogConfigAsYAMLConfig(config SlowQueryLogConfig) *SlowQueryLogYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &SlowQueryLogYAMLConfig{
+		ThresholdMillis_: ptr(config.ThresholdMillis()),
+		File_:            nillableStrPtr(config.FilePath()),
+		MaxSizeMB_:       ptr(config.MaxSizeMB()),
+		MaxBackups_:      ptr(config.MaxBackups()),
+		MaxEntries_:      ptr(config.MaxEntries()),
+	}
+}
+
+func auditLogConfigAsYAMLConfig(config AuditLogConfig) *AuditLogYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &AuditLogYAMLConfig{
+		File_:             nillableStrPtr(config.FilePath()),
+		MaxSizeMB_:        ptr(config.MaxSizeMB()),
+		MaxBackups_:       ptr(config.MaxBackups()),
+		Users_:            config.Users(),
+		ExcludeUsers_:     config.ExcludeUsers(),
+		StatementClasses_: config.StatementClasses(),
+	}
+}
+
+func tracingConfigAsYAMLConfig(config TracingConfig) *TracingYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &TracingYAMLConfig{
+		Endpoint_:    nillableStrPtr(config.Endpoint()),
+		Insecure_:    nillableBoolPtr(config.Insecure()),
+		SampleRate_:  ptr(config.SampleRate()),
+		File_:        nillableStrPtr(config.FilePath()),
+		ServiceName_: ptr(config.ServiceName()),
 	}
 }
 
@@ -453,6 +876,10 @@ func (cfg YAMLConfig) UserVars() []UserSessionVars {
 	return nil
 }
 
+func (cfg YAMLConfig) UserLimits() []UserLimits {
+	return cfg.UserLimits_
+}
+
 func (cfg YAMLConfig) SystemVars() map[string]interface{} {
 	if cfg.SystemVars_ == nil {
 		return map[string]interface{}{}
@@ -561,6 +988,34 @@ func (cfg YAMLConfig) ClusterConfig() ClusterConfig {
 	return cfg.ClusterCfg
 }
 
+func (cfg YAMLConfig) TracingConfig() TracingConfig {
+	if cfg.TracingCfg == nil {
+		return nil
+	}
+	return cfg.TracingCfg
+}
+
+func (cfg YAMLConfig) AuditLogConfig() AuditLogConfig {
+	if cfg.AuditLogCfg == nil {
+		return nil
+	}
+	return cfg.AuditLogCfg
+}
+
+func (cfg YAMLConfig) SlowQueryLogConfig() SlowQueryLogConfig {
+	if cfg.SlowQueryLogCfg == nil {
+		return nil
+	}
+	return cfg.SlowQueryLogCfg
+}
+
+func (cfg YAMLConfig) LDAPConfig() LDAPConfig {
+	if cfg.LDAPCfg == nil {
+		return nil
+	}
+	return cfg.LDAPCfg
+}
+
 func (cfg YAMLConfig) EventSchedulerStatus() string {
 	if cfg.BehaviorConfig.EventSchedulerStatus == nil {
 		return "ON"
@@ -427,3 +427,291 @@ metrics:
 	assert.Equal(t, "localhost", cfg.MetricsHost())
 	assert.Equal(t, -1, cfg.MetricsPort())
 }
+
+func TestUnmarshallTracing(t *testing.T) {
+	testStr := `
+tracing:
+  endpoint: localhost:4318
+  insecure: true
+  sample_rate: 0.25
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.NotNil(t, config.TracingConfig())
+	assert.Equal(t, "localhost:4318", config.TracingConfig().Endpoint())
+	assert.True(t, config.TracingConfig().Insecure())
+	assert.Equal(t, 0.25, config.TracingConfig().SampleRate())
+	assert.Equal(t, "", config.TracingConfig().FilePath())
+	assert.Equal(t, DefaultTracingServiceName, config.TracingConfig().ServiceName())
+	require.NoError(t, ValidateTracingConfig(config.TracingConfig()))
+
+	config, err = NewYamlConfig([]byte(""))
+	require.NoError(t, err)
+	assert.Nil(t, config.TracingConfig())
+}
+
+func TestValidateTracingConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name: "file only",
+			Config: `
+tracing:
+  file: /tmp/traces.json
+`,
+		},
+		{
+			Name: "no destination",
+			Config: `
+tracing:
+  sample_rate: 0.5
+`,
+			Error: true,
+		},
+		{
+			Name: "sample rate out of range",
+			Config: `
+tracing:
+  endpoint: http://localhost:4318
+  sample_rate: 1.5
+`,
+			Error: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				assert.Error(t, ValidateConfig(cfg))
+			} else {
+				assert.NoError(t, ValidateConfig(cfg))
+			}
+		})
+	}
+}
+
+func TestUnmarshallAuditLog(t *testing.T) {
+	testStr := `
+audit_log:
+  file: /var/log/dolt/audit.log
+  max_backups: 2
+  exclude_users: [monitor]
+  statement_classes: [write, ddl]
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.NotNil(t, config.AuditLogConfig())
+	assert.Equal(t, "/var/log/dolt/audit.log", config.AuditLogConfig().FilePath())
+	assert.Equal(t, DefaultAuditLogMaxSizeMB, config.AuditLogConfig().MaxSizeMB())
+	assert.Equal(t, 2, config.AuditLogConfig().MaxBackups())
+	assert.Empty(t, config.AuditLogConfig().Users())
+	assert.Equal(t, []string{"monitor"}, config.AuditLogConfig().ExcludeUsers())
+	assert.Equal(t, []string{"write", "ddl"}, config.AuditLogConfig().StatementClasses())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(""))
+	require.NoError(t, err)
+	assert.Nil(t, config.AuditLogConfig())
+}
+
+func TestValidateAuditLogConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name: "file only",
+			Config: `
+audit_log:
+  file: audit.log
+`,
+		},
+		{
+			Name: "no file",
+			Config: `
+audit_log:
+  users: [root]
+`,
+			Error: true,
+		},
+		{
+			Name: "unknown statement class",
+			Config: `
+audit_log:
+  file: audit.log
+  statement_classes: [writes]
+`,
+			Error: true,
+		},
+		{
+			Name: "zero max size",
+			Config: `
+audit_log:
+  file: audit.log
+  max_size_mb: 0
+`,
+			Error: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				assert.Error(t, ValidateConfig(cfg))
+			} else {
+				assert.NoError(t, ValidateConfig(cfg))
+			}
+		})
+	}
+}
+
+func TestUnmarshallSlowQueryLog(t *testing.T) {
+	testStr := `
+slow_query_log:
+  threshold_millis: 250
+  file: slow.log
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	require.NotNil(t, config.SlowQueryLogConfig())
+	assert.Equal(t, 250, config.SlowQueryLogConfig().ThresholdMillis())
+	assert.Equal(t, "slow.log", config.SlowQueryLogConfig().FilePath())
+	assert.Equal(t, DefaultSlowQueryLogMaxSizeMB, config.SlowQueryLogConfig().MaxSizeMB())
+	assert.Equal(t, DefaultSlowQueryLogMaxBackups, config.SlowQueryLogConfig().MaxBackups())
+	assert.Equal(t, DefaultSlowQueryMaxEntries, config.SlowQueryLogConfig().MaxEntries())
+	require.NoError(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(`
+slow_query_log:
+  threshold_millis: -1
+`))
+	require.NoError(t, err)
+	assert.Error(t, ValidateConfig(config))
+
+	config, err = NewYamlConfig([]byte(""))
+	require.NoError(t, err)
+	assert.Nil(t, config.SlowQueryLogConfig())
+}
+
+func TestUnmarshallUserLimits(t *testing.T) {
+	testStr := `
+user_limits:
+  - user: app
+    max_connections: 10
+    max_execution_time_millis: 30000
+  - role: analyst
+    max_rows: 100000
+    max_working_set_rows: 5000
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	limits := config.UserLimits()
+	require.Len(t, limits, 2)
+	assert.Equal(t, "app", limits[0].User())
+	assert.Equal(t, uint64(10), limits[0].MaxConnections())
+	assert.Equal(t, uint64(30000), limits[0].MaxExecutionTimeMillis())
+	assert.Equal(t, uint64(0), limits[0].MaxRows())
+	assert.Equal(t, "analyst", limits[1].Role())
+	assert.Equal(t, uint64(100000), limits[1].MaxRows())
+	assert.Equal(t, uint64(5000), limits[1].MaxWorkingSetRows())
+	require.NoError(t, ValidateConfig(config))
+
+	for _, invalid := range []string{`
+user_limits:
+  - max_rows: 10
+`, `
+user_limits:
+  - user: app
+    role: analyst
+`, `
+user_limits:
+  - user: app
+  - user: app
+`, `
+user_limits:
+  - user: app
+  - user: app@%
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		assert.Error(t, ValidateConfig(config))
+	}
+}
+
+func TestUnmarshallLDAPConfig(t *testing.T) {
+	testStr := `
+ldap:
+  url: ldaps://ldap.example.com
+  bind_dn: cn=dolt,dc=example,dc=com
+  bind_password: secret
+  user_search_base: ou=people,dc=example,dc=com
+  group_search_base: ou=groups,dc=example,dc=com
+  group_mappings:
+    - group: dba
+      roles: [admins]
+      branch_control:
+        - database: mydb
+          permissions: admin
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	ldap := config.LDAPConfig()
+	require.NotNil(t, ldap)
+	assert.Equal(t, "ldaps://ldap.example.com", ldap.URL())
+	assert.Equal(t, "cn=dolt,dc=example,dc=com", ldap.BindDN())
+	assert.Equal(t, DefaultLDAPUserSearchFilter, ldap.UserSearchFilter())
+	assert.Equal(t, DefaultLDAPGroupSearchFilter, ldap.GroupSearchFilter())
+	assert.Equal(t, DefaultLDAPGroupNameAttribute, ldap.GroupNameAttribute())
+	assert.Equal(t, DefaultLDAPCacheTTLMillis, ldap.CacheTTLMillis())
+	require.Len(t, ldap.GroupMappings(), 1)
+	mapping := ldap.GroupMappings()[0]
+	assert.Equal(t, "dba", mapping.Group())
+	assert.Equal(t, []string{"admins"}, mapping.Roles())
+	require.Len(t, mapping.BranchControl(), 1)
+	assert.Equal(t, "mydb", mapping.BranchControl()[0].Database())
+	assert.Equal(t, "%", mapping.BranchControl()[0].Branch())
+	require.NoError(t, ValidateConfig(config))
+
+	for _, invalid := range []string{`
+ldap:
+  user_dn_template: uid=%s,dc=example,dc=com
+`, `
+ldap:
+  url: ldap://localhost
+`, `
+ldap:
+  url: ldap://localhost
+  user_dn_template: uid=%s,dc=example,dc=com
+  user_search_base: dc=example,dc=com
+`, `
+ldap:
+  url: ldap://localhost
+  user_dn_template: uid=alice,dc=example,dc=com
+`, `
+ldap:
+  url: ldap://localhost
+  user_dn_template: uid=%s,dc=example,dc=com
+  group_mappings:
+    - group: dba
+      roles: [admins]
+`, `
+ldap:
+  url: ldap://localhost
+  user_dn_template: uid=%s,dc=example,dc=com
+  group_search_base: dc=example,dc=com
+  group_mappings:
+    - group: dba
+      branch_control:
+        - permissions: owner
+`} {
+		config, err = NewYamlConfig([]byte(invalid))
+		require.NoError(t, err)
+		assert.Error(t, ValidateConfig(config))
+	}
+}
@@ -260,6 +260,12 @@ func replaceColumnInSchema(sch schema.Schema, oldCol schema.Column, newCol schem
 				tags[i] = newCol.Tag
 			}
 		}
+		includedTags := index.IncludedColumnTags()
+		for i := range includedTags {
+			if includedTags[i] == oldCol.Tag {
+				includedTags[i] = newCol.Tag
+			}
+		}
 		_, err = newSch.Indexes().AddIndexByColTags(
 			index.Name(),
 			tags,
@@ -268,8 +274,11 @@ func replaceColumnInSchema(sch schema.Schema, oldCol schema.Column, newCol schem
 				IsUnique:           index.IsUnique(),
 				IsSpatial:          index.IsSpatial(),
 				IsFullText:         index.IsFullText(),
+				IsColumnar:         index.IsColumnar(),
 				IsUserDefined:      index.IsUserDefined(),
 				Comment:            index.Comment(),
+				IncludedTags:       includedTags,
+				Predicate:          index.Predicate(),
 				FullTextProperties: index.FullTextProperties(),
 			})
 		if err != nil {
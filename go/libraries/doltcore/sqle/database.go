@@ -621,6 +621,22 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		if !resolve.UseSearchPath || isDoltgresSystemTable {
 			dt, found = dtables.NewTagsTable(ctx, lwrName, db.ddb), true
 		}
+	case doltdb.GetNotesTableName(), doltdb.NotesTableName:
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewNotesTable(ctx, lwrName, db.ddb), true
+		}
+	case doltdb.GetStashesTableName(), doltdb.StashesTableName:
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewStashesTable(ctx, lwrName, db.ddb), true
+		}
 	case dtables.AccessTableName:
 		basCtx := branch_control.GetBranchAwareSession(ctx)
 		if basCtx != nil {
@@ -635,6 +651,8 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 				dt, found = dtables.NewBranchNamespaceControlTable(controller.Namespace), true
 			}
 		}
+	case dtables.SlowQueriesTableName:
+		dt, found = dtables.NewSlowQueriesTable(db.Name()), true
 	case doltdb.IgnoreTableName:
 		if resolve.UseSearchPath && db.schemaName == "" {
 			schemaName, err := resolve.FirstExistingSchemaOnSearchPath(ctx, root)
@@ -97,11 +97,16 @@ func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, e
 	}
 
 	cherryPickOptions := cherry_pick.NewCherryPickOptions()
+	cherryPickOptions.KeepSchemaConflicts = true
 
 	// If --allow-empty is specified, then empty commits are allowed to be cherry-picked
 	if apr.Contains(cli.AllowEmptyFlag) {
 		cherryPickOptions.EmptyCommitHandling = doltdb.KeepEmptyCommit
 	}
+	cherryPickOptions.Favor, err = parseStrategyOption(apr)
+	if err != nil {
+		return "", 0, 0, 0, err
+	}
 
 	commit, mergeResult, err := cherry_pick.CherryPick(ctx, cherryStr, cherryPickOptions)
 	if err != nil {
@@ -31,6 +31,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/row"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table"
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
@@ -58,9 +59,10 @@ type DoltConflictsCatFunc struct {
 	children []sql.Expression
 }
 
-func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeStore, hash hash.Hash, tblName string) (prolly.Map, error) {
+// getProllyRowMaps returns the rows of |tblName| in the root-ish |hash|, keyed by the primary key of |keySch|.
+func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeStore, hash hash.Hash, tblName doltdb.TableName, keySch schema.Schema) (prolly.Map, error) {
 	rootVal, err := doltdb.LoadRootValueFromRootIshAddr(ctx, vrw, ns, hash)
-	tbl, ok, err := rootVal.GetTable(ctx, doltdb.TableName{Name: tblName})
+	tbl, ok, err := rootVal.GetTable(ctx, tblName)
 	if err != nil {
 		return prolly.Map{}, err
 	}
@@ -68,12 +70,7 @@ func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeS
 		return prolly.Map{}, doltdb.ErrTableNotFound
 	}
 
-	idx, err := tbl.GetRowData(ctx)
-	if err != nil {
-		return prolly.Map{}, err
-	}
-
-	return durable.ProllyMapFromIndex(idx), nil
+	return merge.RowDataWithPrimaryKeyOf(ctx, tbl, keySch)
 }
 
 func resolveProllyConflicts(ctx *sql.Context, tbl *doltdb.Table, tblName string, ourSch, sch schema.Schema) (*doltdb.Table, error) {
@@ -120,7 +117,8 @@ func resolveProllyConflicts(ctx *sql.Context, tbl *doltdb.Table, tblName string,
 
 		// reload if their root hash changes
 		if theirRoot != cnfArt.TheirRootIsh {
-			theirMap, err = getProllyRowMaps(ctx, tbl.ValueReadWriter(), tbl.NodeStore(), cnfArt.TheirRootIsh, tblName)
+			_, theirName := doltdb.ConflictSourceNames(doltdb.TableName{Name: tblName}, cnfArt.Metadata)
+			theirMap, err = getProllyRowMaps(ctx, tbl.ValueReadWriter(), tbl.NodeStore(), cnfArt.TheirRootIsh, theirName, sch)
 			if err != nil {
 				return nil, err
 			}
@@ -191,6 +189,10 @@ func resolveProllyConflicts(ctx *sql.Context, tbl *doltdb.Table, tblName string,
 			return nil, err
 		}
 	}
+	idxSet, err = index.SyncColumnarIndexes(ctx, sch, idxSet, ourMap, newMap)
+	if err != nil {
+		return nil, err
+	}
 	newTbl, err = newTbl.SetIndexSet(ctx, idxSet)
 	if err != nil {
 		return nil, err
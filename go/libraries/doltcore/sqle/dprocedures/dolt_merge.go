@@ -120,6 +120,13 @@ func doDoltMerge(ctx *sql.Context, args []string) (string, int, int, string, err
 	if apr.ContainsAll(cli.SquashParam, cli.NoFFParam) {
 		return "", noConflictsOrViolations, threeWayMerge, "", fmt.Errorf("error: Flags '--%s' and '--%s' cannot be used together.\n", cli.SquashParam, cli.NoFFParam)
 	}
+	oursStrategy, err := parseMergeStrategy(apr)
+	if err != nil {
+		return "", noConflictsOrViolations, threeWayMerge, "", err
+	}
+	if oursStrategy && apr.Contains(cli.SquashParam) {
+		return "", noConflictsOrViolations, threeWayMerge, "", fmt.Errorf("error: Flags '--%s' and '--%s=ours' cannot be used together.\n", cli.SquashParam, cli.StrategyParam)
+	}
 
 	ws, err := sess.WorkingSet(ctx, dbName)
 	if err != nil {
@@ -153,6 +160,17 @@ func doDoltMerge(ctx *sql.Context, args []string) (string, int, int, string, err
 		return "", noConflictsOrViolations, threeWayMerge, "merge aborted", nil
 	}
 
+	if apr.NArg() > 1 {
+		if oursStrategy {
+			return "", noConflictsOrViolations, threeWayMerge, "", fmt.Errorf("error: Flag '--%s=ours' cannot be used when merging more than one branch", cli.StrategyParam)
+		}
+		commit, message, err := performOctopusMerge(ctx, sess, ws, roots, dbName, apr)
+		if err != nil {
+			return "", noConflictsOrViolations, threeWayMerge, "", err
+		}
+		return commit, noConflictsOrViolations, threeWayMerge, message, nil
+	}
+
 	branchName := apr.Arg(0)
 
 	mergeSpec, err := createMergeSpec(ctx, sess, dbName, apr, branchName)
@@ -225,8 +243,8 @@ func performMerge(
 		}
 	}
 
-	if canFF {
-		if spec.NoFF {
+	if canFF || spec.OursStrategy {
+		if spec.NoFF || spec.OursStrategy {
 			var commit *doltdb.Commit
 			ws, commit, err = executeNoFFMerge(ctx, sess, spec, msg, dbName, ws, noCommit)
 			if err == doltdb.ErrUnresolvedConflictsOrViolations {
@@ -271,7 +289,7 @@ func performMerge(
 		return ws, "", noConflictsOrViolations, threeWayMerge, "", sql.ErrDatabaseNotFound.New(dbName)
 	}
 
-	ws, err = executeMerge(ctx, sess, dbName, spec.Squash, spec.Force, spec.HeadC, spec.MergeC, spec.MergeCSpecStr, ws, dbState.EditOpts(), spec.WorkingDiffs)
+	ws, err = executeMerge(ctx, sess, dbName, spec.Squash, spec.Force, spec.HeadC, spec.MergeC, spec.MergeCSpecStr, ws, dbState.EditOpts(), spec.WorkingDiffs, spec.Favor)
 	if err == doltdb.ErrUnresolvedConflictsOrViolations {
 		// if there are unresolved conflicts, write the resulting working set back to the session and return an
 		// error message
@@ -307,6 +325,153 @@ func performMerge(
 	return ws, commit, noConflictsOrViolations, threeWayMerge, "merge successful", nil
 }
 
+// performOctopusMerge merges all the branches named in |apr| into the current branch at once, creating a single
+// commit with a parent for each of them. Octopus merges never leave a merge in progress: if the branches conflict
+// with each other, or with the current branch, an error naming the conflicting branches is returned instead.
+func performOctopusMerge(
+	ctx *sql.Context,
+	sess *dsess.DoltSession,
+	ws *doltdb.WorkingSet,
+	roots doltdb.Roots,
+	dbName string,
+	apr *argparser.ArgParseResults,
+) (string, string, error) {
+	if ws.MergeActive() {
+		return "", "", doltdb.ErrMergeActive
+	}
+	if apr.Contains(cli.NoCommitFlag) {
+		return "", "", fmt.Errorf("error: Flag '--%s' cannot be used when merging more than one branch", cli.NoCommitFlag)
+	}
+
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return "", "", err
+	}
+	for _, root := range []doltdb.RootValue{roots.Staged, roots.Working} {
+		h, err := root.HashOf()
+		if err != nil {
+			return "", "", err
+		}
+		if h != headHash {
+			return "", "", ErrUncommittedChanges.New()
+		}
+	}
+
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return "", "", fmt.Errorf("Could not load database %s", dbName)
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return "", "", err
+	}
+	head, err := sess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return "", "", err
+	}
+
+	commits := make([]*doltdb.Commit, apr.NArg())
+	for i, spec := range apr.Args {
+		cs, err := doltdb.NewCommitSpec(spec)
+		if err != nil {
+			return "", "", err
+		}
+		optCmt, err := dbData.Ddb.Resolve(ctx, cs, headRef)
+		if err != nil {
+			return "", "", err
+		}
+		var ok bool
+		if commits[i], ok = optCmt.ToCommit(); !ok {
+			return "", "", doltdb.ErrGhostCommitEncountered
+		}
+	}
+
+	dbState, ok, err := sess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return "", "", err
+	} else if !ok {
+		return "", "", sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	favor, err := parseStrategyOption(apr)
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := merge.OctopusMerge(ctx, head, commits, apr.Args, dbState.EditOpts(), favor)
+	if err == doltdb.ErrUpToDate {
+		ctx.Warn(DoltMergeWarningCode, err.Error())
+		return "", err.Error(), nil
+	} else if err != nil {
+		return "", "", err
+	}
+	for _, spec := range result.UpToDate {
+		ctx.Warn(DoltMergeWarningCode, "Already up to date with %s", spec)
+	}
+
+	ws = ws.WithWorkingRoot(result.Root).WithStagedRoot(result.Root)
+	if err = sess.SetWorkingSet(ctx, dbName, ws); err != nil {
+		return "", "", err
+	}
+	if apr.Contains(cli.SquashParam) {
+		return "", "merge successful", nil
+	}
+
+	name, email, err := getNameAndEmail(ctx, apr)
+	if err != nil {
+		return "", "", err
+	}
+	date := ctx.QueryTime()
+	if commitTimeStr, ok := apr.GetValue(cli.DateParam); ok {
+		if date, err = dconfig.ParseDate(commitTimeStr); err != nil {
+			return "", "", err
+		}
+	}
+	msg, ok := apr.GetValue(cli.MessageArg)
+	if !ok {
+		msg = octopusMergeMessage(apr.Args, headRef.GetPath())
+	}
+
+	roots, _ = sess.GetRoots(ctx, dbName)
+	pendingCommit, err := sess.NewPendingCommit(ctx, dbName, roots, actions.CommitStagedProps{
+		Message:    msg,
+		Date:       date,
+		AllowEmpty: true,
+		Force:      apr.Contains(cli.ForceFlag),
+		Name:       name,
+		Email:      email,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	for _, parent := range result.Parents {
+		h, err := parent.HashOf()
+		if err != nil {
+			return "", "", err
+		}
+		pendingCommit.CommitOptions.Parents = append(pendingCommit.CommitOptions.Parents, h)
+	}
+
+	commit, err := sess.DoltCommit(ctx, dbName, sess.GetTransaction(), pendingCommit)
+	if err != nil {
+		return "", "", err
+	}
+	h, err := commit.HashOf()
+	if err != nil {
+		return "", "", err
+	}
+	return h.String(), "merge successful", nil
+}
+
+// octopusMergeMessage returns the default commit message for merging |branches| into |headBranch|.
+func octopusMergeMessage(branches []string, headBranch string) string {
+	quoted := make([]string, len(branches))
+	for i, b := range branches {
+		quoted[i] = fmt.Sprintf("'%s'", b)
+	}
+	return fmt.Sprintf("Merge branches %s and %s into %s", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1], headBranch)
+}
+
 func executeMerge(
 	ctx *sql.Context,
 	sess *dsess.DoltSession,
@@ -318,8 +483,9 @@ func executeMerge(
 	ws *doltdb.WorkingSet,
 	opts editor.Options,
 	workingDiffs map[doltdb.TableName]hash.Hash,
+	favor merge.Favor,
 ) (*doltdb.WorkingSet, error) {
-	result, err := merge.MergeCommits(ctx, head, cm, opts)
+	result, err := merge.MergeCommits(ctx, head, cm, opts, favor)
 	if err != nil {
 		switch err {
 		case doltdb.ErrUpToDate:
@@ -330,7 +496,7 @@ func executeMerge(
 			return nil, err
 		}
 	}
-	return mergeRootToWorking(ctx, sess, dbName, squash, force, ws, result, workingDiffs, cm, cmSpec)
+	return mergeRootToWorking(ctx, sess, dbName, squash, force, ws, result, workingDiffs, cm, cmSpec, favor)
 }
 
 func executeFFMerge(ctx *sql.Context, dbName string, squash bool, ws *doltdb.WorkingSet, dbData env.DbData, cm2 *doltdb.Commit, spec *merge.MergeSpec) (*doltdb.WorkingSet, error) {
@@ -382,7 +548,8 @@ func executeFFMerge(ctx *sql.Context, dbName string, squash bool, ws *doltdb.Wor
 }
 
 // executeNoFFMerge is a helper function for performing a merge that is not a fast-forward merge. It returns the new
-// working set, the resulting commit, and an error. If the error is nil, the commit will be non-nil.
+// working set, the resulting commit, and an error. If the error is nil, the commit will be non-nil. With the ours
+// strategy, the merge is recorded without taking any changes from the merged commit.
 func executeNoFFMerge(
 	ctx *sql.Context,
 	dSess *dsess.DoltSession,
@@ -392,13 +559,17 @@ func executeNoFFMerge(
 	ws *doltdb.WorkingSet,
 	noCommit bool,
 ) (*doltdb.WorkingSet, *doltdb.Commit, error) {
-	mergeRoot, err := spec.MergeC.GetRootValue(ctx)
+	mergeC := spec.MergeC
+	if spec.OursStrategy {
+		mergeC = spec.HeadC
+	}
+	mergeRoot, err := mergeC.GetRootValue(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 	result := &merge.Result{Root: mergeRoot, Stats: make(map[doltdb.TableName]*merge.MergeStats)}
 
-	ws, err = mergeRootToWorking(ctx, dSess, dbName, false, spec.Force, ws, result, spec.WorkingDiffs, spec.MergeC, spec.MergeCSpecStr)
+	ws, err = mergeRootToWorking(ctx, dSess, dbName, false, spec.Force, ws, result, spec.WorkingDiffs, spec.MergeC, spec.MergeCSpecStr, spec.Favor)
 	if err != nil {
 		// This error is recoverable, so we return a working set value along with the error
 		return ws, nil, err
@@ -478,6 +649,14 @@ func createMergeSpec(ctx *sql.Context, sess *dsess.DoltSession, dbName string, a
 	if apr.Contains(cli.NoCommitFlag) && apr.Contains(cli.CommitFlag) {
 		return nil, errors.New("cannot define both 'commit' and 'no-commit' flags at the same time")
 	}
+	oursStrategy, err := parseMergeStrategy(apr)
+	if err != nil {
+		return nil, err
+	}
+	favor, err := parseStrategyOption(apr)
+	if err != nil {
+		return nil, err
+	}
 	return merge.NewMergeSpec(
 		ctx,
 		dbData.Rsr,
@@ -492,9 +671,37 @@ func createMergeSpec(ctx *sql.Context, sess *dsess.DoltSession, dbName string, a
 		merge.WithForce(apr.Contains(cli.ForceFlag)),
 		merge.WithNoCommit(apr.Contains(cli.NoCommitFlag)),
 		merge.WithNoEdit(apr.Contains(cli.NoEditFlag)),
+		merge.WithOursStrategy(oursStrategy),
+		merge.WithFavor(favor),
 	)
 }
 
+// parseMergeStrategy returns whether the ours merge strategy was requested with the strategy param in |apr|. The
+// default strategy, which three-way merges the commits, may be requested explicitly as "recursive" or "ort".
+func parseMergeStrategy(apr *argparser.ArgParseResults) (bool, error) {
+	strategy, ok := apr.GetValue(cli.StrategyParam)
+	if !ok {
+		return false, nil
+	}
+	switch strings.ToLower(strategy) {
+	case "ours":
+		return true, nil
+	case "recursive", "ort":
+		return false, nil
+	default:
+		return false, fmt.Errorf("error: unsupported merge strategy '%s'; valid strategies are 'ort' and 'ours'", strategy)
+	}
+}
+
+// parseStrategyOption returns the side of the merge favored by the strategy option param in |apr|, if any.
+func parseStrategyOption(apr *argparser.ArgParseResults) (merge.Favor, error) {
+	option, ok := apr.GetValue(cli.StrategyOptionParam)
+	if !ok {
+		return merge.FavorNone, nil
+	}
+	return merge.ParseFavor(option)
+}
+
 func getNameAndEmail(ctx *sql.Context, apr *argparser.ArgParseResults) (string, string, error) {
 	var err error
 	var name, email string
@@ -520,6 +727,7 @@ func mergeRootToWorking(
 	workingDiffs map[doltdb.TableName]hash.Hash,
 	cm2 *doltdb.Commit,
 	cm2Spec string,
+	favor merge.Favor,
 ) (*doltdb.WorkingSet, error) {
 	var err error
 	staged, working := merged.Root, merged.Root
@@ -533,7 +741,7 @@ func mergeRootToWorking(
 	if !squash || merged.HasSchemaConflicts() {
 		ws = ws.StartMerge(cm2, cm2Spec)
 		tt := merge.SchemaConflictTableNames(merged.SchemaConflicts)
-		ws = ws.WithUnmergableTables(tt)
+		ws = ws.WithUnmergableTables(tt).WithMergeStrategyOption(string(favor))
 	}
 
 	ws = ws.WithWorkingRoot(working)
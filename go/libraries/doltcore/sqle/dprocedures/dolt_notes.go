@@ -0,0 +1,175 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// doltNotes is the stored procedure version for the CLI command `dolt notes`.
+func doltNotes(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltNotes(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+// doDoltNotes is used as sql dolt_notes command for only adding or removing notes, not listing.
+// To read notes, dolt_notes system table is used.
+func doDoltNotes(ctx *sql.Context, args []string) (int, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return 1, err
+	}
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return 1, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	apr, err := cli.CreateNotesArgParser().Parse(args)
+	if err != nil {
+		return 1, err
+	}
+
+	if apr.NArg() == 0 {
+		return 1, fmt.Errorf("error: invalid argument, use 'dolt_notes' system table to list notes")
+	}
+
+	switch apr.Arg(0) {
+	case "add":
+		err = addNote(ctx, dbData, apr, dSess)
+	case "remove", "rm":
+		err = removeNote(ctx, dbData, apr, dSess)
+	default:
+		err = fmt.Errorf("error: invalid argument")
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	return 0, nil
+}
+
+func addNote(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, sess *dsess.DoltSession) error {
+	msg, ok := apr.GetValue(cli.MessageArg)
+	if !ok {
+		return fmt.Errorf("error: a note message must be provided with -m")
+	}
+	notesRef, cm, err := resolveNoteTarget(ctx, dbData, apr)
+	if err != nil {
+		return err
+	}
+
+	if !apr.Contains(cli.ForceFlag) {
+		_, err = dbData.Ddb.GetNote(ctx, notesRef, cm)
+		if err == nil {
+			return fmt.Errorf("error: cannot add notes; found existing notes for object %s. Use '-f' to overwrite existing notes", noteCommitSpec(apr))
+		} else if !errors.Is(err, doltdb.ErrNoteNotFound) {
+			return err
+		}
+	}
+
+	meta, err := datas.NewCommitMeta(sess.Username(), sess.Email(), "Notes added by 'dolt notes add'")
+	if err != nil {
+		return err
+	}
+	return dbData.Ddb.SetNote(ctx, notesRef, cm, msg, meta)
+}
+
+func removeNote(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, sess *dsess.DoltSession) error {
+	if apr.Contains(cli.MessageArg) || apr.Contains(cli.ForceFlag) {
+		return fmt.Errorf("error: invalid argument")
+	}
+	notesRef, cm, err := resolveNoteTarget(ctx, dbData, apr)
+	if err != nil {
+		return err
+	}
+
+	meta, err := datas.NewCommitMeta(sess.Username(), sess.Email(), "Notes removed by 'dolt notes remove'")
+	if err != nil {
+		return err
+	}
+	err = dbData.Ddb.RemoveNote(ctx, notesRef, cm, meta)
+	if errors.Is(err, doltdb.ErrNoteNotFound) {
+		return fmt.Errorf("error: object %s has no note", noteCommitSpec(apr))
+	}
+	return err
+}
+
+// resolveNoteTarget returns the notes ref and the hash of the commit given by the arguments of a notes subcommand.
+// They default to refs/notes/commits and HEAD.
+func resolveNoteTarget(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults) (ref.NotesRef, hash.Hash, error) {
+	if apr.NArg() > 2 {
+		return ref.NotesRef{}, hash.Hash{}, fmt.Errorf("error: too many arguments")
+	}
+
+	notesName := apr.GetValueOrDefault(cli.NotesRefParam, ref.DefaultNotesName)
+	if ref.IsRef(notesName) && !strings.HasPrefix(notesName, ref.PrefixForType(ref.NotesRefType)) {
+		return ref.NotesRef{}, hash.Hash{}, fmt.Errorf("error: refusing to use notes in %s (outside of refs/notes/)", notesName)
+	}
+	notesRef := ref.NewNotesRef(notesName)
+	if !ref.IsValidBranchName(notesRef.GetPath()) {
+		return ref.NotesRef{}, hash.Hash{}, fmt.Errorf("error: invalid notes ref name: %s", notesName)
+	}
+
+	cs, err := doltdb.NewCommitSpec(noteCommitSpec(apr))
+	if err != nil {
+		return ref.NotesRef{}, hash.Hash{}, err
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return ref.NotesRef{}, hash.Hash{}, err
+	}
+	optCmt, err := dbData.Ddb.Resolve(ctx, cs, headRef)
+	if err != nil {
+		return ref.NotesRef{}, hash.Hash{}, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return ref.NotesRef{}, hash.Hash{}, doltdb.ErrGhostCommitEncountered
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return ref.NotesRef{}, hash.Hash{}, err
+	}
+	return notesRef, h, nil
+}
+
+// noteCommitSpec returns the commit argument of a notes subcommand, which defaults to HEAD.
+func noteCommitSpec(apr *argparser.ArgParseResults) string {
+	if apr.NArg() > 1 {
+		return apr.Arg(1)
+	}
+	return "HEAD"
+}
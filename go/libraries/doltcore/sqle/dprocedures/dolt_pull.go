@@ -241,6 +241,10 @@ func doDoltPull(ctx *sql.Context, args []string) (int, int, string, error) {
 	if err != nil {
 		return conflicts, fastForward, "", err
 	}
+	err = actions.FetchFollowNotes(ctx, tmpDir, srcDB, dbData.Ddb, runProgFuncs, stopProgFuncs)
+	if err != nil {
+		return conflicts, fastForward, "", err
+	}
 
 	return conflicts, fastForward, message, nil
 }
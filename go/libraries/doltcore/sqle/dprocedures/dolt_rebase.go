@@ -182,7 +182,11 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		if !apr.Contains(cli.InteractiveFlag) {
 			return 1, "", fmt.Errorf("non-interactive rebases not currently supported")
 		}
-		err = startRebase(ctx, apr.Arg(0), commitBecomesEmptyHandling, emptyCommitHandling)
+		favor, err := parseStrategyOption(apr)
+		if err != nil {
+			return 1, "", err
+		}
+		err = startRebase(ctx, apr.Arg(0), commitBecomesEmptyHandling, emptyCommitHandling, favor)
 		if err != nil {
 			return 1, "", err
 		}
@@ -223,8 +227,9 @@ func processCommitBecomesEmptyParams(apr *argparser.ArgParseResults) (doltdb.Emp
 
 // startRebase starts a new interactive rebase operation. |upstreamPoint| specifies the commit where the new rebased
 // commits will be based off of, |commitBecomesEmptyHandling| specifies how to  handle commits that are not empty, but
-// do not produce any changes when applied, and |emptyCommitHandling| specifies how to handle empty commits.
-func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) error {
+// do not produce any changes when applied, |emptyCommitHandling| specifies how to handle empty commits, and |favor|
+// specifies which side data conflicts are automatically resolved in favor of, if any.
+func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling, favor merge.Favor) error {
 	if upstreamPoint == "" {
 		return fmt.Errorf("no upstream branch specified")
 	}
@@ -312,7 +317,7 @@ func startRebase(ctx *sql.Context, upstreamPoint string, commitBecomesEmptyHandl
 	}
 
 	newWorkingSet, err := workingSet.StartRebase(ctx, upstreamCommit, rebaseBranch, branchRoots.Working,
-		commitBecomesEmptyHandling, emptyCommitHandling)
+		commitBecomesEmptyHandling, emptyCommitHandling, string(favor))
 	if err != nil {
 		return err
 	}
@@ -677,7 +682,8 @@ func continueRebase(ctx *sql.Context) (string, error) {
 
 			err = processRebasePlanStep(ctx, &step,
 				workingSet.RebaseState().CommitBecomesEmptyHandling(),
-				workingSet.RebaseState().EmptyCommitHandling())
+				workingSet.RebaseState().EmptyCommitHandling(),
+				merge.Favor(workingSet.RebaseState().StrategyOption()))
 			if err != nil {
 				return "", err
 			}
@@ -792,7 +798,7 @@ func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlan
 }
 
 func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep,
-	commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling) error {
+	commitBecomesEmptyHandling doltdb.EmptyCommitHandling, emptyCommitHandling doltdb.EmptyCommitHandling, favor merge.Favor) error {
 	// Make sure we have a transaction opened for the session
 	// NOTE: After our first call to cherry-pick, the tx is committed, so a new tx needs to be started
 	//       as we process additional rebase actions.
@@ -813,6 +819,7 @@ func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep,
 	if err != nil {
 		return err
 	}
+	options.Favor = favor
 
 	return handleRebaseCherryPick(ctx, planStep, *options)
 }
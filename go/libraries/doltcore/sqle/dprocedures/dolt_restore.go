@@ -0,0 +1,388 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// doltRestore is the stored procedure version for the CLI command `dolt restore`.
+func doltRestore(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltRestore(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+// doDoltRestore restores tables in the working set to their contents in the source revision, which defaults to the
+// staged tables. With --where, only the rows of a single table that match the predicate are restored.
+func doDoltRestore(ctx *sql.Context, args []string) (int, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return 1, err
+	}
+
+	apr, err := cli.CreateRestoreArgParser().Parse(args)
+	if err != nil {
+		return 1, err
+	}
+	if apr.NArg() == 0 {
+		return 1, fmt.Errorf("error: you must specify the tables to restore")
+	}
+	predicate, hasPredicate := apr.GetValue(cli.WhereParam)
+	if hasPredicate && apr.NArg() != 1 {
+		return 1, fmt.Errorf("error: --%s requires exactly one table", cli.WhereParam)
+	}
+
+	dSess := dsess.DSessFromSess(ctx.Session)
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return 1, sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	sourceName := "the staged tables"
+	source := roots.Staged
+	if spec, ok := apr.GetValue(cli.SourceParam); ok {
+		sourceName = spec
+		if source, err = resolveRestoreSource(ctx, dbName, spec); err != nil {
+			return 1, err
+		}
+	}
+
+	working := roots.Working
+	if hasPredicate {
+		working, err = restoreTableRows(ctx, working, source, sourceName, apr.Arg(0), predicate)
+	} else {
+		working, err = restoreTables(ctx, working, source, sourceName, apr.Args)
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	if err = dSess.SetWorkingRoot(ctx, dbName, working); err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// resolveRestoreSource returns the root value of the commit |spec|, resolved relative to the current branch.
+func resolveRestoreSource(ctx *sql.Context, dbName, spec string) (doltdb.RootValue, error) {
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	cs, err := doltdb.NewCommitSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	headRef, err := dSess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	optCmt, err := dbData.Ddb.Resolve(ctx, cs, headRef)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm.GetRootValue(ctx)
+}
+
+// restoreTables returns |working| with the tables named replaced by their contents in |source|.
+func restoreTables(ctx *sql.Context, working, source doltdb.RootValue, sourceName string, tables []string) (doltdb.RootValue, error) {
+	tableNames := make([]doltdb.TableName, len(tables))
+	for i, table := range tables {
+		name, _, ok, err := resolve.Table(ctx, source, table)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("error: table %s does not exist in %s", table, sourceName)
+		}
+		tableNames[i] = name
+	}
+	return actions.MoveTablesBetweenRoots(ctx, tableNames, source, working)
+}
+
+// restoreTableRows returns |working| with the rows of the table named that match |predicate| restored to their values
+// in |source|.
+func restoreTableRows(ctx *sql.Context, working, source doltdb.RootValue, sourceName, table, predicate string) (doltdb.RootValue, error) {
+	tblName, tbl, ok, err := resolve.Table(ctx, working, table)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, doltdb.ErrTableNotFound
+	}
+	srcTbl, ok, err := source.GetTable(ctx, tblName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("error: table %s does not exist in %s", table, sourceName)
+	}
+	if tbl.Format() != types.Format_DOLT {
+		return nil, fmt.Errorf("restoring rows is not supported by this storage format")
+	}
+
+	tbl, err = restoreRows(ctx, tblName.Name, tbl, srcTbl, predicate)
+	if err != nil {
+		return nil, err
+	}
+	newWorking, err := working.PutTable(ctx, tblName, tbl)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows restored to this table may reference parent rows that are gone, or be parents that other rows reference
+	violators, err := merge.GetForeignKeyViolatedTables(ctx, newWorking, working, doltdb.NewTableNameSet(nil))
+	if err != nil {
+		return nil, err
+	}
+	if violators.Size() > 0 {
+		return nil, fmt.Errorf("error: restoring rows of table %s would violate foreign keys of %s", table, strings.Join(violators.AsStringSlice(), ", "))
+	}
+	return newWorking, nil
+}
+
+// restoreRows returns |tbl| with the rows that match |predicate| in either |tbl| or |srcTbl| restored to their values
+// in |srcTbl|. Such rows which don't exist in |srcTbl| are deleted. Only the rows that differ between the tables are
+// visited, and the secondary index entries of restored rows are updated along with them. Returns an error if a
+// restored row has the same unique key as another row of |tbl|.
+func restoreRows(ctx *sql.Context, tableName string, tbl, srcTbl *doltdb.Table, predicate string) (*doltdb.Table, error) {
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := durableRows(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+	srcRows, err := durableRows(ctx, srcTbl)
+	if err != nil {
+		return nil, err
+	}
+	kd, vd := rows.Descriptors()
+	srcKd, srcVd := srcRows.Descriptors()
+	if !kd.Equals(srcKd) || !vd.Equals(srcVd) {
+		return nil, fmt.Errorf("error: the schema of table %s has changed, so its rows can't be restored individually", tableName)
+	}
+
+	expr, err := expranalysis.ResolveIndexPredicate(ctx, tableName, sch, predicate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid predicate: %w", err)
+	}
+	ns := tbl.NodeStore()
+	matches := func(k, v val.Tuple) (bool, error) {
+		row, err := index.BuildRow(ctx, k, v, sch, ns)
+		if err != nil {
+			return false, err
+		}
+		return index.PredicateMatchesRow(ctx, expr, row)
+	}
+
+	idxSet, err := tbl.GetIndexSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mutIdxs, err := merge.GetMutableSecondaryIdxs(ctx, sch, sch, tableName, idxSet)
+	if err != nil {
+		return nil, err
+	}
+
+	mutMap := rows.Mutate()
+	err = prolly.DiffMaps(ctx, rows, srcRows, false, func(ctx context.Context, d tree.Diff) error {
+		key, from, to := val.Tuple(d.Key), val.Tuple(d.From), val.Tuple(d.To)
+		restore := false
+		if to != nil {
+			if restore, err = matches(key, to); err != nil {
+				return err
+			}
+		}
+		if !restore && from != nil {
+			if restore, err = matches(key, from); err != nil {
+				return err
+			}
+		}
+		if !restore {
+			return nil
+		}
+
+		if to == nil {
+			err = mutMap.Delete(ctx, key)
+		} else {
+			err = mutMap.Put(ctx, key, to)
+		}
+		if err != nil {
+			return err
+		}
+		for _, mutIdx := range mutIdxs {
+			switch d.Type {
+			case tree.AddedDiff:
+				err = mutIdx.InsertEntry(ctx, key, to)
+			case tree.RemovedDiff:
+				err = mutIdx.DeleteEntry(ctx, key, from)
+			default:
+				err = mutIdx.UpdateEntry(ctx, key, from, to)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	newRows, err := mutMap.Map(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err = tbl.UpdateRows(ctx, durable.IndexFromProllyMap(newRows))
+	if err != nil {
+		return nil, err
+	}
+	for _, mutIdx := range mutIdxs {
+		m, err := mutIdx.Map(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idxSet, err = idxSet.PutIndex(ctx, mutIdx.Name, durable.IndexFromProllyMap(m))
+		if err != nil {
+			return nil, err
+		}
+	}
+	idxSet, err = index.SyncColumnarIndexes(ctx, sch, idxSet, rows, newRows)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkRestoredUniqueKeys(ctx, tableName, sch, rows, newRows, idxSet); err != nil {
+		return nil, err
+	}
+	return tbl.SetIndexSet(ctx, idxSet)
+}
+
+// checkRestoredUniqueKeys returns an error if a row that was restored in |newRows| has the same unique key as another
+// row. The unique indexes in |idxSet| must already hold the restored rows.
+func checkRestoredUniqueKeys(ctx *sql.Context, tableName string, sch schema.Schema, rows, newRows prolly.Map, idxSet durable.IndexSet) error {
+	for _, def := range sch.Indexes().AllIndexes() {
+		if !def.IsUnique() || def.IsColumnar() {
+			continue
+		}
+		idx, err := idxSet.GetIndex(ctx, sch, nil, def.Name())
+		if err != nil {
+			return err
+		}
+		m := durable.ProllyMapFromIndex(idx)
+		keyBld, err := index.NewSecondaryKeyBuilder(ctx, tableName, sch, def, m.KeyDesc(), m.Pool(), m.NodeStore())
+		if err != nil {
+			return err
+		}
+		pred, err := index.NewIndexPredicate(ctx, tableName, sch, def, m.NodeStore())
+		if err != nil {
+			return err
+		}
+		prefixDesc := m.KeyDesc().PrefixDesc(def.Count())
+		prefixBld := val.NewTupleBuilder(prefixDesc)
+
+		err = prolly.DiffMaps(ctx, rows, newRows, false, func(ctx context.Context, d tree.Diff) error {
+			key, to := val.Tuple(d.Key), val.Tuple(d.To)
+			if to == nil {
+				return nil
+			}
+			if ok, err := pred.Matches(ctx, key, to); err != nil || !ok {
+				return err
+			}
+			idxKey, err := keyBld.SecondaryKeyFromRow(ctx, key, to)
+			if err != nil {
+				return err
+			}
+			for i := 0; i < def.Count(); i++ {
+				if idxKey.FieldIsNull(i) {
+					// NULL is incomparable and cannot trigger a UNIQUE KEY violation
+					prefixBld.Recycle()
+					return nil
+				}
+				prefixBld.PutRaw(i, idxKey.GetField(i))
+			}
+			prefix := prefixBld.Build(m.Pool())
+
+			iter, err := m.IterRange(ctx, prolly.PrefixRange(prefix, prefixDesc))
+			if err != nil {
+				return err
+			}
+			for n := 0; ; n++ {
+				if _, _, err = iter.Next(ctx); err == io.EOF {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				if n > 0 {
+					return sql.NewUniqueKeyErr(formatUniqueKey(prefixDesc, prefix), false, nil)
+				}
+			}
+		})
+		if err != nil && err != io.EOF {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatUniqueKey(desc val.TupleDesc, key val.Tuple) string {
+	fields := make([]string, desc.Count())
+	for i := range fields {
+		fields[i] = desc.FormatValue(i, key.GetField(i))
+	}
+	return "[" + strings.Join(fields, ",") + "]"
+}
+
+func durableRows(ctx context.Context, tbl *doltdb.Table) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return durable.ProllyMapFromIndex(idx), nil
+}
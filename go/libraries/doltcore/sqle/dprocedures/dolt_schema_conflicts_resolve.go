@@ -0,0 +1,249 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+)
+
+const schemaConflictsResolveUsage = "usage: dolt_schema_conflicts_resolve(table, 'column', column, 'ours'|'theirs'|'widen')"
+
+// doltSchemaConflictsResolve is the stored procedure that resolves a single column schema conflict of an in-progress
+// merge. Once every schema conflict of a table is resolved, the table's data is merged using the resolved schema.
+func doltSchemaConflictsResolve(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltSchemaConflictsResolve(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+func doDoltSchemaConflictsResolve(ctx *sql.Context, args []string) (int, error) {
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return 1, err
+	}
+	dbName := ctx.GetCurrentDatabase()
+
+	if len(args) != 4 {
+		return 1, fmt.Errorf(schemaConflictsResolveUsage)
+	}
+	tableArg, kind, column, resolution := args[0], strings.ToLower(args[1]), args[2], merge.ColumnResolution(strings.ToLower(args[3]))
+	if kind != "column" {
+		return 1, fmt.Errorf("unsupported schema conflict kind '%s'; %s", args[1], schemaConflictsResolveUsage)
+	}
+	switch resolution {
+	case merge.ResolveColumnOurs, merge.ResolveColumnTheirs, merge.ResolveColumnWiden:
+	default:
+		return 1, fmt.Errorf("unknown resolution '%s'; %s", args[3], schemaConflictsResolveUsage)
+	}
+
+	dSess := dsess.DSessFromSess(ctx.Session)
+	ws, err := dSess.WorkingSet(ctx, dbName)
+	if err != nil {
+		return 1, err
+	}
+	if !ws.MergeActive() || !ws.MergeState().HasSchemaConflicts() {
+		return 1, fmt.Errorf("no schema conflicts to resolve")
+	}
+	ddb, ok := dSess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return 1, sql.ErrDatabaseNotFound.New(dbName)
+	}
+
+	tblName, _, ok, err := resolve.Table(ctx, ws.WorkingRoot(), tableArg)
+	if err != nil {
+		return 1, err
+	}
+	if !ok || !doltdb.NewTableNameSet(ws.MergeState().TablesWithSchemaConflicts()).Contains(tblName) {
+		return 1, fmt.Errorf("table '%s' has no schema conflicts", tableArg)
+	}
+
+	theirCommit := ws.MergeState().Commit()
+	var optCmt *doltdb.OptionalCommit
+	if ws.MergeState().IsCherryPick() {
+		// like dolt_cherry_pick, only apply the delta of the cherry-picked commit
+		optCmt, err = ddb.ResolveParent(ctx, theirCommit, 0)
+	} else {
+		var headCommit *doltdb.Commit
+		headCommit, err = dSess.GetHeadCommit(ctx, dbName)
+		if err != nil {
+			return 1, err
+		}
+		optCmt, err = doltdb.GetCommitAncestor(ctx, headCommit, theirCommit)
+	}
+	if err != nil {
+		return 1, err
+	}
+	ancCommit, ok := optCmt.ToCommit()
+	if !ok {
+		return 1, doltdb.ErrGhostCommitEncountered
+	}
+	theirRoot, err := theirCommit.GetRootValue(ctx)
+	if err != nil {
+		return 1, err
+	}
+	ancRoot, err := ancCommit.GetRootValue(ctx)
+	if err != nil {
+		return 1, err
+	}
+	ourRoot := ws.WorkingRoot()
+
+	ourSch, err := getTableSchema(ctx, ourRoot, tblName)
+	if err != nil {
+		return 1, err
+	}
+	theirSch, err := getTableSchema(ctx, theirRoot, tblName)
+	if err != nil {
+		return 1, err
+	}
+	ancSch, err := getTableSchema(ctx, ancRoot, tblName)
+	if err != nil {
+		return 1, err
+	}
+	if ourSch == nil || theirSch == nil || ancSch == nil {
+		return 1, fmt.Errorf("table '%s' was deleted on one side of the merge; resolve it with dolt_conflicts_resolve", tableArg)
+	}
+
+	var otherResolutions []doltdb.SchemaConflictResolution
+	for _, r := range ws.MergeState().ResolvedSchemaConflicts() {
+		if r.Table != tblName {
+			otherResolutions = append(otherResolutions, r)
+		}
+	}
+	resolutions := merge.ColumnResolutionsForTable(ws.MergeState().ResolvedSchemaConflicts(), tblName)
+	if resolutions == nil {
+		resolutions = make(merge.ColumnResolutions)
+	}
+
+	_, conflict, _, _, err := merge.SchemaMergeWithResolutions(ctx, ddb.Format(), ourSch, theirSch, ancSch, tblName, resolutions)
+	if err != nil {
+		return 1, err
+	}
+	if !hasColumnConflict(conflict, column) {
+		return 1, fmt.Errorf("column '%s' of table '%s' has no schema conflict", column, tableArg)
+	}
+	if isPrimaryKeyColumn(ourSch, column) || isPrimaryKeyColumn(theirSch, column) {
+		return 1, fmt.Errorf("cannot resolve schema conflict for primary key column '%s'", column)
+	}
+
+	resolutions[strings.ToLower(column)] = resolution
+	_, conflict, _, _, err = merge.SchemaMergeWithResolutions(ctx, ddb.Format(), ourSch, theirSch, ancSch, tblName, resolutions)
+	if err != nil {
+		return 1, err
+	}
+
+	if conflict.Count() > 0 {
+		// other conflicts remain, so record the resolution until the table can be merged
+		resolved := otherResolutions
+		for col, res := range resolutions {
+			resolved = append(resolved, doltdb.SchemaConflictResolution{Table: tblName, Column: col, Resolution: string(res)})
+		}
+		if err = dSess.SetWorkingSet(ctx, dbName, ws.WithResolvedSchemaConflicts(resolved)); err != nil {
+			return 1, err
+		}
+		return 0, nil
+	}
+
+	merger, err := merge.NewMerger(ourRoot, theirRoot, ancRoot, theirCommit, ancCommit, ddb.ValueReadWriter(), ddb.NodeStore())
+	if err != nil {
+		return 1, err
+	}
+	mergeOpts := merge.MergeOpts{
+		IsCherryPick:        ws.MergeState().IsCherryPick(),
+		KeepSchemaConflicts: true,
+		ColumnResolutions:   map[doltdb.TableName]merge.ColumnResolutions{tblName: resolutions},
+		// the data is merged with the strategy option the merge was started with, as it would have been if the
+		// schemas hadn't conflicted
+		Favor: merge.Favor(ws.MergeState().StrategyOption()),
+	}
+	merged, _, err := merger.MergeTable(ctx, tblName, editor.Options{}, mergeOpts)
+	if err != nil {
+		return 1, err
+	}
+	if merged.SchemaConflict().Count() > 0 {
+		return 1, merged.SchemaConflict()
+	}
+
+	// like the other tables of the merge, the merged table is staged
+	newWorking, err := putOrRemoveTable(ctx, ourRoot, tblName, merged.Table())
+	if err != nil {
+		return 1, err
+	}
+	newStaged, err := putOrRemoveTable(ctx, ws.StagedRoot(), tblName, merged.Table())
+	if err != nil {
+		return 1, err
+	}
+
+	var unmerged []doltdb.TableName
+	for _, tbl := range ws.MergeState().TablesWithSchemaConflicts() {
+		if tbl != tblName {
+			unmerged = append(unmerged, tbl)
+		}
+	}
+	mergedTables := append(append([]doltdb.TableName{}, ws.MergeState().MergedTables()...), tblName)
+
+	ws = ws.WithWorkingRoot(newWorking).
+		WithStagedRoot(newStaged).
+		WithUnmergableTables(unmerged).
+		WithMergedTables(mergedTables).
+		WithResolvedSchemaConflicts(otherResolutions)
+	if err = dSess.SetWorkingSet(ctx, dbName, ws); err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// putOrRemoveTable puts |tbl| into |root| as |tblName|, or removes |tblName| from |root| if |tbl| is nil.
+func putOrRemoveTable(ctx *sql.Context, root doltdb.RootValue, tblName doltdb.TableName, tbl *doltdb.Table) (doltdb.RootValue, error) {
+	if tbl == nil {
+		return root.RemoveTables(ctx, false, false, tblName)
+	}
+	return root.PutTable(ctx, tblName, tbl)
+}
+
+// getTableSchema returns the schema of table |tblName| in |root|, or nil if the table doesn't exist.
+func getTableSchema(ctx *sql.Context, root doltdb.RootValue, tblName doltdb.TableName) (schema.Schema, error) {
+	tbl, ok, err := root.GetTable(ctx, tblName)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return tbl.GetSchema(ctx)
+}
+
+// hasColumnConflict returns whether |conflict| includes a conflict for the column named |column|.
+func hasColumnConflict(conflict merge.SchemaConflict, column string) bool {
+	for _, c := range conflict.ColConflicts {
+		if strings.EqualFold(c.Ours.Name, column) || strings.EqualFold(c.Theirs.Name, column) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrimaryKeyColumn(sch schema.Schema, column string) bool {
+	_, ok := sch.GetPKCols().GetByNameCaseInsensitive(column)
+	return ok
+}
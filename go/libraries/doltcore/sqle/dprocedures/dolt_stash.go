@@ -0,0 +1,170 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+// doltStash is the stored procedure version for the CLI command `dolt stash`.
+func doltStash(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	res, err := doDoltStash(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return rowToIter(int64(res)), nil
+}
+
+// doDoltStash is used as sql dolt_stash command for pushing, popping, dropping and clearing stash entries. To list
+// them, the dolt_stashes system table is used.
+func doDoltStash(ctx *sql.Context, args []string) (int, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return 1, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return 1, err
+	}
+	dSess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := dSess.GetDbData(ctx, dbName)
+	if !ok {
+		return 1, fmt.Errorf("Could not load database %s", dbName)
+	}
+	if !dbData.Ddb.Format().UsesFlatbuffers() {
+		return 1, actions.ErrStashNotSupportedForOldFormat
+	}
+
+	apr, err := cli.CreateStashArgParser().Parse(args)
+	if err != nil {
+		return 1, err
+	}
+
+	subcommand := "push"
+	if apr.NArg() > 0 {
+		subcommand = strings.ToLower(apr.Arg(0))
+	}
+	if subcommand != "push" && (apr.Contains(cli.IncludeUntrackedFlag) || apr.Contains(cli.AllFlag)) {
+		return 1, fmt.Errorf("error: --%s and --%s are only valid for push", cli.IncludeUntrackedFlag, cli.AllFlag)
+	}
+
+	switch subcommand {
+	case "push":
+		if apr.NArg() > 1 {
+			return 1, fmt.Errorf("error: invalid argument %s", apr.Arg(1))
+		}
+		err = pushStash(ctx, dSess, dbName, apr)
+	case "pop", "drop":
+		var idx int
+		idx, err = stashIndex(apr)
+		if err != nil {
+			return 1, err
+		}
+		if subcommand == "pop" {
+			err = applyStash(ctx, dSess, dbName, idx)
+		}
+		if err == nil {
+			err = dbData.Ddb.RemoveStashAtIdx(ctx, idx)
+		}
+	case "clear":
+		if apr.NArg() > 1 {
+			return 1, fmt.Errorf("error: invalid argument %s", apr.Arg(1))
+		}
+		err = dbData.Ddb.RemoveAllStashes(ctx)
+	case "list":
+		err = fmt.Errorf("error: invalid argument, use 'dolt_stashes' system table to list stashes")
+	default:
+		err = fmt.Errorf("error: invalid argument %s", apr.Arg(0))
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	return 0, nil
+}
+
+// stashIndex returns the index of the stash entry named by the second argument of |apr|, which is either a plain index
+// or a name like stash@{1}. The latest entry is used if none is named.
+func stashIndex(apr *argparser.ArgParseResults) (int, error) {
+	switch apr.NArg() {
+	case 1:
+		return 0, nil
+	case 2:
+		stashName := strings.TrimSuffix(strings.TrimPrefix(apr.Arg(1), "stash@{"), "}")
+		idx, err := strconv.Atoi(stashName)
+		if err != nil {
+			return 0, fmt.Errorf("error: %s is not a valid reference", apr.Arg(1))
+		}
+		return idx, nil
+	default:
+		return 0, fmt.Errorf("error: too many arguments")
+	}
+}
+
+// pushStash saves the local changes of the session's working set as a new stash entry, and resets the working set to
+// HEAD. Nothing is done if there are no local changes to save.
+func pushStash(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, apr *argparser.ArgParseResults) error {
+	dbData, _ := dSess.GetDbData(ctx, dbName)
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("Could not load database %s", dbName)
+	}
+	headRef, err := dSess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	headCommit, err := dSess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return err
+	}
+
+	roots, stashed, err := actions.StashChanges(ctx, dbData.Ddb, roots, headRef, headCommit, apr.Contains(cli.IncludeUntrackedFlag), apr.Contains(cli.AllFlag))
+	if err != nil || !stashed {
+		return err
+	}
+	return dSess.SetRoots(ctx, dbName, roots)
+}
+
+// applyStash merges the stash entry at |idx| into the session's working set. The stash is not applied if doing so
+// would conflict with the local changes.
+func applyStash(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, idx int) error {
+	dbData, _ := dSess.GetDbData(ctx, dbName)
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("Could not load database %s", dbName)
+	}
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	roots, err = merge.ApplyStash(ctx, dbData.Ddb, roots, idx, dbState.EditOpts())
+	if err != nil {
+		return err
+	}
+	return dSess.SetRoots(ctx, dbName, roots)
+}
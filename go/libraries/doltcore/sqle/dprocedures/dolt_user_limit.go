@@ -0,0 +1,73 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// doltUserLimit sets a resource limit for an account or role of the running sql-server, named `name` or `name@host`.
+// Limits set this way take effect immediately for all sessions of the affected accounts, and are persisted next to the
+// server's privileges so that they are applied on top of the user_limits section of the server config after a restart.
+func doltUserLimit(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if err := checkDoltUserLimitPrivs(ctx); err != nil {
+		return nil, err
+	}
+
+	apr, err := cli.CreateUserLimitArgParser().Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	if apr.NArg() != 3 {
+		return nil, fmt.Errorf("usage: dolt_user_limit([--role], name, limit, value)")
+	}
+
+	name, limit := apr.Arg(0), strings.ToLower(apr.Arg(1))
+	val, err := strconv.ParseUint(apr.Arg(2), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value '%s' for limit %s: must be a non-negative integer", apr.Arg(2), limit)
+	}
+
+	limiter := dsess.DSessFromSess(ctx.Session).ResourceLimiter()
+	if limiter == nil {
+		return nil, fmt.Errorf("dolt_user_limit is only supported when running dolt sql-server")
+	}
+	if err = limiter.SetLimit(name, apr.Contains(cli.RoleFlag), limit, val); err != nil {
+		return nil, err
+	}
+
+	return rowToIter(int64(cmdSuccess)), nil
+}
+
+// checkDoltUserLimitPrivs returns an error if the user requesting to set a resource limit does not have SUPER access,
+// since the limits apply server-wide.
+func checkDoltUserLimitPrivs(ctx *sql.Context) error {
+	privs, counter := ctx.GetPrivilegeSet()
+	if counter == 0 {
+		return fmt.Errorf("unable to check user privileges for dolt_user_limit procedure")
+	}
+	if privs.Has(sql.PrivilegeType_Super) == false {
+		return sql.ErrPrivilegeCheckFailed.New(ctx.Session.Client().User)
+	}
+
+	return nil
+}
@@ -63,6 +63,11 @@ type DoltSession struct {
 	mu               *sync.Mutex
 	fs               filesys.Filesys
 	writeSessProv    WriteSessFunc
+	limiter          *ResourceLimiter
+	account          string
+	roles            []string
+	// lastCommit is the hash of the most recent commit this session created, see TakeLastCommit
+	lastCommit hash.Hash
 
 	// If non-nil, this will be returned from ValidateSession.
 	// Used by sqle/cluster to put a session into a terminal err state.
@@ -625,6 +630,16 @@ func (d *DoltSession) DoltCommit(
 	return d.commitCurrentHead(ctx, dbName, tx, commitFunc)
 }
 
+// TakeLastCommit returns the hash of the most recent commit this session created and clears it, so that a later call
+// only returns a commit created after this one. Returns false if the session created no commit since the last call.
+func (d *DoltSession) TakeLastCommit() (hash.Hash, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	h := d.lastCommit
+	d.lastCommit = hash.Hash{}
+	return h, !h.IsEmpty()
+}
+
 // doCommitFunc is a function to write to the database, which involves updating the working set and potentially
 // updating HEAD with a new commit
 type doCommitFunc func(ctx *sql.Context, dtx *DoltTransaction, workingSet *doltdb.WorkingSet) (*doltdb.WorkingSet, *doltdb.Commit, error)
@@ -645,6 +660,15 @@ func (d *DoltSession) commitBranchState(
 	if err != nil {
 		return nil, err
 	}
+	if newCommit != nil {
+		h, err := newCommit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		d.mu.Lock()
+		d.lastCommit = h
+		d.mu.Unlock()
+	}
 
 	// Anything that commits a transaction needs its current transaction state cleared so that the next statement starts
 	// a new transaction. This should in principle be done by the engine, but it currently only understands explicit
@@ -994,9 +1018,29 @@ func (d *DoltSession) SetWorkingRoot(ctx *sql.Context, dbName string, newRoot do
 	if branchState.readOnly {
 		return fmt.Errorf("cannot set root on read-only session")
 	}
+	if err = d.checkWorkingSetLimit(ctx, branchState.roots().Head, newRoot); err != nil {
+		return err
+	}
 	return d.SetWorkingSet(ctx, dbName, existingWorkingSet.WithWorkingRoot(newRoot))
 }
 
+// checkWorkingSetLimit returns an error if the changes between |headRoot| and |newRoot| exceed the session user's
+// max_working_set_rows limit.
+func (d *DoltSession) checkWorkingSetLimit(ctx *sql.Context, headRoot, newRoot doltdb.RootValue) error {
+	limit := d.ResourceLimits().MaxWorkingSetRows
+	if limit == 0 {
+		return nil
+	}
+	changed, err := countChangedRows(ctx, headRoot, newRoot, limit)
+	if err != nil {
+		return err
+	}
+	if changed > limit {
+		return ErrUserLimitReached(d.Client().User, MaxWorkingSetRowsLimit, limit)
+	}
+	return nil
+}
+
 // SetStagingRoot sets the staging root for the session's current database. This is useful when editing the staged
 // table without messing with the HEAD or working trees.
 func (d *DoltSession) SetStagingRoot(ctx *sql.Context, dbName string, newRoot doltdb.RootValue) error {
@@ -1055,6 +1099,26 @@ func (d *DoltSession) ResetGlobals(ctx *sql.Context, dbName string, root doltdb.
 	return nil
 }
 
+// SetResourceLimiter sets the limiter whose limits apply to this session's |account|, which has been granted |roles|.
+func (d *DoltSession) SetResourceLimiter(limiter *ResourceLimiter, account string, roles []string) {
+	d.limiter = limiter
+	d.account = account
+	d.roles = roles
+}
+
+// ResourceLimiter returns the limiter of the sql-server this session belongs to, or nil if it has none.
+func (d *DoltSession) ResourceLimiter() *ResourceLimiter {
+	return d.limiter
+}
+
+// ResourceLimits returns the resource limits currently in effect for this session's account.
+func (d *DoltSession) ResourceLimits() ResourceLimits {
+	if d.limiter == nil {
+		return ResourceLimits{}
+	}
+	return d.limiter.Limits(d.account, d.roles)
+}
+
 func (d *DoltSession) SetFileSystem(fs filesys.Filesys) {
 	d.fs = fs
 }
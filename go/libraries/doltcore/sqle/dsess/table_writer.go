@@ -84,8 +84,12 @@ type IndexState struct {
 	KeyMapping    val.OrdinalMapping
 	PkMapping     val.OrdinalMapping
 	IsFullText    bool
+	IsColumnar    bool
 	IsUnique      bool
 	IsSpatial     bool
 	PrefixLengths []uint16
 	Count         int
+	// Predicate is the resolved predicate of a partial index,
+	// or nil if the index stores every row
+	Predicate sql.Expression
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
@@ -370,6 +372,12 @@ func (tx *DoltTransaction) doCommit(
 	writeFn transactionWrite,
 	dbName string,
 ) (*doltdb.WorkingSet, *doltdb.Commit, error) {
+	span, ctx := ctx.Span("dsess.DoltTransaction.Commit", trace.WithAttributes(
+		attribute.String("database", dbName),
+		attribute.Bool("dolt_commit", commit != nil),
+	))
+	defer span.End()
+
 	sess := DSessFromSess(ctx.Session)
 	branchState, ok, err := sess.lookupDbState(ctx, dbName)
 	if err != nil {
@@ -0,0 +1,424 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// Names of the individual resource limits, as used in the server config and by the dolt_user_limit() procedure.
+const (
+	MaxConnectionsLimit    = "max_connections"
+	MaxExecutionTimeLimit  = "max_execution_time_millis"
+	MaxRowsLimit           = "max_rows"
+	MaxWorkingSetRowsLimit = "max_working_set_rows"
+)
+
+// ResourceLimits are the limits on the resources that the sessions of a single user may consume. A zero value for any
+// limit means that resource is not limited.
+type ResourceLimits struct {
+	// MaxConnections is the number of connections the user may have open at once.
+	MaxConnections uint64
+	// MaxExecutionTime is the longest a single statement may run before it is interrupted.
+	MaxExecutionTime time.Duration
+	// MaxRows is the number of rows a single statement may return to the client.
+	MaxRows uint64
+	// MaxWorkingSetRows is the number of uncommitted row changes a session may make to the working set of a branch.
+	MaxWorkingSetRows uint64
+}
+
+// IsZero returns whether none of the limits in |l| are set.
+func (l ResourceLimits) IsZero() bool {
+	return l == ResourceLimits{}
+}
+
+// WithLimit returns a copy of |l| with the limit named |name| set to |val|. A |val| of 0 removes the limit.
+func (l ResourceLimits) WithLimit(name string, val uint64) (ResourceLimits, error) {
+	switch name {
+	case MaxConnectionsLimit:
+		l.MaxConnections = val
+	case MaxExecutionTimeLimit:
+		l.MaxExecutionTime = time.Duration(val) * time.Millisecond
+	case MaxRowsLimit:
+		l.MaxRows = val
+	case MaxWorkingSetRowsLimit:
+		l.MaxWorkingSetRows = val
+	default:
+		return l, fmt.Errorf("unknown resource limit '%s'", name)
+	}
+	return l, nil
+}
+
+// orTighter returns |l| with each of its unset limits replaced by the corresponding limit of |other|, and each limit set
+// in both replaced by the smaller of the two.
+func (l ResourceLimits) orTighter(other ResourceLimits) ResourceLimits {
+	l.MaxConnections = minLimit(l.MaxConnections, other.MaxConnections)
+	l.MaxExecutionTime = time.Duration(minLimit(uint64(l.MaxExecutionTime), uint64(other.MaxExecutionTime)))
+	l.MaxRows = minLimit(l.MaxRows, other.MaxRows)
+	l.MaxWorkingSetRows = minLimit(l.MaxWorkingSetRows, other.MaxWorkingSetRows)
+	return l
+}
+
+// orElse returns |l| with each of its unset limits replaced by the corresponding limit of |other|.
+func (l ResourceLimits) orElse(other ResourceLimits) ResourceLimits {
+	if l.MaxConnections == 0 {
+		l.MaxConnections = other.MaxConnections
+	}
+	if l.MaxExecutionTime == 0 {
+		l.MaxExecutionTime = other.MaxExecutionTime
+	}
+	if l.MaxRows == 0 {
+		l.MaxRows = other.MaxRows
+	}
+	if l.MaxWorkingSetRows == 0 {
+		l.MaxWorkingSetRows = other.MaxWorkingSetRows
+	}
+	return l
+}
+
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// ErrTooManyUserConnections returns the MySQL error for a user exceeding their connection limit.
+func ErrTooManyUserConnections(user string) error {
+	return mysql.NewSQLError(mysql.ERTooManyUserConnections, mysql.SSClientError, "User %s already has more than 'max_user_connections' active connections", user)
+}
+
+// ErrUserLimitReached returns the MySQL error for a user exceeding the resource limit named |limit|.
+func ErrUserLimitReached(user, limit string, val uint64) error {
+	return mysql.NewSQLError(mysql.ERUserLimitReached, mysql.SSClientError, "User '%s' has exceeded the '%s' resource (current value: %d)", user, limit, val)
+}
+
+// ErrMaxExecutionTimeExceeded is returned when a statement is interrupted for running longer than its user's
+// max_execution_time_millis limit.
+var ErrMaxExecutionTimeExceeded = mysql.NewSQLError(mysql.ERQueryTimeout, mysql.SSUnknownSQLState, "Query execution was interrupted, maximum statement execution time exceeded")
+
+// AccountName returns the name of the account |user|@|host|, which is how the ResourceLimiter identifies users and
+// roles. An empty |host| is the wildcard host, as in MySQL.
+func AccountName(user, host string) string {
+	if host == "" {
+		host = "%"
+	}
+	return user + "@" + host
+}
+
+// ParseAccountName returns the account named by |name|, which may be written as `user`, `user@host`, or with either
+// part quoted, e.g. 'user'@'localhost'. A name without a host is the account of that user on the wildcard host.
+func ParseAccountName(name string) string {
+	user, host := name, ""
+	if i := strings.LastIndex(name, "@"); i >= 0 {
+		user, host = name[:i], name[i+1:]
+	}
+	return AccountName(unquoteAccountPart(user), unquoteAccountPart(host))
+}
+
+func unquoteAccountPart(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"' || s[0] == '`') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// wildcardAccount returns the account of the user of |account| on the wildcard host.
+func wildcardAccount(account string) string {
+	if i := strings.LastIndex(account, "@"); i >= 0 {
+		return AccountName(account[:i], "")
+	}
+	return AccountName(account, "")
+}
+
+// ResourceLimitOverrides are the limits set with the dolt_user_limit() procedure, keyed by account and then by limit
+// name. A value of 0 records that the limit was removed, so that it stays removed when the overrides are applied on
+// top of the limits in the server config.
+type ResourceLimitOverrides struct {
+	Users map[string]map[string]uint64 `json:"users,omitempty"`
+	Roles map[string]map[string]uint64 `json:"roles,omitempty"`
+}
+
+func (o ResourceLimitOverrides) with(account string, isRole bool, limit string, val uint64) ResourceLimitOverrides {
+	res := ResourceLimitOverrides{Users: copyOverrides(o.Users), Roles: copyOverrides(o.Roles)}
+	m := res.Users
+	if isRole {
+		m = res.Roles
+	}
+	limits := make(map[string]uint64, len(m[account])+1)
+	for k, v := range m[account] {
+		limits[k] = v
+	}
+	limits[limit] = val
+	m[account] = limits
+	return res
+}
+
+func copyOverrides(m map[string]map[string]uint64) map[string]map[string]uint64 {
+	res := make(map[string]map[string]uint64, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// ResourceLimiter holds the resource limits configured for the accounts and roles of a sql-server, and tracks the
+// connections each account has open. Limits set on an account take precedence over those set on the same user at the
+// wildcard host, which in turn take precedence over those of the account's roles; where an account is granted several
+// roles with the same limit, the most restrictive applies. It is safe for concurrent use.
+type ResourceLimiter struct {
+	mu        sync.Mutex
+	users     map[string]ResourceLimits
+	roles     map[string]ResourceLimits
+	conns     map[string]uint64
+	connUsers map[uint32]string
+	overrides ResourceLimitOverrides
+	persist   func(ResourceLimitOverrides) error
+}
+
+// NewResourceLimiter returns a ResourceLimiter with no limits set.
+func NewResourceLimiter() *ResourceLimiter {
+	return &ResourceLimiter{
+		users:     make(map[string]ResourceLimits),
+		roles:     make(map[string]ResourceLimits),
+		conns:     make(map[string]uint64),
+		connUsers: make(map[uint32]string),
+	}
+}
+
+// SetUserLimits replaces the limits of |user|, which is parsed with ParseAccountName.
+func (l *ResourceLimiter) SetUserLimits(user string, limits ResourceLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	setLimits(l.users, ParseAccountName(user), limits)
+}
+
+// SetRoleLimits replaces the limits of |role|, which is parsed with ParseAccountName.
+func (l *ResourceLimiter) SetRoleLimits(role string, limits ResourceLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	setLimits(l.roles, ParseAccountName(role), limits)
+}
+
+// SetPersister sets the function which SetLimit calls to persist the limits set through it. A limit is only changed
+// once it has been persisted.
+func (l *ResourceLimiter) SetPersister(persist func(ResourceLimitOverrides) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.persist = persist
+}
+
+// ApplyOverrides sets each of the limits in |o|, as previously persisted by SetLimit, without persisting them again.
+func (l *ResourceLimiter) ApplyOverrides(o ResourceLimitOverrides) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, isRole := range []bool{false, true} {
+		m := o.Users
+		if isRole {
+			m = o.Roles
+		}
+		for name, limits := range m {
+			for limit, val := range limits {
+				if err := l.setLimit(ParseAccountName(name), isRole, limit, val); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SetLimit sets the single limit named |limit| for the user, or role if |isRole| is true, named |name|, and persists
+// it. |name| is parsed with ParseAccountName. A |val| of 0 removes the limit.
+func (l *ResourceLimiter) SetLimit(name string, isRole bool, limit string, val uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	account := ParseAccountName(name)
+	if _, err := (ResourceLimits{}).WithLimit(limit, val); err != nil {
+		return err
+	}
+	if l.persist != nil {
+		if err := l.persist(l.overrides.with(account, isRole, limit, val)); err != nil {
+			return err
+		}
+	}
+	return l.setLimit(account, isRole, limit, val)
+}
+
+// setLimit sets a single limit of |account| and records it in the overrides. Callers must hold |l.mu|.
+func (l *ResourceLimiter) setLimit(account string, isRole bool, limit string, val uint64) error {
+	m := l.users
+	if isRole {
+		m = l.roles
+	}
+	limits, err := m[account].WithLimit(limit, val)
+	if err != nil {
+		return err
+	}
+	setLimits(m, account, limits)
+	l.overrides = l.overrides.with(account, isRole, limit, val)
+	return nil
+}
+
+func setLimits(m map[string]ResourceLimits, name string, limits ResourceLimits) {
+	if limits.IsZero() {
+		delete(m, name)
+	} else {
+		m[name] = limits
+	}
+}
+
+// Limits returns the limits in effect for |account|, as returned by AccountName, which has been granted the role
+// accounts |roles|.
+func (l *ResourceLimiter) Limits(account string, roles []string) ResourceLimits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limits(account, roles)
+}
+
+func (l *ResourceLimiter) limits(account string, roles []string) ResourceLimits {
+	var fromRoles ResourceLimits
+	for _, role := range roles {
+		fromRoles = fromRoles.orTighter(l.roles[role])
+	}
+	return l.users[account].orElse(l.users[wildcardAccount(account)]).orElse(fromRoles)
+}
+
+// AcquireConnection records a new connection for |account|, returning an error if it would take the account over its
+// connection limit.
+func (l *ResourceLimiter) AcquireConnection(connID uint32, account string, roles []string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.connUsers[connID]; ok {
+		return nil
+	}
+	limit := l.limits(account, roles).MaxConnections
+	if limit > 0 && l.conns[account] >= limit {
+		return ErrTooManyUserConnections(account)
+	}
+	l.conns[account]++
+	l.connUsers[connID] = account
+	return nil
+}
+
+// ReleaseConnection releases the connection |connID| recorded by AcquireConnection. It is a no-op for connections
+// which were never acquired.
+func (l *ResourceLimiter) ReleaseConnection(connID uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	account, ok := l.connUsers[connID]
+	if !ok {
+		return
+	}
+	delete(l.connUsers, connID)
+	if l.conns[account] <= 1 {
+		delete(l.conns, account)
+	} else {
+		l.conns[account]--
+	}
+}
+
+// Connections returns the number of connections |account| has open.
+func (l *ResourceLimiter) Connections(account string) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.conns[account]
+}
+
+var errRowLimitExceeded = errors.New("row limit exceeded")
+
+// countChangedRows returns the number of rows which differ between |from| and |to|, counting no further than
+// |limit| + 1. Only tables in the new storage format are counted.
+func countChangedRows(ctx context.Context, from, to doltdb.RootValue, limit uint64) (uint64, error) {
+	names, err := doltdb.UnionTableNames(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	for _, name := range names {
+		fromRows, err := prollyRowData(ctx, from, name)
+		if err != nil {
+			return 0, err
+		}
+		toRows, err := prollyRowData(ctx, to, name)
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case fromRows == nil && toRows == nil:
+			continue
+		case fromRows == nil || toRows == nil:
+			m := fromRows
+			if m == nil {
+				m = toRows
+			}
+			c, err := m.Count()
+			if err != nil {
+				return 0, err
+			}
+			count += uint64(c)
+		default:
+			err = prolly.DiffMaps(ctx, *fromRows, *toRows, false, func(context.Context, tree.Diff) error {
+				count++
+				if count > limit {
+					return errRowLimitExceeded
+				}
+				return nil
+			})
+			if err != nil && err != io.EOF && err != errRowLimitExceeded {
+				return 0, err
+			}
+		}
+
+		if count > limit {
+			return count, nil
+		}
+	}
+	return count, nil
+}
+
+// prollyRowData returns the row data of the table |name| in |root|, or nil if there is no such table or it is not in
+// the new storage format.
+func prollyRowData(ctx context.Context, root doltdb.RootValue, name doltdb.TableName) (*prolly.Map, error) {
+	tbl, ok, err := root.GetTable(ctx, name)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return nil, nil
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m := durable.ProllyMapFromIndex(idx)
+	return &m, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceLimiterLimits(t *testing.T) {
+	l := NewResourceLimiter()
+	l.SetRoleLimits("analyst", ResourceLimits{MaxRows: 1000, MaxExecutionTime: time.Minute})
+	l.SetRoleLimits("reader", ResourceLimits{MaxRows: 100, MaxConnections: 5})
+	l.SetUserLimits("bob", ResourceLimits{MaxRows: 5000})
+	roles := []string{"analyst@%", "reader@%"}
+
+	// The most restrictive role limit applies
+	assert.Equal(t, ResourceLimits{MaxRows: 100, MaxConnections: 5, MaxExecutionTime: time.Minute},
+		l.Limits("alice@%", roles))
+	// Limits set on the user take precedence over those of their roles
+	assert.Equal(t, ResourceLimits{MaxRows: 5000, MaxConnections: 5, MaxExecutionTime: time.Minute},
+		l.Limits("bob@%", roles))
+	assert.Equal(t, ResourceLimits{}, l.Limits("carol@%", nil))
+
+	require.NoError(t, l.SetLimit("carol", false, MaxExecutionTimeLimit, 250))
+	assert.Equal(t, ResourceLimits{MaxExecutionTime: 250 * time.Millisecond}, l.Limits("carol@%", nil))
+	require.NoError(t, l.SetLimit("carol", false, MaxExecutionTimeLimit, 0))
+	assert.Equal(t, ResourceLimits{}, l.Limits("carol@%", nil))
+	require.NoError(t, l.SetLimit("reader", true, MaxRowsLimit, 10))
+	assert.Equal(t, uint64(10), l.Limits("alice@%", roles).MaxRows)
+	assert.Error(t, l.SetLimit("carol", false, "max_cpu", 1))
+}
+
+func TestResourceLimiterAccounts(t *testing.T) {
+	l := NewResourceLimiter()
+	l.SetUserLimits("bob", ResourceLimits{MaxRows: 10, MaxConnections: 1})
+	l.SetUserLimits("bob@localhost", ResourceLimits{MaxRows: 20})
+	require.NoError(t, l.SetLimit("'bob'@'10.0.0.1'", false, MaxRowsLimit, 30))
+
+	// Limits of the account take precedence over those of the same user on the wildcard host
+	assert.Equal(t, ResourceLimits{MaxRows: 20, MaxConnections: 1}, l.Limits("bob@localhost", nil))
+	assert.Equal(t, ResourceLimits{MaxRows: 30, MaxConnections: 1}, l.Limits("bob@10.0.0.1", nil))
+	assert.Equal(t, ResourceLimits{MaxRows: 10, MaxConnections: 1}, l.Limits("bob@example.com", nil))
+	assert.Equal(t, ResourceLimits{}, l.Limits("bobby@localhost", nil))
+
+	// Connections are counted per account
+	require.NoError(t, l.AcquireConnection(1, "bob@localhost", nil))
+	require.NoError(t, l.AcquireConnection(2, "bob@10.0.0.1", nil))
+	require.Error(t, l.AcquireConnection(3, "bob@localhost", nil))
+	assert.Equal(t, uint64(1), l.Connections("bob@localhost"))
+}
+
+func TestResourceLimiterPersistence(t *testing.T) {
+	var persisted ResourceLimitOverrides
+	l := NewResourceLimiter()
+	l.SetUserLimits("bob", ResourceLimits{MaxRows: 10})
+	l.SetPersister(func(o ResourceLimitOverrides) error {
+		persisted = o
+		return nil
+	})
+	require.NoError(t, l.SetLimit("bob", false, MaxRowsLimit, 0))
+	require.NoError(t, l.SetLimit("alice@localhost", false, MaxConnectionsLimit, 2))
+	require.NoError(t, l.SetLimit("reader", true, MaxExecutionTimeLimit, 100))
+	assert.Equal(t, ResourceLimitOverrides{
+		Users: map[string]map[string]uint64{
+			"bob@%":           {MaxRowsLimit: 0},
+			"alice@localhost": {MaxConnectionsLimit: 2},
+		},
+		Roles: map[string]map[string]uint64{
+			"reader@%": {MaxExecutionTimeLimit: 100},
+		},
+	}, persisted)
+
+	// A limit which fails to persist is not changed
+	l.SetPersister(func(ResourceLimitOverrides) error {
+		return errors.New("disk full")
+	})
+	require.Error(t, l.SetLimit("alice@localhost", false, MaxConnectionsLimit, 5))
+	assert.Equal(t, uint64(2), l.Limits("alice@localhost", nil).MaxConnections)
+
+	// Applying the persisted limits on top of the configured ones restores them, including removals
+	restarted := NewResourceLimiter()
+	restarted.SetUserLimits("bob", ResourceLimits{MaxRows: 10})
+	require.NoError(t, restarted.ApplyOverrides(persisted))
+	assert.Equal(t, ResourceLimits{}, restarted.Limits("bob@%", nil))
+	assert.Equal(t, ResourceLimits{MaxConnections: 2}, restarted.Limits("alice@localhost", nil))
+	assert.Equal(t, ResourceLimits{MaxExecutionTime: 100 * time.Millisecond}, restarted.Limits("carol@%", []string{"reader@%"}))
+}
+
+func TestResourceLimiterConnections(t *testing.T) {
+	l := NewResourceLimiter()
+	l.SetUserLimits("bob", ResourceLimits{MaxConnections: 2})
+
+	require.NoError(t, l.AcquireConnection(1, "bob@%", nil))
+	require.NoError(t, l.AcquireConnection(2, "bob@%", nil))
+	// Acquiring the same connection twice does not count against the limit
+	require.NoError(t, l.AcquireConnection(2, "bob@%", nil))
+	err := l.AcquireConnection(3, "bob@%", nil)
+	require.Error(t, err)
+	var sqlErr *mysql.SQLError
+	require.ErrorAs(t, err, &sqlErr)
+	assert.Equal(t, mysql.ERTooManyUserConnections, sqlErr.Number())
+	require.NoError(t, l.AcquireConnection(4, "alice@%", nil))
+
+	l.ReleaseConnection(1)
+	l.ReleaseConnection(1)
+	assert.Equal(t, uint64(1), l.Connections("bob@%"))
+	require.NoError(t, l.AcquireConnection(3, "bob@%", nil))
+	assert.Equal(t, uint64(2), l.Connections("bob@%"))
+}
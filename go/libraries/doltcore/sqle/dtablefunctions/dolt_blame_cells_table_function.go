@@ -0,0 +1,320 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+const blameCellsDefaultRowCount = 1000
+
+var _ sql.TableFunction = (*BlameCellsTableFunction)(nil)
+var _ sql.ExecSourceRel = (*BlameCellsTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*BlameCellsTableFunction)(nil)
+
+// BlameCellsTableFunction implements the dolt_blame_cells table function, which shows the commit that last changed
+// each cell of a table. It returns a row for each column of each row of the table, identified by the row's primary
+// key and the column's name.
+//
+// dolt_blame_cells('table') -> blame the table at HEAD
+// dolt_blame_cells('table', 'ref') -> blame the table at the commit 'ref'
+type BlameCellsTableFunction struct {
+	ctx           *sql.Context
+	tableNameExpr sql.Expression
+	refExpr       sql.Expression
+	database      sql.Database
+	sqlSch        sql.Schema
+
+	tableName doltdb.TableName
+	commit    *doltdb.Commit
+}
+
+var blameCellsMetadataSchema = sql.Schema{
+	&sql.Column{Name: "column_name", Type: types.Text},
+	&sql.Column{Name: "commit", Type: types.Text},
+	&sql.Column{Name: "commit_date", Type: types.Datetime},
+	&sql.Column{Name: "committer", Type: types.Text},
+	&sql.Column{Name: "email", Type: types.Text},
+	&sql.Column{Name: "message", Type: types.Text},
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (btf *BlameCellsTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &BlameCellsTableFunction{
+		ctx:      ctx,
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(expressions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (btf *BlameCellsTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(btf.Schema())
+	numRows, _, err := btf.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (btf *BlameCellsTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return blameCellsDefaultRowCount, false, nil
+}
+
+// Database implements the sql.Databaser interface
+func (btf *BlameCellsTableFunction) Database() sql.Database {
+	return btf.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (btf *BlameCellsTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nbtf := *btf
+	nbtf.database = database
+	return &nbtf, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (btf *BlameCellsTableFunction) Name() string {
+	return "dolt_blame_cells"
+}
+
+// Resolved implements the sql.Resolvable interface
+func (btf *BlameCellsTableFunction) Resolved() bool {
+	if btf.refExpr != nil {
+		return btf.tableNameExpr.Resolved() && btf.refExpr.Resolved()
+	}
+	return btf.tableNameExpr.Resolved()
+}
+
+func (btf *BlameCellsTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// String implements the Stringer interface
+func (btf *BlameCellsTableFunction) String() string {
+	args := make([]string, 0, 2)
+	for _, expr := range btf.Expressions() {
+		args = append(args, expr.String())
+	}
+	return fmt.Sprintf("DOLT_BLAME_CELLS(%s)", strings.Join(args, ", "))
+}
+
+// Schema implements the sql.Node interface.
+func (btf *BlameCellsTableFunction) Schema() sql.Schema {
+	if !btf.Resolved() {
+		return nil
+	}
+
+	if btf.sqlSch == nil {
+		panic("schema hasn't been generated yet")
+	}
+
+	return btf.sqlSch
+}
+
+// Children implements the sql.Node interface.
+func (btf *BlameCellsTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (btf *BlameCellsTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return btf, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (btf *BlameCellsTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	tableName, _, err := btf.evaluateArguments()
+	if err != nil {
+		return ExpressionIsDeferred(btf.tableNameExpr)
+	}
+
+	subject := sql.PrivilegeCheckSubject{Database: btf.database.Name(), Table: tableName}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (btf *BlameCellsTableFunction) Expressions() []sql.Expression {
+	if btf.refExpr != nil {
+		return []sql.Expression{btf.tableNameExpr, btf.refExpr}
+	}
+	return []sql.Expression{btf.tableNameExpr}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (btf *BlameCellsTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 1 || len(exprs) > 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(btf.Name(), "1 to 2", len(exprs))
+	}
+
+	// The schema of the result depends on the table's primary key, so only literal arguments are supported
+	for _, expr := range exprs {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(btf.Name(), expr.String())
+		}
+		// prepared statements resolve functions beforehand, so above check fails
+		if _, ok := expr.(sql.FunctionExpression); ok {
+			return nil, ErrInvalidNonLiteralArgument.New(btf.Name(), expr.String())
+		}
+		if !types.IsText(expr.Type()) && !expression.IsBindVar(expr) {
+			return nil, sql.ErrInvalidArgumentDetails.New(btf.Name(), expr.String())
+		}
+	}
+
+	newBtf := *btf
+	newBtf.tableNameExpr = exprs[0]
+	if len(exprs) > 1 {
+		newBtf.refExpr = exprs[1]
+	}
+
+	tableName, ref, err := newBtf.evaluateArguments()
+	if err != nil {
+		return nil, err
+	}
+	if err = newBtf.generateSchema(newBtf.ctx, tableName, ref); err != nil {
+		return nil, err
+	}
+
+	return &newBtf, nil
+}
+
+// evaluateArguments returns the table name and ref arguments. The ref defaults to HEAD.
+func (btf *BlameCellsTableFunction) evaluateArguments() (string, string, error) {
+	tableNameVal, err := btf.tableNameExpr.Eval(btf.ctx, nil)
+	if err != nil {
+		return "", "", err
+	}
+	tableName, ok := tableNameVal.(string)
+	if !ok {
+		return "", "", ErrInvalidTableName.New(btf.tableNameExpr.String())
+	}
+
+	ref := "HEAD"
+	if btf.refExpr != nil {
+		refVal, err := btf.refExpr.Eval(btf.ctx, nil)
+		if err != nil {
+			return "", "", err
+		}
+		if ref, err = interfaceToString(refVal); err != nil {
+			return "", "", err
+		}
+	}
+	return tableName, ref, nil
+}
+
+// generateSchema resolves the blamed commit and table, and builds the schema of the result from the table's primary
+// key columns followed by the blame metadata columns.
+func (btf *BlameCellsTableFunction) generateSchema(ctx *sql.Context, tableName, ref string) error {
+	sqledb, ok := btf.database.(dsess.SqlDatabase)
+	if !ok {
+		return fmt.Errorf("unexpected database type: %T", btf.database)
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	headRef, err := sess.CWBHeadRef(ctx, sqledb.Name())
+	if err != nil {
+		return err
+	}
+	cm, err := resolveCommit(ctx, sqledb.DbData().Ddb, headRef, ref)
+	if err != nil {
+		return err
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	tblName, tbl, ok, err := resolve.Table(ctx, root, tableName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrTableNotFound.New(tableName)
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	if schema.IsKeyless(sch) {
+		return diff.ErrCellBlameKeyless
+	}
+
+	sqlSch := make(sql.Schema, 0, sch.GetPKCols().Size()+len(blameCellsMetadataSchema))
+	for _, col := range sch.GetPKCols().GetColumns() {
+		sqlSch = append(sqlSch, &sql.Column{Name: col.Name, Type: col.TypeInfo.ToSqlType()})
+	}
+	btf.sqlSch = append(sqlSch, blameCellsMetadataSchema...)
+	btf.tableName = tblName
+	btf.commit = cm
+	return nil
+}
+
+// RowIter implements the sql.Node interface
+func (btf *BlameCellsTableFunction) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	blame, err := diff.BlameCells(ctx, btf.commit, btf.tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	kd, _ := blame.Rows.Descriptors()
+	ns := blame.Rows.NodeStore()
+	var rows []sql.Row
+	err = blame.Iter(ctx, func(k val.Tuple, commits []*doltdb.Commit) error {
+		pk := make(sql.Row, kd.Count())
+		for i := range pk {
+			v, err := tree.GetField(ctx, kd, i, k, ns)
+			if err != nil {
+				return err
+			}
+			pk[i] = v
+		}
+		for i, col := range blame.Cols {
+			h, err := commits[i].HashOf()
+			if err != nil {
+				return err
+			}
+			meta, err := commits[i].GetCommitMeta(ctx)
+			if err != nil {
+				return err
+			}
+			row := append(pk.Copy(), col.Name, h.String(), meta.Time(), meta.Name, meta.Email, meta.Description)
+			rows = append(rows, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
@@ -43,6 +43,7 @@ type DiffSummaryTableFunction struct {
 	toCommitExpr   sql.Expression
 	dotCommitExpr  sql.Expression
 	tableNameExpr  sql.Expression
+	optionExprs    []sql.Expression
 	database       sql.Database
 }
 
@@ -108,6 +109,11 @@ func (ds *DiffSummaryTableFunction) commitsResolved() bool {
 
 // Resolved implements the sql.Resolvable interface
 func (ds *DiffSummaryTableFunction) Resolved() bool {
+	for _, expr := range ds.optionExprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
 	if ds.tableNameExpr != nil {
 		return ds.commitsResolved() && ds.tableNameExpr.Resolved()
 	}
@@ -120,16 +126,11 @@ func (ds *DiffSummaryTableFunction) IsReadOnly() bool {
 
 // String implements the Stringer interface
 func (ds *DiffSummaryTableFunction) String() string {
-	if ds.dotCommitExpr != nil {
-		if ds.tableNameExpr != nil {
-			return fmt.Sprintf("DOLT_DIFF_SUMMARY(%s, %s)", ds.dotCommitExpr.String(), ds.tableNameExpr.String())
-		}
-		return fmt.Sprintf("DOLT_DIFF_SUMMARY(%s)", ds.dotCommitExpr.String())
-	}
-	if ds.tableNameExpr != nil {
-		return fmt.Sprintf("DOLT_DIFF_SUMMARY(%s, %s, %s)", ds.fromCommitExpr.String(), ds.toCommitExpr.String(), ds.tableNameExpr.String())
+	args := make([]string, 0, 4)
+	for _, expr := range ds.Expressions() {
+		args = append(args, expr.String())
 	}
-	return fmt.Sprintf("DOLT_DIFF_SUMMARY(%s, %s)", ds.fromCommitExpr.String(), ds.toCommitExpr.String())
+	return fmt.Sprintf("DOLT_DIFF_SUMMARY(%s)", strings.Join(args, ", "))
 }
 
 // Schema implements the sql.Node interface.
@@ -187,7 +188,7 @@ func (ds *DiffSummaryTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.Pr
 
 // Expressions implements the sql.Expressioner interface.
 func (ds *DiffSummaryTableFunction) Expressions() []sql.Expression {
-	exprs := []sql.Expression{}
+	exprs := append([]sql.Expression{}, ds.optionExprs...)
 	if ds.dotCommitExpr != nil {
 		exprs = append(exprs, ds.dotCommitExpr)
 	} else {
@@ -216,6 +217,11 @@ func (ds *DiffSummaryTableFunction) WithExpressions(exprs ...sql.Expression) (sq
 	}
 
 	newDstf := *ds
+	exprs, newDstf.optionExprs = splitDiffOptions(exprs)
+	if len(exprs) < 1 {
+		return nil, sql.ErrInvalidArgumentNumber.New(ds.Name(), "1 to 3", len(exprs))
+	}
+
 	if strings.Contains(exprs[0].String(), "..") {
 		if len(exprs) < 1 || len(exprs) > 2 {
 			return nil, sql.ErrInvalidArgumentNumber.New(newDstf.Name(), "1 or 2", len(exprs))
@@ -264,6 +270,10 @@ func (ds *DiffSummaryTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.
 	if err != nil {
 		return nil, err
 	}
+	findRenames, err := evaluateDiffOptions(ds.ctx, ds.Name(), ds.optionExprs)
+	if err != nil {
+		return nil, err
+	}
 
 	sqledb, ok := ds.database.(dsess.SqlDatabase)
 	if !ok {
@@ -279,6 +289,12 @@ func (ds *DiffSummaryTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.
 	if err != nil {
 		return nil, err
 	}
+	if findRenames {
+		deltas, err = diff.DetectRenames(ctx, deltas, diff.DefaultRenameSimilarity)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	sort.Slice(deltas, func(i, j int) bool {
 		return deltas[i].ToName.Less(deltas[j].ToName)
@@ -50,6 +50,7 @@ type DiffTableFunction struct {
 	toCommitExpr   sql.Expression
 	dotCommitExpr  sql.Expression
 	tableNameExpr  sql.Expression
+	optionExprs    []sql.Expression
 	database       sql.Database
 	sqlSch         sql.Schema
 	joiner         *rowconv.Joiner
@@ -101,14 +102,11 @@ func (dtf *DiffTableFunction) WithDatabase(database sql.Database) (sql.Node, err
 
 // Expressions implements the sql.Expressioner interface
 func (dtf *DiffTableFunction) Expressions() []sql.Expression {
+	exprs := append([]sql.Expression{}, dtf.optionExprs...)
 	if dtf.dotCommitExpr != nil {
-		return []sql.Expression{
-			dtf.dotCommitExpr, dtf.tableNameExpr,
-		}
-	}
-	return []sql.Expression{
-		dtf.fromCommitExpr, dtf.toCommitExpr, dtf.tableNameExpr,
+		return append(exprs, dtf.dotCommitExpr, dtf.tableNameExpr)
 	}
+	return append(exprs, dtf.fromCommitExpr, dtf.toCommitExpr, dtf.tableNameExpr)
 }
 
 // WithExpressions implements the sql.Expressioner interface
@@ -131,6 +129,10 @@ func (dtf *DiffTableFunction) WithExpressions(expression ...sql.Expression) (sql
 	}
 
 	newDtf := *dtf
+	expression, newDtf.optionExprs = splitDiffOptions(expression)
+	if len(expression) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New(dtf.Name(), "2 to 3", len(expression))
+	}
 	if strings.Contains(expression[0].String(), "..") {
 		if len(expression) != 2 {
 			return nil, sql.ErrInvalidArgumentNumber.New(fmt.Sprintf("%v with .. or ...", newDtf.Name()), 2, len(expression))
@@ -212,6 +214,44 @@ func findMatchingDelta(deltas []diff.TableDelta, tableName string) diff.TableDel
 	return diff.TableDelta{}
 }
 
+// findRenamesOption is the diff table function option that detects tables and columns that were dropped and
+// recreated under a new name or tag. See diff.DetectRenames.
+const findRenamesOption = "--find-renames"
+
+// splitDiffOptions separates the leading option arguments, which begin with "--", from the positional arguments that
+// follow them. Option parsing stops at the first positional argument.
+func splitDiffOptions(exprs []sql.Expression) (positional, options []sql.Expression) {
+	for i, expr := range exprs {
+		// string literals are quoted, so "a--b" names a revision while "--find-renames" is an option
+		if !strings.HasPrefix(strings.TrimLeft(expr.String(), "'\""), "--") {
+			return exprs[i:], options
+		}
+		options = append(options, expr)
+	}
+	return nil, options
+}
+
+// evaluateDiffOptions evaluates the option arguments of a diff table function and returns whether renames should be
+// detected.
+func evaluateDiffOptions(ctx *sql.Context, name string, options []sql.Expression) (findRenames bool, err error) {
+	for _, expr := range options {
+		if !gmstypes.IsText(expr.Type()) {
+			return false, sql.ErrInvalidArgumentDetails.New(name, expr.String())
+		}
+		optVal, err := expr.Eval(ctx, nil)
+		if err != nil {
+			return false, err
+		}
+		switch opt, _ := optVal.(string); strings.ToLower(opt) {
+		case findRenamesOption:
+			findRenames = true
+		default:
+			return false, sql.ErrInvalidArgumentDetails.New(name, expr.String())
+		}
+	}
+	return findRenames, nil
+}
+
 type refDetails struct {
 	root       doltdb.RootValue
 	hashStr    string
@@ -493,6 +533,16 @@ func (dtf *DiffTableFunction) cacheTableDelta(ctx *sql.Context, fromCommitVal, t
 	if err != nil {
 		return diff.TableDelta{}, err
 	}
+	findRenames, err := evaluateDiffOptions(ctx, dtf.Name(), dtf.optionExprs)
+	if err != nil {
+		return diff.TableDelta{}, err
+	}
+	if findRenames {
+		deltas, err = diff.DetectRenames(ctx, deltas, diff.DefaultRenameSimilarity)
+		if err != nil {
+			return diff.TableDelta{}, err
+		}
+	}
 
 	dtf.fromDate = fromRefDetails.commitTime
 	dtf.toDate = toRefDetails.commitTime
@@ -572,15 +622,11 @@ func (dtf *DiffTableFunction) IsReadOnly() bool {
 
 // String implements the Stringer interface
 func (dtf *DiffTableFunction) String() string {
-	if dtf.dotCommitExpr != nil {
-		return fmt.Sprintf("DOLT_DIFF(%s, %s)",
-			dtf.dotCommitExpr.String(),
-			dtf.tableNameExpr.String())
-	}
-	return fmt.Sprintf("DOLT_DIFF(%s, %s, %s)",
-		dtf.fromCommitExpr.String(),
-		dtf.toCommitExpr.String(),
-		dtf.tableNameExpr.String())
+	args := make([]string, 0, 4)
+	for _, expr := range dtf.Expressions() {
+		args = append(args, expr.String())
+	}
+	return fmt.Sprintf("DOLT_DIFF(%s)", strings.Join(args, ", "))
 }
 
 // Name implements the sql.TableFunction interface
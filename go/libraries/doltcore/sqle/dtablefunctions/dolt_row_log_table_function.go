@@ -0,0 +1,412 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+const rowLogDefaultRowCount = 10
+
+var _ sql.TableFunction = (*RowLogTableFunction)(nil)
+var _ sql.ExecSourceRel = (*RowLogTableFunction)(nil)
+var _ sql.AuthorizationCheckerNode = (*RowLogTableFunction)(nil)
+
+// RowLogTableFunction implements the dolt_row_log table function, which returns the commits that changed a single row
+// of a table, newest first, with the row's values before and after each change. The row is identified by a JSON
+// object mapping the names of the table's primary key columns to their values.
+//
+// dolt_row_log('table', '{"id": 42}') -> the history of the row at HEAD
+// dolt_row_log('table', '{"id": 42}', 'ref') -> the history of the row at the commit 'ref'
+type RowLogTableFunction struct {
+	ctx           *sql.Context
+	tableNameExpr sql.Expression
+	pkExpr        sql.Expression
+	refExpr       sql.Expression
+	database      sql.Database
+}
+
+var rowLogTableSchema = sql.Schema{
+	&sql.Column{Name: "commit_hash", Type: types.Text},
+	&sql.Column{Name: "committer", Type: types.Text},
+	&sql.Column{Name: "email", Type: types.Text},
+	&sql.Column{Name: "date", Type: types.Datetime},
+	&sql.Column{Name: "message", Type: types.Text},
+	&sql.Column{Name: "diff_type", Type: types.Text},
+	&sql.Column{Name: "from_row", Type: types.JSON, Nullable: true},
+	&sql.Column{Name: "to_row", Type: types.JSON, Nullable: true},
+}
+
+// NewInstance creates a new instance of TableFunction interface
+func (rtf *RowLogTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
+	newInstance := &RowLogTableFunction{
+		ctx:      ctx,
+		database: db,
+	}
+
+	node, err := newInstance.WithExpressions(expressions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
+func (rtf *RowLogTableFunction) DataLength(ctx *sql.Context) (uint64, error) {
+	numBytesPerRow := schema.SchemaAvgLength(rtf.Schema())
+	numRows, _, err := rtf.RowCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return numBytesPerRow * numRows, nil
+}
+
+func (rtf *RowLogTableFunction) RowCount(_ *sql.Context) (uint64, bool, error) {
+	return rowLogDefaultRowCount, false, nil
+}
+
+// Database implements the sql.Databaser interface
+func (rtf *RowLogTableFunction) Database() sql.Database {
+	return rtf.database
+}
+
+// WithDatabase implements the sql.Databaser interface
+func (rtf *RowLogTableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nrtf := *rtf
+	nrtf.database = database
+	return &nrtf, nil
+}
+
+// Name implements the sql.TableFunction interface
+func (rtf *RowLogTableFunction) Name() string {
+	return "dolt_row_log"
+}
+
+// Resolved implements the sql.Resolvable interface
+func (rtf *RowLogTableFunction) Resolved() bool {
+	for _, expr := range rtf.Expressions() {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func (rtf *RowLogTableFunction) IsReadOnly() bool {
+	return true
+}
+
+// String implements the Stringer interface
+func (rtf *RowLogTableFunction) String() string {
+	args := make([]string, 0, 3)
+	for _, expr := range rtf.Expressions() {
+		args = append(args, expr.String())
+	}
+	return fmt.Sprintf("DOLT_ROW_LOG(%s)", strings.Join(args, ", "))
+}
+
+// Schema implements the sql.Node interface.
+func (rtf *RowLogTableFunction) Schema() sql.Schema {
+	return rowLogTableSchema
+}
+
+// Children implements the sql.Node interface.
+func (rtf *RowLogTableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (rtf *RowLogTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("unexpected children")
+	}
+	return rtf, nil
+}
+
+// CheckAuth implements the interface sql.AuthorizationCheckerNode.
+func (rtf *RowLogTableFunction) CheckAuth(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	tableName, err := rtf.tableNameExpr.Eval(rtf.ctx, nil)
+	if err != nil {
+		return ExpressionIsDeferred(rtf.tableNameExpr)
+	}
+	tableNameStr, ok := tableName.(string)
+	if !ok {
+		return ExpressionIsDeferred(rtf.tableNameExpr)
+	}
+
+	subject := sql.PrivilegeCheckSubject{Database: rtf.database.Name(), Table: tableNameStr}
+	return opChecker.UserHasPrivileges(ctx, sql.NewPrivilegedOperation(subject, sql.PrivilegeType_Select))
+}
+
+// Expressions implements the sql.Expressioner interface.
+func (rtf *RowLogTableFunction) Expressions() []sql.Expression {
+	if rtf.refExpr != nil {
+		return []sql.Expression{rtf.tableNameExpr, rtf.pkExpr, rtf.refExpr}
+	}
+	return []sql.Expression{rtf.tableNameExpr, rtf.pkExpr}
+}
+
+// WithExpressions implements the sql.Expressioner interface.
+func (rtf *RowLogTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) < 2 || len(exprs) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New(rtf.Name(), "2 to 3", len(exprs))
+	}
+
+	for i, expr := range exprs {
+		if !expr.Resolved() {
+			return nil, ErrInvalidNonLiteralArgument.New(rtf.Name(), expr.String())
+		}
+		// the primary key may also be given as a JSON object
+		if i == 1 && types.IsJSON(expr.Type()) {
+			continue
+		}
+		if !types.IsText(expr.Type()) && !expression.IsBindVar(expr) {
+			return nil, sql.ErrInvalidArgumentDetails.New(rtf.Name(), expr.String())
+		}
+	}
+
+	newRtf := *rtf
+	newRtf.tableNameExpr = exprs[0]
+	newRtf.pkExpr = exprs[1]
+	if len(exprs) > 2 {
+		newRtf.refExpr = exprs[2]
+	}
+	return &newRtf, nil
+}
+
+// evaluateArguments returns the table name, primary key and ref arguments. The ref defaults to HEAD.
+func (rtf *RowLogTableFunction) evaluateArguments(ctx *sql.Context, row sql.Row) (string, map[string]interface{}, string, error) {
+	tableNameVal, err := rtf.tableNameExpr.Eval(ctx, row)
+	if err != nil {
+		return "", nil, "", err
+	}
+	tableName, ok := tableNameVal.(string)
+	if !ok {
+		return "", nil, "", ErrInvalidTableName.New(rtf.tableNameExpr.String())
+	}
+
+	pkVal, err := rtf.pkExpr.Eval(ctx, row)
+	if err != nil {
+		return "", nil, "", err
+	}
+	var pkJson string
+	switch pkVal := pkVal.(type) {
+	case string:
+		pkJson = pkVal
+	case sql.JSONWrapper:
+		b, err := types.MarshallJson(pkVal)
+		if err != nil {
+			return "", nil, "", err
+		}
+		pkJson = string(b)
+	default:
+		return "", nil, "", fmt.Errorf("invalid primary key for %s: %v", rtf.Name(), pkVal)
+	}
+	var pk map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(pkJson))
+	dec.UseNumber()
+	if err = dec.Decode(&pk); err != nil || pk == nil {
+		return "", nil, "", fmt.Errorf("invalid primary key for %s: expected a JSON object mapping primary key columns to values, got %s", rtf.Name(), pkJson)
+	}
+
+	ref := "HEAD"
+	if rtf.refExpr != nil {
+		refVal, err := rtf.refExpr.Eval(ctx, row)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if ref, err = interfaceToString(refVal); err != nil {
+			return "", nil, "", err
+		}
+	}
+	return tableName, pk, ref, nil
+}
+
+// RowIter implements the sql.Node interface
+func (rtf *RowLogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	sqledb, ok := rtf.database.(dsess.SqlDatabase)
+	if !ok {
+		return nil, fmt.Errorf("unexpected database type: %T", rtf.database)
+	}
+	tableName, pkVals, ref, err := rtf.evaluateArguments(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	sess := dsess.DSessFromSess(ctx.Session)
+	headRef, err := sess.CWBHeadRef(ctx, sqledb.Name())
+	if err != nil {
+		return nil, err
+	}
+	cm, err := resolveCommit(ctx, sqledb.DbData().Ddb, headRef, ref)
+	if err != nil {
+		return nil, err
+	}
+	root, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tblName, tbl, ok, err := resolve.Table(ctx, root, tableName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrTableNotFound.New(tableName)
+	}
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if schema.IsKeyless(sch) {
+		return nil, diff.ErrRowHistoryKeyless
+	}
+	pk, err := rowLogPrimaryKey(sch, pkVals)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []sql.Row
+	err = diff.RowHistory(ctx, cm, tblName, pk, func(change diff.RowChange) error {
+		h, err := change.Commit.HashOf()
+		if err != nil {
+			return err
+		}
+		meta, err := change.Commit.GetCommitMeta(ctx)
+		if err != nil {
+			return err
+		}
+		fromRow, err := rowLogJson(ctx, change.FromSch, change.FromKey, change.FromValue, change.NodeStore)
+		if err != nil {
+			return err
+		}
+		toRow, err := rowLogJson(ctx, change.ToSch, change.ToKey, change.ToValue, change.NodeStore)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, sql.Row{h.String(), meta.Name, meta.Email, meta.Time(), meta.Description, rowLogDiffType(change.Type), fromRow, toRow})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// rowLogPrimaryKey converts the primary key values |pkVals|, keyed by column name, to the types of the primary key
+// columns of |sch|, keyed by column tag.
+func rowLogPrimaryKey(sch schema.Schema, pkVals map[string]interface{}) (map[uint64]interface{}, error) {
+	pkCols := sch.GetPKCols()
+	pk := make(map[uint64]interface{}, pkCols.Size())
+	for name, v := range pkVals {
+		col, ok := pkCols.LowerNameToCol[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("column '%s' is not a primary key column", name)
+		}
+		if v == nil {
+			return nil, fmt.Errorf("primary key column '%s' cannot be null", col.Name)
+		}
+		if n, ok := v.(json.Number); ok {
+			v = n.String()
+		}
+		v, _, err := col.TypeInfo.ToSqlType().Convert(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for primary key column '%s': %w", col.Name, err)
+		}
+		pk[col.Tag] = v
+	}
+	for _, col := range pkCols.GetColumns() {
+		if _, ok := pk[col.Tag]; !ok {
+			return nil, fmt.Errorf("missing value for primary key column '%s'", col.Name)
+		}
+	}
+	return pk, nil
+}
+
+// rowLogJson returns the row with key |k| and value |v| of the schema |sch| as a JSON object, or nil if there's no row.
+func rowLogJson(ctx *sql.Context, sch schema.Schema, k, v val.Tuple, ns tree.NodeStore) (interface{}, error) {
+	if k == nil {
+		return nil, nil
+	}
+	row, err := index.BuildRow(ctx, k, v, sch, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := make(map[string]interface{}, len(row))
+	for i, col := range sch.GetAllCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		v := row[i]
+		if v == nil {
+			obj[col.Name] = nil
+			continue
+		}
+
+		typ := col.TypeInfo.ToSqlType()
+		sqlVal, err := typ.SQL(ctx, nil, v)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case types.IsJSON(typ):
+			var doc interface{}
+			if err = json.Unmarshal(sqlVal.Raw(), &doc); err != nil {
+				return nil, err
+			}
+			obj[col.Name] = doc
+		case sqlVal.IsSigned():
+			if obj[col.Name], err = strconv.ParseInt(sqlVal.ToString(), 10, 64); err != nil {
+				return nil, err
+			}
+		case sqlVal.IsUnsigned():
+			if obj[col.Name], err = strconv.ParseUint(sqlVal.ToString(), 10, 64); err != nil {
+				return nil, err
+			}
+		case sqlVal.IsFloat():
+			if obj[col.Name], err = strconv.ParseFloat(sqlVal.ToString(), 64); err != nil {
+				return nil, err
+			}
+		default:
+			obj[col.Name] = sqlVal.ToString()
+		}
+	}
+	return types.JSONDocument{Val: obj}, nil
+}
+
+func rowLogDiffType(t tree.DiffType) string {
+	switch t {
+	case tree.AddedDiff:
+		return "added"
+	case tree.RemovedDiff:
+		return "removed"
+	default:
+		return "modified"
+	}
+}
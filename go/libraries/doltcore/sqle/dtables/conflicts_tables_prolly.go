@@ -370,7 +370,7 @@ func (itr *prollyConflictRowIter) nextConflictVals(ctx *sql.Context) (c conf, er
 	b := xxh3.Hash128(append(ca.Key, c.h[:]...)).Bytes()
 	c.id = base64.RawStdEncoding.EncodeToString(b[:])
 
-	err = itr.loadTableMaps(ctx, ca.Metadata.BaseRootIsh, ca.TheirRootIsh)
+	err = itr.loadTableMaps(ctx, ca.Metadata, ca.TheirRootIsh)
 	if err != nil {
 		return conf{}, err
 	}
@@ -401,30 +401,32 @@ func (itr *prollyConflictRowIter) nextConflictVals(ctx *sql.Context) (c conf, er
 }
 
 // loadTableMaps loads the maps specified in the metadata if they are different from
-// the currently loaded maps. |baseHash| and |theirHash| are table hashes.
-func (itr *prollyConflictRowIter) loadTableMaps(ctx *sql.Context, baseHash, theirHash hash.Hash) error {
+// the currently loaded maps. |meta| holds the base root-ish and |theirHash| is their root-ish.
+func (itr *prollyConflictRowIter) loadTableMaps(ctx *sql.Context, meta prolly.ConflictMetadata, theirHash hash.Hash) error {
+	baseHash := meta.BaseRootIsh
+	baseName, theirName := doltdb.ConflictSourceNames(itr.tblName, meta)
 	if itr.baseHash.Compare(baseHash) != 0 {
 		rv, err := doltdb.LoadRootValueFromRootIshAddr(ctx, itr.vrw, itr.ns, baseHash)
 		if err != nil {
 			return err
 		}
-		baseTbl, ok, err := rv.GetTable(ctx, itr.tblName)
+		baseTbl, ok, err := rv.GetTable(ctx, baseName)
 		if err != nil {
 			return err
 		}
 
-		var idx durable.Index
 		if !ok {
-			idx, err = durable.NewEmptyPrimaryIndex(ctx, itr.vrw, itr.ns, itr.ourSch)
+			idx, err := durable.NewEmptyPrimaryIndex(ctx, itr.vrw, itr.ns, itr.ourSch)
+			if err != nil {
+				return err
+			}
+			itr.baseRows = durable.ProllyMapFromIndex(idx)
 		} else {
-			idx, err = baseTbl.GetRowData(ctx)
-		}
-
-		if err != nil {
-			return err
+			itr.baseRows, err = merge.RowDataWithPrimaryKeyOf(ctx, baseTbl, itr.ourSch)
+			if err != nil {
+				return err
+			}
 		}
-
-		itr.baseRows = durable.ProllyMapFromIndex(idx)
 		itr.baseHash = baseHash
 	}
 
@@ -434,19 +436,18 @@ func (itr *prollyConflictRowIter) loadTableMaps(ctx *sql.Context, baseHash, thei
 			return err
 		}
 
-		theirTbl, ok, err := rv.GetTable(ctx, itr.tblName)
+		theirTbl, ok, err := rv.GetTable(ctx, theirName)
 		if err != nil {
 			return err
 		}
 		if !ok {
-			return fmt.Errorf("failed to find table %s in right root value", itr.tblName)
+			return fmt.Errorf("failed to find table %s in right root value", theirName)
 		}
 
-		idx, err := theirTbl.GetRowData(ctx)
+		itr.theirRows, err = merge.RowDataWithPrimaryKeyOf(ctx, theirTbl, itr.ourSch)
 		if err != nil {
 			return err
 		}
-		itr.theirRows = durable.ProllyMapFromIndex(idx)
 		itr.theirHash = theirHash
 	}
 
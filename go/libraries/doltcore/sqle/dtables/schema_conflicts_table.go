@@ -119,7 +119,7 @@ func (sct *SchemaConflictsTable) PartitionRows(ctx *sql.Context, part sql.Partit
 
 	var conflicts []schemaConflict
 	err = p.state.IterSchemaConflicts(ctx, p.ddb, func(table doltdb.TableName, cnf doltdb.SchemaConflict) error {
-		c, err := newSchemaConflict(ctx, table, baseRoot, cnf)
+		c, err := newSchemaConflict(ctx, table, baseRoot, cnf, merge.ColumnResolutionsForTable(p.state.ResolvedSchemaConflicts(), table))
 		if err != nil {
 			return err
 		}
@@ -154,7 +154,7 @@ type schemaConflict struct {
 	description string
 }
 
-func newSchemaConflict(ctx context.Context, table doltdb.TableName, baseRoot doltdb.RootValue, c doltdb.SchemaConflict) (schemaConflict, error) {
+func newSchemaConflict(ctx context.Context, table doltdb.TableName, baseRoot doltdb.RootValue, c doltdb.SchemaConflict, resolutions merge.ColumnResolutions) (schemaConflict, error) {
 	bs, err := doltdb.GetAllSchemas(ctx, baseRoot)
 	if err != nil {
 		return schemaConflict{}, err
@@ -211,7 +211,7 @@ func newSchemaConflict(ctx context.Context, table doltdb.TableName, baseRoot dol
 		}, nil
 	}
 
-	desc, err := getSchemaConflictDescription(ctx, table, baseSch, c.ToSch, c.FromSch)
+	desc, err := getSchemaConflictDescription(ctx, table, baseSch, c.ToSch, c.FromSch, resolutions)
 	if err != nil {
 		return schemaConflict{}, err
 	}
@@ -229,8 +229,8 @@ func getCreateTableStatement(table string, sch schema.Schema, fks []doltdb.Forei
 	return sqlfmt.GenerateCreateTableStatement(table, sch, fks, parents)
 }
 
-func getSchemaConflictDescription(ctx context.Context, table doltdb.TableName, base, ours, theirs schema.Schema) (string, error) {
-	_, conflict, _, _, err := merge.SchemaMerge(ctx, noms.Format_Default, ours, theirs, base, table)
+func getSchemaConflictDescription(ctx context.Context, table doltdb.TableName, base, ours, theirs schema.Schema, resolutions merge.ColumnResolutions) (string, error) {
+	_, conflict, _, _, err := merge.SchemaMergeWithResolutions(ctx, noms.Format_Default, ours, theirs, base, table, resolutions)
 	if err != nil {
 		return "", err
 	}
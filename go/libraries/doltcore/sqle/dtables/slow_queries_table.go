@@ -0,0 +1,148 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+const (
+	SlowQueriesTableName = "dolt_slow_queries"
+)
+
+// SlowQueriesTable is a sql.Table implementation that implements a system table which shows the most recent entries
+// of the sql-server slow query log. It is empty when the slow query log is not enabled.
+type SlowQueriesTable struct {
+	dbName string
+}
+
+var _ sql.Table = SlowQueriesTable{}
+
+// NewSlowQueriesTable creates a SlowQueriesTable
+func NewSlowQueriesTable(dbName string) sql.Table {
+	return SlowQueriesTable{dbName: dbName}
+}
+
+func (sqt SlowQueriesTable) Name() string {
+	return SlowQueriesTableName
+}
+
+func (sqt SlowQueriesTable) String() string {
+	return SlowQueriesTableName
+}
+
+func (sqt SlowQueriesTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "time", Type: types.DatetimeMaxPrecision, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "connection_id", Type: types.Uint32, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "user", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "host", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "database", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: true, DatabaseSource: sqt.dbName},
+		{Name: "query", Type: types.LongText, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "duration_ms", Type: types.Float64, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "rows_sent", Type: types.Uint64, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "rows_affected", Type: types.Uint64, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: false, DatabaseSource: sqt.dbName},
+		{Name: "indexes", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: true, DatabaseSource: sqt.dbName},
+		{Name: "fast_paths", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: true, DatabaseSource: sqt.dbName},
+		{Name: "query_plan", Type: types.LongText, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: true, DatabaseSource: sqt.dbName},
+		{Name: "error", Type: types.Text, Source: SlowQueriesTableName, PrimaryKey: false, Nullable: true, DatabaseSource: sqt.dbName},
+	}
+}
+
+func (sqt SlowQueriesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+func (sqt SlowQueriesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+func (sqt SlowQueriesTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	var entries []slowlog.Entry
+	if buf := slowlog.Recent(); buf != nil {
+		entries = buf.Entries()
+	}
+	if !canSeeAllSlowQueries(ctx) {
+		entries = filterSlowQueries(entries, ctx.Session.Client().User, sqt.dbName)
+	}
+	return &slowQueriesIter{entries: entries}, nil
+}
+
+// canSeeAllSlowQueries returns whether the current user may see the slow queries of every user and database. Like
+// SHOW PROCESSLIST, this requires the PROCESS privilege, or SUPER.
+func canSeeAllSlowQueries(ctx *sql.Context) bool {
+	privs, counter := ctx.GetPrivilegeSet()
+	if counter == 0 {
+		return false
+	}
+	return privs.Has(sql.PrivilegeType_Process) || privs.Has(sql.PrivilegeType_Super)
+}
+
+// filterSlowQueries returns the entries of |entries| that were run by |user| against the database |dbName|.
+func filterSlowQueries(entries []slowlog.Entry, user, dbName string) []slowlog.Entry {
+	var filtered []slowlog.Entry
+	for _, e := range entries {
+		if e.User == user && strings.EqualFold(e.Database, dbName) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+type slowQueriesIter struct {
+	entries []slowlog.Entry
+	idx     int
+}
+
+func (itr *slowQueriesIter) Next(*sql.Context) (sql.Row, error) {
+	if itr.idx >= len(itr.entries) {
+		return nil, io.EOF
+	}
+	e := itr.entries[itr.idx]
+	itr.idx++
+	return sql.NewRow(
+		e.Time,
+		e.ConnectionID,
+		e.User,
+		e.Host,
+		nullIfEmpty(e.Database),
+		e.Query,
+		e.DurationMs,
+		e.RowsSent,
+		e.RowsAffected,
+		nullIfEmpty(strings.Join(e.Indexes, ",")),
+		nullIfEmpty(strings.Join(e.FastPaths, ",")),
+		nullIfEmpty(e.Plan),
+		nullIfEmpty(e.Error),
+	), nil
+}
+
+func (itr *slowQueriesIter) Close(*sql.Context) error {
+	return nil
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
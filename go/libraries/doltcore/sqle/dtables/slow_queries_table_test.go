@@ -0,0 +1,61 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/slowlog"
+)
+
+func TestSlowQueriesTablePrivileges(t *testing.T) {
+	buf := slowlog.NewBuffer(10)
+	buf.Add(slowlog.Entry{User: "alice", Database: "db1", Query: "alice db1"})
+	buf.Add(slowlog.Entry{User: "alice", Database: "db2", Query: "alice db2"})
+	buf.Add(slowlog.Entry{User: "bob", Database: "db1", Query: "bob db1"})
+	slowlog.SetRecent(buf)
+	defer slowlog.SetRecent(nil)
+
+	queries := func(user string, privs ...sql.PrivilegeType) []string {
+		sess := sql.NewBaseSessionWithClientServer("", sql.Client{User: user, Address: "localhost"}, 1)
+		privSet := mysql_db.NewPrivilegeSet()
+		privSet.AddGlobalStatic(privs...)
+		sess.SetPrivilegeSet(privSet, 1)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+
+		tbl := NewSlowQueriesTable("db1")
+		iter, err := tbl.PartitionRows(ctx, nil)
+		require.NoError(t, err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+
+		var qs []string
+		for _, r := range rows {
+			qs = append(qs, r[5].(string))
+		}
+		return qs
+	}
+
+	assert.Equal(t, []string{"alice db1"}, queries("alice"))
+	assert.Equal(t, []string{"bob db1"}, queries("bob", sql.PrivilegeType_Select))
+	assert.Equal(t, []string{"alice db1", "alice db2", "bob db1"}, queries("bob", sql.PrivilegeType_Process))
+	assert.Equal(t, []string{"alice db1", "alice db2", "bob db1"}, queries("carol", sql.PrivilegeType_Super))
+}
@@ -1320,6 +1320,36 @@ func TestDoltTag(t *testing.T) {
 	RunDoltTagTests(t, h)
 }
 
+func TestDoltNotes(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltNotesTests(t, h)
+}
+
+func TestDoltRestore(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltRestoreTests(t, h)
+}
+
+func TestDoltColumnarIndex(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltColumnarIndexTests(t, h)
+}
+
+func TestDoltIndexInclude(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltIndexIncludeTests(t, h)
+}
+
+func TestDoltPartialIndex(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltPartialIndexTests(t, h)
+}
+
+func TestDoltStash(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltStashTests(t, h)
+}
+
 func TestDoltRemote(t *testing.T) {
 	h := newDoltEnginetestHarness(t)
 	RunDoltRemoteTests(t, h)
@@ -1563,6 +1593,16 @@ func TestQueryDiff(t *testing.T) {
 	RunQueryDiffTests(t, harness)
 }
 
+func TestBlameCellsTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunBlameCellsTableFunctionTests(t, harness)
+}
+
+func TestRowLogTableFunction(t *testing.T) {
+	harness := newDoltEnginetestHarness(t)
+	RunRowLogTableFunctionTests(t, harness)
+}
+
 func TestSystemTableIndexes(t *testing.T) {
 	harness := newDoltEnginetestHarness(t)
 	RunSystemTableIndexesTests(t, harness)
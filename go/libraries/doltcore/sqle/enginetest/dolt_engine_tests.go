@@ -1060,6 +1060,66 @@ func RunDoltTagTests(t *testing.T, h DoltEnginetestHarness) {
 	}
 }
 
+func RunDoltNotesTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltNotesTestScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltRestoreTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltRestoreTestScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltColumnarIndexTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltColumnarIndexScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltIndexIncludeTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltIndexIncludeScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltPartialIndexTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltPartialIndexScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltStashTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltStashTestScripts {
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
 func RunDoltRemoteTests(t *testing.T, h DoltEnginetestHarness) {
 	for _, script := range DoltRemoteTestScripts {
 		func() {
@@ -1404,6 +1464,28 @@ func RunQueryDiffTests(t *testing.T, harness DoltEnginetestHarness) {
 	}
 }
 
+func RunBlameCellsTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range BlameCellsTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
+func RunRowLogTableFunctionTests(t *testing.T, harness DoltEnginetestHarness) {
+	for _, test := range RowLogTableFunctionScriptTests {
+		t.Run(test.Name, func(t *testing.T) {
+			harness = harness.NewHarness(t)
+			defer harness.Close()
+			harness.Setup(setup.MydbData)
+			enginetest.TestScript(t, harness, test)
+		})
+	}
+}
+
 func RunSystemTableIndexesTests(t *testing.T, harness DoltEnginetestHarness) {
 	if !types.IsFormat_DOLT(types.Format_Default) {
 		t.Skip("only new format support system table indexing")
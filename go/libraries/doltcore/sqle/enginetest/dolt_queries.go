@@ -4978,2697 +4978,4117 @@ var DoltTagTestScripts = []queries.ScriptTest{
 	},
 }
 
-var DoltRemoteTestScripts = []queries.ScriptTest{
+var DoltNotesTestScripts = []queries.ScriptTest{
 	{
-		Name: "dolt-remote: SQL add remotes",
+		Name: "dolt-notes: add, replace and remove notes",
+		SetUpScript: []string{
+			"CREATE TABLE test(pk int primary key);",
+			"CALL DOLT_ADD('.')",
+			"CALL DOLT_COMMIT('-am','created table test')",
+			"INSERT INTO test VALUES (0),(1),(2);",
+			"CALL DOLT_COMMIT('-am','added rows')",
+			"SET @head = hashof('HEAD');",
+			"SET @commits = (SELECT count(*) FROM dolt_log);",
+		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL DOLT_REMOTE('add','origin','file://../test')",
+				Query:    "SELECT * FROM dolt_notes",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('add', '-m', 'first note')",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
-				Expected: []sql.Row{{"origin", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin/*"]`), types.MustJSON(`{}`)}},
+				Query:    "CALL DOLT_NOTES('add', '-m', 'parent note', 'HEAD~1')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:          "CALL DOLT_REMOTE()",
-				ExpectedErrStr: "error: invalid argument, use 'dolt_remotes' system table to list remotes",
+				Query:    "SELECT ref, commit_hash = hashof('HEAD'), note FROM dolt_notes ORDER BY note",
+				Expected: []sql.Row{{"commits", true, "first note"}, {"commits", false, "parent note"}},
 			},
 			{
-				Query:          "CALL DOLT_REMOTE('origin')",
-				ExpectedErrStr: "error: invalid argument",
+				Query:          "CALL DOLT_NOTES('add', '-m', 'second note')",
+				ExpectedErrStr: "error: cannot add notes; found existing notes for object HEAD. Use '-f' to overwrite existing notes",
 			},
 			{
-				Query:          "INSERT INTO dolt_remotes (name, url) VALUES ('origin', 'file://../test')",
-				ExpectedErrStr: "the dolt_remotes table is read-only; use the dolt_remote stored procedure to edit remotes",
+				Query:    "CALL DOLT_NOTES('add', '-f', '-m', 'second note')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT note FROM dolt_notes WHERE commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{"second note"}},
+			},
+			{
+				Query:    "SELECT hashof('HEAD') = @head",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "SELECT count(*) = @commits FROM dolt_log",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "CALL DOLT_NOTES('remove', 'HEAD~1')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "CALL DOLT_NOTES('remove', 'HEAD~1')",
+				ExpectedErrStr: "error: object HEAD~1 has no note",
+			},
+			{
+				Query:    "SELECT ref, note FROM dolt_notes",
+				Expected: []sql.Row{{"commits", "second note"}},
 			},
 		},
 	},
 	{
-		Name: "dolt-remote: SQL remove remotes",
+		Name: "dolt-notes: notes refs",
 		SetUpScript: []string{
-			"CALL DOLT_REMOTE('add','origin1','file://.')",
-			"CALL DOLT_REMOTE('add','origin2','aws://[dynamo_db_table:s3_bucket]/repo_name')",
+			"CREATE TABLE test(pk int primary key);",
+			"CALL DOLT_ADD('.')",
+			"CALL DOLT_COMMIT('-am','created table test')",
+			"CALL DOLT_NOTES('add', '-m', 'default note')",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
-				Expected: []sql.Row{
-					{"origin1", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin1/*"]`), types.MustJSON(`{}`)},
-					{"origin2", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin2/*"]`), types.MustJSON(`{}`)}},
+				Query:    "CALL DOLT_NOTES('add', '--ref', 'review', '-m', 'review note')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "CALL DOLT_REMOTE('remove','origin2')",
+				Query:    "CALL DOLT_NOTES('add', '--ref', 'refs/notes/other', '-m', 'other note')",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
-				Expected: []sql.Row{{"origin1", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin1/*"]`), types.MustJSON(`{}`)}},
+				Query:    "SELECT ref, note FROM dolt_notes ORDER BY ref",
+				Expected: []sql.Row{{"commits", "default note"}, {"other", "other note"}, {"review", "review note"}},
 			},
-			// 'origin1' remote must exist in order this error to be returned; otherwise, no error from EOF
 			{
-				Query:          "DELETE FROM dolt_remotes WHERE name = 'origin1'",
-				ExpectedErrStr: "the dolt_remotes table is read-only; use the dolt_remote stored procedure to edit remotes",
+				Query:    "CALL DOLT_NOTES('remove', '--ref', 'review')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT ref, note FROM dolt_notes ORDER BY ref",
+				Expected: []sql.Row{{"commits", "default note"}, {"other", "other note"}},
+			},
+			{
+				Query:          "CALL DOLT_NOTES('add', '--ref', 'refs/heads/main', '-m', 'note')",
+				ExpectedErrStr: "error: refusing to use notes in refs/heads/main (outside of refs/notes/)",
+			},
+			{
+				Query:    "SELECT name FROM dolt_branches",
+				Expected: []sql.Row{{"main"}},
 			},
 		},
 	},
 	{
-		Name: "dolt-remote: multi-repo test",
+		Name: "dolt-notes: invalid arguments",
 		SetUpScript: []string{
-			"create database one",
+			"CREATE TABLE test(pk int primary key);",
+			"CALL DOLT_ADD('.')",
+			"CALL DOLT_COMMIT('-am','created table test')",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "use one;",
-				Expected: []sql.Row{},
+				Query:          "CALL DOLT_NOTES()",
+				ExpectedErrStr: "error: invalid argument, use 'dolt_notes' system table to list notes",
 			},
 			{
-				Query:    "CALL DOLT_REMOTE('add','test01','file:///foo');",
-				Expected: []sql.Row{{0}},
+				Query:          "CALL DOLT_NOTES('add')",
+				ExpectedErrStr: "error: a note message must be provided with -m",
 			},
 			{
-				Query:    "select count(*) from dolt_remotes where name='test01';",
-				Expected: []sql.Row{{1}},
+				Query:          "CALL DOLT_NOTES('show')",
+				ExpectedErrStr: "error: invalid argument",
 			},
 			{
-				Query:    "use mydb;",
-				Expected: []sql.Row{},
+				Query:          "CALL DOLT_NOTES('remove')",
+				ExpectedErrStr: "error: object HEAD has no note",
 			},
 			{
-				Query:    "select count(*) from dolt_remotes where name='test01';",
-				Expected: []sql.Row{{0}},
+				Query:          "CALL DOLT_NOTES('add', '-m', 'note', 'HEAD', 'HEAD~1')",
+				ExpectedErrStr: "error: too many arguments",
+			},
+			{
+				Query:          "CALL DOLT_NOTES('add', '-m', 'note', 'nosuchbranch')",
+				ExpectedErrStr: "branch not found: nosuchbranch",
 			},
 		},
 	},
 }
 
-var DoltUndropTestScripts = []queries.ScriptTest{
+var DoltRestoreTestScripts = []queries.ScriptTest{
 	{
-		Name: "dolt-undrop",
+		Name: "dolt-restore: restore whole tables",
 		SetUpScript: []string{
-			"create database one;",
-			"create database two;",
-			"use one;",
-			"create table t1(pk int primary key);",
-			"insert into t1 values(1);",
-			"call dolt_commit('-Am', 'creating table t1');",
-			"use two;",
-			"create table t2(pk int primary key);",
-			"insert into t2 values(2);",
-			"call dolt_commit('-Am', 'creating table t2');",
+			"CREATE TABLE t (pk int primary key, c int);",
+			"CREATE TABLE u (pk int primary key);",
+			"INSERT INTO t VALUES (1, 10), (2, 20);",
+			"CALL DOLT_COMMIT('-Am', 'created tables')",
+			"UPDATE t SET c = c + 1;",
+			"CALL DOLT_ADD('t')",
+			"UPDATE t SET c = c + 1;",
+			"INSERT INTO u VALUES (1);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "show databases;",
-				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"one"}, {"two"}},
+				Query:    "CALL DOLT_RESTORE('t')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "drop database one;",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 11}, {2, 21}},
 			},
 			{
-				Query:    "show databases;",
-				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"two"}},
+				Query:    "CALL DOLT_RESTORE('--source', 'HEAD', 't', 'u')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "call dolt_undrop('one');",
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10}, {2, 20}},
+			},
+			{
+				Query:    "SELECT count(*) FROM u",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "show databases;",
-				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"one"}, {"two"}},
+				Query:    "SELECT * FROM t AS OF STAGED ORDER BY pk",
+				Expected: []sql.Row{{1, 11}, {2, 21}},
 			},
 			{
-				Query:    "use one;",
-				Expected: []sql.Row{},
+				Query:          "CALL DOLT_RESTORE('nosuch')",
+				ExpectedErrStr: "error: table nosuch does not exist in the staged tables",
 			},
 			{
-				Query:    "select * from one.t1;",
-				Expected: []sql.Row{{1}},
+				Query:          "CALL DOLT_RESTORE('--source', 'nosuch', 't')",
+				ExpectedErrStr: "branch not found: nosuch",
 			},
 			{
-				Query:    "select * from two.t2;",
+				Query:          "CALL DOLT_RESTORE()",
+				ExpectedErrStr: "error: you must specify the tables to restore",
+			},
+		},
+	},
+	{
+		Name: "dolt-restore: restore matching rows",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, c int, d varchar(10), KEY c_idx (c));",
+			"INSERT INTO t VALUES (1, 10, 'a'), (2, 20, 'b'), (3, 30, 'c'), (4, 40, 'd');",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"UPDATE t SET c = c + 100;",
+			"DELETE FROM t WHERE pk = 3;",
+			"INSERT INTO t VALUES (5, 50, 'e');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_RESTORE('--source', 'HEAD', '--where', 'pk <= 2', 't')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10, "a"}, {2, 20, "b"}, {4, 140, "d"}, {5, 50, "e"}},
+			},
+			{
+				Query:    "SELECT pk FROM t WHERE c = 20",
 				Expected: []sql.Row{{2}},
 			},
 			{
-				Query:    "drop database one;",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				// rows are restored if they match in either the working set or the source
+				Query:    "CALL DOLT_RESTORE('--where', 'c >= 40 and c < 100', 't')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:          "call dolt_undrop;",
-				ExpectedErrStr: "no database name specified. available databases that can be undropped: one",
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10, "a"}, {2, 20, "b"}, {4, 40, "d"}},
 			},
 			{
-				Query:    "call dolt_purge_dropped_databases;",
+				Query:    "SELECT pk FROM t WHERE c = 140",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_RESTORE('--where', 'd = \"c\"', 't')",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "show databases;",
-				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"two"}},
+				Query:    "SELECT * FROM t WHERE c = 30",
+				Expected: []sql.Row{{3, 30, "c"}},
 			},
 			{
-				Query:          "call dolt_undrop;",
-				ExpectedErrStr: "no database name specified. there are no databases currently available to be undropped",
+				Query:    "SELECT count(*) FROM dolt_status",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "CALL DOLT_RESTORE('--where', 'x = 1', 't')",
+				ExpectedErrStr: "invalid predicate: column \"x\" could not be found in any table in scope",
+			},
+			{
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 1', 't', 't')",
+				ExpectedErrStr: "error: --where requires exactly one table",
 			},
 		},
 	},
-}
-
-var DoltReflogTestScripts = []queries.ScriptTest{
 	{
-		Name: "dolt_reflog: error cases",
+		Name: "dolt-restore: restore matching rows of a keyless table",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int, b int);",
+			"INSERT INTO t VALUES (1, 1), (1, 1), (2, 2);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"DELETE FROM t WHERE a = 1 LIMIT 1;",
+			"UPDATE t SET b = 3 WHERE a = 2;",
+		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "select * from dolt_reflog('foo', 'bar');",
-				ExpectedErrStr: "error: dolt_reflog has too many positional arguments. Expected at most 1, found 2: ['foo' 'bar']",
+				Query:    "CALL DOLT_RESTORE('--where', 'a = 1', 't')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:          "select * from dolt_reflog(NULL);",
-				ExpectedErrStr: "argument (<nil>) is not a string value, but a <nil>",
+				Query:    "SELECT * FROM t ORDER BY a, b",
+				Expected: []sql.Row{{1, 1}, {1, 1}, {2, 3}},
 			},
+		},
+	},
+	{
+		Name: "dolt-restore: restoring rows requires the same schema",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, c int);",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"ALTER TABLE t ADD COLUMN d int;",
+			"CREATE TABLE u (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "select * from dolt_reflog(-100);",
-				ExpectedErrStr: "argument (-100) is not a string value, but a int8",
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 1', 't')",
+				ExpectedErrStr: "error: the schema of table t has changed, so its rows can't be restored individually",
+			},
+			{
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 1', 'u')",
+				ExpectedErrStr: "error: table u does not exist in the staged tables",
 			},
 		},
 	},
 	{
-		Name: "dolt_reflog: basic cases with no arguments",
+		Name: "dolt-restore: restored rows must keep unique keys unique",
 		SetUpScript: []string{
-			"create table t1(pk int primary key);",
-			"call dolt_commit('-Am', 'creating table t1');",
-
-			"insert into t1 values(1);",
-			"call dolt_commit('-Am', 'inserting row 1');",
-			"call dolt_tag('tag1');",
-
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t1 values(2);",
-			"call dolt_commit('-Am', 'inserting row 2');",
-
-			"insert into t1 values(3);",
-			"call dolt_commit('-Am', 'inserting row 3');",
-			"call dolt_tag('-d', 'tag1');",
-			"call dolt_tag('tag1');",
+			"CREATE TABLE t (pk int primary key, c int, d int, UNIQUE KEY uniq_c (c));",
+			"INSERT INTO t VALUES (1, 10, 1), (2, 20, 2), (3, NULL, 3);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"UPDATE t SET c = 30 WHERE pk = 1;",
+			"INSERT INTO t VALUES (4, 10, 4), (5, NULL, 5);",
+			"UPDATE t SET d = 30 WHERE pk = 3;",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "select ref, commit_hash, commit_message from dolt_reflog();",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/heads/branch1", doltCommit, "inserting row 3"},
-					{"refs/heads/branch1", doltCommit, "inserting row 2"},
-					{"refs/heads/branch1", doltCommit, "inserting row 1"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 1', 't')",
+				ExpectedErrStr: "duplicate unique key given: [10]",
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 30, 1}, {2, 20, 2}, {3, nil, 30}, {4, 10, 4}, {5, nil, 5}},
+			},
+			{
+				Query:    "CALL DOLT_RESTORE('--where', 'pk = 3', 't')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_RESTORE('--where', 'pk in (1, 4)', 't')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10, 1}, {2, 20, 2}, {3, nil, 3}, {5, nil, 5}},
 			},
 		},
 	},
 	{
-		Name: "dolt_reflog: basic cases with a ref argument",
+		Name: "dolt-restore: restored rows must satisfy foreign keys",
 		SetUpScript: []string{
-			"create table t1(pk int primary key);",
-			"call dolt_commit('-Am', 'creating table t1');",
-
-			"insert into t1 values(1);",
-			"call dolt_commit('-Am', 'inserting row 1');",
-			"call dolt_tag('tag1');",
-
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t1 values(2);",
-			"call dolt_commit('-Am', 'inserting row 2');",
+			"CREATE TABLE parent (pk int primary key);",
+			"CREATE TABLE child (pk int primary key, parent_pk int, FOREIGN KEY (parent_pk) REFERENCES parent (pk));",
+			"INSERT INTO parent VALUES (1), (2);",
+			"INSERT INTO child VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-Am', 'created tables')",
+			"DELETE FROM child WHERE pk = 1;",
+			"DELETE FROM parent WHERE pk = 1;",
+			"INSERT INTO parent VALUES (3);",
+			"INSERT INTO child VALUES (2, 2), (3, 3);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 1', 'child')",
+				ExpectedErrStr: "error: restoring rows of table child would violate foreign keys of child",
+			},
+			{
+				Query:          "CALL DOLT_RESTORE('--where', 'pk = 3', 'parent')",
+				ExpectedErrStr: "error: restoring rows of table parent would violate foreign keys of child",
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY pk",
+				Expected: []sql.Row{{2, 2}, {3, 3}},
+			},
+			{
+				Query:    "CALL DOLT_RESTORE('--where', 'pk = 1', 'parent')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_RESTORE('--where', 'pk = 1', 'child')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM child ORDER BY pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+}
 
-			"insert into t1 values(3);",
-			"call dolt_commit('-Am', 'inserting row 3');",
-			"call dolt_tag('-d', 'tag1');",
-			"call dolt_tag('tag1');",
+var DoltStashTestScripts = []queries.ScriptTest{
+	{
+		Name: "dolt-stash: push and pop",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, c int);",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"UPDATE t SET c = 11 WHERE pk = 1;",
+			"CALL DOLT_ADD('t')",
+			"INSERT INTO t VALUES (2, 20);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "select * from dolt_reflog('doesNotExist');",
-				Expected: []sql.Row{},
+				Query:    "CALL DOLT_STASH()",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/heads/main')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
-			}, {
-				// ref is case-insensitive
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('reFS/Heads/MaIn')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
-			}, {
-				// ref is case-insensitive
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('MaIN')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/heads/branch1')",
-				Expected: []sql.Row{
-					{"refs/heads/branch1", doltCommit, "inserting row 3"},
-					{"refs/heads/branch1", doltCommit, "inserting row 2"},
-					{"refs/heads/branch1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('branch1')",
-				Expected: []sql.Row{
-					{"refs/heads/branch1", doltCommit, "inserting row 3"},
-					{"refs/heads/branch1", doltCommit, "inserting row 2"},
-					{"refs/heads/branch1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/tags/tag1')",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				// ref is case-insensitive
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('Refs/TAGs/taG1')",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('tag1')",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				// ref is case-insensitive
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('tAG1')",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				// checkout main, so we can delete branch1
-				Query:    "call dolt_checkout('main');",
-				Expected: []sql.Row{{0, "Switched to branch 'main'"}},
-			}, {
-				// delete branch branch1 and make sure we can still query it in reflog
-				Query:    "call dolt_branch('-D', 'branch1')",
-				Expected: []sql.Row{{0}},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('branch1')",
-				Expected: []sql.Row{
-					{"refs/heads/branch1", doltCommit, "inserting row 3"},
-					{"refs/heads/branch1", doltCommit, "inserting row 2"},
-					{"refs/heads/branch1", doltCommit, "inserting row 1"},
-				},
-			}, {
-				// delete tag tag1 and make sure we can still query it in reflog
-				Query:    "call dolt_tag('-d', 'tag1')",
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 10}},
+			},
+			{
+				Query:    "SELECT count(*) FROM dolt_status",
 				Expected: []sql.Row{{0}},
-			}, {
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('tag1')",
-				Expected: []sql.Row{
-					{"refs/tags/tag1", doltCommit, "inserting row 3"},
-					{"refs/tags/tag1", doltCommit, "inserting row 1"},
-				},
 			},
-		},
-	},
-	{
-		Name: "dolt_reflog: garbage collection with no newgen data",
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
+				Query:    "SELECT name, branch, commit_message FROM dolt_stashes",
+				Expected: []sql.Row{{"stash@{0}", "main", "created table"}},
 			},
 			{
-				Query:    "call dolt_gc();",
+				Query:    "SELECT count(*) FROM dolt_stashes WHERE commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "CALL DOLT_STASH('pop')",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				// Calling dolt_gc() invalidates the session, so we have to ask this assertion to create a new session
-				NewSession: true,
-				Query:      "select ref, commit_hash, commit_message from dolt_reflog('main')",
-				Expected:   []sql.Row{},
+				Query:    "SELECT * FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, 11}, {2, 20}},
 			},
-		},
-	},
-	{
-		Name: "dolt_reflog: garbage collection with newgen data",
-		SetUpScript: []string{
-			"create table t1(pk int primary key);",
-			"call dolt_commit('-Am', 'creating table t1');",
-			"insert into t1 values(1);",
-			"call dolt_commit('-Am', 'inserting row 1');",
-			"call dolt_tag('tag1');",
-			"insert into t1 values(2);",
-			"call dolt_commit('-Am', 'inserting row 2');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
-				Expected: []sql.Row{
-					{"refs/heads/main", doltCommit, "inserting row 2"},
-					{"refs/heads/main", doltCommit, "inserting row 1"},
-					{"refs/heads/main", doltCommit, "creating table t1"},
-					{"refs/heads/main", doltCommit, "Initialize data repository"},
-				},
+				// the stashed changes come back unstaged
+				Query:    "SELECT table_name, staged, status FROM dolt_status",
+				Expected: []sql.Row{{"t", false, "modified"}},
 			},
 			{
-				Query:    "call dolt_gc();",
+				Query:    "SELECT count(*) FROM dolt_stashes",
 				Expected: []sql.Row{{0}},
 			},
 			{
-				// Calling dolt_gc() invalidates the session, so we have to force this test to create a new session
-				NewSession: true,
-				Query:      "select ref, commit_hash, commit_message from dolt_reflog('main')",
-				Expected:   []sql.Row{},
+				Query:          "CALL DOLT_STASH('pop')",
+				ExpectedErrStr: "No stash entries found.",
 			},
 		},
 	},
-}
-
-// DoltAutoIncrementTests is tests of dolt's global auto increment logic
-var DoltAutoIncrementTests = []queries.ScriptTest{
 	{
-		Name: "insert on different branches",
+		Name: "dolt-stash: untracked and added tables",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"CREATE TABLE u (pk int primary key);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "insert into t (b) values (1), (2)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				// untracked tables aren't stashed by default, so there's nothing to save
+				Query:    "CALL DOLT_STASH()",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "call dolt_commit('-am', 'two values on main')",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "SELECT count(*) FROM dolt_stashes",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:            "call dolt_checkout('branch1')",
-				SkipResultsCheck: true,
+				Query:    "CALL DOLT_STASH('push', '--include-untracked')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "insert into t (b) values (3), (4)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 3}}},
+				Query:    "SHOW TABLES",
+				Expected: []sql.Row{{"t"}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{3, 3},
-					{4, 4},
-				},
+				Query:    "CALL DOLT_STASH('pop')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "call dolt_commit('-am', 'two values on branch1')",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "SELECT table_name, staged, status FROM dolt_status",
+				Expected: []sql.Row{{"u", false, "new table"}},
 			},
 			{
-				Query:            "call dolt_checkout('branch2')",
-				SkipResultsCheck: true,
+				Query:    "CALL DOLT_ADD('u')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "insert into t (b) values (5), (6)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
+				Query:    "CALL DOLT_STASH()",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-				},
+				Query:    "SHOW TABLES",
+				Expected: []sql.Row{{"t"}},
+			},
+			{
+				// tables that were added when stashed are staged again when popped
+				Query:    "CALL DOLT_STASH('pop')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT table_name, staged, status FROM dolt_status",
+				Expected: []sql.Row{{"u", true, "new table"}},
 			},
 		},
 	},
 	{
-		Name: "drop table",
+		Name: "dolt-stash: drop and clear",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('branch1')",
+			"CREATE TABLE t (pk int primary key, c int);",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_COMMIT('-Am', 'first commit')",
+			"UPDATE t SET c = 11;",
+			"CALL DOLT_STASH()",
+			"CALL DOLT_COMMIT('--allow-empty', '-m', 'second commit')",
+			"UPDATE t SET c = 12;",
+			"CALL DOLT_STASH()",
+			"CALL DOLT_COMMIT('--allow-empty', '-m', 'third commit')",
+			"UPDATE t SET c = 13;",
+			"CALL DOLT_STASH()",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "drop table t",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
-			},
-			{
-				Query:            "call dolt_checkout('main')",
-				SkipResultsCheck: true,
+				Query:    "SELECT name, commit_message FROM dolt_stashes",
+				Expected: []sql.Row{{"stash@{0}", "third commit"}, {"stash@{1}", "second commit"}, {"stash@{2}", "first commit"}},
 			},
 			{
-				// highest value in any branch is 6
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+				Query:    "CALL DOLT_STASH('drop', 'stash@{1}')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{7, 7},
-					{8, 8},
-				},
+				Query:    "SELECT name, commit_message FROM dolt_stashes",
+				Expected: []sql.Row{{"stash@{0}", "third commit"}, {"stash@{1}", "first commit"}},
 			},
 			{
-				Query:    "drop table t",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "CALL DOLT_STASH('drop')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:            "call dolt_checkout('branch2')",
-				SkipResultsCheck: true,
+				Query:    "SELECT name, commit_message FROM dolt_stashes",
+				Expected: []sql.Row{{"stash@{0}", "first commit"}},
 			},
 			{
-				// highest value in any branch is still 6 (dropped table above)
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, 10}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-					{7, 7},
-					{8, 8},
-				},
+				Query:    "CALL DOLT_STASH('pop', '0')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "drop table t",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, 11}},
 			},
 			{
-				Query:            "create table t (a int primary key auto_increment, b int)",
-				SkipResultsCheck: true,
+				Query:    "CALL DOLT_STASH()",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				// no value on any branch
-				Query:    "insert into t (b) values (1), (2)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				Query:    "CALL DOLT_STASH('clear')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-				},
+				Query:    "SELECT count(*) FROM dolt_stashes",
+				Expected: []sql.Row{{0}},
 			},
 		},
 	},
 	{
-		Name: "delete all rows in table",
+		Name: "dolt-stash: pop conflicting with local changes",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
+			"CREATE TABLE t (pk int primary key, c int);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
+			"INSERT INTO t VALUES (1, 10);",
+			"CALL DOLT_STASH()",
+			"INSERT INTO t VALUES (1, 20);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "delete from t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
-			},
-			{
-				Query:            "alter table t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query: "CALL DOLT_STASH('pop')",
+				ExpectedErrStr: "error: Your local changes to the following tables would be overwritten by applying stash 0:\n" +
+					"\t{'t'}\n" +
+					"Please commit your changes or stash them before you merge.\nAborting",
 			},
 			{
-				// empty tables, start at 1
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, 20}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 7},
-					{2, 8},
-				},
+				// the stash is kept when it can't be applied
+				Query:    "SELECT count(*) FROM dolt_stashes",
+				Expected: []sql.Row{{1}},
 			},
 		},
 	},
 	{
-		Name: "set auto-increment below current max value",
+		Name: "dolt-stash: invalid arguments",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"insert into t (b) values (1), (2), (3), (4)",
-			"call dolt_commit('-am', 'two values on main')",
+			"CREATE TABLE t (pk int primary key);",
+			"CALL DOLT_COMMIT('-Am', 'created table')",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:            "alter table t auto_increment = 2",
-				SkipResultsCheck: true,
-			},
-			{
-				// previous update was ignored
-				Query:    "insert into t (b) values (5), (6)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
-			},
-			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{3, 3},
-					{4, 4},
-					{5, 5},
-					{6, 6},
-				},
+				Query:          "CALL DOLT_STASH('list')",
+				ExpectedErrStr: "error: invalid argument, use 'dolt_stashes' system table to list stashes",
 			},
 			{
-				Query:    "insert into t (a, b) values (100, 100)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 5}}},
+				Query:          "CALL DOLT_STASH('nosuch')",
+				ExpectedErrStr: "error: invalid argument nosuch",
 			},
 			{
-				Query:            "alter table t auto_increment = 50",
-				SkipResultsCheck: true,
+				Query:          "CALL DOLT_STASH('pop', '--all')",
+				ExpectedErrStr: "error: --include-untracked and --all are only valid for push",
 			},
 			{
-				// previous update was ignored, value still below max on that table
-				Query:    "insert into t (b) values (101)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 101}}},
+				Query:          "CALL DOLT_STASH('drop', 'stash@{x}')",
+				ExpectedErrStr: "error: stash@{x} is not a valid reference",
 			},
 			{
-				Query:    "select * from t where a >= 100 order by a",
-				Expected: []sql.Row{{100, 100}, {101, 101}},
+				Query:          "CALL DOLT_STASH('clear', 'stash@{0}')",
+				ExpectedErrStr: "error: invalid argument stash@{0}",
 			},
 		},
 	},
+}
+
+var DoltRemoteTestScripts = []queries.ScriptTest{
 	{
-		Name: "set auto-increment above current max value",
-		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"insert into t (b) values (1), (2), (3), (4)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_branch('branch1')",
-		},
+		Name: "dolt-remote: SQL add remotes",
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:            "alter table t auto_increment = 20",
-				SkipResultsCheck: true,
+				Query:    "CALL DOLT_REMOTE('add','origin','file://../test')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "insert into `mydb/branch1`.t (b) values (5), (6)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 20}}},
+				Query:    "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
+				Expected: []sql.Row{{"origin", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin/*"]`), types.MustJSON(`{}`)}},
 			},
 			{
-				Query:    "insert into t (b) values (5), (6)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 22}}},
+				Query:          "CALL DOLT_REMOTE()",
+				ExpectedErrStr: "error: invalid argument, use 'dolt_remotes' system table to list remotes",
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{3, 3},
-					{4, 4},
-					{22, 5},
-					{23, 6},
-				},
+				Query:          "CALL DOLT_REMOTE('origin')",
+				ExpectedErrStr: "error: invalid argument",
+			},
+			{
+				Query:          "INSERT INTO dolt_remotes (name, url) VALUES ('origin', 'file://../test')",
+				ExpectedErrStr: "the dolt_remotes table is read-only; use the dolt_remote stored procedure to edit remotes",
 			},
 		},
 	},
 	{
-		Name: "delete all rows in table in all branches",
+		Name: "dolt-remote: SQL remove remotes",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('main')",
+			"CALL DOLT_REMOTE('add','origin1','file://.')",
+			"CALL DOLT_REMOTE('add','origin2','aws://[dynamo_db_table:s3_bucket]/repo_name')",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "delete from t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query: "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
+				Expected: []sql.Row{
+					{"origin1", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin1/*"]`), types.MustJSON(`{}`)},
+					{"origin2", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin2/*"]`), types.MustJSON(`{}`)}},
 			},
 			{
-				Query:    "delete from `mydb/branch1`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "CALL DOLT_REMOTE('remove','origin2')",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "delete from `mydb/branch2`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "SELECT name, IF(CHAR_LENGTH(url) < 0, NULL, 'not null'), fetch_specs, params FROM DOLT_REMOTES",
+				Expected: []sql.Row{{"origin1", "not null", types.MustJSON(`["refs/heads/*:refs/remotes/origin1/*"]`), types.MustJSON(`{}`)}},
 			},
+			// 'origin1' remote must exist in order this error to be returned; otherwise, no error from EOF
 			{
-				Query:            "alter table `mydb/branch1`.t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:          "DELETE FROM dolt_remotes WHERE name = 'origin1'",
+				ExpectedErrStr: "the dolt_remotes table is read-only; use the dolt_remote stored procedure to edit remotes",
 			},
+		},
+	},
+	{
+		Name: "dolt-remote: multi-repo test",
+		SetUpScript: []string{
+			"create database one",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:            "alter table `mydb/branch2`.t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:    "use one;",
+				Expected: []sql.Row{},
 			},
 			{
-				Query:            "alter table t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:    "CALL DOLT_REMOTE('add','test01','file:///foo');",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				// empty tables, start at 1
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				Query:    "select count(*) from dolt_remotes where name='test01';",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 7},
-					{2, 8},
-				},
+				Query:    "use mydb;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select count(*) from dolt_remotes where name='test01';",
+				Expected: []sql.Row{{0}},
 			},
 		},
 	},
+}
+
+var DoltUndropTestScripts = []queries.ScriptTest{
 	{
-		Name: "delete all rows in table in all but one branch",
+		Name: "dolt-undrop",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('main')",
+			"create database one;",
+			"create database two;",
+			"use one;",
+			"create table t1(pk int primary key);",
+			"insert into t1 values(1);",
+			"call dolt_commit('-Am', 'creating table t1');",
+			"use two;",
+			"create table t2(pk int primary key);",
+			"insert into t2 values(2);",
+			"call dolt_commit('-Am', 'creating table t2');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "delete from t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "show databases;",
+				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"one"}, {"two"}},
 			},
 			{
-				Query:    "delete from `mydb/branch1`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "drop database one;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "delete from `mydb/branch2`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "show databases;",
+				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"two"}},
 			},
 			{
-				Query:            "alter table t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:    "call dolt_undrop('one');",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:            "alter table `mydb/branch2`.t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:    "show databases;",
+				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"one"}, {"two"}},
 			},
 			{
-				// empty tables, start at 5 (highest remaining value, update above ignored)
-				Query:    "insert into t (b) values (5), (6)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
+				Query:    "use one;",
+				Expected: []sql.Row{},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-				},
+				Query:    "select * from one.t1;",
+				Expected: []sql.Row{{1}},
 			},
-		},
-	},
-	{
-		Name: "truncate table in all branches",
-		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('main')",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "truncate t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "select * from two.t2;",
+				Expected: []sql.Row{{2}},
 			},
 			{
-				Query:    "truncate `mydb/branch1`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:    "drop database one;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "truncate `mydb/branch2`.t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query:          "call dolt_undrop;",
+				ExpectedErrStr: "no database name specified. available databases that can be undropped: one",
 			},
 			{
-				Query:            "alter table t auto_increment = 1",
-				SkipResultsCheck: true,
+				Query:    "call dolt_purge_dropped_databases;",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				// empty tables, start at 1
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				Query:    "show databases;",
+				Expected: []sql.Row{{"information_schema"}, {"mydb"}, {"mysql"}, {"two"}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 7},
-					{2, 8},
-				},
+				Query:          "call dolt_undrop;",
+				ExpectedErrStr: "no database name specified. there are no databases currently available to be undropped",
 			},
 		},
 	},
+}
+
+var DoltReflogTestScripts = []queries.ScriptTest{
 	{
-		Name: "truncate table",
-		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"call dolt_add('.')",
-			"call dolt_commit('-am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('branch1')",
+		Name: "dolt_reflog: error cases",
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "select * from dolt_reflog('foo', 'bar');",
+				ExpectedErrStr: "error: dolt_reflog has too many positional arguments. Expected at most 1, found 2: ['foo' 'bar']",
+			},
+			{
+				Query:          "select * from dolt_reflog(NULL);",
+				ExpectedErrStr: "argument (<nil>) is not a string value, but a <nil>",
+			},
+			{
+				Query:          "select * from dolt_reflog(-100);",
+				ExpectedErrStr: "argument (-100) is not a string value, but a int8",
+			},
+		},
+	},
+	{
+		Name: "dolt_reflog: basic cases with no arguments",
+		SetUpScript: []string{
+			"create table t1(pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t1');",
+
+			"insert into t1 values(1);",
+			"call dolt_commit('-Am', 'inserting row 1');",
+			"call dolt_tag('tag1');",
+
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t1 values(2);",
+			"call dolt_commit('-Am', 'inserting row 2');",
+
+			"insert into t1 values(3);",
+			"call dolt_commit('-Am', 'inserting row 3');",
+			"call dolt_tag('-d', 'tag1');",
+			"call dolt_tag('tag1');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "truncate table t",
-				Expected: []sql.Row{{types.NewOkResult(2)}},
+				Query: "select ref, commit_hash, commit_message from dolt_reflog();",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/heads/branch1", doltCommit, "inserting row 3"},
+					{"refs/heads/branch1", doltCommit, "inserting row 2"},
+					{"refs/heads/branch1", doltCommit, "inserting row 1"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
 			},
+		},
+	},
+	{
+		Name: "dolt_reflog: basic cases with a ref argument",
+		SetUpScript: []string{
+			"create table t1(pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t1');",
+
+			"insert into t1 values(1);",
+			"call dolt_commit('-Am', 'inserting row 1');",
+			"call dolt_tag('tag1');",
+
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t1 values(2);",
+			"call dolt_commit('-Am', 'inserting row 2');",
+
+			"insert into t1 values(3);",
+			"call dolt_commit('-Am', 'inserting row 3');",
+			"call dolt_tag('-d', 'tag1');",
+			"call dolt_tag('tag1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:            "call dolt_checkout('main')",
-				SkipResultsCheck: true,
+				Query:    "select * from dolt_reflog('doesNotExist');",
+				Expected: []sql.Row{},
 			},
 			{
-				// highest value in any branch is 6
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/heads/main')",
+				Expected: []sql.Row{
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
+			}, {
+				// ref is case-insensitive
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('reFS/Heads/MaIn')",
+				Expected: []sql.Row{
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
+				Expected: []sql.Row{
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
+			}, {
+				// ref is case-insensitive
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('MaIN')",
+				Expected: []sql.Row{
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/heads/branch1')",
+				Expected: []sql.Row{
+					{"refs/heads/branch1", doltCommit, "inserting row 3"},
+					{"refs/heads/branch1", doltCommit, "inserting row 2"},
+					{"refs/heads/branch1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('branch1')",
+				Expected: []sql.Row{
+					{"refs/heads/branch1", doltCommit, "inserting row 3"},
+					{"refs/heads/branch1", doltCommit, "inserting row 2"},
+					{"refs/heads/branch1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('refs/tags/tag1')",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				// ref is case-insensitive
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('Refs/TAGs/taG1')",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('tag1')",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				// ref is case-insensitive
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('tAG1')",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				// checkout main, so we can delete branch1
+				Query:    "call dolt_checkout('main');",
+				Expected: []sql.Row{{0, "Switched to branch 'main'"}},
+			}, {
+				// delete branch branch1 and make sure we can still query it in reflog
+				Query:    "call dolt_branch('-D', 'branch1')",
+				Expected: []sql.Row{{0}},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('branch1')",
+				Expected: []sql.Row{
+					{"refs/heads/branch1", doltCommit, "inserting row 3"},
+					{"refs/heads/branch1", doltCommit, "inserting row 2"},
+					{"refs/heads/branch1", doltCommit, "inserting row 1"},
+				},
+			}, {
+				// delete tag tag1 and make sure we can still query it in reflog
+				Query:    "call dolt_tag('-d', 'tag1')",
+				Expected: []sql.Row{{0}},
+			}, {
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('tag1')",
+				Expected: []sql.Row{
+					{"refs/tags/tag1", doltCommit, "inserting row 3"},
+					{"refs/tags/tag1", doltCommit, "inserting row 1"},
+				},
 			},
+		},
+	},
+	{
+		Name: "dolt_reflog: garbage collection with no newgen data",
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "select * from t order by a",
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
 				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{7, 7},
-					{8, 8},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
 				},
 			},
 			{
-				Query:    "truncate table t",
-				Expected: []sql.Row{{types.NewOkResult(4)}},
+				Query:    "call dolt_gc();",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:            "call dolt_checkout('branch2')",
-				SkipResultsCheck: true,
+				// Calling dolt_gc() invalidates the session, so we have to ask this assertion to create a new session
+				NewSession: true,
+				Query:      "select ref, commit_hash, commit_message from dolt_reflog('main')",
+				Expected:   []sql.Row{},
 			},
+		},
+	},
+	{
+		Name: "dolt_reflog: garbage collection with newgen data",
+		SetUpScript: []string{
+			"create table t1(pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t1');",
+			"insert into t1 values(1);",
+			"call dolt_commit('-Am', 'inserting row 1');",
+			"call dolt_tag('tag1');",
+			"insert into t1 values(2);",
+			"call dolt_commit('-Am', 'inserting row 2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				// highest value in any branch is still 6 (truncated table above)
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+				Query: "select ref, commit_hash, commit_message from dolt_reflog('main')",
+				Expected: []sql.Row{
+					{"refs/heads/main", doltCommit, "inserting row 2"},
+					{"refs/heads/main", doltCommit, "inserting row 1"},
+					{"refs/heads/main", doltCommit, "creating table t1"},
+					{"refs/heads/main", doltCommit, "Initialize data repository"},
+				},
 			},
 			{
-				Query: "select * from t order by a",
+				Query:    "call dolt_gc();",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// Calling dolt_gc() invalidates the session, so we have to force this test to create a new session
+				NewSession: true,
+				Query:      "select ref, commit_hash, commit_message from dolt_reflog('main')",
+				Expected:   []sql.Row{},
+			},
+		},
+	},
+}
+
+// DoltAutoIncrementTests is tests of dolt's global auto increment logic
+var DoltAutoIncrementTests = []queries.ScriptTest{
+	{
+		Name: "insert on different branches",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "insert into t (b) values (1), (2)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'two values on main')",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:            "call dolt_checkout('branch1')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into t (b) values (3), (4)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 3}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{3, 3},
+					{4, 4},
+				},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'two values on branch1')",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:            "call dolt_checkout('branch2')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into t (b) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+				},
+			},
+		},
+	},
+	{
+		Name: "drop table",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('branch1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "drop table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:            "call dolt_checkout('main')",
+				SkipResultsCheck: true,
+			},
+			{
+				// highest value in any branch is 6
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{7, 7},
+					{8, 8},
+				},
+			},
+			{
+				Query:    "drop table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:            "call dolt_checkout('branch2')",
+				SkipResultsCheck: true,
+			},
+			{
+				// highest value in any branch is still 6 (dropped table above)
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+					{7, 7},
+					{8, 8},
+				},
+			},
+			{
+				Query:    "drop table t",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:            "create table t (a int primary key auto_increment, b int)",
+				SkipResultsCheck: true,
+			},
+			{
+				// no value on any branch
+				Query:    "insert into t (b) values (1), (2)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+				},
+			},
+		},
+	},
+	{
+		Name: "delete all rows in table",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "delete from t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:            "alter table t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				// empty tables, start at 1
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 7},
+					{2, 8},
+				},
+			},
+		},
+	},
+	{
+		Name: "set auto-increment below current max value",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"insert into t (b) values (1), (2), (3), (4)",
+			"call dolt_commit('-am', 'two values on main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "alter table t auto_increment = 2",
+				SkipResultsCheck: true,
+			},
+			{
+				// previous update was ignored
+				Query:    "insert into t (b) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{3, 3},
+					{4, 4},
+					{5, 5},
+					{6, 6},
+				},
+			},
+			{
+				Query:    "insert into t (a, b) values (100, 100)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 5}}},
+			},
+			{
+				Query:            "alter table t auto_increment = 50",
+				SkipResultsCheck: true,
+			},
+			{
+				// previous update was ignored, value still below max on that table
+				Query:    "insert into t (b) values (101)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, InsertID: 101}}},
+			},
+			{
+				Query:    "select * from t where a >= 100 order by a",
+				Expected: []sql.Row{{100, 100}, {101, 101}},
+			},
+		},
+	},
+	{
+		Name: "set auto-increment above current max value",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"insert into t (b) values (1), (2), (3), (4)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_branch('branch1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "alter table t auto_increment = 20",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into `mydb/branch1`.t (b) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 20}}},
+			},
+			{
+				Query:    "insert into t (b) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 22}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{3, 3},
+					{4, 4},
+					{22, 5},
+					{23, 6},
+				},
+			},
+		},
+	},
+	{
+		Name: "delete all rows in table in all branches",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "delete from t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "delete from `mydb/branch1`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "delete from `mydb/branch2`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:            "alter table `mydb/branch1`.t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "alter table `mydb/branch2`.t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "alter table t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				// empty tables, start at 1
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 7},
+					{2, 8},
+				},
+			},
+		},
+	},
+	{
+		Name: "delete all rows in table in all but one branch",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "delete from t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "delete from `mydb/branch1`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "delete from `mydb/branch2`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:            "alter table t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "alter table `mydb/branch2`.t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				// empty tables, start at 5 (highest remaining value, update above ignored)
+				Query:    "insert into t (b) values (5), (6)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 5}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+				},
+			},
+		},
+	},
+	{
+		Name: "truncate table in all branches",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "truncate t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "truncate `mydb/branch1`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:    "truncate `mydb/branch2`.t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:            "alter table t auto_increment = 1",
+				SkipResultsCheck: true,
+			},
+			{
+				// empty tables, start at 1
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 7},
+					{2, 8},
+				},
+			},
+		},
+	},
+	{
+		Name: "truncate table",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('branch1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "truncate table t",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
+			},
+			{
+				Query:            "call dolt_checkout('main')",
+				SkipResultsCheck: true,
+			},
+			{
+				// highest value in any branch is 6
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{7, 7},
+					{8, 8},
+				},
+			},
+			{
+				Query:    "truncate table t",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				Query:            "call dolt_checkout('branch2')",
+				SkipResultsCheck: true,
+			},
+			{
+				// highest value in any branch is still 6 (truncated table above)
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+					{7, 7},
+					{8, 8},
+				},
+			},
+			{
+				Query:    "truncate table t",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
+			},
+			{
+				// no value on any branch
+				Query:    "insert into t (b) values (1), (2)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+				},
+			},
+		},
+	},
+	{
+		// Dropping the primary key constraint from a table implicitly truncates the table, which resets the
+		// auto_increment value for the table to 0. These tests assert that the correct auto_increment value is
+		// restored after the drop pk operation.
+		Name: "drop auto_increment primary key",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int, key (a))",
+			"call dolt_commit('-Am', 'empty table')",
+			"call dolt_branch('branch1')",
+			"call dolt_branch('branch2')",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-am', 'two values on main')",
+			"call dolt_checkout('branch1')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_commit('-am', 'two values on branch1')",
+			"call dolt_checkout('branch2')",
+			"insert into t (b) values (5), (6)",
+			"call dolt_checkout('main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "alter table t drop primary key",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				// highest value in any branch is 6
+				Query:    "insert into t (b) values (7), (8)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{7, 7},
+					{8, 8},
+				},
+			},
+			{
+				Query:            "call dolt_checkout('branch2')",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "insert into t (b) values (9), (10)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 9}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+					{9, 9},
+					{10, 10},
+				},
+			},
+			{
+				Query:    "alter table t drop primary key",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into t (b) values (11), (12)",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 11}}},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{5, 5},
+					{6, 6},
+					{9, 9},
+					{10, 10},
+					{11, 11},
+					{12, 12},
+				},
+			},
+		},
+	},
+	{
+		Name: "hard reset dropped table restores auto increment",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-Am', 'initialize table')",
+			"drop table t",
+			"call dolt_reset('--hard')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "insert into t(b) values (3)",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, InsertID: 3}},
+				},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{3, 3},
+				},
+			},
+		},
+	},
+	{
+		// this behavior aligns with how we treat branches
+		Name: "hard reset inserted rows continues auto increment",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-Am', 'initialize table')",
+			"insert into t (b) values (3), (4)",
+			"call dolt_reset('--hard')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "insert into t(b) values (5)",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, InsertID: 5}},
+				},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{5, 5},
+				},
+			},
+		},
+	},
+	{
+		Name: "hard reset dropped table with branch restores auto increment",
+		SetUpScript: []string{
+			"create table t (a int primary key auto_increment, b int)",
+			"insert into t (b) values (1), (2)",
+			"call dolt_commit('-Am', 'initialize table')",
+			"call dolt_checkout('-b', 'branch1')",
+			"insert into t values (100, 100)",
+			"call dolt_commit('-Am', 'other')",
+			"call dolt_checkout('main')",
+			"drop table t",
+			"call dolt_reset('--hard')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "insert into t(b) values (101)",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 1, InsertID: 101}},
+				},
+			},
+			{
+				Query: "select * from t order by a",
+				Expected: []sql.Row{
+					{1, 1},
+					{2, 2},
+					{101, 101},
+				},
+			},
+		},
+	},
+}
+
+var DoltCherryPickTests = []queries.ScriptTest{
+	{
+		Name: "cherry-pick with -X resolves data conflicts",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int, c2 int);",
+			"insert into t values (1, 1, 1), (2, 2, 2);",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set c1 = 10, c2 = 10 where pk = 1;",
+			"delete from t where pk = 2;",
+			"call dolt_commit('-am', 'update on branch1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"update t set c1 = 100 where pk = 1;",
+			"update t set c1 = 200 where pk = 2;",
+			"call dolt_commit('-am', 'update on main');",
+			"call dolt_branch('main2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_cherry_pick('-X', 'mine', @commit1);",
+				ExpectedErrStr: "unsupported strategy option 'mine'; only 'ours' or 'theirs' are allowed",
+			},
+			{
+				Query:    "call dolt_cherry_pick('-X', 'theirs', @commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 10, 10}},
+			},
+			{
+				Query:    "call dolt_checkout('main2');",
+				Expected: []sql.Row{{0, "Switched to branch 'main2'"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('-X', 'ours', @commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, 100, 10}, {2, 200, 2}},
+			},
+		},
+	},
+	{
+		Name: "error cases: basic validation",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, \"one\");",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL Dolt_Cherry_Pick('HEAD~100');",
+				ExpectedErrStr: "invalid ancestor spec",
+			},
+			{
+				Query:          "CALL Dolt_Cherry_Pick('abcdaaaaaaaaaaaaaaaaaaaaaaaaaaaa');",
+				ExpectedErrStr: "target commit not found",
+			},
+			{
+				Query:          "CALL Dolt_Cherry_Pick('--abort');",
+				ExpectedErrStr: "error: There is no cherry-pick merge to abort",
+			},
+		},
+	},
+	{
+		Name: "error cases: merge commits cannot be cherry-picked",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, \"one\");",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL dolt_merge('--no-ff', 'branch1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:          "CALL dolt_cherry_pick('HEAD');",
+				ExpectedErrStr: "cherry-picking a merge commit is not supported",
+			},
+		},
+	},
+	{
+		Name: "error cases: error with staged or unstaged changes ",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, \"one\");",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"INSERT INTO t VALUES (100, 'onehundy');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
+				ExpectedErrStr: "cannot cherry-pick with uncommitted changes",
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
+				ExpectedErrStr: "cannot cherry-pick with uncommitted changes",
+			},
+		},
+	},
+	{
+		Name: "NULL values in a new primary key are reported as constraint violations",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (pk, v);",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"insert into t values (1, NULL), (2, 'two');",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL Dolt_Cherry_Pick(@commit1);",
+				Expected: []sql.Row{{"", 0, 0, 1}},
+			},
+			{
+				Query:    "select violation_type, pk, v from dolt_constraint_violations_t;",
+				Expected: []sql.Row{{"not null", 1, nil}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{2, "two"}},
+			},
+		},
+	},
+	{
+		Name: "basic case",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, \"one\");",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, \"two\");",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit2 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_cherry_pick(@commit2);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{2, "two"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM t order by pk;",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}},
+			},
+			{
+				// Assert that our new commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "keyless table",
+		SetUpScript: []string{
+			"call dolt_checkout('main');",
+			"CREATE TABLE keyless (id int, name varchar(10));",
+			"call dolt_commit('-Am', 'create table keyless on main');",
+			"call dolt_checkout('-b', 'branch1');",
+			"INSERT INTO keyless VALUES (1,'1'), (2,'3');",
+			"call dolt_commit('-am', 'insert rows into keyless table on branch1');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM keyless;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "CALL DOLT_CHERRY_PICK('branch1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SELECT * FROM keyless;",
+				Expected: []sql.Row{{1, "1"}, {2, "3"}},
+			},
+		},
+	},
+	{
+		Name: "schema change: CREATE TABLE",
+		SetUpScript: []string{
+			"call dolt_checkout('-b', 'branch1');",
+			"CREATE TABLE table_a (pk BIGINT PRIMARY KEY, v varchar(10));",
+			"INSERT INTO table_a VALUES (11, 'aa'), (22, 'ab');",
+			"call dolt_commit('-Am', 'create table table_a');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SHOW TABLES;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				// Assert that our new commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SHOW TABLES;",
+				Expected: []sql.Row{{"table_a"}},
+			},
+			{
+				Query:    "SELECT * FROM table_a;",
+				Expected: []sql.Row{{11, "aa"}, {22, "ab"}},
+			},
+		},
+	},
+	{
+		Name: "schema change: DROP TABLE",
+		SetUpScript: []string{
+			"CREATE TABLE dropme (pk BIGINT PRIMARY KEY, v varchar(10));",
+			"INSERT INTO dropme VALUES (11, 'aa'), (22, 'ab');",
+			"call dolt_commit('-Am', 'create table dropme');",
+			"call dolt_checkout('-b', 'branch1');",
+			"drop table dropme;",
+			"call dolt_commit('-Am', 'drop table dropme');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SHOW TABLES;",
+				Expected: []sql.Row{{"dropme"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SHOW TABLES;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "schema change: ALTER TABLE ADD COLUMN",
+		SetUpScript: []string{
+			"create table test(pk int primary key);",
+			"call dolt_commit('-Am', 'create table test on main');",
+			"call dolt_checkout('-b', 'branch1');",
+			"ALTER TABLE test ADD COLUMN v VARCHAR(100);",
+			"call dolt_commit('-am', 'add column v to test on branch1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  `v` varchar(100),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "schema change: ALTER TABLE DROP COLUMN",
+		SetUpScript: []string{
+			"create table test(pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table test on main');",
+			"call dolt_checkout('-b', 'branch1');",
+			"ALTER TABLE test DROP COLUMN v;",
+			"call dolt_commit('-am', 'drop column v from test on branch1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "schema change: ALTER TABLE RENAME COLUMN",
+		SetUpScript: []string{
+			"create table test(pk int primary key, v1 varchar(100));",
+			"call dolt_commit('-Am', 'create table test on main');",
+			"call dolt_checkout('-b', 'branch1');",
+			"ALTER TABLE test RENAME COLUMN v1 to v2;",
+			"call dolt_commit('-am', 'rename column v1 to v2 in test on branch1');",
+			"set @commit1 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE test;",
+				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  `v2` varchar(100),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "abort (@@autocommit=0)",
+		SetUpScript: []string{
+			"SET @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v=\"uno\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> uno');",
+			"alter table t drop column v;",
+			"call dolt_commit('-am', 'drop column v');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(hashof('branch1'));",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
+				Expected: []sql.Row{
+					{1, "uno", 1, "modified", 1, "modified"},
+				},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}},
+			},
+		},
+	},
+	{
+		Name: "abort (@@autocommit=1)",
+		SetUpScript: []string{
+			"SET @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v=\"uno\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> uno');",
+			"alter table t drop column v;",
+			"call dolt_commit('-am', 'drop column v');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(hashof('branch1'));",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
+				Expected: []sql.Row{
+					{1, "uno", 1, "modified", 1, "modified"},
+				},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}},
+			},
+		},
+	},
+	{
+		Name: "conflict resolution (@@autocommit=0)",
+		SetUpScript: []string{
+			"SET @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v=\"uno\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> uno');",
+			"alter table t drop column v;",
+			"call dolt_commit('-am', 'drop column v');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(hashof('branch1'));",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{{"t", false, "modified"}, {"t", false, "conflict"}},
+			},
+			{
+				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
+				Expected: []sql.Row{
+					{1, "uno", 1, "modified", 1, "modified"},
+				},
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--ours', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{{"t", false, "modified"}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'committing cherry-pick');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				// Assert that our new commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "conflict resolution (@@autocommit=1)",
+		SetUpScript: []string{
+			"set @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, c1 varchar(100));",
+			"call dolt_commit('-Am', 'creating table t');",
+			"insert into t values (1, \"one\");",
+			"call dolt_commit('-Am', 'inserting row 1');",
+			"SET @commit1 = hashof('HEAD');",
+			"update t set c1=\"uno\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> uno');",
+			"update t set c1=\"ein\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> ein');",
+			"SET @commit2 = hashof('HEAD');",
+			"call dolt_reset('--hard', @commit1);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * from dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * from t;",
+				Expected: []sql.Row{{1, "one"}},
+			},
+			{
+				Query:    `CALL dolt_cherry_pick(@commit2);`,
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    `SELECT * FROM dolt_conflicts;`,
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query:    `commit;`,
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    `SELECT * FROM dolt_conflicts;`,
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query:    `SELECT base_pk, base_c1, our_pk, our_c1, their_diff_type, their_pk, their_c1 FROM dolt_conflicts_t;`,
+				Expected: []sql.Row{{1, "uno", 1, "one", "modified", 1, "ein"}},
+			},
+			{
+				Query:    `SELECT * FROM t;`,
+				Expected: []sql.Row{{1, "one"}},
+			},
+			{
+				Query:    `call dolt_conflicts_resolve('--theirs', 't');`,
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    `SELECT * FROM t;`,
+				Expected: []sql.Row{{1, "ein"}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'committing cherry-pick');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				// Assert that our new commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "abort (@@autocommit=1) with ignored table",
+		SetUpScript: []string{
+			"INSERT INTO dolt_ignore VALUES ('generated_*', 1);",
+			"CREATE TABLE generated_foo (pk int PRIMARY KEY);",
+			"CREATE TABLE generated_bar (pk int PRIMARY KEY);",
+			"insert into generated_foo values (1);",
+			"insert into generated_bar values (1);",
+			"SET @@autocommit=1;",
+			"SET @@dolt_allow_commit_conflicts=1;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_add('--force', 'generated_bar');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v=\"uno\" where pk=1;",
+			"call dolt_commit('-Am', 'updating row 1 -> uno');",
+			"alter table t drop column v;",
+			"call dolt_commit('-am', 'drop column v');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(hashof('branch1'));",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{{"t", uint64(1)}},
+			},
+			{
+				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
+				Expected: []sql.Row{
+					{1, "uno", 1, "modified", 1, "modified"},
+				},
+			},
+			{
+				Query: "insert into generated_foo values (2);",
+			},
+			/*
+				// TODO: https://github.com/dolthub/dolt/issues/7411
+				// see below
+				{
+					Query: "insert into generated_bar values (2);",
+				},
+			*/
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{1, "one"}},
+			},
+			{
+				// An ignored table should still be present (and unstaged) after aborting the merge.
+				Query:    "select * from dolt_status;",
+				Expected: []sql.Row{{"generated_foo", false, "new table"}},
+			},
+			{
+				// Changes made to the table during the merge should not be reverted.
+				Query:    "select * from generated_foo;",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			/*{
+				// TODO: https://github.com/dolthub/dolt/issues/7411
+				// The table that was force-added should be treated like any other table
+				// and reverted to its state before the merge began.
+				Query:    "select * from generated_bar;",
+				Expected: []sql.Row{{1}},
+			},*/
+		},
+	},
+}
+
+var DoltCommitTests = []queries.ScriptTest{
+	{
+		Name: "CALL DOLT_COMMIT('-ALL') adds all tables (including new ones) to the commit.",
+		SetUpScript: []string{
+			"CREATE table t (pk int primary key);",
+			"INSERT INTO t VALUES (1);",
+			"CALL DOLT_ADD('t');",
+			"CALL DOLT_COMMIT('-m', 'add table t');",
+			"CALL DOLT_RESET('--hard');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * from t;",
+				Expected: []sql.Row{{1}},
+			},
+			// update a table
+			{
+				Query:    "DELETE from t where pk = 1;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-ALL', '-m', 'update table terminator');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			// check last commit
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"update table terminator"}},
+			},
+			// amend last commit
+			{
+				Query:    "CALL DOLT_COMMIT('-amend', '-m', 'update table t');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			// check amended commit
+			{
+				Query:    "SELECT * from t;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"update table t"}},
+			},
+			{
+				Query:    "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * from t;",
+				Expected: []sql.Row{},
+			},
+			// delete a table
+			{
+				Query:    "DROP TABLE t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-Am', 'drop table t');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:       "SELECT * from t;",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			// create a table
+			{
+				Query:    "CREATE table t2 (pk int primary key);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-Am', 'add table 21');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			// amend last commit
+			{
+				Query:    "CALL DOLT_COMMIT('-amend', '-m', 'add table 2');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			// check amended commit
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"add table 2"}},
+			},
+			{
+				Query:    "CALL DOLT_RESET('--hard');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * from t2;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt commit works with arguments",
+		SetUpScript: []string{
+			"CREATE table t (pk int primary key);",
+			"INSERT INTO t VALUES (1);",
+			"CALL DOLT_ADD('t');",
+			"CALL DOLT_COMMIT('-m', concat('author: ','somebody'));",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT message from dolt_log where message = 'author: somebody'",
+				Expected: []sql.Row{
+					{"author: somebody"},
+				},
+			},
+		},
+	},
+	{
+		Name: "CALL DOLT_COMMIT('-amend') works to update commit message",
+		SetUpScript: []string{
+			"SET @@AUTOCOMMIT=0;",
+			"CREATE TABLE test (id INT PRIMARY KEY );",
+			"INSERT INTO test (id) VALUES (2)",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'original commit message');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT  message FROM dolt_log;",
 				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-					{7, 7},
-					{8, 8},
+					{"original commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
 				},
 			},
 			{
-				Query:    "truncate table t",
-				Expected: []sql.Row{{types.NewOkResult(4)}},
+				Query:    "SELECT to_id, from_id, diff_type FROM dolt_diff_tEST;",
+				Expected: []sql.Row{{2, nil, "added"}},
 			},
 			{
-				// no value on any branch
-				Query:    "insert into t (b) values (1), (2)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 1}}},
+				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit message');",
+				Expected: []sql.Row{{doltCommit}},
 			},
 			{
-				Query: "select * from t order by a",
+				Query: "SELECT  message FROM dolt_log;",
 				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
 				},
 			},
+			{
+				Query:    "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
+				Expected: []sql.Row{{2, nil, "added"}},
+			},
 		},
 	},
 	{
-		// Dropping the primary key constraint from a table implicitly truncates the table, which resets the
-		// auto_increment value for the table to 0. These tests assert that the correct auto_increment value is
-		// restored after the drop pk operation.
-		Name: "drop auto_increment primary key",
+		Name: "CALL DOLT_COMMIT('-amend') works to add changes to a commit",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int, key (a))",
-			"call dolt_commit('-Am', 'empty table')",
-			"call dolt_branch('branch1')",
-			"call dolt_branch('branch2')",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-am', 'two values on main')",
-			"call dolt_checkout('branch1')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_commit('-am', 'two values on branch1')",
-			"call dolt_checkout('branch2')",
-			"insert into t (b) values (5), (6)",
-			"call dolt_checkout('main')",
+			"SET @@AUTOCOMMIT=0;",
+			"INSERT INTO test (id) VALUES (3)",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'original commit message for adding changes to a commit');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "alter table t drop primary key",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
+				Expected: []sql.Row{
+					{3, nil, "added"},
+					{2, nil, "added"},
+				},
 			},
 			{
-				// highest value in any branch is 6
-				Query:    "insert into t (b) values (7), (8)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 7}}},
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query: "select * from t order by a",
+				Query: "SELECT  message FROM dolt_log;",
 				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{7, 7},
-					{8, 8},
+					{"original commit message for adding changes to a commit"},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
 				},
 			},
 			{
-				Query:            "call dolt_checkout('branch2')",
-				SkipResultsCheck: true,
-			},
-			{
-				Query:    "insert into t (b) values (9), (10)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 9}}},
+				Query:    "INSERT INTO test (id) VALUES (4)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-					{9, 9},
-					{10, 10},
-				},
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query:    "alter table t drop primary key",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "CALL DOLT_ADD('.');",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "insert into t (b) values (11), (12)",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 2, InsertID: 11}}},
+				Query:    "CALL DOLT_COMMIT('--amend');",
+				Expected: []sql.Row{{doltCommit}},
 			},
 			{
-				Query: "select * from t order by a",
+				Query: "SELECT message FROM dolt_log;",
 				Expected: []sql.Row{
-					{5, 5},
-					{6, 6},
-					{9, 9},
-					{10, 10},
-					{11, 11},
-					{12, 12},
+					{"original commit message for adding changes to a commit"},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
 				},
 			},
-		},
-	},
-	{
-		Name: "hard reset dropped table restores auto increment",
-		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-Am', 'initialize table')",
-			"drop table t",
-			"call dolt_reset('--hard')",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "insert into t(b) values (3)",
+				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
 				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 1, InsertID: 3}},
+					{4, nil, "added"},
+					{3, nil, "added"},
+					{2, nil, "added"},
 				},
 			},
 			{
-				Query: "select * from t order by a",
-				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{3, 3},
-				},
+				Query:    "INSERT INTO test (id) VALUES (5)",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
-		},
-	},
-	{
-		// this behavior aligns with how we treat branches
-		Name: "hard reset inserted rows continues auto increment",
-		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-Am', 'initialize table')",
-			"insert into t (b) values (3), (4)",
-			"call dolt_reset('--hard')",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "insert into t(b) values (5)",
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "CALL DOLT_ADD('.');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit with added changes');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query: "SELECT message FROM dolt_log;",
 				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 1, InsertID: 5}},
+					{"amended commit with added changes"},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
 				},
 			},
 			{
-				Query: "select * from t order by a",
+				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
 				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{5, 5},
+					{5, nil, "added"},
+					{4, nil, "added"},
+					{3, nil, "added"},
+					{2, nil, "added"},
 				},
 			},
 		},
 	},
 	{
-		Name: "hard reset dropped table with branch restores auto increment",
+		Name: "CALL DOLT_COMMIT('-amend') works to remove changes from a commit",
 		SetUpScript: []string{
-			"create table t (a int primary key auto_increment, b int)",
-			"insert into t (b) values (1), (2)",
-			"call dolt_commit('-Am', 'initialize table')",
-			"call dolt_checkout('-b', 'branch1')",
-			"insert into t values (100, 100)",
-			"call dolt_commit('-Am', 'other')",
-			"call dolt_checkout('main')",
-			"drop table t",
-			"call dolt_reset('--hard')",
+			"SET @@AUTOCOMMIT=0;",
+			"INSERT INTO test (id) VALUES (6)",
+			"INSERT INTO test (id) VALUES (7)",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'original commit message');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "insert into t(b) values (101)",
-				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 1, InsertID: 101}},
-				},
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{2}, {3}, {4}, {5}, {6}, {7}},
 			},
 			{
-				Query: "select * from t order by a",
+				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
 				Expected: []sql.Row{
-					{1, 1},
-					{2, 2},
-					{101, 101},
+					{7, nil, "added"},
+					{6, nil, "added"},
+					{5, nil, "added"},
+					{4, nil, "added"},
+					{3, nil, "added"},
+					{2, nil, "added"},
 				},
 			},
-		},
-	},
-}
-
-var DoltCherryPickTests = []queries.ScriptTest{
-	{
-		Name: "error cases: basic validation",
-		SetUpScript: []string{
-			"create table t (pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t values (1, \"one\");",
-			"call dolt_commit('-am', 'adding row 1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "CALL Dolt_Cherry_Pick('HEAD~100');",
-				ExpectedErrStr: "invalid ancestor spec",
+				Query:    "DELETE FROM test WHERE id = 6",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "CALL DOLT_ADD('.');",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:          "CALL Dolt_Cherry_Pick('abcdaaaaaaaaaaaaaaaaaaaaaaaaaaaa');",
-				ExpectedErrStr: "target commit not found",
+				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit with removed changes');",
+				Expected: []sql.Row{{doltCommit}},
 			},
 			{
-				Query:          "CALL Dolt_Cherry_Pick('--abort');",
-				ExpectedErrStr: "error: There is no cherry-pick merge to abort",
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{2}, {3}, {4}, {5}, {7}},
 			},
-		},
-	},
-	{
-		Name: "error cases: merge commits cannot be cherry-picked",
-		SetUpScript: []string{
-			"create table t (pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t values (1, \"one\");",
-			"call dolt_commit('-am', 'adding row 1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL dolt_merge('--no-ff', 'branch1');",
-				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+				Query: "SELECT message FROM dolt_log;",
+				Expected: []sql.Row{
+					{"amended commit with removed changes"},
+					{"amended commit with added changes"},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
+				},
 			},
 			{
-				Query:          "CALL dolt_cherry_pick('HEAD');",
-				ExpectedErrStr: "cherry-picking a merge commit is not supported",
+				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
+				Expected: []sql.Row{
+					{7, nil, "added"},
+					{5, nil, "added"},
+					{4, nil, "added"},
+					{3, nil, "added"},
+					{2, nil, "added"},
+				},
 			},
 		},
 	},
 	{
-		Name: "error cases: error with staged or unstaged changes ",
+		Name: "CALL DOLT_COMMIT('-amend') works to update a merge commit",
 		SetUpScript: []string{
-			"create table t (pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t values (1, \"one\");",
-			"call dolt_commit('-am', 'adding row 1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-			"INSERT INTO t VALUES (100, 'onehundy');",
+			"SET @@AUTOCOMMIT=0;",
+
+			"CREATE TABLE test2 (id INT PRIMARY KEY, id2 INT);",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'original table');",
+
+			"CALL DOLT_CHECKOUT('-b','test-branch');",
+			"INSERT INTO test2 (id, id2) VALUES (0, 2)",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'conflicting commit message');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO test2 (id, id2) VALUES (0, 1)",
+			"CALL DOLT_ADD('.');",
+			"CALL DOLT_COMMIT('-m', 'original commit message');",
+
+			"CALL DOLT_MERGE('test-branch');",
+			"CALL DOLT_CONFLICTS_RESOLVE('--theirs', '.');",
+			"CALL DOLT_COMMIT('-m', 'final merge');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
-				ExpectedErrStr: "cannot cherry-pick with uncommitted changes",
+				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'new merge');",
+				Expected: []sql.Row{{doltCommit}},
 			},
 			{
-				Query:    "call dolt_add('t');",
-				Expected: []sql.Row{{0}},
+				Query: "SELECT message FROM dolt_log;",
+				Expected: []sql.Row{
+					{"new merge"},
+					{"original commit message"},
+					{"conflicting commit message"},
+					{"original table"},
+					{"amended commit with removed changes"},
+					{"amended commit with added changes"},
+					{"amended commit message"},
+					{"author: somebody"},
+					{"add table 2"},
+					{"drop table t"},
+					{"update table t"},
+					{"add table t"},
+					{"checkpoint enginetest database mydb"},
+					{"Initialize data repository"},
+				},
 			},
 			{
-				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
-				ExpectedErrStr: "cannot cherry-pick with uncommitted changes",
+				Query:    "SET @hash=(SELECT commit_hash FROM dolt_log LIMIT 1);",
+				Expected: []sql.Row{{}},
 			},
-		},
-	},
-	{
-		Name: "error cases: different primary keys",
-		SetUpScript: []string{
-			"create table t (pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (pk, v);",
-			"call dolt_commit('-am', 'adding row 1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
-				ExpectedErrStr: "error: cannot merge because table t has different primary keys",
+				Query:    "SELECT COUNT(parent_hash) FROM dolt_commit_ancestors WHERE commit_hash= @hash;",
+				Expected: []sql.Row{{2}},
 			},
 		},
 	},
+}
+
+var DoltIndexPrefixScripts = []queries.ScriptTest{
 	{
-		Name: "basic case",
+		Name: "inline secondary indexes with collation",
 		SetUpScript: []string{
-			"create table t (pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"insert into t values (1, \"one\");",
-			"call dolt_commit('-am', 'adding row 1');",
-			"set @commit1 = hashof('HEAD');",
-			"insert into t values (2, \"two\");",
-			"call dolt_commit('-am', 'adding row 2');",
-			"set @commit2 = hashof('HEAD');",
-			"call dolt_checkout('main');",
+			"create table t (i int primary key, v1 varchar(10), v2 varchar(10), unique index (v1(3),v2(5))) collate utf8mb4_0900_ai_ci",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM t;",
-				Expected: []sql.Row{},
-			},
-			{
-				Query:    "call dolt_cherry_pick(@commit2);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10),\n  `v2` varchar(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
 			},
 			{
-				Query:    "SELECT * FROM t;",
-				Expected: []sql.Row{{2, "two"}},
+				Query:    "insert into t values (0, 'a', 'a'), (1, 'ab','ab'), (2, 'abc', 'abc'), (3, 'abcde', 'abcde')",
+				Expected: []sql.Row{{types.NewOkResult(4)}},
 			},
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query:       "insert into t values (99, 'ABC', 'ABCDE')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
 			{
-				Query:    "SELECT * FROM t order by pk;",
-				Expected: []sql.Row{{1, "one"}, {2, "two"}},
+				Query:          "insert into t values (99, 'ABC', 'ABCDE')",
+				ExpectedErrStr: "duplicate unique key given: [ABC,ABCDE]",
 			},
 			{
-				// Assert that our new commit only has one parent (i.e. not a merge commit)
-				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
-				Expected: []sql.Row{{1}},
+				Query:       "insert into t values (99, 'ABC123', 'ABCDE123')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
-		},
-	},
-	{
-		Name: "keyless table",
-		SetUpScript: []string{
-			"call dolt_checkout('main');",
-			"CREATE TABLE keyless (id int, name varchar(10));",
-			"call dolt_commit('-Am', 'create table keyless on main');",
-			"call dolt_checkout('-b', 'branch1');",
-			"INSERT INTO keyless VALUES (1,'1'), (2,'3');",
-			"call dolt_commit('-am', 'insert rows into keyless table on branch1');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM keyless;",
-				Expected: []sql.Row{},
+				Query:          "insert into t values (99, 'ABC123', 'ABCDE123')",
+				ExpectedErrStr: "duplicate unique key given: [ABC,ABCDE]",
 			},
 			{
-				Query:    "CALL DOLT_CHERRY_PICK('branch1');",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query: "select * from t where v1 = 'A'",
+				Expected: []sql.Row{
+					{0, "a", "a"},
+				},
 			},
 			{
-				Query:    "SELECT * FROM keyless;",
-				Expected: []sql.Row{{1, "1"}, {2, "3"}},
+				Query: "select * from t where v1 = 'ABC'",
+				Expected: []sql.Row{
+					{2, "abc", "abc"},
+				},
 			},
-		},
-	},
-	{
-		Name: "schema change: CREATE TABLE",
-		SetUpScript: []string{
-			"call dolt_checkout('-b', 'branch1');",
-			"CREATE TABLE table_a (pk BIGINT PRIMARY KEY, v varchar(10));",
-			"INSERT INTO table_a VALUES (11, 'aa'), (22, 'ab');",
-			"call dolt_commit('-Am', 'create table table_a');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SHOW TABLES;",
+				Query:    "select * from t where v1 = 'ABCD'",
 				Expected: []sql.Row{},
 			},
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
-			},
-			{
-				// Assert that our new commit only has one parent (i.e. not a merge commit)
-				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
-				Expected: []sql.Row{{1}},
+				Query: "select * from t where v1 > 'A' and v1 < 'ABCDE'",
+				Expected: []sql.Row{
+					{1, "ab", "ab"},
+					{2, "abc", "abc"},
+				},
 			},
 			{
-				Query:    "SHOW TABLES;",
-				Expected: []sql.Row{{"table_a"}},
+				Query: "select * from t where v1 > 'A' and v2 < 'ABCDE'",
+				Expected: []sql.Row{
+					{1, "ab", "ab"},
+					{2, "abc", "abc"},
+				},
 			},
 			{
-				Query:    "SELECT * FROM table_a;",
-				Expected: []sql.Row{{11, "aa"}, {22, "ab"}},
+				Query: "update t set v1 = concat(v1, 'Z') where v1 >= 'A'",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 4, InsertID: 0, Info: plan.UpdateInfo{Matched: 4, Updated: 4}}},
+				},
 			},
-		},
-	},
-	{
-		Name: "schema change: DROP TABLE",
-		SetUpScript: []string{
-			"CREATE TABLE dropme (pk BIGINT PRIMARY KEY, v varchar(10));",
-			"INSERT INTO dropme VALUES (11, 'aa'), (22, 'ab');",
-			"call dolt_commit('-Am', 'create table dropme');",
-			"call dolt_checkout('-b', 'branch1');",
-			"drop table dropme;",
-			"call dolt_commit('-Am', 'drop table dropme');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SHOW TABLES;",
-				Expected: []sql.Row{{"dropme"}},
+				Query: "select * from t",
+				Expected: []sql.Row{
+					{0, "aZ", "a"},
+					{1, "abZ", "ab"},
+					{2, "abcZ", "abc"},
+					{3, "abcdeZ", "abcde"},
+				},
 			},
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query: "delete from t where v1 >= 'A'",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 4}},
+				},
 			},
 			{
-				Query:    "SHOW TABLES;",
+				Query:    "select * from t",
 				Expected: []sql.Row{},
 			},
 		},
 	},
+	// TODO: these should eventually go in GMS, but it doesn't currently support index rewrite on column modify
 	{
-		Name: "schema change: ALTER TABLE ADD COLUMN",
+		Name: "drop prefix lengths when modifying column to non string type",
 		SetUpScript: []string{
-			"create table test(pk int primary key);",
-			"call dolt_commit('-Am', 'create table test on main');",
-			"call dolt_checkout('-b', 'branch1');",
-			"ALTER TABLE test ADD COLUMN v VARCHAR(100);",
-			"call dolt_commit('-am', 'add column v to test on branch1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
+			"create table t (j varchar(100), index (j(10)))",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query:    "alter table t modify column j int",
+				Expected: []sql.Row{{types.OkResult{}}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  `v` varchar(100),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` int,\n  KEY `j` (`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
 	{
-		Name: "schema change: ALTER TABLE DROP COLUMN",
+		Name: "drop prefix length when modifying columns to invalid string type",
 		SetUpScript: []string{
-			"create table test(pk int primary key, v varchar(100));",
-			"call dolt_commit('-Am', 'create table test on main');",
-			"call dolt_checkout('-b', 'branch1');",
-			"ALTER TABLE test DROP COLUMN v;",
-			"call dolt_commit('-am', 'drop column v from test on branch1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
+			"create table t (j varchar(100), index (j(10)))",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query:    "alter table t modify column j varchar(2)",
+				Expected: []sql.Row{{types.OkResult{}}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` varchar(2),\n  KEY `j` (`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
 	{
-		Name: "schema change: ALTER TABLE RENAME COLUMN",
+		Name: "preserve prefix length when modifying column to valid string type",
 		SetUpScript: []string{
-			"create table test(pk int primary key, v1 varchar(100));",
-			"call dolt_commit('-Am', 'create table test on main');",
-			"call dolt_checkout('-b', 'branch1');",
-			"ALTER TABLE test RENAME COLUMN v1 to v2;",
-			"call dolt_commit('-am', 'rename column v1 to v2 in test on branch1');",
-			"set @commit1 = hashof('HEAD');",
-			"call dolt_checkout('main');",
+			"create table t (j varchar(100), index (j(10)))",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(@commit1);",
-				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+				Query:    "alter table t modify column j varchar(200)",
+				Expected: []sql.Row{{types.OkResult{}}},
 			},
 			{
-				Query:    "SHOW CREATE TABLE test;",
-				Expected: []sql.Row{{"test", "CREATE TABLE `test` (\n  `pk` int NOT NULL,\n  `v2` varchar(100),\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` varchar(200),\n  KEY `j` (`j`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
 	{
-		Name: "abort (@@autocommit=0)",
+		Name: "preserve prefix lengths when there are other unchanged prefix lengths",
 		SetUpScript: []string{
-			"SET @@autocommit=0;",
-			"create table t (pk int primary key, v varchar(100));",
-			"insert into t values (1, 'one');",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"update t set v=\"uno\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> uno');",
-			"alter table t drop column v;",
-			"call dolt_commit('-am', 'drop column v');",
-			"call dolt_checkout('main');",
+			"create table t (i varchar(100), j varchar(100), index (i(10), j(10)))",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(hashof('branch1'));",
-				Expected: []sql.Row{{"", 1, 0, 0}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{{"t", uint64(1)}},
-			},
-			{
-				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
-				Expected: []sql.Row{
-					{1, "uno", 1, "modified", 1, "modified"},
-				},
-			},
-			{
-				Query:    "call dolt_cherry_pick('--abort');",
-				Expected: []sql.Row{{"", 0, 0, 0}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{},
+				Query:    "alter table t modify column j int",
+				Expected: []sql.Row{{types.OkResult{}}},
 			},
 			{
-				Query:    "select * from t;",
-				Expected: []sql.Row{{1, "one"}},
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` varchar(100),\n  `j` int,\n  KEY `i` (`i`(10),`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
 			},
 		},
 	},
 	{
-		Name: "abort (@@autocommit=1)",
+		Name: "prefix length too long",
 		SetUpScript: []string{
-			"SET @@autocommit=1;",
-			"SET @@dolt_allow_commit_conflicts=1;",
-			"create table t (pk int primary key, v varchar(100));",
-			"insert into t values (1, 'one');",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"update t set v=\"uno\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> uno');",
-			"alter table t drop column v;",
-			"call dolt_commit('-am', 'drop column v');",
-			"call dolt_checkout('main');",
+			"create table t (i blob, index(i(3072)))",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(hashof('branch1'));",
-				Expected: []sql.Row{{"", 1, 0, 0}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{{"t", uint64(1)}},
-			},
-			{
-				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
-				Expected: []sql.Row{
-					{1, "uno", 1, "modified", 1, "modified"},
-				},
-			},
-			{
-				Query:    "call dolt_cherry_pick('--abort');",
-				Expected: []sql.Row{{"", 0, 0, 0}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{},
-			},
-			{
-				Query:    "select * from t;",
-				Expected: []sql.Row{{1, "one"}},
+				Query:       "alter table t modify column i text",
+				ExpectedErr: sql.ErrKeyTooLong,
 			},
 		},
 	},
 	{
-		Name: "conflict resolution (@@autocommit=0)",
+		Name: "text and blob key errors",
 		SetUpScript: []string{
-			"SET @@autocommit=0;",
-			"create table t (pk int primary key, v varchar(100));",
-			"insert into t values (1, 'one');",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"update t set v=\"uno\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> uno');",
-			"alter table t drop column v;",
-			"call dolt_commit('-am', 'drop column v');",
-			"call dolt_checkout('main');",
+			"create table t (t text, b blob, unique(t(4)), unique(b));",
+			"insert into t values ('hello', 'goodbye');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(hashof('branch1'));",
-				Expected: []sql.Row{{"", 1, 0, 0}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{{"t", uint64(1)}},
-			},
-			{
-				Query:    "select * from dolt_status",
-				Expected: []sql.Row{{"t", false, "modified"}, {"t", false, "conflict"}},
-			},
-			{
-				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
-				Expected: []sql.Row{
-					{1, "uno", 1, "modified", 1, "modified"},
-				},
-			},
-			{
-				Query:    "call dolt_conflicts_resolve('--ours', 't');",
-				Expected: []sql.Row{{0}},
-			},
-			{
-				Query:    "select * from dolt_status",
-				Expected: []sql.Row{{"t", false, "modified"}},
-			},
-			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{},
-			},
-			{
-				Query:    "select * from t;",
-				Expected: []sql.Row{{1}},
-			},
-			{
-				Query:    "call dolt_commit('-am', 'committing cherry-pick');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:          "insert into t values('hello123', 'something different');",
+				ExpectedErrStr: "duplicate unique key given: [hell]",
 			},
 			{
-				// Assert that our new commit only has one parent (i.e. not a merge commit)
-				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
-				Expected: []sql.Row{{1}},
+				Query:          "insert into t values('something different', 'goodbye');",
+				ExpectedErrStr: "duplicate unique key given: [goodbye]",
 			},
 		},
 	},
+}
+
+// DoltCallAsOf are tests of using CALL ... AS OF using commits
+var DoltCallAsOf = []queries.ScriptTest{
 	{
-		Name: "conflict resolution (@@autocommit=1)",
+		Name: "Database syntax properly handles inter-CALL communication",
 		SetUpScript: []string{
-			"set @@autocommit=1;",
-			"SET @@dolt_allow_commit_conflicts=1;",
-			"create table t (pk int primary key, c1 varchar(100));",
-			"call dolt_commit('-Am', 'creating table t');",
-			"insert into t values (1, \"one\");",
-			"call dolt_commit('-Am', 'inserting row 1');",
-			"SET @commit1 = hashof('HEAD');",
-			"update t set c1=\"uno\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> uno');",
-			"update t set c1=\"ein\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> ein');",
-			"SET @commit2 = hashof('HEAD');",
-			"call dolt_reset('--hard', @commit1);",
-		},
-		Assertions: []queries.ScriptTestAssertion{
-			{
-				Query:    "SELECT * from dolt_status;",
-				Expected: []sql.Row{},
-			},
-			{
-				Query:    "SELECT * from t;",
-				Expected: []sql.Row{{1, "one"}},
-			},
-			{
-				Query:    `CALL dolt_cherry_pick(@commit2);`,
-				Expected: []sql.Row{{"", 1, 0, 0}},
-			},
-			{
-				Query:    `SELECT * FROM dolt_conflicts;`,
-				Expected: []sql.Row{{"t", uint64(1)}},
-			},
+			`CREATE PROCEDURE p1()
+BEGIN
+	DECLARE str VARCHAR(20);
+    CALL p2(str);
+	SET str = CONCAT('a', str);
+    SELECT str;
+END`,
+			`CREATE PROCEDURE p2(OUT param VARCHAR(20))
+BEGIN
+	SET param = 'b';
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'First procedures');",
+			"CALL DOLT_BRANCH('p12');",
+			"DROP PROCEDURE p1;",
+			"DROP PROCEDURE p2;",
+			`CREATE PROCEDURE p1()
+BEGIN
+	DECLARE str VARCHAR(20);
+    CALL p2(str);
+	SET str = CONCAT('c', str);
+    SELECT str;
+END`,
+			`CREATE PROCEDURE p2(OUT param VARCHAR(20))
+BEGIN
+	SET param = 'd';
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'Second procedures');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    `commit;`,
-				Expected: []sql.Row{},
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{"cd"}},
 			},
 			{
-				Query:    `SELECT * FROM dolt_conflicts;`,
-				Expected: []sql.Row{{"t", uint64(1)}},
+				Query:    "CALL `mydb/main`.p1();",
+				Expected: []sql.Row{{"cd"}},
 			},
 			{
-				Query:    `SELECT base_pk, base_c1, our_pk, our_c1, their_diff_type, their_pk, their_c1 FROM dolt_conflicts_t;`,
-				Expected: []sql.Row{{1, "uno", 1, "one", "modified", 1, "ein"}},
+				Query:    "CALL `mydb/p12`.p1();",
+				Expected: []sql.Row{{"ab"}},
 			},
+		},
+	},
+	{
+		Name: "CALL ... AS OF references historic data through nested calls",
+		SetUpScript: []string{
+			"CREATE TABLE test (v1 BIGINT);",
+			"INSERT INTO test VALUES (1);",
+			`CREATE PROCEDURE p1()
+BEGIN
+	CALL p2();
+END`,
+			`CREATE PROCEDURE p2()
+BEGIN
+	SELECT * FROM test;
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"UPDATE test SET v1 = 2;",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"UPDATE test SET v1 = 3;",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"UPDATE test SET v1 = 4;",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    `SELECT * FROM t;`,
-				Expected: []sql.Row{{1, "one"}},
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query:    `call dolt_conflicts_resolve('--theirs', 't');`,
-				Expected: []sql.Row{{0}},
+				Query:    "CALL p1() AS OF 'HEAD';",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query:    `SELECT * FROM t;`,
-				Expected: []sql.Row{{1, "ein"}},
+				Query:    "CALL p1() AS OF 'HEAD~1';",
+				Expected: []sql.Row{{3}},
 			},
 			{
-				Query:    "call dolt_commit('-am', 'committing cherry-pick');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "CALL p1() AS OF 'HEAD~2';",
+				Expected: []sql.Row{{2}},
 			},
 			{
-				// Assert that our new commit only has one parent (i.e. not a merge commit)
-				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Query:    "CALL p1() AS OF 'HEAD~3';",
 				Expected: []sql.Row{{1}},
 			},
 		},
 	},
 	{
-		Name: "abort (@@autocommit=1) with ignored table",
+		Name: "CALL ... AS OF doesn't overwrite nested CALL ... AS OF",
 		SetUpScript: []string{
-			"INSERT INTO dolt_ignore VALUES ('generated_*', 1);",
-			"CREATE TABLE generated_foo (pk int PRIMARY KEY);",
-			"CREATE TABLE generated_bar (pk int PRIMARY KEY);",
-			"insert into generated_foo values (1);",
-			"insert into generated_bar values (1);",
-			"SET @@autocommit=1;",
-			"SET @@dolt_allow_commit_conflicts=1;",
-			"create table t (pk int primary key, v varchar(100));",
-			"insert into t values (1, 'one');",
-			"call dolt_add('--force', 'generated_bar');",
-			"call dolt_commit('-Am', 'create table t');",
-			"call dolt_checkout('-b', 'branch1');",
-			"update t set v=\"uno\" where pk=1;",
-			"call dolt_commit('-Am', 'updating row 1 -> uno');",
-			"alter table t drop column v;",
-			"call dolt_commit('-am', 'drop column v');",
-			"call dolt_checkout('main');",
+			"CREATE TABLE myhistorytable (pk BIGINT PRIMARY KEY, s TEXT);",
+			"INSERT INTO myhistorytable VALUES (1, 'first row, 1'), (2, 'second row, 1'), (3, 'third row, 1');",
+			"CREATE PROCEDURE p1() BEGIN CALL p2(); END",
+			"CREATE PROCEDURE p1a() BEGIN CALL p2() AS OF 'HEAD~2'; END",
+			"CREATE PROCEDURE p1b() BEGIN CALL p2a(); END",
+			"CREATE PROCEDURE p2() BEGIN SELECT * FROM myhistorytable; END",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"DELETE FROM myhistorytable;",
+			"INSERT INTO myhistorytable VALUES (1, 'first row, 2'), (2, 'second row, 2'), (3, 'third row, 2');",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"DROP TABLE myhistorytable;",
+			"CREATE TABLE myhistorytable (pk BIGINT PRIMARY KEY, s TEXT, c TEXT);",
+			"INSERT INTO myhistorytable VALUES (1, 'first row, 3', '1'), (2, 'second row, 3', '2'), (3, 'third row, 3', '3');",
+			"CREATE PROCEDURE p2a() BEGIN SELECT * FROM myhistorytable AS OF 'HEAD~1'; END",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_cherry_pick(hashof('branch1'));",
-				Expected: []sql.Row{{"", 1, 0, 0}},
+				Query: "CALL p1();",
+				Expected: []sql.Row{
+					{int64(1), "first row, 3", "1"},
+					{int64(2), "second row, 3", "2"},
+					{int64(3), "third row, 3", "3"},
+				},
 			},
 			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{{"t", uint64(1)}},
+				Query: "CALL p1a();",
+				Expected: []sql.Row{
+					{int64(1), "first row, 1"},
+					{int64(2), "second row, 1"},
+					{int64(3), "third row, 1"},
+				},
 			},
 			{
-				Query: "select base_pk, base_v, our_pk, our_diff_type, their_pk, their_diff_type from dolt_conflicts_t;",
+				Query: "CALL p1b();",
 				Expected: []sql.Row{
-					{1, "uno", 1, "modified", 1, "modified"},
+					{int64(1), "first row, 2"},
+					{int64(2), "second row, 2"},
+					{int64(3), "third row, 2"},
 				},
 			},
 			{
-				Query: "insert into generated_foo values (2);",
+				Query: "CALL p2();",
+				Expected: []sql.Row{
+					{int64(1), "first row, 3", "1"},
+					{int64(2), "second row, 3", "2"},
+					{int64(3), "third row, 3", "3"},
+				},
 			},
-			/*
-				// TODO: https://github.com/dolthub/dolt/issues/7411
-				// see below
-				{
-					Query: "insert into generated_bar values (2);",
+			{
+				Query: "CALL p2a();",
+				Expected: []sql.Row{
+					{int64(1), "first row, 2"},
+					{int64(2), "second row, 2"},
+					{int64(3), "third row, 2"},
 				},
-			*/
+			},
 			{
-				Query:    "call dolt_cherry_pick('--abort');",
-				Expected: []sql.Row{{"", 0, 0, 0}},
+				Query: "CALL p1() AS OF 'HEAD~2';",
+				Expected: []sql.Row{
+					{int64(1), "first row, 1"},
+					{int64(2), "second row, 1"},
+					{int64(3), "third row, 1"},
+				},
 			},
 			{
-				Query:    "select * from dolt_conflicts;",
-				Expected: []sql.Row{},
+				Query: "CALL p1a() AS OF 'HEAD';",
+				Expected: []sql.Row{
+					{int64(1), "first row, 1"},
+					{int64(2), "second row, 1"},
+					{int64(3), "third row, 1"},
+				},
 			},
 			{
-				Query:    "select * from t;",
-				Expected: []sql.Row{{1, "one"}},
+				Query: "CALL p1b() AS OF 'HEAD';",
+				Expected: []sql.Row{
+					{int64(1), "first row, 2"},
+					{int64(2), "second row, 2"},
+					{int64(3), "third row, 2"},
+				},
 			},
 			{
-				// An ignored table should still be present (and unstaged) after aborting the merge.
-				Query:    "select * from dolt_status;",
-				Expected: []sql.Row{{"generated_foo", false, "new table"}},
+				Query: "CALL p2() AS OF 'HEAD~2';",
+				Expected: []sql.Row{
+					{int64(1), "first row, 1"},
+					{int64(2), "second row, 1"},
+					{int64(3), "third row, 1"},
+				},
 			},
 			{
-				// Changes made to the table during the merge should not be reverted.
-				Query:    "select * from generated_foo;",
-				Expected: []sql.Row{{1}, {2}},
+				Query: "CALL p2a() AS OF 'HEAD';",
+				Expected: []sql.Row{
+					{int64(1), "first row, 2"},
+					{int64(2), "second row, 2"},
+					{int64(3), "third row, 2"},
+				},
 			},
-			/*{
-				// TODO: https://github.com/dolthub/dolt/issues/7411
-				// The table that was force-added should be treated like any other table
-				// and reverted to its state before the merge began.
-				Query:    "select * from generated_bar;",
-				Expected: []sql.Row{{1}},
-			},*/
 		},
 	},
-}
-
-var DoltCommitTests = []queries.ScriptTest{
 	{
-		Name: "CALL DOLT_COMMIT('-ALL') adds all tables (including new ones) to the commit.",
+		Name: "CALL ... AS OF errors if attempting to modify a table",
 		SetUpScript: []string{
-			"CREATE table t (pk int primary key);",
-			"INSERT INTO t VALUES (1);",
-			"CALL DOLT_ADD('t');",
-			"CALL DOLT_COMMIT('-m', 'add table t');",
-			"CALL DOLT_RESET('--hard');",
+			"CREATE TABLE test (v1 BIGINT);",
+			"INSERT INTO test VALUES (2);",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			`CREATE PROCEDURE p1()
+BEGIN
+	UPDATE test SET v1 = v1 * 2;
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SELECT * from t;",
-				Expected: []sql.Row{{1}},
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{2}},
 			},
-			// update a table
 			{
-				Query:    "DELETE from t where pk = 1;",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('-ALL', '-m', 'update table terminator');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{4}},
 			},
-			// check last commit
 			{
-				Query:    "select message from dolt_log limit 1",
-				Expected: []sql.Row{{"update table terminator"}},
+				Query:       "CALL p1() AS OF 'HEAD~1';",
+				ExpectedErr: sql.ErrProcedureCallAsOfReadOnly,
 			},
-			// amend last commit
+		},
+	},
+	{
+		Name: "Database syntax propagates to inner calls",
+		SetUpScript: []string{
+			"CALL DOLT_CHECKOUT('main');",
+			`CREATE PROCEDURE p4()
+BEGIN
+	CALL p5();
+END`,
+			`CREATE PROCEDURE p5()
+BEGIN
+	SELECT 3;
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"CALL DOLT_BRANCH('p45');",
+			"DROP PROCEDURE p4;",
+			"DROP PROCEDURE p5;",
+			`CREATE PROCEDURE p4()
+BEGIN
+	CALL p5();
+END`,
+			`CREATE PROCEDURE p5()
+BEGIN
+	SELECT 4;
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL DOLT_COMMIT('-amend', '-m', 'update table t');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "CALL p4();",
+				Expected: []sql.Row{{4}},
 			},
-			// check amended commit
 			{
-				Query:    "SELECT * from t;",
-				Expected: []sql.Row{},
+				Query:    "CALL p5();",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query:    "select message from dolt_log limit 1",
-				Expected: []sql.Row{{"update table t"}},
+				Query:    "CALL `mydb/main`.p4();",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query:    "CALL DOLT_RESET('--hard');",
-				Expected: []sql.Row{{0}},
+				Query:    "CALL `mydb/main`.p5();",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query:    "SELECT * from t;",
-				Expected: []sql.Row{},
+				Query:    "CALL `mydb/p45`.p4();",
+				Expected: []sql.Row{{3}},
 			},
-			// delete a table
 			{
-				Query:    "DROP TABLE t;",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "CALL `mydb/p45`.p5();",
+				Expected: []sql.Row{{3}},
 			},
+		},
+	},
+	{
+		Name: "Database syntax with AS OF",
+		SetUpScript: []string{
+			"CREATE TABLE test (v1 BIGINT);",
+			"INSERT INTO test VALUES (2);",
+			`CREATE PROCEDURE p1()
+BEGIN
+	SELECT v1 * 10 FROM test;
+END`,
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"CALL DOLT_BRANCH('other');",
+			"DROP PROCEDURE p1;",
+			`CREATE PROCEDURE p1()
+BEGIN
+	SELECT v1 * 100 FROM test;
+END`,
+			"UPDATE test SET v1 = 3;",
+			"CALL DOLT_ADD('-A');",
+			"CALL DOLT_COMMIT('-m', 'commit message');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL DOLT_COMMIT('-Am', 'drop table t');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "CALL p1();",
+				Expected: []sql.Row{{300}},
 			},
 			{
-				Query:    "CALL DOLT_RESET('--hard');",
-				Expected: []sql.Row{{0}},
+				Query:    "CALL `mydb/main`.p1();",
+				Expected: []sql.Row{{300}},
 			},
 			{
-				Query:       "SELECT * from t;",
-				ExpectedErr: sql.ErrTableNotFound,
+				Query:    "CALL `mydb/other`.p1();",
+				Expected: []sql.Row{{30}},
 			},
-			// create a table
 			{
-				Query:    "CREATE table t2 (pk int primary key);",
-				Expected: []sql.Row{{types.NewOkResult(0)}},
+				Query:    "CALL p1() AS OF 'HEAD';",
+				Expected: []sql.Row{{300}},
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('-Am', 'add table 21');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "CALL `mydb/main`.p1() AS OF 'HEAD';",
+				Expected: []sql.Row{{300}},
 			},
-			// amend last commit
 			{
-				Query:    "CALL DOLT_COMMIT('-amend', '-m', 'add table 2');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "CALL `mydb/other`.p1() AS OF 'HEAD';",
+				Expected: []sql.Row{{30}},
 			},
-			// check amended commit
 			{
-				Query:    "select message from dolt_log limit 1",
-				Expected: []sql.Row{{"add table 2"}},
+				Query:    "CALL p1() AS OF 'HEAD~1';",
+				Expected: []sql.Row{{200}},
 			},
 			{
-				Query:    "CALL DOLT_RESET('--hard');",
-				Expected: []sql.Row{{0}},
+				Query:    "CALL `mydb/main`.p1() AS OF 'HEAD~1';",
+				Expected: []sql.Row{{200}},
 			},
 			{
-				Query:    "SELECT * from t2;",
-				Expected: []sql.Row{},
+				Query:    "CALL `mydb/other`.p1() AS OF 'HEAD~1';",
+				Expected: []sql.Row{{20}},
 			},
 		},
 	},
+}
+
+var DoltSystemVariables = []queries.ScriptTest{
 	{
-		Name: "dolt commit works with arguments",
+		Name: "DOLT_SHOW_SYSTEM_TABLES",
 		SetUpScript: []string{
-			"CREATE table t (pk int primary key);",
-			"INSERT INTO t VALUES (1);",
-			"CALL DOLT_ADD('t');",
-			"CALL DOLT_COMMIT('-m', concat('author: ','somebody'));",
+			"CREATE TABLE test (pk int PRIMARY KEY);",
+			"SET @@DOLT_SHOW_SYSTEM_TABLES=1",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "SELECT message from dolt_log where message = 'author: somebody'",
+				Query: "SHOW TABLES;",
 				Expected: []sql.Row{
-					{"author: somebody"},
+					{"dolt_branches"},
+					{"dolt_commit_ancestors"},
+					{"dolt_commit_diff_test"},
+					{"dolt_commits"},
+					{"dolt_conflicts"},
+					{"dolt_conflicts_test"},
+					{"dolt_constraint_violations"},
+					{"dolt_constraint_violations_test"},
+					{"dolt_diff_test"},
+					{"dolt_history_test"},
+					{"dolt_log"},
+					{"dolt_remote_branches"},
+					{"dolt_remotes"},
+					{"dolt_status"},
+					{"dolt_workspace_test"},
+					{"test"},
 				},
 			},
 		},
 	},
+}
+
+// DoltTempTableScripts tests temporary tables.
+// Temporary tables are not supported in GMS, eventually should move those tests there.
+var DoltTempTableScripts = []queries.ScriptTest{
 	{
-		Name: "CALL DOLT_COMMIT('-amend') works to update commit message",
+		Name: "temporary table supports auto increment",
 		SetUpScript: []string{
-			"SET @@AUTOCOMMIT=0;",
-			"CREATE TABLE test (id INT PRIMARY KEY );",
-			"INSERT INTO test (id) VALUES (2)",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'original commit message');",
+			"create temporary table t (i int primary key auto_increment)",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "SELECT  message FROM dolt_log;",
+				Query: "show create table t;",
 				Expected: []sql.Row{
-					{"original commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
+					{"t", "CREATE TABLE `t` (\n" +
+						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
+						"  PRIMARY KEY (`i`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
-				Query:    "SELECT to_id, from_id, diff_type FROM dolt_diff_tEST;",
-				Expected: []sql.Row{{2, nil, "added"}},
+				Query: "insert into t values (), (), ()",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 3, InsertID: 1}},
+				},
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit message');",
-				Expected: []sql.Row{{doltCommit}},
+				Query: "show create table t;",
+				Expected: []sql.Row{
+					{"t", "CREATE TABLE `t` (\n" +
+						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
+						"  PRIMARY KEY (`i`)\n" +
+						") ENGINE=InnoDB AUTO_INCREMENT=4 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+				},
 			},
 			{
-				Query: "SELECT  message FROM dolt_log;",
+				Query: "select * from t",
 				Expected: []sql.Row{
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
+					{1},
+					{2},
+					{3},
 				},
 			},
 			{
-				Query:    "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
-				Expected: []sql.Row{{2, nil, "added"}},
+				Query: "insert into t values (100), (1000)",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 2, InsertID: 1}},
+				},
 			},
-		},
-	},
-	{
-		Name: "CALL DOLT_COMMIT('-amend') works to add changes to a commit",
-		SetUpScript: []string{
-			"SET @@AUTOCOMMIT=0;",
-			"INSERT INTO test (id) VALUES (3)",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'original commit message for adding changes to a commit');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
+				Query: "show create table t;",
 				Expected: []sql.Row{
-					{3, nil, "added"},
-					{2, nil, "added"},
+					{"t", "CREATE TABLE `t` (\n" +
+						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
+						"  PRIMARY KEY (`i`)\n" +
+						") ENGINE=InnoDB AUTO_INCREMENT=1001 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
 				},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM dolt_status;",
-				Expected: []sql.Row{{0}},
+				Query: "insert into t values (), (), ()",
+				Expected: []sql.Row{
+					{types.OkResult{RowsAffected: 3, InsertID: 0x3e9}},
+				},
 			},
 			{
-				Query: "SELECT  message FROM dolt_log;",
+				Query: "select * from t",
 				Expected: []sql.Row{
-					{"original commit message for adding changes to a commit"},
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
+					{1},
+					{2},
+					{3},
+					{100},
+					{1000},
+					{1001},
+					{1002},
+					{1003},
+				},
+			},
+		},
+	},
+	{
+		Name: "temporary table tag collision",
+		SetUpScript: []string{
+			"CREATE TABLE note(a int, b int, userid int);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "CREATE TEMPORARY TABLE tmp_tbl(a int, b int, c int, d int, e int, f int, g int);",
+				Expected: []sql.Row{
+					{types.NewOkResult(0)},
 				},
 			},
+		},
+	},
+}
+
+var DoltColumnarIndexScripts = []queries.ScriptTest{
+	{
+		Name: "columnar index is kept in sync with table edits",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(20), c int);",
+			"insert into t with recursive r(i) as (select 0 union all select i+1 from r where i < 99) select x.i*100+y.i, (x.i*100+y.i) % 7, concat('v', x.i % 5), x.i from r x, r y;",
+			"create columnar index col_ab on t (a, b);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "INSERT INTO test (id) VALUES (4)",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select count(a), sum(a), count(b) from t;",
+				Expected: []sql.Row{{10000, float64(29994), 10000}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM dolt_status;",
-				Expected: []sql.Row{{1}},
+				Query:    "select b, count(a), sum(a) from t group by b order by b;",
+				Expected: []sql.Row{{"v0", 2000, float64(5997)}, {"v1", 2000, float64(6000)}, {"v2", 2000, float64(6003)}, {"v3", 2000, float64(5999)}, {"v4", 2000, float64(5995)}},
 			},
 			{
-				Query:    "CALL DOLT_ADD('.');",
-				Expected: []sql.Row{{0}},
+				Query:    "select count(*) from information_schema.statistics where table_name = 't';",
+				Expected: []sql.Row{{1}},
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('--amend');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "update t set a = 100 where pk between 5000 and 5010;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 11, Info: plan.UpdateInfo{Matched: 11, Updated: 11}}}},
 			},
 			{
-				Query: "SELECT message FROM dolt_log;",
-				Expected: []sql.Row{
-					{"original commit message for adding changes to a commit"},
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
-				},
+				Query:    "delete from t where pk >= 9000;",
+				Expected: []sql.Row{{types.NewOkResult(1000)}},
 			},
 			{
-				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
-				Expected: []sql.Row{
-					{4, nil, "added"},
-					{3, nil, "added"},
-					{2, nil, "added"},
-				},
+				Query:    "insert into t values (-1, 3, 'new', 0);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "INSERT INTO test (id) VALUES (5)",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select count(a), sum(a), count(b) from t;",
+				Expected: []sql.Row{{9001, float64(28063), 9001}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM dolt_status;",
-				Expected: []sql.Row{{1}},
+				Query:    "select count(a) from t where a = 100;",
+				Expected: []sql.Row{{11}},
 			},
 			{
-				Query:    "CALL DOLT_ADD('.');",
-				Expected: []sql.Row{{0}},
+				Query:    "select b, count(a), sum(a) from t group by b order by b;",
+				Expected: []sql.Row{{"new", 1, float64(3)}, {"v0", 1800, float64(6460)}, {"v1", 1800, float64(5404)}, {"v2", 1800, float64(5399)}, {"v3", 1800, float64(5401)}, {"v4", 1800, float64(5396)}},
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit with added changes');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "select a, b from t where pk = 5005;",
+				Expected: []sql.Row{{100, "v0"}},
 			},
 			{
-				Query:    "SELECT COUNT(*) FROM dolt_status;",
-				Expected: []sql.Row{{0}},
+				Query:    "drop index col_ab on t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "SELECT message FROM dolt_log;",
-				Expected: []sql.Row{
-					{"amended commit with added changes"},
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
-				},
+				Query:    "select count(a), sum(a), count(b) from t;",
+				Expected: []sql.Row{{9001, float64(28063), 9001}},
 			},
 			{
-				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
-				Expected: []sql.Row{
-					{5, nil, "added"},
-					{4, nil, "added"},
-					{3, nil, "added"},
-					{2, nil, "added"},
-				},
+				Query:          "drop index col_ab on t;",
+				ExpectedErrStr: "error: can't drop 'col_ab'; check that column/key exists",
 			},
 		},
 	},
 	{
-		Name: "CALL DOLT_COMMIT('-amend') works to remove changes from a commit",
+		Name: "columnar index is merged",
 		SetUpScript: []string{
-			"SET @@AUTOCOMMIT=0;",
-			"INSERT INTO test (id) VALUES (6)",
-			"INSERT INTO test (id) VALUES (7)",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'original commit message');",
+			"create table t (pk int primary key, a int);",
+			"insert into t values (1, 1), (2, 2), (3, 3), (4, 4);",
+			"create columnar index col_a on t (a);",
+			"call dolt_commit('-Am', 'create columnar index');",
+			"call dolt_checkout('-b', 'other');",
+			"update t set a = 20 where pk = 2;",
+			"insert into t values (5, 5);",
+			"call dolt_commit('-am', 'other');",
+			"call dolt_checkout('main');",
+			"delete from t where pk = 4;",
+			"insert into t values (0, 100);",
+			"call dolt_commit('-am', 'main');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{2}, {3}, {4}, {5}, {6}, {7}},
+				Query:    "call dolt_merge('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
 			},
 			{
-				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
-				Expected: []sql.Row{
-					{7, nil, "added"},
-					{6, nil, "added"},
-					{5, nil, "added"},
-					{4, nil, "added"},
-					{3, nil, "added"},
-					{2, nil, "added"},
-				},
+				Query:    "select count(a), sum(a) from t;",
+				Expected: []sql.Row{{5, float64(129)}},
 			},
 			{
-				Query:    "DELETE FROM test WHERE id = 6",
-				Expected: []sql.Row{{types.NewOkResult(1)}},
+				Query:    "select a from t order by a;",
+				Expected: []sql.Row{{1}, {3}, {5}, {20}, {100}},
 			},
+		},
+	},
+	{
+		Name: "columnar index errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, j json);",
+			"create table keyless (a int, b int);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL DOLT_ADD('.');",
-				Expected: []sql.Row{{0}},
+				Query:          "create columnar index col_pk on t (pk);",
+				ExpectedErrStr: "columnar index 'col_pk' cannot project primary key column 'pk'",
 			},
 			{
-				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'amended commit with removed changes');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:          "create columnar index col_j on t (j);",
+				ExpectedErrStr: "columnar index 'col_j' cannot project out-of-band column 'j'",
 			},
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{2}, {3}, {4}, {5}, {7}},
+				Query:          "create columnar index col_a on keyless (a);",
+				ExpectedErrStr: "columnar indexes are not supported on tables without a primary key",
 			},
 			{
-				Query: "SELECT message FROM dolt_log;",
-				Expected: []sql.Row{
-					{"amended commit with removed changes"},
-					{"amended commit with added changes"},
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
-				},
+				Query:          "create columnar index col_a on missing (a);",
+				ExpectedErrStr: "table not found: missing",
 			},
 			{
-				Query: "SELECT to_id, from_id, diff_type FROM dolt_diff_test;",
-				Expected: []sql.Row{
-					{7, nil, "added"},
-					{5, nil, "added"},
-					{4, nil, "added"},
-					{3, nil, "added"},
-					{2, nil, "added"},
-				},
+				Query:          "create columnar index col_a on t (missing);",
+				ExpectedErrStr: "column `missing` does not exist for the table",
+			},
+			{
+				Query:          "create columnar index `primary` on t (a);",
+				ExpectedErrStr: "invalid index name 'primary'",
 			},
 		},
 	},
 	{
-		Name: "CALL DOLT_COMMIT('-amend') works to update a merge commit",
+		Name: "columnar index is hidden from the engine",
 		SetUpScript: []string{
-			"SET @@AUTOCOMMIT=0;",
-
-			"CREATE TABLE test2 (id INT PRIMARY KEY, id2 INT);",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'original table');",
-
-			"CALL DOLT_CHECKOUT('-b','test-branch');",
-			"INSERT INTO test2 (id, id2) VALUES (0, 2)",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'conflicting commit message');",
-
-			"CALL DOLT_CHECKOUT('main');",
-			"INSERT INTO test2 (id, id2) VALUES (0, 1)",
-			"CALL DOLT_ADD('.');",
-			"CALL DOLT_COMMIT('-m', 'original commit message');",
-
-			"CALL DOLT_MERGE('test-branch');",
-			"CALL DOLT_CONFLICTS_RESOLVE('--theirs', '.');",
-			"CALL DOLT_COMMIT('-m', 'final merge');",
+			"create table t (pk int primary key, a int, b int);",
+			"insert into t values (1, 10, 100), (2, 20, 200), (3, 10, 300);",
+			"create columnar index col_ab on t (a, b);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL DOLT_COMMIT('--amend', '-m', 'new merge');",
-				Expected: []sql.Row{{doltCommit}},
+				Query:    "show indexes from t;",
+				Expected: []sql.Row{{"t", 0, "PRIMARY", 1, "pk", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil}},
 			},
 			{
-				Query: "SELECT message FROM dolt_log;",
-				Expected: []sql.Row{
-					{"new merge"},
-					{"original commit message"},
-					{"conflicting commit message"},
-					{"original table"},
-					{"amended commit with removed changes"},
-					{"amended commit with added changes"},
-					{"amended commit message"},
-					{"author: somebody"},
-					{"add table 2"},
-					{"drop table t"},
-					{"update table t"},
-					{"add table t"},
-					{"checkpoint enginetest database mydb"},
-					{"Initialize data repository"},
-				},
+				Query:    "select pk, b from t where a = 10 order by pk;",
+				Expected: []sql.Row{{1, 100}, {3, 300}},
 			},
 			{
-				Query:    "SET @hash=(SELECT commit_hash FROM dolt_log LIMIT 1);",
-				Expected: []sql.Row{{}},
+				Query:    "select t1.pk, t2.pk from t t1 join t t2 on t1.a = t2.a where t1.pk < t2.pk;",
+				Expected: []sql.Row{{1, 3}},
 			},
 			{
-				Query:    "SELECT COUNT(parent_hash) FROM dolt_commit_ancestors WHERE commit_hash= @hash;",
-				Expected: []sql.Row{{2}},
+				Query:          "create index col_ab on t (b);",
+				ExpectedErrStr: "`col_ab` already exists as an index for this table",
+			},
+			{
+				Query:    "drop index col_ab on t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create index col_ab on t (a);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create columnar index col_b on mydb.t (b) comment 'b only';",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select sum(b) from t;",
+				Expected: []sql.Row{{float64(600)}},
+			},
+			{
+				Query:    "alter table t drop index col_b;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query: "show indexes from t;",
+				Expected: []sql.Row{
+					{"t", 0, "PRIMARY", 1, "pk", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"t", 1, "col_ab", 1, "a", nil, 0, nil, nil, "YES", "BTREE", "", "", "YES", nil},
+				},
 			},
 		},
 	},
 }
 
-var DoltIndexPrefixScripts = []queries.ScriptTest{
+var DoltIndexIncludeScripts = []queries.ScriptTest{
 	{
-		Name: "inline secondary indexes with collation",
+		Name: "included index columns are kept in sync with table edits",
 		SetUpScript: []string{
-			"create table t (i int primary key, v1 varchar(10), v2 varchar(10), unique index (v1(3),v2(5))) collate utf8mb4_0900_ai_ci",
+			"create table t (pk int primary key, a int, b varchar(20), c int);",
+			"insert into t values (1, 1, 'one', 10), (2, 2, 'two', 20), (3, 2, 'three', 30), (4, 4, 'four', 40);",
+			"create index idx_a on t (a) include (b, c);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` int NOT NULL,\n  `v1` varchar(10),\n  `v2` varchar(10),\n  PRIMARY KEY (`i`),\n  UNIQUE KEY `v1` (`v1`(3),`v2`(5))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci"}},
-			},
-			{
-				Query:    "insert into t values (0, 'a', 'a'), (1, 'ab','ab'), (2, 'abc', 'abc'), (3, 'abcde', 'abcde')",
-				Expected: []sql.Row{{types.NewOkResult(4)}},
-			},
-			{
-				Query:       "insert into t values (99, 'ABC', 'ABCDE')",
-				ExpectedErr: sql.ErrUniqueKeyViolation,
+				Query:    "select pk, b, c from t where a = 2 order by pk;",
+				Expected: []sql.Row{{2, "two", 20}, {3, "three", 30}},
 			},
 			{
-				Query:          "insert into t values (99, 'ABC', 'ABCDE')",
-				ExpectedErrStr: "duplicate unique key given: [ABC,ABCDE]",
+				Query:    "update t set b = 'deux' where pk = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:       "insert into t values (99, 'ABC123', 'ABCDE123')",
-				ExpectedErr: sql.ErrUniqueKeyViolation,
+				Query:    "update t set a = 4 where pk = 3;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:          "insert into t values (99, 'ABC123', 'ABCDE123')",
-				ExpectedErrStr: "duplicate unique key given: [ABC,ABCDE]",
+				Query:    "delete from t where pk = 1;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select * from t where v1 = 'A'",
-				Expected: []sql.Row{
-					{0, "a", "a"},
-				},
+				Query:    "insert into t values (5, 2, 'five', 50);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "select * from t where v1 = 'ABC'",
-				Expected: []sql.Row{
-					{2, "abc", "abc"},
-				},
+				Query:    "select a, b, c from t where a > 0 order by a, b;",
+				Expected: []sql.Row{{2, "deux", 20}, {2, "five", 50}, {4, "four", 40}, {4, "three", 30}},
 			},
 			{
-				Query:    "select * from t where v1 = 'ABCD'",
-				Expected: []sql.Row{},
+				Query:    "alter table t rename column b to bb;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "select * from t where v1 > 'A' and v1 < 'ABCDE'",
-				Expected: []sql.Row{
-					{1, "ab", "ab"},
-					{2, "abc", "abc"},
-				},
+				Query:    "alter table t modify column c bigint;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "select * from t where v1 > 'A' and v2 < 'ABCDE'",
-				Expected: []sql.Row{
-					{1, "ab", "ab"},
-					{2, "abc", "abc"},
-				},
+				Query:    "select bb, c from t where a = 4 order by bb;",
+				Expected: []sql.Row{{"four", 40}, {"three", 30}},
 			},
 			{
-				Query: "update t set v1 = concat(v1, 'Z') where v1 >= 'A'",
-				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 4, InsertID: 0, Info: plan.UpdateInfo{Matched: 4, Updated: 4}}},
-				},
+				Query:    "alter table t drop column c;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query: "select * from t",
-				Expected: []sql.Row{
-					{0, "aZ", "a"},
-					{1, "abZ", "ab"},
-					{2, "abcZ", "abc"},
-					{3, "abcdeZ", "abcde"},
-				},
+				Query:    "select index_name from information_schema.statistics where table_name = 't' and column_name = 'a';",
+				Expected: []sql.Row{{"idx_a"}},
 			},
 			{
-				Query: "delete from t where v1 >= 'A'",
-				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 4}},
-				},
+				Query:    "select bb from t where a = 2 order by bb;",
+				Expected: []sql.Row{{"deux"}, {"five"}},
 			},
 			{
-				Query:    "select * from t",
-				Expected: []sql.Row{},
+				Query:    "drop index idx_a on t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
-		},
-	},
-	// TODO: these should eventually go in GMS, but it doesn't currently support index rewrite on column modify
-	{
-		Name: "drop prefix lengths when modifying column to non string type",
-		SetUpScript: []string{
-			"create table t (j varchar(100), index (j(10)))",
-		},
-		Assertions: []queries.ScriptTestAssertion{
-			{
-				Query:    "alter table t modify column j int",
-				Expected: []sql.Row{{types.OkResult{}}},
+			{
+				Query:    "create index idx_a on t (a);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` int,\n  KEY `j` (`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "select bb from t where a = 4 order by bb;",
+				Expected: []sql.Row{{"four"}, {"three"}},
 			},
 		},
 	},
 	{
-		Name: "drop prefix length when modifying columns to invalid string type",
+		Name: "included index columns on unique and keyless indexes",
 		SetUpScript: []string{
-			"create table t (j varchar(100), index (j(10)))",
+			"create table u (pk int primary key, a int, b int);",
+			"insert into u values (1, 1, 10), (2, 2, 20);",
+			"create unique index uniq_a on u (a) include (b);",
+			"create table keyless (a int, b int);",
+			"insert into keyless values (1, 10), (1, 10), (2, 20);",
+			"create index idx_a on keyless (a) include (b);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "alter table t modify column j varchar(2)",
-				Expected: []sql.Row{{types.OkResult{}}},
+				Query:       "insert into u values (3, 2, 30);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
 			{
-				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` varchar(2),\n  KEY `j` (`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "update u set b = 21 where a = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
-		},
-	},
-	{
-		Name: "preserve prefix length when modifying column to valid string type",
-		SetUpScript: []string{
-			"create table t (j varchar(100), index (j(10)))",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "alter table t modify column j varchar(200)",
-				Expected: []sql.Row{{types.OkResult{}}},
+				Query:    "select a, b from u where a = 2;",
+				Expected: []sql.Row{{2, 21}},
 			},
 			{
-				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `j` varchar(200),\n  KEY `j` (`j`(10))\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "insert into keyless values (2, 21);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
-		},
-	},
-	{
-		Name: "preserve prefix lengths when there are other unchanged prefix lengths",
-		SetUpScript: []string{
-			"create table t (i varchar(100), j varchar(100), index (i(10), j(10)))",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "alter table t modify column j int",
-				Expected: []sql.Row{{types.OkResult{}}},
+				Query:    "delete from keyless where a = 1 limit 1;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "show create table t",
-				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `i` varchar(100),\n  `j` int,\n  KEY `i` (`i`(10),`j`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+				Query:    "select a, b from keyless where a > 0 order by a, b;",
+				Expected: []sql.Row{{1, 10}, {2, 20}, {2, 21}},
 			},
 		},
 	},
 	{
-		Name: "prefix length too long",
+		Name: "included index columns are merged",
 		SetUpScript: []string{
-			"create table t (i blob, index(i(3072)))",
+			"create table t (pk int primary key, a int, b int);",
+			"insert into t values (1, 1, 10), (2, 2, 20), (3, 3, 30);",
+			"create index idx_a on t (a) include (b);",
+			"call dolt_commit('-Am', 'include b');",
+			"call dolt_checkout('-b', 'other');",
+			"update t set b = 200 where pk = 2;",
+			"insert into t values (4, 4, 40);",
+			"call dolt_commit('-am', 'other');",
+			"call dolt_checkout('main');",
+			"update t set b = 300 where pk = 3;",
+			"call dolt_commit('-am', 'main');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:       "alter table t modify column i text",
-				ExpectedErr: sql.ErrKeyTooLong,
+				Query:    "call dolt_merge('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select a, b from t where a > 0 order by a;",
+				Expected: []sql.Row{{1, 10}, {2, 200}, {3, 300}, {4, 40}},
 			},
 		},
 	},
 	{
-		Name: "text and blob key errors",
+		Name: "included index column errors",
 		SetUpScript: []string{
-			"create table t (t text, b blob, unique(t(4)), unique(b));",
-			"insert into t values ('hello', 'goodbye');",
+			"create table t (pk int primary key, a int, b int, c int as (a + 1) virtual, s varchar(20));",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "insert into t values('hello123', 'something different');",
-				ExpectedErrStr: "duplicate unique key given: [hell]",
+				Query:          "create index idx_a on t (a) include (pk);",
+				ExpectedErrStr: "primary key column `pk` is already stored in every index",
 			},
 			{
-				Query:          "insert into t values('something different', 'goodbye');",
-				ExpectedErrStr: "duplicate unique key given: [goodbye]",
+				Query:          "create index idx_a on t (a) include (A);",
+				ExpectedErrStr: "column `a` is already indexed by 'idx_a'",
 			},
-		},
-	},
-}
-
-// DoltCallAsOf are tests of using CALL ... AS OF using commits
-var DoltCallAsOf = []queries.ScriptTest{
-	{
-		Name: "Database syntax properly handles inter-CALL communication",
-		SetUpScript: []string{
-			`CREATE PROCEDURE p1()
-BEGIN
-	DECLARE str VARCHAR(20);
-    CALL p2(str);
-	SET str = CONCAT('a', str);
-    SELECT str;
-END`,
-			`CREATE PROCEDURE p2(OUT param VARCHAR(20))
-BEGIN
-	SET param = 'b';
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'First procedures');",
-			"CALL DOLT_BRANCH('p12');",
-			"DROP PROCEDURE p1;",
-			"DROP PROCEDURE p2;",
-			`CREATE PROCEDURE p1()
-BEGIN
-	DECLARE str VARCHAR(20);
-    CALL p2(str);
-	SET str = CONCAT('c', str);
-    SELECT str;
-END`,
-			`CREATE PROCEDURE p2(OUT param VARCHAR(20))
-BEGIN
-	SET param = 'd';
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'Second procedures');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL p1();",
-				Expected: []sql.Row{{"cd"}},
+				Query:          "create index idx_a on t (a) include (c);",
+				ExpectedErrStr: "virtual column `c` cannot be included in an index",
 			},
 			{
-				Query:    "CALL `mydb/main`.p1();",
-				Expected: []sql.Row{{"cd"}},
+				Query:          "create index idx_a on t (a) include (b, `b`);",
+				ExpectedErrStr: "column `b` is included more than once",
 			},
 			{
-				Query:    "CALL `mydb/p12`.p1();",
-				Expected: []sql.Row{{"ab"}},
+				Query:          "create index idx_a on t (a) include (missing);",
+				ExpectedErrStr: "column `missing` does not exist for the table",
 			},
-		},
-	},
-	{
-		Name: "CALL ... AS OF references historic data through nested calls",
-		SetUpScript: []string{
-			"CREATE TABLE test (v1 BIGINT);",
-			"INSERT INTO test VALUES (1);",
-			`CREATE PROCEDURE p1()
-BEGIN
-	CALL p2();
-END`,
-			`CREATE PROCEDURE p2()
-BEGIN
-	SELECT * FROM test;
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"UPDATE test SET v1 = 2;",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"UPDATE test SET v1 = 3;",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"UPDATE test SET v1 = 4;",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL p1();",
-				Expected: []sql.Row{{4}},
+				Query:          "create index idx_s on t (s(5)) include (b);",
+				ExpectedErrStr: "index 'idx_s' cannot include columns",
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD';",
-				Expected: []sql.Row{{4}},
+				Query:          "create columnar index col_a on t (a) include (b);",
+				ExpectedErrStr: "columnar indexes cannot include columns",
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD~1';",
-				Expected: []sql.Row{{3}},
+				Query:          "create index idx_a on t (a) include ();",
+				ExpectedErrStr: "INCLUDE must be followed by a parenthesized list of columns",
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD~2';",
-				Expected: []sql.Row{{2}},
+				Query:          "create index idx_a on t (a) include (b) using btree;",
+				ExpectedErrStr: "unexpected 'using' at the end of CREATE INDEX",
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD~3';",
-				Expected: []sql.Row{{1}},
+				Query:    "create index idx_a on t (a) comment 'covering' include (b);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select index_name, comment, index_comment from information_schema.statistics where table_name = 't' and index_name = 'idx_a';",
+				Expected: []sql.Row{{"idx_a", "", "covering"}},
 			},
 		},
 	},
+}
+
+var DoltPartialIndexScripts = []queries.ScriptTest{
 	{
-		Name: "CALL ... AS OF doesn't overwrite nested CALL ... AS OF",
+		Name: "partial indexes only hold matching rows",
 		SetUpScript: []string{
-			"CREATE TABLE myhistorytable (pk BIGINT PRIMARY KEY, s TEXT);",
-			"INSERT INTO myhistorytable VALUES (1, 'first row, 1'), (2, 'second row, 1'), (3, 'third row, 1');",
-			"CREATE PROCEDURE p1() BEGIN CALL p2(); END",
-			"CREATE PROCEDURE p1a() BEGIN CALL p2() AS OF 'HEAD~2'; END",
-			"CREATE PROCEDURE p1b() BEGIN CALL p2a(); END",
-			"CREATE PROCEDURE p2() BEGIN SELECT * FROM myhistorytable; END",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"DELETE FROM myhistorytable;",
-			"INSERT INTO myhistorytable VALUES (1, 'first row, 2'), (2, 'second row, 2'), (3, 'third row, 2');",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"DROP TABLE myhistorytable;",
-			"CREATE TABLE myhistorytable (pk BIGINT PRIMARY KEY, s TEXT, c TEXT);",
-			"INSERT INTO myhistorytable VALUES (1, 'first row, 3', '1'), (2, 'second row, 3', '2'), (3, 'third row, 3', '3');",
-			"CREATE PROCEDURE p2a() BEGIN SELECT * FROM myhistorytable AS OF 'HEAD~1'; END",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"create table t (pk int primary key, a int, status varchar(10));",
+			"insert into t values (1, 1, 'open'), (2, 2, 'closed'), (3, 2, 'open'), (4, 4, 'open');",
+			"create index idx_a on t (a) where status = 'open';",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "CALL p1();",
-				Expected: []sql.Row{
-					{int64(1), "first row, 3", "1"},
-					{int64(2), "second row, 3", "2"},
-					{int64(3), "third row, 3", "3"},
-				},
+				Query:    "select pk from t where a = 2 and status = 'open';",
+				Expected: []sql.Row{{3}},
 			},
 			{
-				Query: "CALL p1a();",
-				Expected: []sql.Row{
-					{int64(1), "first row, 1"},
-					{int64(2), "second row, 1"},
-					{int64(3), "third row, 1"},
-				},
+				Query:    "select pk from t where a = 2 order by pk;",
+				Expected: []sql.Row{{2}, {3}},
 			},
 			{
-				Query: "CALL p1b();",
-				Expected: []sql.Row{
-					{int64(1), "first row, 2"},
-					{int64(2), "second row, 2"},
-					{int64(3), "third row, 2"},
-				},
+				Query:    "select pk from t where status = 'open' order by pk;",
+				Expected: []sql.Row{{1}, {3}, {4}},
 			},
 			{
-				Query: "CALL p2();",
-				Expected: []sql.Row{
-					{int64(1), "first row, 3", "1"},
-					{int64(2), "second row, 3", "2"},
-					{int64(3), "third row, 3", "3"},
-				},
+				Query:    "update t set status = 'open' where pk = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query: "CALL p2a();",
-				Expected: []sql.Row{
-					{int64(1), "first row, 2"},
-					{int64(2), "second row, 2"},
-					{int64(3), "third row, 2"},
-				},
+				Query:    "update t set status = 'closed' where pk = 3;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query: "CALL p1() AS OF 'HEAD~2';",
-				Expected: []sql.Row{
-					{int64(1), "first row, 1"},
-					{int64(2), "second row, 1"},
-					{int64(3), "third row, 1"},
-				},
+				Query:    "update t set a = 5 where pk = 4;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query: "CALL p1a() AS OF 'HEAD';",
-				Expected: []sql.Row{
-					{int64(1), "first row, 1"},
-					{int64(2), "second row, 1"},
-					{int64(3), "third row, 1"},
-				},
+				Query:    "insert into t values (5, 2, 'open'), (6, 2, 'closed');",
+				Expected: []sql.Row{{types.NewOkResult(2)}},
 			},
 			{
-				Query: "CALL p1b() AS OF 'HEAD';",
-				Expected: []sql.Row{
-					{int64(1), "first row, 2"},
-					{int64(2), "second row, 2"},
-					{int64(3), "third row, 2"},
-				},
+				Query:    "delete from t where pk = 1;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query: "CALL p2() AS OF 'HEAD~2';",
-				Expected: []sql.Row{
-					{int64(1), "first row, 1"},
-					{int64(2), "second row, 1"},
-					{int64(3), "third row, 1"},
-				},
+				Query:    "select pk from t where a = 2 and status = 'open' order by pk;",
+				Expected: []sql.Row{{2}, {5}},
 			},
 			{
-				Query: "CALL p2a() AS OF 'HEAD';",
-				Expected: []sql.Row{
-					{int64(1), "first row, 2"},
-					{int64(2), "second row, 2"},
-					{int64(3), "third row, 2"},
-				},
+				Query:    "select pk from t where status = 'open' and a > 2 order by pk;",
+				Expected: []sql.Row{{4}},
 			},
-		},
-	},
-	{
-		Name: "CALL ... AS OF errors if attempting to modify a table",
-		SetUpScript: []string{
-			"CREATE TABLE test (v1 BIGINT);",
-			"INSERT INTO test VALUES (2);",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			`CREATE PROCEDURE p1()
-BEGIN
-	UPDATE test SET v1 = v1 * 2;
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{2}},
+				Query:    "alter table t rename column status to state;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "CALL p1();",
-				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+				Query:    "select pk from t where a = 2 and state = 'open' order by pk;",
+				Expected: []sql.Row{{2}, {5}},
 			},
 			{
-				Query:    "SELECT * FROM test;",
-				Expected: []sql.Row{{4}},
+				Query:    "drop index idx_a on t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create index idx_a on t (a);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:       "CALL p1() AS OF 'HEAD~1';",
-				ExpectedErr: sql.ErrProcedureCallAsOfReadOnly,
+				Query:    "select pk from t where a = 2 order by pk;",
+				Expected: []sql.Row{{2}, {3}, {5}, {6}},
 			},
 		},
 	},
 	{
-		Name: "Database syntax propagates to inner calls",
+		Name: "unique partial indexes",
 		SetUpScript: []string{
-			"CALL DOLT_CHECKOUT('main');",
-			`CREATE PROCEDURE p4()
-BEGIN
-	CALL p5();
-END`,
-			`CREATE PROCEDURE p5()
-BEGIN
-	SELECT 3;
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"CALL DOLT_BRANCH('p45');",
-			"DROP PROCEDURE p4;",
-			"DROP PROCEDURE p5;",
-			`CREATE PROCEDURE p4()
-BEGIN
-	CALL p5();
-END`,
-			`CREATE PROCEDURE p5()
-BEGIN
-	SELECT 4;
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"create table t (pk int primary key, email varchar(20), deleted bool);",
+			"create unique index uniq_email on t (email) where deleted = false;",
+			"insert into t values (1, 'a@x', false), (2, 'a@x', true), (3, 'b@x', false);",
+			"create table keyless (email varchar(20), deleted bool);",
+			"create unique index uniq_email on keyless (email) where deleted = false;",
+			"insert into keyless values ('a@x', false), ('a@x', true), ('a@x', true);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL p4();",
-				Expected: []sql.Row{{4}},
+				Query:       "insert into t values (4, 'a@x', false);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
 			{
-				Query:    "CALL p5();",
-				Expected: []sql.Row{{4}},
+				Query:    "insert into t values (4, 'a@x', true);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
 			},
 			{
-				Query:    "CALL `mydb/main`.p4();",
-				Expected: []sql.Row{{4}},
+				Query:       "update t set deleted = false where pk = 2;",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
 			},
 			{
-				Query:    "CALL `mydb/main`.p5();",
-				Expected: []sql.Row{{4}},
+				Query:    "update t set deleted = true where pk = 1;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:    "CALL `mydb/p45`.p4();",
-				Expected: []sql.Row{{3}},
+				Query:    "update t set deleted = false where pk = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
 			},
 			{
-				Query:    "CALL `mydb/p45`.p5();",
-				Expected: []sql.Row{{3}},
+				Query:    "select pk from t where email = 'a@x' and deleted = false;",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "insert into keyless values ('a@x', false);",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "select count(*) from keyless where email = 'a@x' and deleted = false;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:          "create unique index uniq_all on t (email);",
+				ExpectedErrStr: "duplicate unique key given: [a@x,2]",
 			},
 		},
 	},
 	{
-		Name: "Database syntax with AS OF",
+		Name: "partial indexes are merged",
 		SetUpScript: []string{
-			"CREATE TABLE test (v1 BIGINT);",
-			"INSERT INTO test VALUES (2);",
-			`CREATE PROCEDURE p1()
-BEGIN
-	SELECT v1 * 10 FROM test;
-END`,
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
-			"CALL DOLT_BRANCH('other');",
-			"DROP PROCEDURE p1;",
-			`CREATE PROCEDURE p1()
-BEGIN
-	SELECT v1 * 100 FROM test;
-END`,
-			"UPDATE test SET v1 = 3;",
-			"CALL DOLT_ADD('-A');",
-			"CALL DOLT_COMMIT('-m', 'commit message');",
+			"create table t (pk int primary key, a int, status varchar(10));",
+			"insert into t values (1, 1, 'open'), (2, 2, 'open'), (3, 3, 'closed');",
+			"create index idx_a on t (a) where status = 'open';",
+			"call dolt_commit('-Am', 'partial idx_a');",
+			"call dolt_checkout('-b', 'other');",
+			"update t set status = 'closed' where pk = 2;",
+			"insert into t values (4, 4, 'open');",
+			"call dolt_commit('-am', 'other');",
+			"call dolt_checkout('main');",
+			"update t set status = 'open' where pk = 3;",
+			"call dolt_commit('-am', 'main');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL p1();",
-				Expected: []sql.Row{{300}},
+				Query:    "call dolt_merge('other');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
 			},
 			{
-				Query:    "CALL `mydb/main`.p1();",
-				Expected: []sql.Row{{300}},
+				Query:    "select pk from t where a > 0 and status = 'open' order by pk;",
+				Expected: []sql.Row{{1}, {3}, {4}},
 			},
+		},
+	},
+	{
+		Name: "dropping a column used by a partial index predicate drops the index",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, status varchar(10), key idx_status (status));",
+			"insert into t values (1, 1, 'open'), (2, 2, 'closed');",
+			"create index idx_a on t (a) where status = 'open';",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL `mydb/other`.p1();",
-				Expected: []sql.Row{{30}},
+				Query:    "alter table t drop column status;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD';",
-				Expected: []sql.Row{{300}},
+				Query:    "select count(*) from information_schema.statistics where table_name = 't' and index_name <> 'PRIMARY';",
+				Expected: []sql.Row{{0}},
 			},
 			{
-				Query:    "CALL `mydb/main`.p1() AS OF 'HEAD';",
-				Expected: []sql.Row{{300}},
+				Query:    "select pk from t where a = 2;",
+				Expected: []sql.Row{{2}},
 			},
+		},
+	},
+	{
+		Name: "partial indexes in joins, and dropping partial indexes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, status varchar(10));",
+			"create table u (pk int primary key, a int);",
+			"insert into t values (1, 1, 'open'), (2, 2, 'closed'), (3, 2, 'open');",
+			"insert into u values (1, 2), (2, 3);",
+			"create index idx_a on t (a) where status = 'open';",
+		},
+		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "CALL `mydb/other`.p1() AS OF 'HEAD';",
-				Expected: []sql.Row{{30}},
+				Query:    "select t.pk, u.pk from t join u on t.a = u.a where t.status = 'open' and t.a = 2;",
+				Expected: []sql.Row{{3, 1}},
 			},
 			{
-				Query:    "CALL p1() AS OF 'HEAD~1';",
-				Expected: []sql.Row{{200}},
+				Query:    "select t.pk from t where t.status = 'open' and t.a in (select a from u) order by t.pk;",
+				Expected: []sql.Row{{3}},
 			},
 			{
-				Query:    "CALL `mydb/main`.p1() AS OF 'HEAD~1';",
-				Expected: []sql.Row{{200}},
+				Query:    "drop index idx_a on t;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
 			},
 			{
-				Query:    "CALL `mydb/other`.p1() AS OF 'HEAD~1';",
-				Expected: []sql.Row{{20}},
+				Query:    "select pk from t where a = 2 and status = 'open';",
+				Expected: []sql.Row{{3}},
 			},
 		},
 	},
-}
-
-var DoltSystemVariables = []queries.ScriptTest{
 	{
-		Name: "DOLT_SHOW_SYSTEM_TABLES",
+		Name: "partial indexes are only used by queries whose filters imply their predicate",
 		SetUpScript: []string{
-			"CREATE TABLE test (pk int PRIMARY KEY);",
-			"SET @@DOLT_SHOW_SYSTEM_TABLES=1",
+			"create table t (pk int primary key, a int, status varchar(10));",
+			"create table u (pk int primary key, a int);",
+			"create table d (id int primary key, v int, deleted_at datetime);",
+			"create table r (pk int primary key, x int);",
+			"insert into t values (1, 1, 'open'), (2, 2, 'closed'), (3, 2, 'open'), (4, null, 'closed');",
+			"insert into u values (1, 2), (2, 3);",
+			"insert into d values (1, 1, null), (6, 2, null), (7, 3, '2024-01-01'), (8, 4, null);",
+			"insert into r values (1, 1), (2, 7), (3, 12), (4, 20);",
+			"create index idx_a on t (a) where status = 'open';",
+			"create index idx_v on d (v) where deleted_at is null;",
+			"create index idx_x on r (x) where x > 5;",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "SHOW TABLES;",
+				Query: "explain plan select pk from t where a = 2 and status = 'open';",
 				Expected: []sql.Row{
-					{"dolt_branches"},
-					{"dolt_commit_ancestors"},
-					{"dolt_commit_diff_test"},
-					{"dolt_commits"},
-					{"dolt_conflicts"},
-					{"dolt_conflicts_test"},
-					{"dolt_constraint_violations"},
-					{"dolt_constraint_violations_test"},
-					{"dolt_diff_test"},
-					{"dolt_history_test"},
-					{"dolt_log"},
-					{"dolt_remote_branches"},
-					{"dolt_remotes"},
-					{"dolt_status"},
-					{"dolt_workspace_test"},
-					{"test"},
+					{"Project"},
+					{" ├─ columns: [t.pk]"},
+					{" └─ Filter"},
+					{"     ├─ ((t.a = 2) AND (t.status = 'open'))"},
+					{"     └─ IndexedTableAccess(t)"},
+					{"         ├─ index: [t.a]"},
+					{"         ├─ filters: [{[2, 2]}]"},
+					{"         └─ columns: [pk a status]"},
 				},
 			},
-		},
-	},
-}
-
-// DoltTempTableScripts tests temporary tables.
-// Temporary tables are not supported in GMS, eventually should move those tests there.
-var DoltTempTableScripts = []queries.ScriptTest{
-	{
-		Name: "temporary table supports auto increment",
-		SetUpScript: []string{
-			"create temporary table t (i int primary key auto_increment)",
-		},
-		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "show create table t;",
-				Expected: []sql.Row{
-					{"t", "CREATE TABLE `t` (\n" +
-						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
-						"  PRIMARY KEY (`i`)\n" +
-						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "select pk from t where a = 2 and status = 'open';",
+				Expected: []sql.Row{{3}},
 			},
 			{
-				Query: "insert into t values (), (), ()",
+				Query: "explain plan select pk from t where a = 2;",
 				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 3, InsertID: 1}},
+					{"Project"},
+					{" ├─ columns: [t.pk]"},
+					{" └─ Filter"},
+					{"     ├─ (t.a = 2)"},
+					{"     └─ Table"},
+					{"         ├─ name: t"},
+					{"         └─ columns: [pk a]"},
 				},
 			},
 			{
-				Query: "show create table t;",
-				Expected: []sql.Row{
-					{"t", "CREATE TABLE `t` (\n" +
-						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
-						"  PRIMARY KEY (`i`)\n" +
-						") ENGINE=InnoDB AUTO_INCREMENT=4 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
-				},
+				Query:    "select pk from t where a = 2 order by pk;",
+				Expected: []sql.Row{{2}, {3}},
 			},
 			{
-				Query: "select * from t",
-				Expected: []sql.Row{
-					{1},
-					{2},
-					{3},
-				},
+				Query:    "select pk from t where a is null;",
+				Expected: []sql.Row{{4}},
 			},
 			{
-				Query: "insert into t values (100), (1000)",
-				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 2, InsertID: 1}},
-				},
+				Query:    "select pk, a from t order by a, pk;",
+				Expected: []sql.Row{{4, nil}, {1, 1}, {2, 2}, {3, 2}},
 			},
 			{
-				Query: "show create table t;",
+				Query:    "select t.pk, u.pk from t join u on t.a = u.a order by t.pk;",
+				Expected: []sql.Row{{2, 1}, {3, 1}},
+			},
+			{
+				Query:    "select /*+ LOOKUP_JOIN(u, t) */ t.pk, u.pk from u join t on t.a = u.a order by t.pk;",
+				Expected: []sql.Row{{2, 1}, {3, 1}},
+			},
+			{
+				Query: "explain plan select id from d where deleted_at is null and id > 5;",
 				Expected: []sql.Row{
-					{"t", "CREATE TABLE `t` (\n" +
-						"  `i` int NOT NULL AUTO_INCREMENT,\n" +
-						"  PRIMARY KEY (`i`)\n" +
-						") ENGINE=InnoDB AUTO_INCREMENT=1001 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"},
+					{"Project"},
+					{" ├─ columns: [d.id]"},
+					{" └─ Filter"},
+					{"     ├─ (d.deleted_at IS NULL AND (d.id > 5))"},
+					{"     └─ IndexedTableAccess(d)"},
+					{"         ├─ index: [d.v]"},
+					{"         ├─ filters: [{[NULL, ∞)}]"},
+					{"         └─ columns: [id deleted_at]"},
 				},
 			},
 			{
-				Query: "insert into t values (), (), ()",
+				Query:    "select id from d where deleted_at is null and id > 5 order by id;",
+				Expected: []sql.Row{{6}, {8}},
+			},
+			{
+				Query: "explain plan select pk from r where x > 10;",
 				Expected: []sql.Row{
-					{types.OkResult{RowsAffected: 3, InsertID: 0x3e9}},
+					{"Project"},
+					{" ├─ columns: [r.pk]"},
+					{" └─ Filter"},
+					{"     ├─ (r.x > 10)"},
+					{"     └─ IndexedTableAccess(r)"},
+					{"         ├─ index: [r.x]"},
+					{"         ├─ filters: [{[NULL, ∞)}]"},
+					{"         └─ columns: [pk x]"},
 				},
 			},
 			{
-				Query: "select * from t",
+				Query:    "select pk from r where x > 10 order by pk;",
+				Expected: []sql.Row{{3}, {4}},
+			},
+			{
+				Query: "explain plan select pk from r where x > 3;",
 				Expected: []sql.Row{
-					{1},
-					{2},
-					{3},
-					{100},
-					{1000},
-					{1001},
-					{1002},
-					{1003},
+					{"Project"},
+					{" ├─ columns: [r.pk]"},
+					{" └─ Filter"},
+					{"     ├─ (r.x > 3)"},
+					{"     └─ Table"},
+					{"         ├─ name: r"},
+					{"         └─ columns: [pk x]"},
 				},
 			},
+			{
+				Query:    "select pk from r where x > 3 order by pk;",
+				Expected: []sql.Row{{2}, {3}, {4}},
+			},
+			{
+				Query:    "analyze table t;",
+				Expected: []sql.Row{{"t", "analyze", "status", "OK"}},
+			},
+			{
+				Query:    "select index_name from dolt_statistics where table_name = 't' order by index_name;",
+				Expected: []sql.Row{{"primary"}},
+			},
 		},
 	},
 	{
-		Name: "temporary table tag collision",
+		Name: "partial unique indexes are not used as unique keys",
 		SetUpScript: []string{
-			"CREATE TABLE note(a int, b int, userid int);",
+			"create table t (pk int primary key, email varchar(20), deleted bool);",
+			"create unique index uniq_email on t (email) where deleted = false;",
+			"insert into t values (1, 'a@x', false), (2, 'a@x', true), (3, 'a@x', true);",
+			"create table parent (pk int primary key, email varchar(20), deleted bool);",
+			"create unique index uniq_email on parent (email) where deleted = false;",
+			"insert into parent values (1, 'a@x', true);",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query: "CREATE TEMPORARY TABLE tmp_tbl(a int, b int, c int, d int, e int, f int, g int);",
-				Expected: []sql.Row{
-					{types.NewOkResult(0)},
-				},
+				Query:    "select pk from t where email = 'a@x' order by pk;",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "select distinct email from t where email = 'a@x';",
+				Expected: []sql.Row{{"a@x"}},
+			},
+			{
+				Query:    "select t1.pk, t2.pk from t t1 join t t2 on t1.email = t2.email where t1.pk = 1 order by t2.pk;",
+				Expected: []sql.Row{{1, 1}, {1, 2}, {1, 3}},
+			},
+			{
+				Query:          "create table child (pk int primary key, email varchar(20), foreign key (email) references parent (email));",
+				ExpectedErrStr: "missing index for foreign key `` on the referenced table `parent`",
+			},
+			{
+				Query:    "create index idx_email on parent (email);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "create table child (pk int primary key, email varchar(20), foreign key (email) references parent (email));",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "insert into child values (1, 'a@x');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+		},
+	},
+	{
+		Name: "partial index errors",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int);",
+			"create table u (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "create index idx_a on t (a) where rand() > 0.5;",
+				ExpectedErrStr: "partial index predicate cannot use non-deterministic expression rand()",
+			},
+			{
+				Query:          "create index idx_b on t (b) where missing > 0;",
+				ExpectedErrStr: "invalid partial index predicate: column \"missing\" could not be found in any table in scope",
+			},
+			{
+				Query:          "create index idx_b on t (b) where;",
+				ExpectedErrStr: "WHERE must be followed by the predicate of the partial index",
+			},
+			{
+				Query:          "create columnar index idx_b on t (b) where b > 0;",
+				ExpectedErrStr: "columnar indexes cannot be partial indexes",
+			},
+			{
+				Query:          "create index idx_b on missing (b) where b > 0;",
+				ExpectedErrStr: "table not found: missing",
+			},
+			{
+				Query:    "create index idx_b on t (b) include (a) where b > 0;",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+			{
+				Query:    "select pk from t where b > 0 and b = 1;",
+				Expected: []sql.Row{},
 			},
 		},
 	},
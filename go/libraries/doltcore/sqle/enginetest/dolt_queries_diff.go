@@ -3042,6 +3042,84 @@ var DiffSummaryTableFunctionScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_diff_summary with --find-renames",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, name varchar(20), score int);",
+			"INSERT INTO t VALUES (1, 'a', 10), (2, 'b', 20), (3, 'c', 30), (4, 'd', 40);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CREATE TABLE people (pk int primary key, name varchar(20), score int);",
+			"INSERT INTO people SELECT * FROM t;",
+			"DROP TABLE t;",
+			"CALL DOLT_COMMIT('-Am', 'recreate t as people');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_diff_summary('HEAD~', 'HEAD') ORDER BY from_table_name, to_table_name;",
+				Expected: []sql.Row{
+					{"", "people", "added", true, true},
+					{"t", "", "dropped", true, true},
+				},
+			},
+			{
+				Query: "SELECT * FROM dolt_diff_summary('--find-renames', 'HEAD~', 'HEAD');",
+				Expected: []sql.Row{
+					{"t", "people", "renamed", false, true},
+				},
+			},
+			{
+				Query: "SELECT * FROM dolt_diff_summary('--find-renames', 'HEAD~', 'HEAD', 'people');",
+				Expected: []sql.Row{
+					{"t", "people", "renamed", false, true},
+				},
+			},
+			{
+				Query:       "SELECT * FROM dolt_diff_summary('--find-copies', 'HEAD~', 'HEAD');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				// options are only parsed before the first positional argument
+				Query:       "SELECT * FROM dolt_diff_summary('HEAD~', 'HEAD', 'people', '--find-renames');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:       "SELECT * FROM dolt_diff_summary('--find-renames', 'HEAD~', 'HEAD', 'people', '--find-copies');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+		},
+	},
+	{
+		Name: "dolt_diff_summary with revisions containing --",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, c1 int);",
+			"CALL DOLT_COMMIT('-Am', 'create t');",
+			"CALL DOLT_BRANCH('a--b');",
+			"CALL DOLT_CHECKOUT('a--b');",
+			"INSERT INTO t VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-am', 'insert into t');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "SELECT * FROM dolt_diff_summary('main', 'a--b');",
+				Expected: []sql.Row{
+					{"t", "t", "modified", true, false},
+				},
+			},
+			{
+				Query: "SELECT * FROM dolt_diff_summary('--find-renames', 'main', 'a--b');",
+				Expected: []sql.Row{
+					{"t", "t", "modified", true, false},
+				},
+			},
+			{
+				Query: "SELECT to_pk, diff_type FROM dolt_diff('main', 'a--b', 't');",
+				Expected: []sql.Row{
+					{1, "added"},
+				},
+			},
+		},
+	},
 }
 
 var PatchTableFunctionScriptTests = []queries.ScriptTest{
@@ -6493,3 +6571,403 @@ var QueryDiffTableScriptTests = []queries.ScriptTest{
 		},
 	},
 }
+
+var BlameCellsTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_blame_cells: rows and columns changed across commits",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(10));",
+			"insert into t values (1, 1, 'x'), (2, 2, 'y');",
+			"call dolt_commit('-Am', 'c1');",
+			"update t set a = 10 where pk = 1;",
+			"call dolt_commit('-am', 'c2');",
+			"insert into t values (3, 3, 'z');",
+			"update t set b = 'yy' where pk = 2;",
+			"call dolt_commit('-am', 'c3');",
+			"alter table t add column c int;",
+			"call dolt_commit('-am', 'c4');",
+			"update t set c = 5 where pk = 3;",
+			"call dolt_commit('-am', 'c5');",
+			"delete from t where pk = 2;",
+			"call dolt_commit('-am', 'c6');",
+			"insert into t values (2, 2, 'yy', null);",
+			"call dolt_commit('-am', 'c7');",
+			"update t set a = 100;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select pk, column_name, message from dolt_blame_cells('t');",
+				Expected: []sql.Row{
+					{1, "pk", "c1"},
+					{1, "a", "c2"},
+					{1, "b", "c1"},
+					{1, "c", "c4"},
+					{2, "pk", "c7"},
+					{2, "a", "c7"},
+					{2, "b", "c7"},
+					{2, "c", "c7"},
+					{3, "pk", "c3"},
+					{3, "a", "c3"},
+					{3, "b", "c3"},
+					{3, "c", "c5"},
+				},
+			},
+			{
+				Query: "select pk, column_name, message from dolt_blame_cells('T', 'HEAD~2') where pk = 2;",
+				Expected: []sql.Row{
+					{2, "pk", "c1"},
+					{2, "a", "c1"},
+					{2, "b", "c3"},
+					{2, "c", "c4"},
+				},
+			},
+			{
+				Query:    "select count(*) from dolt_blame_cells('t') as b join dolt_log as l on b.commit = l.commit_hash and b.commit_date = l.date and b.committer = l.committer and b.email = l.email;",
+				Expected: []sql.Row{{12}},
+			},
+		},
+	},
+	{
+		Name: "dolt_blame_cells: schema changes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int, c int);",
+			"insert into t values (1, 1, 1, 1), (2, 2, 2, 2);",
+			"call dolt_commit('-Am', 'c1');",
+			"alter table t modify column a bigint;",
+			"call dolt_commit('-am', 'c2');",
+			"alter table t drop column b;",
+			"call dolt_commit('-am', 'c3');",
+			"alter table t rename column c to d;",
+			"update t set d = 20 where pk = 2;",
+			"call dolt_commit('-am', 'c4');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select pk, column_name, message from dolt_blame_cells('t');",
+				Expected: []sql.Row{
+					{1, "pk", "c1"},
+					{1, "a", "c2"},
+					{1, "d", "c1"},
+					{2, "pk", "c1"},
+					{2, "a", "c2"},
+					{2, "d", "c4"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_blame_cells: merges",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int);",
+			"insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3);",
+			"call dolt_commit('-Am', 'c1');",
+			"call dolt_branch('other');",
+			"update t set b = 20 where pk = 2;",
+			"call dolt_commit('-am', 'main change');",
+			"call dolt_checkout('other');",
+			"update t set a = 10 where pk = 1;",
+			"insert into t values (4, 4, 4);",
+			"call dolt_commit('-am', 'other change');",
+			"call dolt_checkout('main');",
+			"call dolt_merge('other', '--no-commit');",
+			"update t set a = 30 where pk = 3;",
+			"call dolt_commit('-am', 'merge other');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// Cells changed on either branch are blamed on the commit that changed them, and the merge is only
+				// blamed for the cells it changed itself
+				Query: "select pk, column_name, message from dolt_blame_cells('t');",
+				Expected: []sql.Row{
+					{1, "pk", "c1"},
+					{1, "a", "other change"},
+					{1, "b", "c1"},
+					{2, "pk", "c1"},
+					{2, "a", "c1"},
+					{2, "b", "main change"},
+					{3, "pk", "c1"},
+					{3, "a", "merge other"},
+					{3, "b", "c1"},
+					{4, "pk", "other change"},
+					{4, "a", "other change"},
+					{4, "b", "other change"},
+				},
+			},
+			{
+				Query: "select distinct l.message from dolt_blame_cells('t') as b join dolt_log('--tables', 't') as l on b.commit = l.commit_hash order by 1;",
+				Expected: []sql.Row{
+					{"c1"},
+					{"main change"},
+					{"merge other"},
+					{"other change"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_blame_cells: errors",
+		SetUpScript: []string{
+			"create table keyless (a int, b int);",
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'c1');",
+			"create table new_table (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "select * from dolt_blame_cells('keyless');",
+				ExpectedErrStr: "unable to blame cells of a table without a primary key",
+			},
+			{
+				Query:       "select * from dolt_blame_cells('new_table');",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:       "select * from dolt_blame_cells('t', 'HEAD', 'extra');",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:       "select * from dolt_blame_cells('t', 1);",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:    "select * from dolt_blame_cells('t');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+}
+
+var RowLogTableFunctionScriptTests = []queries.ScriptTest{
+	{
+		Name: "dolt_row_log: row added, modified, removed and re-added",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b varchar(10));",
+			"insert into t values (1, 1, 'x'), (2, 2, 'y');",
+			"call dolt_commit('-Am', 'c1');",
+			"update t set a = 20 where pk = 2;",
+			"call dolt_commit('-am', 'c2');",
+			"update t set a = 10 where pk = 1;",
+			"call dolt_commit('-am', 'c3');",
+			"delete from t where pk = 1;",
+			"call dolt_commit('-am', 'c4');",
+			"insert into t values (1, 100, 'xx');",
+			"call dolt_commit('-am', 'c5');",
+			"update t set a = 1000 where pk = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select message, diff_type from dolt_row_log('t', '{\"pk\": 1}');",
+				Expected: []sql.Row{
+					{"c5", "added"},
+					{"c4", "removed"},
+					{"c3", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query: "select message, from_row, to_row from dolt_row_log('t', '{\"pk\": 1}');",
+				Expected: []sql.Row{
+					{"c5", nil, gmstypes.MustJSON(`{"pk": 1, "a": 100, "b": "xx"}`)},
+					{"c4", gmstypes.MustJSON(`{"pk": 1, "a": 10, "b": "x"}`), nil},
+					{"c3", gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": "x"}`), gmstypes.MustJSON(`{"pk": 1, "a": 10, "b": "x"}`)},
+					{"c1", nil, gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": "x"}`)},
+				},
+			},
+			{
+				Query: "select message, diff_type from dolt_row_log('T', '{\"PK\": \"2\"}');",
+				Expected: []sql.Row{
+					{"c2", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query: "select message, diff_type from dolt_row_log('t', json_object('pk', 1), 'HEAD~2');",
+				Expected: []sql.Row{
+					{"c3", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query:    "select count(*) from dolt_row_log('t', '{\"pk\": 1}') as r join dolt_log as l on r.commit_hash = l.commit_hash and r.date = l.date and r.committer = l.committer and r.email = l.email and r.message = l.message;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select * from dolt_row_log('t', '{\"pk\": 3}');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_log: merges",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int);",
+			"insert into t values (1, 1), (2, 2), (3, 3);",
+			"call dolt_commit('-Am', 'c1');",
+			"call dolt_branch('other');",
+			"update t set a = 20 where pk = 2;",
+			"call dolt_commit('-am', 'main change');",
+			"call dolt_checkout('other');",
+			"update t set a = 10 where pk = 1;",
+			"call dolt_commit('-am', 'other change');",
+			"update t set a = 11 where pk = 1;",
+			"call dolt_commit('-am', 'other change 2');",
+			"call dolt_checkout('main');",
+			"call dolt_merge('other', '--no-commit');",
+			"update t set a = 30 where pk = 3;",
+			"call dolt_commit('-am', 'merge other');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// Changes made on the merged branch are reported for the commits that made them
+				Query: "select message, diff_type, from_row, to_row from dolt_row_log('t', '{\"pk\": 1}');",
+				Expected: []sql.Row{
+					{"other change 2", "modified", gmstypes.MustJSON(`{"pk": 1, "a": 10}`), gmstypes.MustJSON(`{"pk": 1, "a": 11}`)},
+					{"other change", "modified", gmstypes.MustJSON(`{"pk": 1, "a": 1}`), gmstypes.MustJSON(`{"pk": 1, "a": 10}`)},
+					{"c1", "added", nil, gmstypes.MustJSON(`{"pk": 1, "a": 1}`)},
+				},
+			},
+			{
+				Query: "select message, diff_type from dolt_row_log('t', '{\"pk\": 2}');",
+				Expected: []sql.Row{
+					{"main change", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				// The merge is only reported for the rows it changed from every parent
+				Query: "select message, diff_type from dolt_row_log('t', '{\"pk\": 3}');",
+				Expected: []sql.Row{
+					{"merge other", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query:    "select count(*) from dolt_row_log('t', '{\"pk\": 1}') as r join dolt_log('--tables', 't') as l on r.commit_hash = l.commit_hash;",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_log: composite primary key",
+		SetUpScript: []string{
+			"create table t (a int, b varchar(20), c int, primary key (a, b));",
+			"insert into t values (1, 'one', 1), (1, 'two', 2), (2, 'one', 3);",
+			"call dolt_commit('-Am', 'c1');",
+			"update t set c = c + 10 where b = 'one';",
+			"call dolt_commit('-am', 'c2');",
+			"update t set c = c + 10 where a = 1;",
+			"call dolt_commit('-am', 'c3');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select message, diff_type from dolt_row_log('t', '{\"a\": 1, \"b\": \"one\"}');",
+				Expected: []sql.Row{
+					{"c3", "modified"},
+					{"c2", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query: "select message, diff_type from dolt_row_log('t', '{\"b\": \"two\", \"a\": 1}');",
+				Expected: []sql.Row{
+					{"c3", "modified"},
+					{"c1", "added"},
+				},
+			},
+			{
+				Query: "select message, to_row from dolt_row_log('t', '{\"a\": 2, \"b\": \"one\"}');",
+				Expected: []sql.Row{
+					{"c2", gmstypes.MustJSON(`{"a": 2, "b": "one", "c": 13}`)},
+					{"c1", gmstypes.MustJSON(`{"a": 2, "b": "one", "c": 3}`)},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_log: schema changes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, a int, b int);",
+			"insert into t values (1, 1, 1), (2, 2, 2);",
+			"call dolt_commit('-Am', 'c1');",
+			"alter table t add column c int;",
+			"call dolt_commit('-am', 'c2');",
+			"update t set c = 5 where pk = 1;",
+			"call dolt_commit('-am', 'c3');",
+			"alter table t drop column b;",
+			"call dolt_commit('-am', 'c4');",
+			"alter table t modify column pk bigint;",
+			"call dolt_commit('-am', 'c5');",
+			"update t set a = 10 where pk = 2;",
+			"call dolt_commit('-am', 'c6');",
+			"drop table t;",
+			"call dolt_commit('-am', 'c7');",
+			"create table t (pk int primary key, a int);",
+			"insert into t values (1, 100);",
+			"call dolt_commit('-Am', 'c8');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select message, diff_type, from_row, to_row from dolt_row_log('t', '{\"pk\": 1}');",
+				Expected: []sql.Row{
+					{"c8", "added", nil, gmstypes.MustJSON(`{"pk": 1, "a": 100}`)},
+					{"c7", "removed", gmstypes.MustJSON(`{"pk": 1, "a": 1, "c": 5}`), nil},
+					{"c5", "modified", gmstypes.MustJSON(`{"pk": 1, "a": 1, "c": 5}`), gmstypes.MustJSON(`{"pk": 1, "a": 1, "c": 5}`)},
+					{"c4", "modified", gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": 1, "c": 5}`), gmstypes.MustJSON(`{"pk": 1, "a": 1, "c": 5}`)},
+					{"c3", "modified", gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": 1, "c": null}`), gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": 1, "c": 5}`)},
+					{"c1", "added", nil, gmstypes.MustJSON(`{"pk": 1, "a": 1, "b": 1}`)},
+				},
+			},
+			{
+				Query: "select message, diff_type from dolt_row_log('t', '{\"pk\": 2}', 'HEAD~2');",
+				Expected: []sql.Row{
+					{"c6", "modified"},
+					{"c5", "modified"},
+					{"c4", "modified"},
+					{"c1", "added"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_row_log: errors",
+		SetUpScript: []string{
+			"create table t (a int, b int, primary key (a, b));",
+			"create table keyless (a int);",
+			"call dolt_commit('-Am', 'c1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "select * from dolt_row_log('t');",
+				ExpectedErrStr: "function 'dolt_row_log' expected 2 to 3 arguments, 1 received",
+			},
+			{
+				Query:          "select * from dolt_row_log('t', '{\"a\": 1}');",
+				ExpectedErrStr: "missing value for primary key column 'b'",
+			},
+			{
+				Query:          "select * from dolt_row_log('t', '{\"a\": 1, \"b\": 1, \"c\": 1}');",
+				ExpectedErrStr: "column 'c' is not a primary key column",
+			},
+			{
+				Query:          "select * from dolt_row_log('t', '{\"a\": 1, \"b\": null}');",
+				ExpectedErrStr: "primary key column 'b' cannot be null",
+			},
+			{
+				Query:          "select * from dolt_row_log('t', '[1, 2]');",
+				ExpectedErrStr: "invalid primary key for dolt_row_log: expected a JSON object mapping primary key columns to values, got [1, 2]",
+			},
+			{
+				Query:          "select * from dolt_row_log('keyless', '{\"a\": 1}');",
+				ExpectedErrStr: "unable to show the history of a row of a table without a primary key",
+			},
+			{
+				Query:       "select * from dolt_row_log('missing', '{\"a\": 1}');",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:          "select * from dolt_row_log('t', '{\"a\": 1, \"b\": 1}', 'nonexistent');",
+				ExpectedErrStr: "branch not found: nonexistent",
+			},
+		},
+	},
+}
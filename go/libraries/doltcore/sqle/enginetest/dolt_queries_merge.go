@@ -2159,6 +2159,198 @@ var MergeScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Merge across a widened primary key",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int, index (b));",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 2, 2), (3, 3, 3);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (pk, a);",
+			"INSERT INTO t VALUES (1, 10, 10);",
+			"UPDATE t SET b = 20 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET b = 30 WHERE pk = 3;",
+			"INSERT INTO t VALUES (4, 4, 4);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY pk, a;",
+				Expected: []sql.Row{{1, 1, 1}, {1, 10, 10}, {2, 2, 20}, {3, 3, 30}, {4, 4, 4}},
+			},
+			{
+				Query:    "SELECT pk, a FROM t WHERE b = 30;",
+				Expected: []sql.Row{{3, 3}},
+			},
+			{
+				Query: "SHOW CREATE TABLE t;",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `a` int NOT NULL,\n" +
+					"  `b` int,\n" +
+					"  PRIMARY KEY (`pk`,`a`),\n" +
+					"  KEY `b` (`b`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+		},
+	},
+	{
+		Name: "Merge across a replaced primary key reports conflicting rows",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int primary key, code varchar(10) NOT NULL, v int);",
+			"INSERT INTO t VALUES (1, 'a', 1), (2, 'b', 2), (3, 'c', 3);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"UPDATE t SET v = 20 WHERE id = 2;",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (code);",
+			"UPDATE t SET v = 30 WHERE code = 'b';",
+			"UPDATE t SET v = 10 WHERE code = 'a';",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT base_id, base_code, base_v, our_id, our_code, our_v, their_id, their_code, their_v FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{2, "b", 2, 2, "b", 30, 2, "b", 20}},
+			},
+			{
+				Query:    "CALL DOLT_CONFLICTS_RESOLVE('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY id;",
+				Expected: []sql.Row{{1, "a", 10}, {2, "b", 20}, {3, "c", 3}},
+			},
+		},
+	},
+	{
+		Name: "Merge across a primary key change conflicts on rows that are ambiguous in the ancestor",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int NOT NULL, b int);",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 1, 2);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"DELETE FROM t WHERE pk = 2;",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (a);",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET b = 5 WHERE pk = 1;",
+			"UPDATE t SET a = 2 WHERE pk = 2;",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT base_pk, base_a, base_b, our_pk, our_a, our_b, their_pk, their_a, their_b FROM dolt_conflicts_t;",
+				Expected: []sql.Row{{nil, nil, nil, 1, 1, 5, 1, 1, 1}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY a;",
+				Expected: []sql.Row{{1, 1, 5}, {2, 2, 2}},
+			},
+		},
+	},
+	{
+		Name: "Merge across a primary key change records rows that share the other branch's new key as violations",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int NOT NULL, b int);",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 2, 2);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (a);",
+			"UPDATE t SET b = 20 WHERE a = 2;",
+			"INSERT INTO t VALUES (3, 3, 3);",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET a = 2, b = 10 WHERE pk = 1;",
+			"INSERT INTO t VALUES (4, 4, 4);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT pk, a, b FROM t ORDER BY a;",
+				Expected: []sql.Row{{2, 2, 20}, {3, 3, 3}, {4, 4, 4}},
+			},
+			{
+				Query:    "SELECT violation_type, pk, a, b, CAST(violation_info as CHAR) FROM dolt_constraint_violations_t;",
+				Expected: []sql.Row{{"unique index", 1, 2, 10, `{"Name": "PRIMARY", "Columns": ["a"]}`}},
+			},
+			{
+				Query:    "SELECT * FROM dolt_conflicts_t;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "Merge across a primary key change records rows with a NULL in the other branch's new key as violations",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int primary key, a int, b int);",
+			"INSERT INTO t VALUES (1, 1, 1), (2, 2, 2);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (a);",
+			"UPDATE t SET b = 10 WHERE a = 1;",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET a = NULL WHERE pk = 2;",
+			"INSERT INTO t VALUES (3, NULL, 3), (4, 4, 4);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+			"SET @@autocommit = 0;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT pk, a, b FROM t ORDER BY a;",
+				Expected: []sql.Row{{1, 1, 10}, {4, 4, 4}},
+			},
+			{
+				Query:    "SELECT violation_type, pk, a, b, CAST(violation_info as CHAR) FROM dolt_constraint_violations_t;",
+				Expected: []sql.Row{{"not null", 2, nil, 2, `{"Columns": ["a"]}`}},
+			},
+			{
+				Query:    "DELETE FROM dolt_constraint_violations_t;",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_constraint_violations_t;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
 	{
 		Name:        "`Delete from table` should keep artifacts - conflicts",
 		SetUpScript: createConflictsSetupScript,
@@ -2523,9 +2715,513 @@ var MergeScripts = []queries.ScriptTest{
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:    "call dolt_merge('main')",
+				Query:    "call dolt_merge('main')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+		},
+	},
+	{
+		Name: "merge edits into a table renamed on the other branch",
+		SetUpScript: []string{
+			"create table t (id int primary key, name varchar(20), score int);",
+			"insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30);",
+			"call dolt_commit('-Am', 'create t');",
+			"call dolt_branch('other');",
+			"rename table t to u;",
+			"update u set name = 'A' where id = 1;",
+			"call dolt_commit('-Am', 'rename t to u');",
+			"call dolt_checkout('other');",
+			"update t set score = 300 where id = 3;",
+			"insert into t values (4, 'd', 40);",
+			"call dolt_commit('-am', 'edit t');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "show tables",
+				Expected: []sql.Row{{"u"}},
+			},
+			{
+				Query:    "select * from u order by id",
+				Expected: []sql.Row{{1, "A", 10}, {2, "b", 20}, {3, "c", 300}, {4, "d", 40}},
+			},
+		},
+	},
+	{
+		Name: "merge edits into a table dropped and recreated under a new name",
+		SetUpScript: []string{
+			"create table t (id int primary key, name varchar(20), score int);",
+			"insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30), (4, 'd', 40);",
+			"call dolt_commit('-Am', 'create t');",
+			"call dolt_branch('other');",
+			"create table people (id int primary key, name varchar(20), points int);",
+			"insert into people select * from t;",
+			"drop table t;",
+			"call dolt_commit('-Am', 'recreate t as people');",
+			"call dolt_checkout('other');",
+			"update t set score = 300 where id = 3;",
+			"call dolt_commit('-am', 'edit t');",
+			"call dolt_checkout('main');",
+			"set @@dolt_merge_detect_renames = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "show tables",
+				Expected: []sql.Row{{"people"}},
+			},
+			{
+				Query:    "select * from people order by id",
+				Expected: []sql.Row{{1, "a", 10}, {2, "b", 20}, {3, "c", 300}, {4, "d", 40}},
+			},
+		},
+	},
+	{
+		Name: "dropped and recreated tables are not followed by default",
+		SetUpScript: []string{
+			"create table t (id int primary key, name varchar(20), score int);",
+			"insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30), (4, 'd', 40);",
+			"call dolt_commit('-Am', 'create t');",
+			"call dolt_branch('other');",
+			"create table people (id int primary key, name varchar(20), points int);",
+			"insert into people select * from t;",
+			"drop table t;",
+			"call dolt_commit('-Am', 'recreate t as people');",
+			"call dolt_checkout('other');",
+			"update t set score = 300 where id = 3;",
+			"call dolt_commit('-am', 'edit t');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('other')",
+				ExpectedErrStr: merge.ErrTableDeletedAndModified.Error(),
+			},
+		},
+	},
+	{
+		Name: "empty tables are not paired as renames",
+		SetUpScript: []string{
+			"create table a (id int primary key, v int);",
+			"call dolt_commit('-Am', 'create a');",
+			"call dolt_branch('other');",
+			"create table b (id int primary key, v int);",
+			"drop table a;",
+			"call dolt_commit('-Am', 'replace a with b');",
+			"call dolt_checkout('other');",
+			"insert into a values (1, 1);",
+			"call dolt_commit('-am', 'edit a');",
+			"call dolt_checkout('main');",
+			"set @@dolt_merge_detect_renames = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('other')",
+				ExpectedErrStr: merge.ErrTableDeletedAndModified.Error(),
+			},
+			{
+				Query:    "select count(*) from b",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "conflicting edits to a renamed table",
+		SetUpScript: []string{
+			"set autocommit = 0;",
+			"create table t (id int primary key, name varchar(20), score int);",
+			"insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30);",
+			"call dolt_commit('-Am', 'create t');",
+			"call dolt_branch('other');",
+			"rename table t to u;",
+			"update u set name = 'A' where id = 1;",
+			"call dolt_commit('-Am', 'rename t to u');",
+			"call dolt_checkout('other');",
+			"update t set name = 'x' where id = 1;",
+			"call dolt_commit('-am', 'edit t');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "select base_name, our_name, their_name from dolt_conflicts_u",
+				Expected: []sql.Row{{"a", "A", "x"}},
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--theirs', 'u')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from u order by id",
+				Expected: []sql.Row{{1, "x", 10}, {2, "b", 20}, {3, "c", 30}},
+			},
+		},
+	},
+	{
+		Name: "octopus merge of branches that don't conflict",
+		SetUpScript: []string{
+			"create table a (pk int primary key, v int);",
+			"create table b (pk int primary key, v int);",
+			"create table c (pk int primary key, v int);",
+			"call dolt_commit('-Am', 'created tables');",
+			"call dolt_checkout('-b', 'b1');",
+			"insert into a values (1, 1);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"insert into b values (1, 1);",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('-b', 'b3', 'main');",
+			"insert into c values (1, 1);",
+			"call dolt_commit('-am', 'b3');",
+			"call dolt_checkout('main');",
+			"insert into a values (2, 2);",
+			"call dolt_commit('-am', 'main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('b1', 'b2', 'b3')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"Merge branches 'b1', 'b2' and 'b3' into main"}},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "select parent_hash = hashof('b3') from dolt_commit_ancestors where commit_hash = hashof('HEAD') and parent_index = 3",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select * from a order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "select * from b union all select * from c",
+				Expected: []sql.Row{{1, 1}, {1, 1}},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "octopus merge skips branches that are already merged",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_branch('old');",
+			"call dolt_checkout('-b', 'b1');",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"insert into t values (2, 2);",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('old', 'main')",
+				Expected: []sql.Row{{"", 0, 0, "Everything up-to-date"}},
+			},
+			{
+				Query:    "call dolt_merge('old', 'b1', 'b2', 'b1')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+		},
+	},
+	{
+		Name: "octopus merge of a branch built on an earlier merged branch",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_checkout('-b', 'b1');",
+			"insert into t values (1, 1), (2, 2);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2');",
+			"delete from t where pk = 1;",
+			"update t set v = 20 where pk = 2;",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('-b', 'b3', 'main');",
+			"insert into t values (3, 3);",
+			"call dolt_commit('-am', 'b3');",
+			"call dolt_checkout('main');",
+			"insert into t values (4, 4);",
+			"call dolt_commit('-am', 'main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('b1', 'b3', 'b2')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				// b2's changes to the rows of b1 are applied rather than treated as conflicting with b1
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{2, 20}, {3, 3}, {4, 4}},
+			},
+		},
+	},
+	{
+		Name: "octopus merge of conflicting branches",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"create table u (pk int primary key, v int);",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_checkout('-b', 'b1');",
+			"update t set v = 10;",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"insert into u values (1, 1);",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('-b', 'b3', 'main');",
+			"update t set v = 30;",
+			"call dolt_commit('-am', 'b3');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('b1', 'b2', 'b3')",
+				ExpectedErrStr: "octopus merge failed; merge these commits one at a time to resolve their conflicts:\n\ttable t: b1 and b3",
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:          "call dolt_merge('--no-commit', 'b1', 'b2')",
+				ExpectedErrStr: "error: Flag '--no-commit' cannot be used when merging more than one branch",
+			},
+			{
+				Query:    "call dolt_merge('-m', 'merged b1 and b2', 'b1', 'b2')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"merged b1 and b2"}},
+			},
+		},
+	},
+	{
+		Name: "octopus merge reports the conflicts of every branch",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"create table u (pk int primary key, v int);",
+			"insert into t values (1, 1);",
+			"insert into u values (1, 1);",
+			"call dolt_commit('-Am', 'created tables');",
+			"call dolt_checkout('-b', 'b1');",
+			"update t set v = 10;",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"update t set v = 20;",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('-b', 'b3', 'main');",
+			"update u set v = 30;",
+			"call dolt_commit('-am', 'b3');",
+			"call dolt_checkout('-b', 'b4', 'main');",
+			"update t set v = 40;",
+			"update u set v = 40;",
+			"call dolt_commit('-am', 'b4');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_merge('b1', 'b2', 'b3', 'b4')",
+				ExpectedErrStr: "octopus merge failed; merge these commits one at a time to resolve their conflicts:" +
+					"\n\ttable t: b1 and b2" +
+					"\n\ttable t: b1 and b4" +
+					"\n\ttable u: b3 and b4" +
+					"\n\ttable t: b2 and b4",
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t",
+				Expected: []sql.Row{{1, 1}},
+			},
+		},
+	},
+	{
+		Name: "merge with -X theirs favors their side of conflicting cells and rows",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int, c2 int);",
+			"insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_checkout('-b', 'right');",
+			"update t set c1 = 10, c2 = 20 where pk = 1;",
+			"delete from t where pk = 2;",
+			"update t set c1 = 30 where pk = 3;",
+			"update t set c1 = 40 where pk = 4;",
+			"insert into t values (5, 50, 50);",
+			"call dolt_commit('-am', 'right');",
+			"call dolt_checkout('main');",
+			"update t set c1 = 100 where pk = 1;",
+			"update t set c2 = 200 where pk = 2;",
+			"delete from t where pk = 4;",
+			"insert into t values (5, 500, 50);",
+			"call dolt_commit('-am', 'left');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('-X', 'theirs', 'right')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 10, 20}, {3, 30, 3}, {4, 40, 4}, {5, 50, 50}},
+			},
+			{
+				Query:    "select * from dolt_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "merge with -X ours favors our side of conflicting cells and rows",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int, c2 int);",
+			"insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_checkout('-b', 'right');",
+			"update t set c1 = 10, c2 = 20 where pk = 1;",
+			"delete from t where pk = 2;",
+			"update t set c1 = 30 where pk = 3;",
+			"update t set c1 = 40 where pk = 4;",
+			"insert into t values (5, 50, 50);",
+			"call dolt_commit('-am', 'right');",
+			"call dolt_checkout('main');",
+			"update t set c1 = 100 where pk = 1;",
+			"update t set c2 = 200 where pk = 2;",
+			"delete from t where pk = 4;",
+			"insert into t values (5, 500, 50);",
+			"call dolt_commit('-am', 'left');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('--strategy-option', 'ours', 'right')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 100, 20}, {2, 2, 200}, {3, 30, 3}, {5, 500, 50}},
+			},
+			{
+				Query:    "select * from dolt_conflicts",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "merge with an invalid strategy or strategy option",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_branch('b1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_merge('-X', 'mine', 'b1')",
+				ExpectedErrStr: "unsupported strategy option 'mine'; only 'ours' or 'theirs' are allowed",
+			},
+			{
+				Query:          "call dolt_merge('-s', 'octopus', 'b1')",
+				ExpectedErrStr: "error: unsupported merge strategy 'octopus'; valid strategies are 'ort' and 'ours'",
+			},
+			{
+				Query:          "call dolt_merge('-s', 'ours', '--squash', 'b1')",
+				ExpectedErrStr: "error: Flags '--squash' and '--strategy=ours' cannot be used together.\n",
+			},
+		},
+	},
+	{
+		Name: "merge with the ours strategy records the merge but keeps our tables",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v int);",
+			"insert into t values (1, 1);",
+			"call dolt_commit('-Am', 'created table');",
+			"call dolt_checkout('-b', 'b1');",
+			"update t set v = 10;",
+			"insert into t values (2, 2);",
+			"call dolt_commit('-am', 'b1');",
+			"call dolt_checkout('-b', 'b2', 'main');",
+			"insert into t values (3, 3);",
+			"call dolt_commit('-am', 'b2');",
+			"call dolt_checkout('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				// a fast-forward is still recorded as a merge commit
+				Query:    "call dolt_merge('--strategy=ours', 'b2')",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "update t set v = 100;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'main');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "call dolt_merge('-s', 'ours', '-m', 'kept main', 'b1')",
 				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
 			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 100}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1",
+				Expected: []sql.Row{{"kept main"}},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "call dolt_merge('b1')",
+				Expected: []sql.Row{{"", 0, 0, "cannot fast forward from a to b. a is ahead of b already"}},
+			},
 		},
 	},
 }
@@ -3886,6 +4582,338 @@ var SchemaConflictScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_schema_conflicts_resolve: widen a column",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(10), c1 int)",
+			"insert into t values (1, 'one', 1)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 varchar(30)",
+			"insert into t values (2, 'a much longer value', 2)",
+			"call dolt_commit('-am', 'widened c0 on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(20)",
+			"insert into t values (3, 'three', 3)",
+			"call dolt_commit('-am', 'widened c0 on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "select table_name, description from dolt_schema_conflicts",
+				Expected: []sql.Row{{"t", "different column definitions for our column c0 and their column c0"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_schema_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{{"t", true, "merged"}, {"t", true, "modified"}},
+			},
+			{
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` varchar(30),\n  `c1` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "one", 1}, {2, "a much longer value", 2}, {3, "three", 3}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'merged other')",
+				Expected: []sql.Row{{doltCommit}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: data is merged with the merge's strategy option",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(10), c1 int)",
+			"insert into t values (1, 'one', 1)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 varchar(30)",
+			"update t set c1 = 10 where pk = 1",
+			"call dolt_commit('-am', 'widened c0 on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(20)",
+			"update t set c1 = 100 where pk = 1",
+			"call dolt_commit('-am', 'widened c0 on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('-X', 'theirs', 'other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "one", 10}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: data conflicts are kept without a strategy option",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(10), c1 int)",
+			"insert into t values (1, 'one', 1)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 varchar(30)",
+			"update t set c1 = 10 where pk = 1",
+			"call dolt_commit('-am', 'widened c0 on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(20)",
+			"update t set c1 = 100 where pk = 1",
+			"call dolt_commit('-am', 'widened c0 on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select our_c1, their_c1 from dolt_conflicts_t",
+				Expected: []sql.Row{{100, 10}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: cherry-pick only applies the picked commit",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(10), c1 int)",
+			"insert into t values (1, 'one', 1)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"insert into t values (2, 'two', 2)",
+			"call dolt_commit('-am', 'inserted 2 on branch other')",
+			"alter table t modify column c0 varchar(30)",
+			"insert into t values (4, 'a much longer value', 4)",
+			"call dolt_commit('-am', 'widened c0 on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(20)",
+			"insert into t values (3, 'three', 3)",
+			"call dolt_commit('-am', 'widened c0 on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick('other')",
+				Expected: []sql.Row{{"", 0, 1, 0}},
+			},
+			{
+				Query:    "select table_name, description from dolt_schema_conflicts",
+				Expected: []sql.Row{{"t", "different column definitions for our column c0 and their column c0"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_schema_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "show create table t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  `c0` varchar(30),\n  `c1` int,\n  PRIMARY KEY (`pk`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin"}},
+			},
+			{
+				// row 2 was inserted by an earlier commit of other, so it is not picked
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "one", 1}, {3, "three", 3}, {4, "a much longer value", 4}},
+			},
+			{
+				Query:    "call dolt_commit('-am', 'cherry-picked widened c0')",
+				Expected: []sql.Row{{doltCommit}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: take their column",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(20))",
+			"insert into t values (1, '1')",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 int",
+			"insert into t values (2, 2)",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(10)",
+			"insert into t values (3, '3')",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'theirs')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_schema_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: keep our column",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(20))",
+			"insert into t values (1, '1')",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 int",
+			"insert into t values (2, 2)",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 varchar(10)",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'ours')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, "1"}, {2, "2"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: table is merged once all of its columns are resolved",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 int, c1 varchar(10))",
+			"insert into t values (1, 1, 'one')",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 bigint",
+			"alter table t modify column c1 varchar(30)",
+			"insert into t values (2, 2, 'two')",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 smallint",
+			"alter table t modify column c1 varchar(20)",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select table_name, description from dolt_schema_conflicts",
+				Expected: []sql.Row{{"t", "different column definitions for our column c1 and their column c1"}},
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{{"t", false, "schema conflict"}},
+			},
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				ExpectedErrStr: "column 'c0' of table 't' has no schema conflict",
+			},
+			{
+				Query:    "call dolt_schema_conflicts_resolve('t', 'column', 'c1', 'theirs')",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from dolt_schema_conflicts",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select column_name, column_type from information_schema.columns where table_name = 't' order by ordinal_position",
+				Expected: []sql.Row{{"pk", "int"}, {"c0", "bigint"}, {"c1", "varchar(30)"}},
+			},
+			{
+				Query:    "select * from t order by pk",
+				Expected: []sql.Row{{1, 1, "one"}, {2, 2, "two"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_schema_conflicts_resolve: errors",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, c0 varchar(20), c1 int)",
+			"call dolt_commit('-Am', 'added table t')",
+			"call dolt_checkout('-b', 'other')",
+			"alter table t modify column c0 int",
+			"call dolt_commit('-am', 'altered t on branch other')",
+			"call dolt_checkout('main')",
+			"alter table t modify column c0 datetime(6)",
+			"call dolt_commit('-am', 'altered t on branch main')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'ours')",
+				ExpectedErrStr: "no schema conflicts to resolve",
+			},
+			{
+				Query:    "call dolt_merge('other')",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'c0', 'ours')",
+				ExpectedErrStr: "usage: dolt_schema_conflicts_resolve(table, 'column', column, 'ours'|'theirs'|'widen')",
+			},
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'mine')",
+				ExpectedErrStr: "unknown resolution 'mine'; usage: dolt_schema_conflicts_resolve(table, 'column', column, 'ours'|'theirs'|'widen')",
+			},
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'column', 'c1', 'ours')",
+				ExpectedErrStr: "column 'c1' of table 't' has no schema conflict",
+			},
+			{
+				Query:          "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')",
+				ExpectedErrStr: "cannot widen column types datetime(6) and int to a type that holds the values of both",
+			},
+			{
+				Query:    "select * from dolt_status",
+				Expected: []sql.Row{{"t", false, "schema conflict"}},
+			},
+		},
+	},
 }
 
 // OldFormatMergeConflictsAndCVsScripts tests old format merge behavior
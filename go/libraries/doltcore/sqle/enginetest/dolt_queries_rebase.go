@@ -1611,6 +1611,53 @@ var DoltRebaseScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_rebase: data conflicts resolved with -X theirs",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 varchar(100));",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'inserting row 1 on main');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1, 'uno');",
+			"call dolt_commit('-am', 'inserting row 1 on branch1');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'inserting row 2 on branch1');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_rebase('-i', '-X', 'mine', 'main');",
+				ExpectedErrStr: "unsupported strategy option 'mine'; only 'ours' or 'theirs' are allowed",
+			},
+			{
+				Query: "call dolt_rebase('-i', '-X', 'theirs', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "uno"}, {2, "two"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 2 on branch1"},
+					{"inserting row 1 on branch1"},
+					{"inserting row 1 on main"},
+					{"creating table t"},
+					{"Initialize data repository"},
+				},
+			},
+		},
+	},
 }
 
 var DoltRebaseMultiSessionScriptTests = []queries.ScriptTest{
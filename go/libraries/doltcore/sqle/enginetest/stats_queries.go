@@ -770,6 +770,121 @@ var StatBranchTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "checked out branches are tracked without configuration",
+		SetUpScript: []string{
+			"set @@PERSIST.dolt_stats_auto_refresh_interval = 0;",
+			"set @@PERSIST.dolt_stats_auto_refresh_threshold = 0;",
+			"set @@PERSIST.dolt_stats_branches = '';",
+			"CREATE table xy (x bigint primary key, y int, key(y));",
+			"insert into xy values (0,0), (1,0), (2,1), (3,2)",
+			"call dolt_commit('-Am', 'xy')",
+			"call dolt_branch('feat')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_stats_restart()",
+			},
+			{
+				Query: "select sleep(.1)",
+			},
+			{
+				Query: "select table_name, index_name, row_count from dolt_statistics as of 'main'",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(4)},
+					{"xy", "y", uint64(4)},
+				},
+			},
+			{
+				Query: "call dolt_checkout('feat')",
+			},
+			{
+				// the first read loads feat in the background
+				Query:    "select count(*) >= 0 from dolt_statistics",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query: "select sleep(.1)",
+			},
+			{
+				// feat shares every chunk with main, so its statistics
+				// are built without sampling
+				Query: "select table_name, index_name, row_count from dolt_statistics",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(4)},
+					{"xy", "y", uint64(4)},
+				},
+			},
+			{
+				Query: "insert into xy values (4,3)",
+			},
+			{
+				Query: "call dolt_commit('-am', 'feat')",
+			},
+			{
+				Query: "select sleep(.1)",
+			},
+			{
+				Query: "select table_name, index_name, row_count from dolt_statistics as of 'feat'",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(5)},
+					{"xy", "y", uint64(5)},
+				},
+			},
+			{
+				Query: "select table_name, index_name, row_count from dolt_statistics as of 'main'",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(4)},
+					{"xy", "y", uint64(4)},
+				},
+			},
+		},
+	},
+	{
+		Name: "checked out branches are tracked without a refresh thread",
+		SetUpScript: []string{
+			"set @@PERSIST.dolt_stats_auto_refresh_enabled = 0;",
+			"CREATE table xy (x bigint primary key, y int, key(y));",
+			"insert into xy values (0,0), (1,0), (2,1), (3,2)",
+			"call dolt_commit('-Am', 'xy')",
+			"call dolt_branch('feat')",
+			"analyze table xy",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_checkout('feat')",
+			},
+			{
+				Query:    "select count(*) >= 0 from dolt_statistics",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query: "select sleep(.1)",
+			},
+			{
+				// buckets analyzed on main are shared with feat
+				Query: "select table_name, index_name, row_count from dolt_statistics",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(4)},
+					{"xy", "y", uint64(4)},
+				},
+			},
+			{
+				Query: "insert into xy values (4,3)",
+			},
+			{
+				Query: "select sleep(.1)",
+			},
+			{
+				// nothing refreshes feat without a refresh thread
+				Query: "select table_name, index_name, row_count from dolt_statistics",
+				Expected: []sql.Row{
+					{"xy", "primary", uint64(4)},
+					{"xy", "y", uint64(4)},
+				},
+			},
+		},
+	},
 	{
 		Name: "issue #7710: branch connection string errors",
 		SetUpScript: []string{
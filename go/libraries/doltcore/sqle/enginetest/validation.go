@@ -15,6 +15,7 @@
 package enginetest
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -28,6 +29,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/types"
@@ -126,7 +128,15 @@ func validateSecondaryIndexes(ctx context.Context, db sqle.Database) error {
 			}
 			secondary := durable.MapFromIndex(idx)
 
-			err = validateIndexConsistency(ctx, sch, def, primary, secondary)
+			if def.IsColumnar() {
+				err = validateColumnarIndex(ctx, sch, def, primary, secondary)
+				if err != nil {
+					return true, err
+				}
+				continue
+			}
+
+			err = validateIndexConsistency(ctx, n.Name, sch, def, primary, secondary)
 			if err != nil {
 				return true, err
 			}
@@ -138,17 +148,64 @@ func validateSecondaryIndexes(ctx context.Context, db sqle.Database) error {
 
 func validateIndexConsistency(
 	ctx context.Context,
+	tableName string,
 	sch schema.Schema,
 	def schema.Index,
 	primary, secondary prolly.MapInterface,
 ) error {
+	sqlCtx, ok := ctx.(*sql.Context)
+	if !ok {
+		sqlCtx = sql.NewContext(ctx)
+	}
+	// partial indexes only hold the rows matching their predicate
+	pred, err := index.NewIndexPredicate(sqlCtx, tableName, sch, def, secondary.NodeStore())
+	if err != nil {
+		return err
+	}
 	if schema.IsKeyless(sch) {
-		return validateKeylessIndex(ctx, sch, def, primary, secondary)
+		return validateKeylessIndex(ctx, sch, def, pred, primary, secondary)
 	} else {
-		return validatePkIndex(ctx, sch, def, primary, secondary)
+		return validatePkIndex(ctx, sch, def, pred, primary, secondary)
 	}
 }
 
+// validateColumnarIndex checks that the columnar index |def| decodes to the projected columns of every row of |primary|.
+func validateColumnarIndex(ctx context.Context, sch schema.Schema, def schema.Index, primary, columnar prolly.MapInterface) error {
+	iter, err := index.NewColumnarIter(ctx, sch, def, columnar.(prolly.Map), nil, nil)
+	if err != nil {
+		return err
+	}
+	rows, err := primary.IterAll(ctx)
+	if err != nil {
+		return err
+	}
+	_, vd := primary.Descriptors()
+	for {
+		k, v, err := rows.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		_, cv, err := iter.Next(ctx)
+		if err == io.EOF {
+			return fmt.Errorf("columnar index %s is missing row %s", def.Name(), sch.GetKeyDescriptor().Format(k))
+		} else if err != nil {
+			return err
+		}
+		for _, tag := range def.IndexedColumnTags() {
+			i, _ := sch.GetNonPKCols().StoredIndexByTag(tag)
+			if !bytes.Equal(vd.GetField(i, v), vd.GetField(i, cv)) {
+				return fmt.Errorf("columnar index %s does not match row %s", def.Name(), sch.GetKeyDescriptor().Format(k))
+			}
+		}
+	}
+	if _, _, err = iter.Next(ctx); err != io.EOF {
+		return fmt.Errorf("columnar index %s has more rows than its table", def.Name())
+	}
+	return nil
+}
+
 // printIndexContents prints the contents of |prollyMap| to stdout. Intended for use debugging
 // index consistency issues.
 func printIndexContents(ctx context.Context, prollyMap prolly.MapInterface) {
@@ -164,7 +221,7 @@ func printIndexContents(ctx context.Context, prollyMap prolly.MapInterface) {
 	}
 }
 
-func validateKeylessIndex(ctx context.Context, sch schema.Schema, def schema.Index, primary, secondary prolly.MapInterface) error {
+func validateKeylessIndex(ctx context.Context, sch schema.Schema, def schema.Index, pred index.IndexPredicate, primary, secondary prolly.MapInterface) error {
 	// Full-Text indexes do not make use of their internal map, so we may safely skip this check
 	if def.IsFullText() {
 		return nil
@@ -193,6 +250,11 @@ func validateKeylessIndex(ctx context.Context, sch schema.Schema, def schema.Ind
 		if err != nil {
 			return err
 		}
+		if ok, err := pred.Matches(ctx, hashId, value); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
 
 		// make secondary index key
 		for i := range mapping {
@@ -236,10 +298,13 @@ func validateKeylessIndex(ctx context.Context, sch schema.Schema, def schema.Ind
 			printIndexContents(ctx, secondary)
 			return fmt.Errorf("index key %s not found in index %s", builder.Desc.Format(k), def.Name())
 		}
+		if err = validateIncludedColumns(ctx, sch, def, secondary, k, value); err != nil {
+			return err
+		}
 	}
 }
 
-func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, primary, secondary prolly.MapInterface) error {
+func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, pred index.IndexPredicate, primary, secondary prolly.MapInterface) error {
 	// Full-Text indexes do not make use of their internal map, so we may safely skip this check
 	if def.IsFullText() {
 		return nil
@@ -250,7 +315,7 @@ func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, p
 		return nil
 	}
 
-	// secondary indexes have empty values
+	// secondary index values only hold included columns, which are checked separately
 	idxDesc, _ := secondary.Descriptors()
 	builder := val.NewTupleBuilder(idxDesc)
 	mapping := ordinalMappingsForSecondaryIndex(sch, def)
@@ -267,11 +332,12 @@ func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, p
 	if err != nil {
 		return err
 	}
-	if totalSecondaryCount != totalPrimaryCount {
+	if totalSecondaryCount != totalPrimaryCount && !pred.IsPartial() {
 		return fmt.Errorf("primary index row count (%d) does not match secondary index row count (%d)",
 			totalPrimaryCount, totalSecondaryCount)
 	}
 
+	matchingCount := 0
 	pkSize := kd.Count()
 	iter, err := primary.IterAll(ctx)
 	if err != nil {
@@ -281,11 +347,21 @@ func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, p
 	for {
 		key, value, err := iter.Next(ctx)
 		if err == io.EOF {
+			if pred.IsPartial() && matchingCount != totalSecondaryCount {
+				return fmt.Errorf("partial index matching row count (%d) does not match secondary index row count (%d)",
+					matchingCount, totalSecondaryCount)
+			}
 			return nil
 		}
 		if err != nil {
 			return err
 		}
+		if ok, err := pred.Matches(ctx, key, value); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		matchingCount++
 
 		// make secondary index key
 		for i := range mapping {
@@ -331,9 +407,29 @@ func validatePkIndex(ctx context.Context, sch schema.Schema, def schema.Index, p
 			printIndexContents(ctx, secondary)
 			return fmt.Errorf("index key %v not found in index %s", builder.Desc.Format(k), def.Name())
 		}
+		if err = validateIncludedColumns(ctx, sch, def, secondary, k, value); err != nil {
+			return err
+		}
 	}
 }
 
+// validateIncludedColumns checks that the value stored for index key |k| holds the included columns of the primary
+// row value |value|.
+func validateIncludedColumns(ctx context.Context, sch schema.Schema, def schema.Index, secondary prolly.MapInterface, k, value val.Tuple) error {
+	m, ok := secondary.(prolly.Map)
+	if !ok || len(def.IncludedColumnTags()) == 0 {
+		return nil
+	}
+	expected := index.NewSecondaryValueBuilder(sch, def, m.Pool()).SecondaryValueFromRow(value)
+	return m.Get(ctx, k, func(_, v val.Tuple) error {
+		if !bytes.Equal(expected, v) {
+			return fmt.Errorf("index key %s has included columns %s in index %s, expected %s", m.KeyDesc().Format(k),
+				m.ValDesc().Format(v), def.Name(), m.ValDesc().Format(expected))
+		}
+		return nil
+	})
+}
+
 func isVirtualIndex(def schema.Index, sch schema.Schema) bool {
 	for _, colName := range def.ColumnNames() {
 		col, ok := sch.GetAllCols().GetByName(colName)
@@ -444,9 +540,9 @@ func trimValueToPrefixLength(value []byte, prefixLength uint16, encoding val.Enc
 }
 
 func ordinalMappingsForSecondaryIndex(sch schema.Schema, def schema.Index) (ord val.OrdinalMapping) {
-	// assert empty values for secondary indexes
-	if def.Schema().GetNonPKCols().Size() > 0 {
-		panic("expected empty secondary index values")
+	// assert that secondary index values only hold included columns
+	if def.Schema().GetNonPKCols().Size() != len(def.IncludedColumnTags()) {
+		panic("expected secondary index values to hold only included columns")
 	}
 
 	secondary := def.Schema().GetPKCols()
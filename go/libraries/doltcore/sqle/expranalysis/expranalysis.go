@@ -16,6 +16,7 @@ package expranalysis
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
@@ -71,6 +72,78 @@ func ResolveCheckExpression(ctx *sql.Context, tableName string, sch schema.Schem
 	return nil, fmt.Errorf("unable to find check expression")
 }
 
+// ResolveIndexPredicate returns a sql.Expression for the partial index predicate provided. The expression evaluates
+// rows of the full table schema |sch|.
+func ResolveIndexPredicate(ctx *sql.Context, tableName string, sch schema.Schema, predicate string) (sql.Expression, error) {
+	// predicates are resolved the same way as check constraints, using a schema with the predicate as its only check
+	predSch, err := schema.SchemaFromCols(sch.GetAllCols())
+	if err != nil {
+		return nil, err
+	}
+	predSch.SetCollation(sch.GetCollation())
+	if _, err = predSch.Checks().AddCheck("partial_index_predicate", predicate, true); err != nil {
+		return nil, err
+	}
+
+	ct, err := parseCreateTable(ctx, tableName, predSch)
+	if err != nil {
+		return nil, err
+	}
+	checks := ct.Checks()
+	if len(checks) != 1 {
+		return nil, fmt.Errorf("unable to find predicate expression")
+	}
+	return checks[0].Expr, nil
+}
+
+// NormalizeIndexPredicate returns the canonical string form of the resolved predicate |expr|, which is how partial
+// index predicates are stored. Column references are quoted so that the result can be parsed again.
+func NormalizeIndexPredicate(expr sql.Expression) string {
+	e, _, _ := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if col, ok := e.(*expression.GetField); ok {
+			return col.WithTable("").WithBackTickNames(true), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	return e.String()
+}
+
+// IndexPredicateReferencesColumn returns whether the partial index |predicate| over the table schema |sch| refers to
+// the column |colName|.
+func IndexPredicateReferencesColumn(ctx *sql.Context, tableName string, sch schema.Schema, predicate, colName string) (bool, error) {
+	expr, err := ResolveIndexPredicate(ctx, tableName, sch, predicate)
+	if err != nil {
+		return false, err
+	}
+	found := false
+	sql.Inspect(expr, func(e sql.Expression) bool {
+		if col, ok := e.(*expression.GetField); ok && strings.EqualFold(col.Name(), colName) {
+			found = true
+		}
+		return !found
+	})
+	return found, nil
+}
+
+// RenameIndexPredicateColumn returns the partial index |predicate| over the table schema |sch| with references to
+// the column |oldName| renamed to |newName|.
+func RenameIndexPredicateColumn(ctx *sql.Context, tableName string, sch schema.Schema, predicate, oldName, newName string) (string, error) {
+	expr, err := ResolveIndexPredicate(ctx, tableName, sch, predicate)
+	if err != nil {
+		return "", err
+	}
+	expr, _, err = transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		if col, ok := e.(*expression.GetField); ok && strings.EqualFold(col.Name(), oldName) {
+			return col.WithName(newName), transform.NewTree, nil
+		}
+		return e, transform.SameTree, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return NormalizeIndexPredicate(expr), nil
+}
+
 func stripTableNamesFromExpression(expr sql.Expression) sql.Expression {
 	e, _, _ := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		if col, ok := e.(*expression.GetField); ok {
@@ -0,0 +1,480 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// A columnar index is a projection of some of a table's columns, stored column by column. The table's rows are
+// divided into chunks of consecutive primary key ranges. Each chunk is an entry of the index map, keyed by the
+// primary key of its first row, and its value holds one blob per projected column. A column blob is a sequence of
+// runs of equal values, each encoded as:
+//
+//	uvarint(run length) uvarint(field length + 1, or 0 for NULL) field
+//
+// Scans and aggregates that only read projected columns can decode these blobs instead of reading whole rows.
+
+// ColumnarChunkSize is the number of rows in each chunk of a columnar index built from scratch.
+var ColumnarChunkSize = 4096
+
+// ValidateColumnarIndex returns an error if |idx| cannot be a columnar index of a table with schema |sch|.
+func ValidateColumnarIndex(sch schema.Schema, idx schema.Index) error {
+	if schema.IsKeyless(sch) {
+		return fmt.Errorf("columnar indexes are not supported on tables without a primary key")
+	}
+	if idx.IsUnique() || idx.IsSpatial() || idx.IsFullText() || len(idx.PrefixLengths()) > 0 {
+		return fmt.Errorf("columnar index '%s' cannot be unique, spatial, full-text or use prefix lengths", idx.Name())
+	}
+	_, valDesc := sch.GetMapDescriptors()
+	for _, tag := range idx.IndexedColumnTags() {
+		col, _ := sch.GetAllCols().GetByTag(tag)
+		if col.IsPartOfPK {
+			return fmt.Errorf("columnar index '%s' cannot project primary key column '%s'", idx.Name(), col.Name)
+		}
+		if col.Virtual {
+			return fmt.Errorf("columnar index '%s' cannot project virtual column '%s'", idx.Name(), col.Name)
+		}
+		i, _ := sch.GetNonPKCols().StoredIndexByTag(tag)
+		if val.IsAddrEncoding(valDesc.Types[i].Enc) {
+			return fmt.Errorf("columnar index '%s' cannot project out-of-band column '%s'", idx.Name(), col.Name)
+		}
+	}
+	return nil
+}
+
+// ColumnarIndexForTags returns a columnar index of |sch| which projects every column in |tags|, or nil if there is
+// none. A nil |tags| references every column of the table, which is never covered.
+func ColumnarIndexForTags(sch schema.Schema, tags []uint64) schema.Index {
+	if len(tags) == 0 {
+		return nil
+	}
+	for _, idx := range sch.Indexes().AllIndexes() {
+		if !idx.IsColumnar() {
+			continue
+		}
+		projected := make(map[uint64]struct{}, idx.Count())
+		for _, tag := range idx.IndexedColumnTags() {
+			projected[tag] = struct{}{}
+		}
+		covered := true
+		for _, tag := range tags {
+			if _, ok := projected[tag]; !ok {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return idx
+		}
+	}
+	return nil
+}
+
+// BuildColumnarIndex returns the columnar index |idx| of the rows of |primary|.
+func BuildColumnarIndex(ctx context.Context, ns tree.NodeStore, sch schema.Schema, idx schema.Index, primary prolly.Map) (prolly.Map, error) {
+	kd, vd := idx.Schema().GetMapDescriptors()
+	empty, err := prolly.NewMapFromTuples(ctx, ns, kd, vd)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return SyncColumnarIndex(ctx, ns, sch, idx, empty, empty, primary)
+}
+
+// SyncColumnarIndex updates |columnar|, the columnar index |idx| of the rows of |from|, to index the rows of |to|.
+// Only the chunks whose primary key ranges hold modified rows are re-encoded.
+func SyncColumnarIndex(ctx context.Context, ns tree.NodeStore, sch schema.Schema, idx schema.Index, columnar, from, to prolly.Map) (prolly.Map, error) {
+	cnt, err := columnar.Count()
+	if err != nil {
+		return prolly.Map{}, err
+	}
+
+	var dirty []columnarChunkRange
+	if cnt == 0 {
+		// no chunks to align to, index every row
+		dirty = append(dirty, columnarChunkRange{})
+	} else {
+		kd, _ := columnar.Descriptors()
+		err = prolly.DiffMaps(ctx, from, to, false, func(ctx context.Context, diff tree.Diff) error {
+			k := val.Tuple(diff.Key)
+			if len(dirty) > 0 && dirty[len(dirty)-1].contains(kd, k) {
+				return nil
+			}
+			r, err := chunkRangeForKey(ctx, columnar, k, uint64(cnt))
+			if err != nil {
+				return err
+			}
+			dirty = append(dirty, r)
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return prolly.Map{}, err
+		}
+	}
+
+	mut := columnar.Mutate()
+	enc := newColumnarChunkEncoder(ns, sch, idx)
+	for _, r := range dirty {
+		if r.key != nil {
+			if err = mut.Delete(ctx, r.key); err != nil {
+				return prolly.Map{}, err
+			}
+		}
+		iter, err := to.IterKeyRange(ctx, r.start, r.stop)
+		if err != nil {
+			return prolly.Map{}, err
+		}
+		for {
+			k, v, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return prolly.Map{}, err
+			}
+			enc.add(k, v)
+			if enc.rows >= ColumnarChunkSize {
+				if err = enc.flush(ctx, mut); err != nil {
+					return prolly.Map{}, err
+				}
+			}
+		}
+		if err = enc.flush(ctx, mut); err != nil {
+			return prolly.Map{}, err
+		}
+	}
+	return mut.Map(ctx)
+}
+
+// SyncColumnarIndexes updates every columnar index of |sch| in |s| after the table's rows changed from |from| to |to|.
+// Code which edits a table's primary index directly, rather than through a table writer, must call this before
+// storing the table's index set.
+func SyncColumnarIndexes(ctx context.Context, sch schema.Schema, s durable.IndexSet, from, to prolly.Map) (durable.IndexSet, error) {
+	if !sch.Indexes().ContainsColumnarIndex() {
+		return s, nil
+	}
+	for _, idx := range sch.Indexes().AllIndexes() {
+		if !idx.IsColumnar() {
+			continue
+		}
+		idxRows, err := s.GetIndex(ctx, sch, idx.Schema(), idx.Name())
+		if err != nil {
+			return nil, err
+		}
+		columnar, err := SyncColumnarIndex(ctx, to.NodeStore(), sch, idx, durable.ProllyMapFromIndex(idxRows), from, to)
+		if err != nil {
+			return nil, err
+		}
+		s, err = s.PutIndex(ctx, idx.Name(), durable.IndexFromProllyMap(columnar))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// columnarChunkRange is the primary key range [start, stop) of the chunk keyed by |key|. The first chunk's range is
+// unbounded below and the last chunk's range is unbounded above.
+type columnarChunkRange struct {
+	key, start, stop val.Tuple
+}
+
+func (r columnarChunkRange) contains(kd val.TupleDesc, k val.Tuple) bool {
+	return (r.start == nil || kd.Compare(k, r.start) >= 0) &&
+		(r.stop == nil || kd.Compare(k, r.stop) < 0)
+}
+
+// chunkRangeForKey returns the range of the chunk of |columnar| which holds the primary key |k|.
+func chunkRangeForKey(ctx context.Context, columnar prolly.Map, k val.Tuple, cnt uint64) (columnarChunkRange, error) {
+	ord, err := columnar.GetOrdinalForKey(ctx, k)
+	if err != nil {
+		return columnarChunkRange{}, err
+	}
+	if ord > 0 {
+		// |ord| is the first chunk with a key >= |k|, step back unless it starts at |k|
+		var exact bool
+		if ord < cnt {
+			err = columnar.Get(ctx, k, func(key, _ val.Tuple) error {
+				exact = key != nil
+				return nil
+			})
+			if err != nil {
+				return columnarChunkRange{}, err
+			}
+		}
+		if !exact {
+			ord--
+		}
+	}
+
+	iter, err := columnar.FetchOrdinalRange(ctx, ord, min(ord+2, cnt))
+	if err != nil {
+		return columnarChunkRange{}, err
+	}
+	var r columnarChunkRange
+	r.key, _, err = iter.Next(ctx)
+	if err != nil {
+		return columnarChunkRange{}, err
+	}
+	if ord > 0 {
+		r.start = r.key
+	}
+	r.stop, _, err = iter.Next(ctx)
+	if err != nil && err != io.EOF {
+		return columnarChunkRange{}, err
+	}
+	return r, nil
+}
+
+// columnarChunkEncoder encodes consecutive table rows into a chunk of a columnar index.
+type columnarChunkEncoder struct {
+	ns     tree.NodeStore
+	valMap []int
+	valDesc,
+	chunkKd val.TupleDesc
+	bld *val.TupleBuilder
+
+	first val.Tuple
+	rows  int
+	cols  []columnRunWriter
+}
+
+func newColumnarChunkEncoder(ns tree.NodeStore, sch schema.Schema, idx schema.Index) *columnarChunkEncoder {
+	_, valDesc := sch.GetMapDescriptors()
+	_, chunkVd := idx.Schema().GetMapDescriptors()
+	tags := idx.IndexedColumnTags()
+	valMap := make([]int, len(tags))
+	for i, tag := range tags {
+		valMap[i], _ = sch.GetNonPKCols().StoredIndexByTag(tag)
+	}
+	return &columnarChunkEncoder{
+		ns:      ns,
+		valMap:  valMap,
+		valDesc: valDesc,
+		bld:     val.NewTupleBuilder(chunkVd),
+		cols:    make([]columnRunWriter, len(tags)),
+	}
+}
+
+func (e *columnarChunkEncoder) add(k, v val.Tuple) {
+	if e.rows == 0 {
+		e.first = k
+	}
+	for i, j := range e.valMap {
+		e.cols[i].add(e.valDesc.GetField(j, v))
+	}
+	e.rows++
+}
+
+// flush writes the rows added since the last flush to |mut| as a single chunk.
+func (e *columnarChunkEncoder) flush(ctx context.Context, mut *prolly.MutableMap) error {
+	if e.rows == 0 {
+		return nil
+	}
+	for i := range e.cols {
+		buf := e.cols[i].finish()
+		addr, err := tree.SerializeBytesToAddr(ctx, e.ns, bytes.NewReader(buf), len(buf))
+		if err != nil {
+			return err
+		}
+		e.bld.PutBytesAddr(i, addr)
+	}
+	if err := mut.Put(ctx, e.first, e.bld.Build(sharePool)); err != nil {
+		return err
+	}
+	e.first, e.rows = nil, 0
+	return nil
+}
+
+// columnRunWriter run-length encodes the values of a column.
+type columnRunWriter struct {
+	buf  []byte
+	prev []byte
+	run  uint64
+}
+
+func (w *columnRunWriter) add(field []byte) {
+	if w.run > 0 && (field == nil) == (w.prev == nil) && bytes.Equal(field, w.prev) {
+		w.run++
+		return
+	}
+	w.writeRun()
+	w.prev = nil
+	if field != nil {
+		w.prev = append(make([]byte, 0, len(field)), field...)
+	}
+	w.run = 1
+}
+
+func (w *columnRunWriter) writeRun() {
+	if w.run == 0 {
+		return
+	}
+	w.buf = binary.AppendUvarint(w.buf, w.run)
+	if w.prev == nil {
+		w.buf = binary.AppendUvarint(w.buf, 0)
+	} else {
+		w.buf = binary.AppendUvarint(w.buf, uint64(len(w.prev))+1)
+		w.buf = append(w.buf, w.prev...)
+	}
+}
+
+// finish returns the encoded column and resets the writer.
+func (w *columnRunWriter) finish() []byte {
+	w.writeRun()
+	buf := w.buf
+	*w = columnRunWriter{}
+	return buf
+}
+
+// columnRunReader decodes a column encoded by columnRunWriter.
+type columnRunReader struct {
+	buf  []byte
+	cur  []byte
+	left uint64
+}
+
+func (r *columnRunReader) next() ([]byte, error) {
+	if r.left == 0 {
+		run, n := binary.Uvarint(r.buf)
+		if n <= 0 {
+			return nil, fmt.Errorf("corrupt columnar index chunk")
+		}
+		r.buf = r.buf[n:]
+		sz, n := binary.Uvarint(r.buf)
+		if n <= 0 || uint64(len(r.buf)-n)+1 < sz {
+			return nil, fmt.Errorf("corrupt columnar index chunk")
+		}
+		r.buf = r.buf[n:]
+		r.cur = nil
+		if sz > 0 {
+			r.cur, r.buf = r.buf[:sz-1], r.buf[sz-1:]
+		}
+		r.left = run
+	}
+	r.left--
+	return r.cur, nil
+}
+
+// columnarIter iterates the rows of a columnar index as primary index tuples. Only the fields of projected columns
+// are populated in the value tuples, and the key tuples are empty.
+type columnarIter struct {
+	chunks prolly.MapIter
+	ns     tree.NodeStore
+
+	chunkVd val.TupleDesc
+	valMap  []int
+	bld     *val.TupleBuilder
+	key     val.Tuple
+
+	cols []columnRunReader
+	left uint64
+}
+
+var _ prolly.MapIter = (*columnarIter)(nil)
+
+// NewColumnarIter returns an iterator over the rows indexed by the chunks of |columnar| with keys in the range
+// [|start|, |stop|). Since chunks are keyed by their first row, this covers the table rows in the same range whenever
+// |start| and |stop| are themselves chunk keys.
+func NewColumnarIter(ctx context.Context, sch schema.Schema, idx schema.Index, columnar prolly.Map, start, stop val.Tuple) (prolly.MapIter, error) {
+	chunks, err := columnar.IterKeyRange(ctx, start, stop)
+	if err != nil {
+		return nil, err
+	}
+	keyDesc, valDesc := sch.GetMapDescriptors()
+	_, chunkVd := columnar.Descriptors()
+	tags := idx.IndexedColumnTags()
+	valMap := make([]int, len(tags))
+	for i, tag := range tags {
+		valMap[i], _ = sch.GetNonPKCols().StoredIndexByTag(tag)
+	}
+	return &columnarIter{
+		chunks:  chunks,
+		ns:      columnar.NodeStore(),
+		chunkVd: chunkVd,
+		valMap:  valMap,
+		bld:     val.NewTupleBuilder(valDesc),
+		key:     val.NewTupleBuilder(keyDesc).BuildPermissive(sharePool),
+		cols:    make([]columnRunReader, len(tags)),
+	}, nil
+}
+
+func (it *columnarIter) Next(ctx context.Context) (val.Tuple, val.Tuple, error) {
+	for it.left == 0 {
+		if err := it.nextChunk(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	for i, j := range it.valMap {
+		field, err := it.cols[i].next()
+		if err != nil {
+			return nil, nil, err
+		}
+		it.bld.PutRaw(j, field)
+	}
+	it.left--
+	return it.key, it.bld.BuildPermissive(sharePool), nil
+}
+
+func (it *columnarIter) nextChunk(ctx context.Context) error {
+	_, v, err := it.chunks.Next(ctx)
+	if err != nil {
+		return err
+	}
+	var rows uint64
+	for i := range it.cols {
+		addr, _ := it.chunkVd.GetBytesAddr(i, v)
+		buf, err := tree.NewByteArray(addr, it.ns).ToBytes(ctx)
+		if err != nil {
+			return err
+		}
+		it.cols[i] = columnRunReader{buf: buf}
+		if i == 0 {
+			rows = columnRowCount(buf)
+		}
+	}
+	it.left = rows
+	return nil
+}
+
+// columnRowCount returns the number of values in an encoded column.
+func columnRowCount(buf []byte) (rows uint64) {
+	for len(buf) > 0 {
+		run, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return
+		}
+		buf = buf[n:]
+		sz, n := binary.Uvarint(buf)
+		if n <= 0 || uint64(len(buf)-n)+1 < sz {
+			return
+		}
+		buf = buf[n:]
+		if sz > 0 {
+			buf = buf[sz-1:]
+		}
+		rows += run
+	}
+	return
+}
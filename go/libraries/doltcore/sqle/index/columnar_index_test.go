@@ -0,0 +1,134 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+func TestColumnarIndex(t *testing.T) {
+	defer func(sz int) { ColumnarChunkSize = sz }(ColumnarChunkSize)
+	ColumnarChunkSize = 64
+
+	ctx := context.Background()
+	ns := tree.NewTestNodeStore()
+	sch := schema.MustSchemaFromCols(schema.NewColCollection(
+		schema.NewColumn("pk", 0, types.IntKind, true),
+		schema.NewColumn("c1", 1, types.IntKind, false),
+		schema.NewColumn("c2", 2, types.IntKind, false),
+		schema.NewColumn("c3", 3, types.IntKind, false),
+	))
+	idx, err := sch.Indexes().AddIndexByColNames("col", []string{"c2", "c1"}, nil, schema.IndexProperties{IsColumnar: true})
+	require.NoError(t, err)
+	require.NoError(t, ValidateColumnarIndex(sch, idx))
+	assert.Equal(t, idx, ColumnarIndexForTags(sch, []uint64{1}))
+	assert.Nil(t, ColumnarIndexForTags(sch, []uint64{1, 3}))
+	assert.Nil(t, ColumnarIndexForTags(sch, nil))
+
+	kd, vd := sch.GetMapDescriptors()
+	kb, vb := val.NewTupleBuilder(kd), val.NewTupleBuilder(vd)
+	row := func(pk, c1 int64) (val.Tuple, val.Tuple) {
+		kb.PutInt64(0, pk)
+		// long runs of c1 and NULLs in c2 exercise run-length encoding
+		vb.PutInt64(0, c1)
+		if pk%3 != 0 {
+			vb.PutInt64(1, pk)
+		}
+		vb.PutInt64(2, -pk)
+		return kb.Build(sharePool), vb.Build(sharePool)
+	}
+
+	var tups []val.Tuple
+	for i := int64(0); i < 1000; i += 2 {
+		k, v := row(i, i/100)
+		tups = append(tups, k, v)
+	}
+	primary, err := prolly.NewMapFromTuples(ctx, ns, kd, vd, tups...)
+	require.NoError(t, err)
+	columnar, err := BuildColumnarIndex(ctx, ns, sch, idx, primary)
+	require.NoError(t, err)
+	chunks, err := columnar.Count()
+	require.NoError(t, err)
+	assert.Equal(t, 8, chunks)
+	validateColumnar(t, ctx, sch, idx, primary, columnar)
+
+	// insert before, between and after existing rows, update and delete
+	mut := primary.Mutate()
+	for _, pk := range []int64{-5, 1, 501, 777, 2000} {
+		k, v := row(pk, 42)
+		require.NoError(t, mut.Put(ctx, k, v))
+	}
+	for pk := int64(100); pk < 400; pk += 2 {
+		k, v := row(pk, 7)
+		require.NoError(t, mut.Put(ctx, k, v))
+	}
+	for pk := int64(600); pk < 900; pk += 2 {
+		k, _ := row(pk, 0)
+		require.NoError(t, mut.Delete(ctx, k))
+	}
+	edited, err := mut.Map(ctx)
+	require.NoError(t, err)
+
+	synced, err := SyncColumnarIndex(ctx, ns, sch, idx, columnar, primary, edited)
+	require.NoError(t, err)
+	validateColumnar(t, ctx, sch, idx, edited, synced)
+
+	// syncing an unchanged table is a no-op
+	same, err := SyncColumnarIndex(ctx, ns, sch, idx, synced, edited, edited)
+	require.NoError(t, err)
+	assert.Equal(t, synced.HashOf(), same.HashOf())
+
+	// delete every row
+	empty, err := prolly.NewMapFromTuples(ctx, ns, kd, vd)
+	require.NoError(t, err)
+	synced, err = SyncColumnarIndex(ctx, ns, sch, idx, synced, edited, empty)
+	require.NoError(t, err)
+	validateColumnar(t, ctx, sch, idx, empty, synced)
+}
+
+// validateColumnar checks that |columnar| decodes to the projected columns of |primary|.
+func validateColumnar(t *testing.T, ctx context.Context, sch schema.Schema, idx schema.Index, primary, columnar prolly.Map) {
+	iter, err := NewColumnarIter(ctx, sch, idx, columnar, nil, nil)
+	require.NoError(t, err)
+	rows, err := primary.IterAll(ctx)
+	require.NoError(t, err)
+	_, vd := sch.GetMapDescriptors()
+	for {
+		k, v, err := rows.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		_, cv, err := iter.Next(ctx)
+		require.NoError(t, err)
+		for _, i := range []int{0, 1} {
+			assert.Equal(t, vd.GetField(i, v), vd.GetField(i, cv), "row %s", sch.GetKeyDescriptor().Format(k))
+		}
+		assert.Nil(t, vd.GetField(2, cv))
+	}
+	_, _, err = iter.Next(ctx)
+	assert.Equal(t, io.EOF, err)
+}
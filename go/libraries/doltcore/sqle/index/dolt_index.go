@@ -257,6 +257,12 @@ func DoltIndexesFromTable(ctx context.Context, db, tbl string, t *doltdb.Table)
 	}
 
 	for _, definition := range sch.Indexes().AllIndexes() {
+		// Columnar indexes store chunks of rows rather than index entries, so they can't serve lookups. Partial indexes
+		// don't hold every row, so the engine can't plan lookups or joins on them. They are only handed to it for
+		// queries whose filters imply their predicate, see PartialIndexLookup.
+		if definition.IsColumnar() || definition.Predicate() != "" {
+			continue
+		}
 		idx, err := getSecondaryIndex(ctx, db, tbl, t, sch, definition)
 		if err != nil {
 			return nil, err
@@ -284,6 +290,9 @@ func TableHasIndex(ctx context.Context, db, tbl string, t *doltdb.Table, i sql.I
 	}
 
 	for _, definition := range sch.Indexes().AllIndexes() {
+		if definition.IsColumnar() {
+			continue
+		}
 		idx, err := getSecondaryIndex(ctx, db, tbl, t, sch, definition)
 		if err != nil {
 			return false, err
@@ -32,9 +32,12 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
 )
 
 type indexComp int
@@ -1412,6 +1415,70 @@ INSERT INTO types VALUES (1, 4, '2020-05-14 12:00:03', 1.1, 'd', 1.1, 'a,c', '00
 	return root, indexMap
 }
 
+// TestDoltIndexMissingIncludedValues reads an index whose schema includes a column but whose values were written
+// without it, as clients that predate included columns do.
+func TestDoltIndexMissingIncludedValues(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	dEnv := dtestutils.CreateTestEnv()
+	root, err := dEnv.WorkingRoot(ctx)
+	require.NoError(t, err)
+	root, err = sqle.ExecuteSql(dEnv, root, `
+CREATE TABLE t (
+  pk BIGINT PRIMARY KEY,
+  v1 BIGINT,
+  v2 BIGINT
+);
+CREATE INDEX idx_v1 ON t(v1);
+INSERT INTO t VALUES (1, 1, 10), (2, 2, 20), (3, 2, 30);
+`)
+	require.NoError(t, err)
+
+	tblName := doltdb.TableName{Name: "t"}
+	tbl, _, err := root.GetTable(ctx, tblName)
+	require.NoError(t, err)
+	sch, err := tbl.GetSchema(ctx)
+	require.NoError(t, err)
+	def := sch.Indexes().GetByName("idx_v1")
+	_, err = sch.Indexes().RemoveIndex(def.Name())
+	require.NoError(t, err)
+	v2, ok := sch.GetAllCols().GetByName("v2")
+	require.True(t, ok)
+	def, err = sch.Indexes().AddIndexByColTags(def.Name(), def.IndexedColumnTags(), nil, schema.IndexProperties{
+		IsUserDefined: true,
+		IncludedTags:  []uint64{v2.Tag},
+	})
+	require.NoError(t, err)
+	// only the schema changes, the index keeps its empty values
+	tbl, err = tbl.UpdateSchema(ctx, sch)
+	require.NoError(t, err)
+
+	readIndex := func(tbl *doltdb.Table) ([]sql.Row, error) {
+		indexes, err := index.DoltIndexesFromTable(ctx, "dolt", "t", tbl)
+		require.NoError(t, err)
+		idx := indexes[1].(index.DoltIndex)
+		require.Equal(t, "idx_v1", idx.ID())
+		lookup, err := sql.NewMySQLIndexBuilder(idx).Equals(ctx, idx.Expressions()[0], 2).Build(ctx)
+		require.NoError(t, err)
+		pkSch, err := sqlutil.FromDoltSchema("", "t", sch)
+		require.NoError(t, err)
+		iter, err := index.RowIterForIndexLookup(ctx, NoCacheTableable{tbl}, lookup, pkSch, nil)
+		require.NoError(t, err)
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	_, err = readIndex(tbl)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index 'idx_v1' is missing the values of its included columns")
+
+	rows, err := creation.BuildSecondaryIndex(ctx, tbl, def, "t", editor.Options{})
+	require.NoError(t, err)
+	tbl, err = tbl.SetIndexRows(ctx, def.Name(), rows)
+	require.NoError(t, err)
+	read, err := readIndex(tbl)
+	require.NoError(t, err)
+	assert.Equal(t, []sql.Row{{int64(2), int64(2), int64(20)}, {int64(3), int64(2), int64(30)}}, read)
+}
+
 func mustTime(timeString string) time.Time {
 	t, err := time.Parse("2006-01-02 15:04:05", timeString)
 	if err != nil {
@@ -330,7 +330,7 @@ func newCoveringLookupBuilder(b *baseIndexImplBuilder) *coveringIndexImplBuilder
 	if b.idx.IsPrimaryKey() {
 		keyMap, valMap, ordMap = primaryIndexMapping(b.idx, b.projections)
 	} else {
-		keyMap, ordMap = coveringIndexMapping(b.idx, b.projections)
+		keyMap, valMap, ordMap = coveringIndexMapping(b.idx, b.projections)
 	}
 	return &coveringIndexImplBuilder{
 		baseIndexImplBuilder: b,
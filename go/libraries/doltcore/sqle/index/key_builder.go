@@ -222,3 +222,42 @@ func (b ClusteredKeyBuilder) ClusteredKeyFromIndexKey(k val.Tuple) val.Tuple {
 	}
 	return b.builder.Build(b.pool)
 }
+
+// NewSecondaryValueBuilder creates a SecondaryValueBuilder for the value tuples of the secondary index |def|. Value
+// tuples hold copies of the index's included columns, and are empty for indexes without any.
+func NewSecondaryValueBuilder(sch schema.Schema, def schema.Index, p pool.BuffPool) (b SecondaryValueBuilder) {
+	included := def.IncludedColumnTags()
+	if len(included) == 0 {
+		return
+	}
+	offset := 0
+	if schema.IsKeyless(sch) {
+		// skip the cardinality field
+		offset = 1
+	}
+	b.mapping = make(val.OrdinalMapping, len(included))
+	for i, tag := range included {
+		b.mapping[i] = offset + sch.GetNonPKCols().TagToIdx[tag]
+	}
+	b.builder = val.NewTupleBuilder(def.Schema().GetValueDescriptor())
+	b.pool = p
+	return
+}
+
+type SecondaryValueBuilder struct {
+	// mapping defines how to map fields from the source table's value tuples to this index's value tuples
+	mapping val.OrdinalMapping
+	builder *val.TupleBuilder
+	pool    pool.BuffPool
+}
+
+// SecondaryValueFromRow builds a secondary index value from a clustered index row's value tuple.
+func (b SecondaryValueBuilder) SecondaryValueFromRow(v val.Tuple) val.Tuple {
+	if b.builder == nil {
+		return val.EmptyTuple
+	}
+	for to, from := range b.mapping {
+		b.builder.PutRaw(to, v.GetField(from))
+	}
+	return b.builder.Build(b.pool)
+}
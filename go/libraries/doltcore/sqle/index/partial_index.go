@@ -0,0 +1,394 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// IndexPredicate evaluates the predicate of a partial index against rows of its table. Only rows for which the
+// predicate is true are stored in the index. The zero value matches every row.
+type IndexPredicate struct {
+	expr sql.Expression
+	sch  schema.Schema
+	ns   tree.NodeStore
+}
+
+// NewIndexPredicate returns an IndexPredicate for the secondary index |def| of the table with schema |sch|.
+func NewIndexPredicate(ctx *sql.Context, tableName string, sch schema.Schema, def schema.Index, ns tree.NodeStore) (IndexPredicate, error) {
+	if def.Predicate() == "" {
+		return IndexPredicate{}, nil
+	}
+	expr, err := expranalysis.ResolveIndexPredicate(ctx, tableName, sch, def.Predicate())
+	if err != nil {
+		return IndexPredicate{}, err
+	}
+	return IndexPredicate{expr: expr, sch: sch, ns: ns}, nil
+}
+
+// IsPartial returns whether the index stores only some rows of its table.
+func (p IndexPredicate) IsPartial() bool {
+	return p.expr != nil
+}
+
+// MatchesRow returns whether |row|, laid out in the order of the table's columns, is stored in the index.
+func (p IndexPredicate) MatchesRow(ctx context.Context, row sql.Row) (bool, error) {
+	return PredicateMatchesRow(ctx, p.expr, row)
+}
+
+// Matches returns whether the clustered index row |k|, |v| is stored in the index.
+func (p IndexPredicate) Matches(ctx context.Context, k, v val.Tuple) (bool, error) {
+	if p.expr == nil {
+		return true, nil
+	}
+	sqlCtx := sqlContext(ctx)
+	row, err := BuildRow(sqlCtx, k, v, p.sch, p.ns)
+	if err != nil {
+		return false, err
+	}
+	return p.MatchesRow(sqlCtx, row)
+}
+
+// PredicateMatchesRow returns whether |row| satisfies the resolved partial index predicate |expr|. A nil |expr|
+// matches every row.
+func PredicateMatchesRow(ctx context.Context, expr sql.Expression, row sql.Row) (bool, error) {
+	if expr == nil {
+		return true, nil
+	}
+	res, err := sql.EvaluateCondition(sqlContext(ctx), expr, row)
+	if err != nil {
+		return false, err
+	}
+	return sql.IsTrue(res), nil
+}
+
+// ValidateIndexPredicate returns an error if the resolved predicate |expr| can't be used by a partial index. Index
+// contents must only depend on the row being indexed, so predicates must be deterministic and can't use subqueries.
+func ValidateIndexPredicate(expr sql.Expression) (err error) {
+	sql.Inspect(expr, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				err = fmt.Errorf("partial index predicate cannot use non-deterministic expression %s", e.String())
+				return false
+			}
+		case *plan.Subquery:
+			err = fmt.Errorf("partial index predicate cannot use subquery %s", e.String())
+			return false
+		}
+		return true
+	})
+	return err
+}
+
+// PartialIndexLookup returns a lookup into a partial index of the table |t| that can answer a query filtered by the
+// conjunction of |filters|. A partial index can be used when |filters| imply its predicate, see predicateImplied.
+// Equality filters on a prefix of the index's columns narrow the lookup, and the partial index with the longest such
+// prefix is chosen. Rows returned by the lookup must still be filtered by |filters|.
+func PartialIndexLookup(ctx *sql.Context, db, tbl string, t *doltdb.Table, sch schema.Schema, filters []sql.Expression) (sql.IndexLookup, bool, error) {
+	if !hasPartialIndex(sch) {
+		return sql.IndexLookup{}, false, nil
+	}
+
+	var conds []sql.Expression
+	var eqCols []expression.LookupColumn
+	for _, f := range filters {
+		for _, c := range expression.SplitConjunction(f) {
+			if !referencesOnlyTable(db, tbl, c) {
+				continue
+			}
+			conds = append(conds, c)
+			if col, ok := expression.LookupEqualityColumn(db, tbl, c); ok && expression.PreciseComparison(col.Eq) && col.Lit.Value() != nil {
+				eqCols = append(eqCols, col)
+			}
+		}
+	}
+
+	var best sql.IndexLookup
+	bestPrefix := -1
+	for _, def := range sch.Indexes().AllIndexes() {
+		if def.Predicate() == "" {
+			continue
+		}
+		pred, err := expranalysis.ResolveIndexPredicate(ctx, tbl, sch, def.Predicate())
+		if err != nil {
+			return sql.IndexLookup{}, false, err
+		}
+		if !predicateImplied(pred, conds) {
+			continue
+		}
+
+		idx, err := getSecondaryIndex(ctx, db, tbl, t, sch, def)
+		if err != nil {
+			return sql.IndexLookup{}, false, err
+		}
+		rng, prefix := partialIndexRange(idx.(*doltIndex), eqCols)
+		if prefix > bestPrefix {
+			best = sql.NewIndexLookup(idx, sql.MySQLRangeCollection{rng}, false, false, false, false)
+			bestPrefix = prefix
+		}
+	}
+	return best, bestPrefix >= 0, nil
+}
+
+func hasPartialIndex(sch schema.Schema) bool {
+	for _, def := range sch.Indexes().AllIndexes() {
+		if def.Predicate() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// referencesOnlyTable returns whether every column referenced by |e| belongs to the table |tbl| of |db|. Filters on
+// other tables, such as correlated references to an outer scope, say nothing about the rows of |tbl|.
+func referencesOnlyTable(db, tbl string, e sql.Expression) bool {
+	ok := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			if !strings.EqualFold(e.Table(), tbl) || !strings.EqualFold(e.Database(), db) {
+				ok = false
+			}
+		case *plan.Subquery, sql.NonDeterministicExpression:
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// predicateImplied returns whether every row that satisfies all of |filters| satisfies the resolved partial index
+// predicate |pred|. This is the case when each conjunct of |pred| is implied by one of |filters|, see
+// conjunctImplied.
+func predicateImplied(pred sql.Expression, filters []sql.Expression) bool {
+	for _, conj := range expression.SplitConjunction(pred) {
+		implied := false
+		for _, f := range filters {
+			if conjunctImplied(conj, f) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+	return true
+}
+
+// conjunctImplied returns whether every row that satisfies |filter| satisfies |conj|. That's the case when they're
+// the same expression, or when both compare the same column to a constant and the values allowed by |filter| are a
+// subset of those allowed by |conj|, e.g. x > 10 implies x > 5. Any comparison to a constant also implies that the
+// column isn't NULL.
+func conjunctImplied(conj, filter sql.Expression) bool {
+	if expranalysis.NormalizeIndexPredicate(conj) == expranalysis.NormalizeIndexPredicate(filter) {
+		return true
+	}
+	bounds := filterBounds(filter)
+	if len(bounds) == 0 {
+		return false
+	}
+
+	if not, ok := conj.(*expression.Not); ok {
+		if isNull, ok := not.Child.(*expression.IsNull); ok {
+			if col, ok := isNull.Child.(*expression.GetField); ok {
+				return strings.EqualFold(col.Name(), bounds[0].col.Name())
+			}
+		}
+		return false
+	}
+
+	target, ok := columnBound(conj)
+	if !ok {
+		return false
+	}
+	for _, b := range bounds {
+		if strings.EqualFold(b.col.Name(), target.col.Name()) && boundImplies(b, target) {
+			return true
+		}
+	}
+	return false
+}
+
+type comparisonOp int
+
+const (
+	opEq comparisonOp = iota
+	opLt
+	opLte
+	opGt
+	opGte
+)
+
+// bound is a comparison of a column to a constant.
+type bound struct {
+	col *expression.GetField
+	op  comparisonOp
+	lit *expression.Literal
+}
+
+// filterBounds returns the comparisons of a single column to constants that |e| is the conjunction of.
+func filterBounds(e sql.Expression) []bound {
+	if b, ok := e.(*expression.Between); ok {
+		col, ok := b.Val.(*expression.GetField)
+		lower, lok := b.Lower.(*expression.Literal)
+		upper, uok := b.Upper.(*expression.Literal)
+		if !ok || !lok || !uok || lower.Value() == nil || upper.Value() == nil {
+			return nil
+		}
+		return []bound{{col: col, op: opGte, lit: lower}, {col: col, op: opLte, lit: upper}}
+	}
+	if b, ok := columnBound(e); ok {
+		return []bound{b}
+	}
+	return nil
+}
+
+// columnBound returns |e| as a comparison of a column to a non-NULL constant, if it is one.
+func columnBound(e sql.Expression) (bound, bool) {
+	var op comparisonOp
+	switch e.(type) {
+	case *expression.Equals:
+		op = opEq
+	case *expression.LessThan:
+		op = opLt
+	case *expression.LessThanOrEqual:
+		op = opLte
+	case *expression.GreaterThan:
+		op = opGt
+	case *expression.GreaterThanOrEqual:
+		op = opGte
+	default:
+		return bound{}, false
+	}
+	cmp := e.(expression.Comparer)
+	col, cok := cmp.Left().(*expression.GetField)
+	lit, lok := cmp.Right().(*expression.Literal)
+	if !cok || !lok {
+		// constant on the left, e.g. 5 < x, which is x > 5
+		col, cok = cmp.Right().(*expression.GetField)
+		lit, lok = cmp.Left().(*expression.Literal)
+		if !cok || !lok {
+			return bound{}, false
+		}
+		switch op {
+		case opLt:
+			op = opGt
+		case opLte:
+			op = opGte
+		case opGt:
+			op = opLt
+		case opGte:
+			op = opLte
+		}
+	}
+	if lit.Value() == nil {
+		return bound{}, false
+	}
+	return bound{col: col, op: op, lit: lit}, true
+}
+
+// boundImplies returns whether every value of a column satisfying |b| satisfies |target|.
+func boundImplies(b, target bound) bool {
+	c, ok := compareConstants(b.col.Type(), b.lit, target.lit)
+	if !ok {
+		return false
+	}
+	switch target.op {
+	case opEq:
+		return b.op == opEq && c == 0
+	case opGt:
+		return (b.op == opEq && c > 0) || (b.op == opGt && c >= 0) || (b.op == opGte && c > 0)
+	case opGte:
+		return (b.op == opEq || b.op == opGt || b.op == opGte) && c >= 0
+	case opLt:
+		return (b.op == opEq && c < 0) || (b.op == opLt && c <= 0) || (b.op == opLte && c < 0)
+	case opLte:
+		return (b.op == opEq || b.op == opLt || b.op == opLte) && c <= 0
+	}
+	return false
+}
+
+// compareConstants compares the constants |a| and |b| the way they compare to values of a column of type |typ|. It
+// returns false if they can't be compared that way, in which case no implication is drawn.
+func compareConstants(typ sql.Type, a, b *expression.Literal) (int, bool) {
+	switch {
+	case types.IsNumber(typ) && types.IsNumber(a.Type()) && types.IsNumber(b.Type()):
+		// numeric columns compare to numeric constants by value, whatever the constants' types
+		c, err := types.InternalDecimalType.Compare(a.Value(), b.Value())
+		return c, err == nil
+	case (types.IsText(typ) || types.IsTime(typ)) && types.IsText(a.Type()) && types.IsText(b.Type()):
+		// string constants are converted to the column's type, and compared using its collation
+		av, _, err := typ.Convert(a.Value())
+		if err != nil {
+			return 0, false
+		}
+		bv, _, err := typ.Convert(b.Value())
+		if err != nil {
+			return 0, false
+		}
+		c, err := typ.Compare(av, bv)
+		return c, err == nil
+	}
+	return 0, false
+}
+
+// partialIndexRange returns a range over |idx| that is closed on the longest prefix of its columns with an equality
+// in |eqCols|, and the length of that prefix.
+func partialIndexRange(idx *doltIndex, eqCols []expression.LookupColumn) (sql.MySQLRange, int) {
+	cets := idx.ColumnExpressionTypes()
+	rng := make(sql.MySQLRange, len(cets))
+	prefix := 0
+	for i, col := range idx.columns {
+		typ := cets[i].Type
+		rng[i] = sql.AllRangeColumnExpr(typ)
+		if prefix < i {
+			continue
+		}
+		for _, c := range eqCols {
+			if !strings.EqualFold(c.Col, col.Name) {
+				continue
+			}
+			// literals that don't convert cleanly are left to the filters
+			if k, _, err := typ.Convert(c.Lit.Value()); err == nil {
+				rng[i] = sql.ClosedRangeColumnExpr(k, k, typ)
+				prefix++
+			}
+			break
+		}
+	}
+	return rng, prefix
+}
+
+func sqlContext(ctx context.Context) *sql.Context {
+	if sqlCtx, ok := ctx.(*sql.Context); ok {
+		return sqlCtx
+	}
+	return sql.NewContext(ctx)
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicateImplied(t *testing.T) {
+	col := func(tbl, name string, typ sql.Type) *expression.GetField {
+		return expression.NewGetFieldWithTable(0, 1, typ, "mydb", tbl, name, true)
+	}
+	x := col("t", "x", types.Int32)
+	s := col("t", "s", types.Text)
+	deletedAt := col("t", "deleted_at", types.Datetime)
+	id := col("t", "id", types.Int64)
+	lit := func(v interface{}) *expression.Literal {
+		return expression.NewLiteral(v, types.Int8)
+	}
+	str := func(v string) *expression.Literal {
+		return expression.NewLiteral(v, types.LongText)
+	}
+
+	tests := []struct {
+		name    string
+		pred    sql.Expression
+		filters []sql.Expression
+		implied bool
+	}{
+		{
+			name:    "same expression",
+			pred:    expression.NewIsNull(deletedAt),
+			filters: []sql.Expression{expression.NewIsNull(deletedAt)},
+			implied: true,
+		},
+		{
+			name:    "conjuncts of the predicate are a subset of the filters",
+			pred:    expression.NewIsNull(deletedAt),
+			filters: []sql.Expression{expression.NewIsNull(deletedAt), expression.NewGreaterThan(id, lit(5))},
+			implied: true,
+		},
+		{
+			name:    "every conjunct of the predicate must be implied",
+			pred:    expression.NewAnd(expression.NewIsNull(deletedAt), expression.NewGreaterThan(id, lit(5))),
+			filters: []sql.Expression{expression.NewIsNull(deletedAt)},
+			implied: false,
+		},
+		{
+			name:    "narrower lower bound",
+			pred:    expression.NewGreaterThan(x, lit(5)),
+			filters: []sql.Expression{expression.NewGreaterThan(x, lit(10))},
+			implied: true,
+		},
+		{
+			name:    "wider lower bound",
+			pred:    expression.NewGreaterThan(x, lit(10)),
+			filters: []sql.Expression{expression.NewGreaterThan(x, lit(5))},
+			implied: false,
+		},
+		{
+			name:    "inclusive bound doesn't imply exclusive bound on the same constant",
+			pred:    expression.NewGreaterThan(x, lit(5)),
+			filters: []sql.Expression{expression.NewGreaterThanOrEqual(x, lit(5))},
+			implied: false,
+		},
+		{
+			name:    "exclusive bound implies inclusive bound on the same constant",
+			pred:    expression.NewGreaterThanOrEqual(x, lit(5)),
+			filters: []sql.Expression{expression.NewGreaterThan(x, lit(5))},
+			implied: true,
+		},
+		{
+			name:    "constant on the left",
+			pred:    expression.NewLessThan(x, lit(100)),
+			filters: []sql.Expression{expression.NewGreaterThan(lit(50), x)},
+			implied: true,
+		},
+		{
+			name:    "equality within a range",
+			pred:    expression.NewLessThanOrEqual(x, lit(10)),
+			filters: []sql.Expression{expression.NewEquals(x, lit(7))},
+			implied: true,
+		},
+		{
+			name:    "between within a range",
+			pred:    expression.NewAnd(expression.NewGreaterThan(x, lit(0)), expression.NewLessThan(x, lit(10))),
+			filters: []sql.Expression{expression.NewBetween(x, lit(1), lit(9))},
+			implied: true,
+		},
+		{
+			name:    "different columns",
+			pred:    expression.NewGreaterThan(x, lit(5)),
+			filters: []sql.Expression{expression.NewGreaterThan(id, lit(10))},
+			implied: false,
+		},
+		{
+			name:    "comparison implies not null",
+			pred:    expression.NewNot(expression.NewIsNull(x)),
+			filters: []sql.Expression{expression.NewEquals(x, lit(3))},
+			implied: true,
+		},
+		{
+			name:    "string ranges",
+			pred:    expression.NewGreaterThanOrEqual(s, str("b")),
+			filters: []sql.Expression{expression.NewEquals(s, str("c"))},
+			implied: true,
+		},
+		{
+			name:    "string constants aren't compared to numeric columns",
+			pred:    expression.NewGreaterThan(x, str("5")),
+			filters: []sql.Expression{expression.NewGreaterThan(x, str("10"))},
+			implied: false,
+		},
+		{
+			name:    "disjunctions only imply themselves",
+			pred:    expression.NewGreaterThan(x, lit(5)),
+			filters: []sql.Expression{expression.NewOr(expression.NewGreaterThan(x, lit(10)), expression.NewEquals(x, lit(1)))},
+			implied: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.implied, predicateImplied(test.pred, test.filters))
+		})
+	}
+}
+
+func TestReferencesOnlyTable(t *testing.T) {
+	x := expression.NewGetFieldWithTable(0, 1, types.Int32, "mydb", "t", "x", true)
+	y := expression.NewGetFieldWithTable(0, 2, types.Int32, "mydb", "u", "x", true)
+	assert.True(t, referencesOnlyTable("mydb", "t", expression.NewGreaterThan(x, expression.NewLiteral(1, types.Int8))))
+	assert.False(t, referencesOnlyTable("mydb", "t", expression.NewGreaterThan(y, expression.NewLiteral(1, types.Int8))))
+	assert.False(t, referencesOnlyTable("mydb", "t", expression.NewEquals(x, y)))
+}
@@ -16,6 +16,7 @@ package index
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -194,7 +195,7 @@ func newProllyCoveringIndexIter(
 	if idx.IsPrimaryKey() {
 		keyMap, valMap, ordMap = primaryIndexMapping(idx, projections)
 	} else {
-		keyMap, ordMap = coveringIndexMapping(idx, projections)
+		keyMap, valMap, ordMap = coveringIndexMapping(idx, projections)
 	}
 
 	return prollyCoveringIndexIter{
@@ -235,6 +236,10 @@ func (p prollyCoveringIndexIter) writeRowFromTuples(ctx context.Context, key, va
 		}
 	}
 
+	if len(p.valMap) > 0 && !p.idx.IsPrimaryKey() && value.Count() < p.valDesc.Count() {
+		// clients that predate included columns write empty values into these indexes
+		return fmt.Errorf("index '%s' is missing the values of its included columns, drop and recreate it to rebuild it", p.idx.ID())
+	}
 	for i, idx := range p.valMap {
 		outputIdx := p.ordMap[len(p.keyMap)+i]
 		r[outputIdx], err = tree.GetField(ctx, p.valDesc, idx, value, p.ns)
@@ -249,7 +254,11 @@ func (p prollyCoveringIndexIter) Close(*sql.Context) error {
 	return nil
 }
 
-func coveringIndexMapping(d DoltIndex, projections []uint64) (keyMap, ordMap val.OrdinalMapping) {
+func coveringIndexMapping(d DoltIndex, projections []uint64) (keyMap, valMap, ordMap val.OrdinalMapping) {
+	if d.IndexSchema().GetNonPKCols().Size() > 0 {
+		// included columns are read from the index's value tuples
+		return ProjectionMappingsForIndex(d.IndexSchema(), projections)
+	}
 	idx := d.IndexSchema().GetAllCols()
 	allMap := make(val.OrdinalMapping, len(projections)*2)
 	var i int
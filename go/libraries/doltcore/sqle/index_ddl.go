@@ -0,0 +1,650 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer/analyzererrors"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	sqltypes "github.com/dolthub/go-mysql-server/sql/types"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func init() {
+	// TODO: get rid of me, the engine should be handed its parser rather than reading a global
+	sql.GlobalParser = NewIndexDDLParser(sql.GlobalParser)
+}
+
+// IndexDDLParser is the parser of Dolt's engine. It extends the grammar of the parser it wraps with the forms of
+// CREATE INDEX that create Dolt's own kinds of indexes:
+//
+//	CREATE COLUMNAR INDEX name ON table (column, ...)
+//	CREATE [UNIQUE] INDEX name ON table (column, ...) [INCLUDE (column, ...)] [WHERE predicate]
+//
+// Columns named by INCLUDE are stored in the index's values, so that lookups projecting them don't need to read the
+// primary index. An index with a WHERE clause is a partial index, which only stores the rows matching its predicate.
+//
+// Statements using these extensions are returned as an ast.InjectedStatement holding the node that executes them.
+// Columnar and partial indexes are hidden from the engine, so DROP INDEX statements naming one are returned the same
+// way.
+type IndexDDLParser struct {
+	sql.Parser
+}
+
+var _ sql.Parser = IndexDDLParser{}
+
+// NewIndexDDLParser returns an IndexDDLParser extending |parser|.
+func NewIndexDDLParser(parser sql.Parser) IndexDDLParser {
+	return IndexDDLParser{Parser: parser}
+}
+
+// ParseSimple implements sql.Parser.
+func (p IndexDDLParser) ParseSimple(query string) (ast.Statement, error) {
+	stmt, err := p.Parser.ParseSimple(query)
+	if err == nil {
+		return stmt, nil
+	}
+	s := sql.RemoveSpaceAndDelimiter(query, ';')
+	idxStmt, next, ok, idxErr := parseIndexDDL(context.Background(), s, ast.ParserOptions{})
+	if !ok || next < len(s) {
+		return nil, err
+	}
+	return idxStmt, idxErr
+}
+
+// Parse implements sql.Parser.
+func (p IndexDDLParser) Parse(ctx *sql.Context, query string, multi bool) (ast.Statement, string, string, error) {
+	return p.ParseWithOptions(ctx, query, ';', multi, sql.LoadSqlMode(ctx).ParserOptions())
+}
+
+// ParseWithOptions implements sql.Parser.
+func (p IndexDDLParser) ParseWithOptions(ctx context.Context, query string, delimiter rune, multi bool, options ast.ParserOptions) (ast.Statement, string, string, error) {
+	stmt, parsed, remainder, err := p.Parser.ParseWithOptions(ctx, query, delimiter, multi, options)
+	if err == nil {
+		return injectIndexDrop(ctx, stmt), parsed, remainder, nil
+	}
+
+	s := sql.RemoveSpaceAndDelimiter(query, delimiter)
+	idxStmt, next, ok, idxErr := parseIndexDDL(ctx, s, options)
+	if !ok || (!multi && next < len(s)) {
+		return stmt, parsed, remainder, err
+	}
+	parsed, remainder = s, ""
+	if next < len(s) {
+		parsed, remainder = sql.RemoveSpaceAndDelimiter(s[:next], delimiter), s[next:]
+	}
+	return idxStmt, parsed, remainder, idxErr
+}
+
+// ParseOneWithOptions implements sql.Parser.
+func (p IndexDDLParser) ParseOneWithOptions(ctx context.Context, query string, options ast.ParserOptions) (ast.Statement, int, error) {
+	stmt, next, err := p.Parser.ParseOneWithOptions(ctx, query, options)
+	if err == nil {
+		return injectIndexDrop(ctx, stmt), next, nil
+	}
+
+	idxStmt, idxNext, ok, idxErr := parseIndexDDL(ctx, query, options)
+	if !ok {
+		return stmt, next, err
+	}
+	return idxStmt, idxNext, idxErr
+}
+
+// parseIndexDDL parses the first statement of |s| if it uses Dolt's extensions to CREATE INDEX, returning the offset
+// just past it. Returns false if the statement doesn't use them, in which case it isn't valid SQL at all.
+func parseIndexDDL(ctx context.Context, s string, options ast.ParserOptions) (ast.Statement, int, bool, error) {
+	toks, end, next, ok := scanStatement(s)
+	if !ok || len(toks) < 3 || !toks[0].isKeyword("create") {
+		return nil, 0, false, nil
+	}
+	i := 1
+	columnar := toks[i].isKeyword("columnar")
+	if columnar || toks[i].isKeyword("unique") {
+		i++
+	}
+	if i >= len(toks) || !toks[i].isKeyword("index") {
+		return nil, 0, false, nil
+	}
+
+	// Dolt's clauses follow the key part list, which is the first parenthesized list of the statement.
+	clause := len(toks)
+	for ; i < len(toks); i++ {
+		if toks[i].depth == 0 && toks[i].isPunct(")") {
+			break
+		}
+	}
+	for i++; i < len(toks); i++ {
+		if toks[i].isKeyword("include") || toks[i].isKeyword("where") {
+			clause = i
+			break
+		}
+	}
+	if !columnar && clause == len(toks) {
+		return nil, 0, false, nil
+	}
+
+	// Without Dolt's extensions the statement is a plain CREATE INDEX, which gives us the index's name, table and
+	// columns, as well as the privileges needed to create it.
+	base := s[:end]
+	if clause < len(toks) {
+		base = s[:toks[clause].start]
+	}
+	if columnar {
+		base = base[:toks[1].start] + base[toks[1].end:]
+	}
+	stmt, err := ast.ParseWithOptions(ctx, base, options)
+	if err != nil {
+		return nil, next, true, err
+	}
+	alter, ok := stmt.(*ast.AlterTable)
+	if !ok || len(alter.Statements) != 1 || alter.Statements[0].IndexSpec == nil {
+		return nil, 0, false, nil
+	}
+	ddl := alter.Statements[0]
+
+	idx, err := indexDefFromSpec(ddl.IndexSpec)
+	if err != nil {
+		return nil, next, true, err
+	}
+	node := &createIndexNode{
+		dbName:    alter.Table.DbQualifier.String(),
+		tableName: alter.Table.Name.String(),
+		idx:       idx,
+		columnar:  columnar,
+	}
+
+	rest := toks[clause:]
+	if len(rest) > 0 && rest[0].isKeyword("include") {
+		if columnar {
+			return nil, next, true, fmt.Errorf("columnar indexes cannot include columns")
+		}
+		node.include, rest, err = parseIncludeClause(rest[1:])
+		if err != nil {
+			return nil, next, true, err
+		}
+	}
+	if len(rest) > 0 && rest[0].isKeyword("where") {
+		if columnar {
+			return nil, next, true, fmt.Errorf("columnar indexes cannot be partial indexes")
+		}
+		// the predicate is everything up to the end of the statement, and is parsed when the index is created
+		node.predicate = strings.TrimSpace(s[rest[0].end:end])
+		if node.predicate == "" {
+			return nil, next, true, fmt.Errorf("WHERE must be followed by the predicate of the partial index")
+		}
+		rest = nil
+	}
+	if len(rest) > 0 {
+		return nil, next, true, fmt.Errorf("unexpected '%s' at the end of CREATE INDEX", rest[0].text)
+	}
+	return ast.InjectedStatement{Statement: node, Auth: ddl.Auth}, next, true, nil
+}
+
+// parseIncludeClause parses the column list of an INCLUDE clause at the start of |toks|, returning the tokens that
+// follow it.
+func parseIncludeClause(toks []ddlToken) ([]string, []ddlToken, error) {
+	if len(toks) > 0 && toks[0].isPunct("(") {
+		var columns []string
+		for i := 1; i+1 < len(toks) && toks[i].word; i += 2 {
+			columns = append(columns, toks[i].text)
+			if toks[i+1].isPunct(")") {
+				return columns, toks[i+2:], nil
+			} else if !toks[i+1].isPunct(",") {
+				break
+			}
+		}
+	}
+	return nil, nil, fmt.Errorf("INCLUDE must be followed by a parenthesized list of columns")
+}
+
+// indexDefFromSpec returns the definition of the index created by |spec|, the same way the engine builds it.
+func indexDefFromSpec(spec *ast.IndexSpec) (sql.IndexDef, error) {
+	idx := sql.IndexDef{
+		Name:       spec.ToName.String(),
+		Storage:    sql.IndexUsing_BTree,
+		Constraint: sql.IndexConstraint_None,
+		Columns:    make([]sql.IndexColumn, len(spec.Columns)),
+	}
+	if strings.EqualFold(idx.Name, ast.PrimaryStr) {
+		return sql.IndexDef{}, sql.ErrInvalidIndexName.New(idx.Name)
+	}
+	if spec.Type == ast.UniqueStr {
+		idx.Constraint = sql.IndexConstraint_Unique
+	}
+	for i, col := range spec.Columns {
+		var length int64
+		if col.Length != nil && col.Length.Type == ast.IntVal {
+			var err error
+			length, err = strconv.ParseInt(string(col.Length.Val), 10, 64)
+			if err != nil {
+				return sql.IndexDef{}, err
+			}
+			if length < 1 {
+				return sql.IndexDef{}, sql.ErrKeyZero.New(col.Column)
+			}
+		}
+		idx.Columns[i] = sql.IndexColumn{Name: col.Column.String(), Length: length}
+	}
+	for _, option := range spec.Options {
+		if strings.EqualFold(option.Name, ast.KeywordString(ast.COMMENT_KEYWORD)) {
+			idx.Comment = string(option.Value.Val)
+		}
+	}
+	return idx, nil
+}
+
+// injectIndexDrop returns |stmt|, or a statement dropping the index it names if |stmt| drops a columnar or partial
+// index. These indexes are hidden from the engine, which would otherwise reject the statement for naming an unknown
+// index.
+func injectIndexDrop(ctx context.Context, stmt ast.Statement) ast.Statement {
+	sqlCtx, ok := ctx.(*sql.Context)
+	if !ok {
+		return stmt
+	}
+	if _, ok = sqlCtx.Session.(*dsess.DoltSession); !ok {
+		return stmt
+	}
+	alter, ok := stmt.(*ast.AlterTable)
+	if !ok || len(alter.Statements) != 1 {
+		return stmt
+	}
+	ddl := alter.Statements[0]
+	spec := ddl.IndexSpec
+	if spec == nil || !strings.EqualFold(spec.Action, ast.DropStr) || spec.Type == ast.PrimaryStr {
+		return stmt
+	}
+
+	node := &dropIndexNode{
+		dbName:    alter.Table.DbQualifier.String(),
+		tableName: alter.Table.Name.String(),
+		indexName: spec.ToName.String(),
+	}
+	tbl, err := alterableTable(sqlCtx, node.dbName, node.tableName)
+	if err != nil {
+		return stmt
+	}
+	idx, ok := tbl.sch.Indexes().GetByNameCaseInsensitive(node.indexName)
+	if !ok || (!idx.IsColumnar() && idx.Predicate() == "") {
+		return stmt
+	}
+	return ast.InjectedStatement{Statement: node, Auth: ddl.Auth}
+}
+
+// alterableTable returns the table named |tableName| in the database named |dbName|, or in the current database if
+// |dbName| is empty.
+func alterableTable(ctx *sql.Context, dbName, tableName string) (*AlterableDoltTable, error) {
+	if dbName == "" {
+		dbName = ctx.GetCurrentDatabase()
+	}
+	if dbName == "" {
+		return nil, sql.ErrNoDatabaseSelected.New()
+	}
+	db, err := dsess.DSessFromSess(ctx.Session).Provider().Database(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	if rodb, ok := db.(sql.ReadOnlyDatabase); ok && rodb.IsReadOnly() {
+		return nil, analyzererrors.ErrReadOnlyDatabase.New(db.Name())
+	}
+	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrTableNotFound.New(tableName)
+	}
+	alterable, ok := tbl.(*AlterableDoltTable)
+	if !ok {
+		return nil, sql.ErrAlterTableNotSupported.New(tableName)
+	}
+	return alterable, nil
+}
+
+// createIndexNode creates an index using Dolt's extensions to CREATE INDEX.
+type createIndexNode struct {
+	dbName    string
+	tableName string
+	idx       sql.IndexDef
+	columnar  bool
+	include   []string
+	predicate string
+}
+
+var _ sql.ExecSourceRel = (*createIndexNode)(nil)
+var _ ast.Injectable = (*createIndexNode)(nil)
+
+// WithResolvedChildren implements ast.Injectable.
+func (n *createIndexNode) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// RowIter implements sql.ExecSourceRel.
+func (n *createIndexNode) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	tbl, err := alterableTable(ctx, n.dbName, n.tableName)
+	if err != nil {
+		return nil, err
+	}
+	if err = dsess.CheckAccessForDb(ctx, tbl.db, branch_control.Permissions_Write); err != nil {
+		return nil, err
+	}
+	includedTags, err := n.includedColumnTags(tbl)
+	if err != nil {
+		return nil, err
+	}
+	predicate, err := n.normalizedPredicate(ctx, tbl)
+	if err != nil {
+		return nil, err
+	}
+	err = tbl.createIndexWithProperties(ctx, n.idx, schema.IndexProperties{
+		IsUnique:      n.idx.Constraint == sql.IndexConstraint_Unique,
+		IsColumnar:    n.columnar,
+		IsUserDefined: true,
+		Comment:       n.idx.Comment,
+		IncludedTags:  includedTags,
+		Predicate:     predicate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sqltypes.NewOkResult(0))), nil
+}
+
+// includedColumnTags returns the tags of the columns named by the INCLUDE clause, checking that |tbl| can store them
+// in the index.
+func (n *createIndexNode) includedColumnTags(tbl *AlterableDoltTable) ([]uint64, error) {
+	if len(n.include) == 0 {
+		return nil, nil
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return nil, fmt.Errorf("included index columns are not supported by this storage format")
+	}
+	if hasNonZeroPrefixLength(n.idx.Columns) {
+		return nil, fmt.Errorf("index '%s' cannot include columns", n.idx.Name)
+	}
+
+	cols := tbl.sch.GetAllCols()
+	var indexedTags []uint64
+	for _, idxCol := range n.idx.Columns {
+		if col, ok := cols.GetByNameCaseInsensitive(idxCol.Name); ok {
+			indexedTags = append(indexedTags, col.Tag)
+		}
+	}
+	var includedTags []uint64
+	for _, name := range n.include {
+		col, ok := cols.GetByNameCaseInsensitive(name)
+		if !ok {
+			return nil, fmt.Errorf("column `%s` does not exist for the table", name)
+		}
+		switch {
+		case col.IsPartOfPK:
+			return nil, fmt.Errorf("primary key column `%s` is already stored in every index", col.Name)
+		case col.Virtual:
+			return nil, fmt.Errorf("virtual column `%s` cannot be included in an index", col.Name)
+		case slices.Contains(indexedTags, col.Tag):
+			return nil, fmt.Errorf("column `%s` is already indexed by '%s'", col.Name, n.idx.Name)
+		case slices.Contains(includedTags, col.Tag):
+			return nil, fmt.Errorf("column `%s` is included more than once", col.Name)
+		}
+		includedTags = append(includedTags, col.Tag)
+	}
+	return includedTags, nil
+}
+
+// normalizedPredicate returns the predicate of the WHERE clause in the form it's stored in the schema, checking that
+// |tbl| can have a partial index with it.
+func (n *createIndexNode) normalizedPredicate(ctx *sql.Context, tbl *AlterableDoltTable) (string, error) {
+	if n.predicate == "" {
+		return "", nil
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return "", fmt.Errorf("partial indexes are not supported by this storage format")
+	}
+	expr, err := expranalysis.ResolveIndexPredicate(ctx, tbl.Name(), tbl.sch, n.predicate)
+	if err != nil {
+		return "", fmt.Errorf("invalid partial index predicate: %w", err)
+	}
+	if err = index.ValidateIndexPredicate(expr); err != nil {
+		return "", err
+	}
+	return expranalysis.NormalizeIndexPredicate(expr), nil
+}
+
+// Resolved implements sql.Node.
+func (n *createIndexNode) Resolved() bool {
+	return true
+}
+
+// String implements sql.Node.
+func (n *createIndexNode) String() string {
+	columns := make([]string, len(n.idx.Columns))
+	for i, col := range n.idx.Columns {
+		columns[i] = col.Name
+	}
+	kind := "INDEX"
+	if n.columnar {
+		kind = "COLUMNAR INDEX"
+	} else if n.idx.Constraint == sql.IndexConstraint_Unique {
+		kind = "UNIQUE INDEX"
+	}
+	str := fmt.Sprintf("CREATE %s %s ON %s (%s)", kind, n.idx.Name, n.tableName, strings.Join(columns, ", "))
+	if len(n.include) > 0 {
+		str += fmt.Sprintf(" INCLUDE (%s)", strings.Join(n.include, ", "))
+	}
+	if n.predicate != "" {
+		str += " WHERE " + n.predicate
+	}
+	return str
+}
+
+// Schema implements sql.Node.
+func (n *createIndexNode) Schema() sql.Schema {
+	return sqltypes.OkResultSchema
+}
+
+// Children implements sql.Node.
+func (n *createIndexNode) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements sql.Node.
+func (n *createIndexNode) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(n, children...)
+}
+
+// IsReadOnly implements sql.Node.
+func (n *createIndexNode) IsReadOnly() bool {
+	return false
+}
+
+// dropIndexNode drops an index that is hidden from the engine.
+type dropIndexNode struct {
+	dbName    string
+	tableName string
+	indexName string
+}
+
+var _ sql.ExecSourceRel = (*dropIndexNode)(nil)
+var _ ast.Injectable = (*dropIndexNode)(nil)
+
+// WithResolvedChildren implements ast.Injectable.
+func (n *dropIndexNode) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 0)
+	}
+	return n, nil
+}
+
+// RowIter implements sql.ExecSourceRel.
+func (n *dropIndexNode) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	tbl, err := alterableTable(ctx, n.dbName, n.tableName)
+	if err != nil {
+		return nil, err
+	}
+	if err = tbl.DropIndex(ctx, n.indexName); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sqltypes.NewOkResult(0))), nil
+}
+
+// Resolved implements sql.Node.
+func (n *dropIndexNode) Resolved() bool {
+	return true
+}
+
+// String implements sql.Node.
+func (n *dropIndexNode) String() string {
+	return fmt.Sprintf("DROP INDEX %s ON %s", n.indexName, n.tableName)
+}
+
+// Schema implements sql.Node.
+func (n *dropIndexNode) Schema() sql.Schema {
+	return sqltypes.OkResultSchema
+}
+
+// Children implements sql.Node.
+func (n *dropIndexNode) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements sql.Node.
+func (n *dropIndexNode) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(n, children...)
+}
+
+// IsReadOnly implements sql.Node.
+func (n *dropIndexNode) IsReadOnly() bool {
+	return false
+}
+
+// ddlToken is a token of a statement scanned by scanStatement.
+type ddlToken struct {
+	// text is the text of the token, without the quotes of quoted identifiers and strings.
+	text string
+	// start and end are the offsets of the token in the statement.
+	start, end int
+	// depth is the number of parentheses enclosing the token.
+	depth int
+	// word is true for keywords and identifiers, and quoted is true for quoted identifiers and strings.
+	word, quoted bool
+}
+
+// isKeyword returns whether the token is the keyword |keyword| outside of any parentheses.
+func (t ddlToken) isKeyword(keyword string) bool {
+	return t.word && !t.quoted && t.depth == 0 && strings.EqualFold(t.text, keyword)
+}
+
+// isPunct returns whether the token is the punctuation character |punct|.
+func (t ddlToken) isPunct(punct string) bool {
+	return !t.word && !t.quoted && t.text == punct
+}
+
+// scanStatement splits the first statement of |s| into tokens, skipping comments. Returns the offset of the end of the
+// statement and the offset just past its terminating semicolon, which are both len(|s|) if it has none. Returns false
+// if the statement has an unterminated quote or comment, or unbalanced parentheses.
+func scanStatement(s string) (toks []ddlToken, end, next int, ok bool) {
+	depth := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case c == '#' || (c == '-' && strings.HasPrefix(s[i:], "--") && (i+2 == len(s) || s[i+2] <= ' ')):
+			if j := strings.IndexByte(s[i:], '\n'); j >= 0 {
+				i += j + 1
+			} else {
+				i = len(s)
+			}
+		case c == '/' && strings.HasPrefix(s[i:], "/*"):
+			j := strings.Index(s[i+2:], "*/")
+			if j < 0 {
+				return nil, 0, 0, false
+			}
+			i += j + 4
+		case c == '\'' || c == '"' || c == '`':
+			j, text, ok := scanQuoted(s, i)
+			if !ok {
+				return nil, 0, 0, false
+			}
+			toks = append(toks, ddlToken{text: text, start: i, end: j, depth: depth, word: c == '`', quoted: true})
+			i = j
+		case isWordByte(c):
+			j := i
+			for j < len(s) && isWordByte(s[j]) {
+				j++
+			}
+			toks = append(toks, ddlToken{text: s[i:j], start: i, end: j, depth: depth, word: true})
+			i = j
+		case c == ';' && depth == 0:
+			return toks, i, i + 1, true
+		default:
+			if c == ')' {
+				if depth == 0 {
+					return nil, 0, 0, false
+				}
+				depth--
+			}
+			toks = append(toks, ddlToken{text: s[i : i+1], start: i, end: i + 1, depth: depth})
+			if c == '(' {
+				depth++
+			}
+			i++
+		}
+	}
+	return toks, len(s), len(s), depth == 0
+}
+
+// scanQuoted scans the quoted identifier or string starting at |s|[|start|]. Returns the offset just past its closing
+// quote and its unquoted text.
+func scanQuoted(s string, start int) (int, string, bool) {
+	quote := s[start]
+	var sb strings.Builder
+	for i := start + 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && quote != '`' && i+1 < len(s):
+			i++
+			sb.WriteByte(s[i])
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			sb.WriteByte(quote)
+		case c == quote:
+			return i + 1, sb.String(), true
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return 0, "", false
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
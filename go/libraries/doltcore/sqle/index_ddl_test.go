@@ -0,0 +1,113 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	ast "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanStatement(t *testing.T) {
+	s := "create /* a; comment */ index `my``idx` on t (a, (b + 1)) -- trailing;\n where c = 'x;\\'y' # more;\n; select 1"
+	toks, end, next, ok := scanStatement(s)
+	require.True(t, ok)
+	assert.Equal(t, s[end:next], ";")
+	assert.Equal(t, " select 1", s[next:])
+
+	var texts []string
+	for _, tok := range toks {
+		texts = append(texts, tok.text)
+	}
+	assert.Equal(t, []string{"create", "index", "my`idx", "on", "t", "(", "a", ",", "(", "b", "+", "1", ")", ")", "where", "c", "=", "x;'y"}, texts)
+	assert.True(t, toks[0].isKeyword("CREATE"))
+	assert.False(t, toks[2].isKeyword("my`idx"))
+	assert.Equal(t, 1, toks[6].depth)
+	assert.Equal(t, 2, toks[9].depth)
+	assert.Equal(t, 0, toks[13].depth)
+
+	toks, end, next, ok = scanStatement("select 1")
+	require.True(t, ok)
+	assert.Len(t, toks, 2)
+	assert.Equal(t, 8, end)
+	assert.Equal(t, 8, next)
+
+	for _, s := range []string{"select 'unterminated", "select /* unterminated", "select (1", "select 1)"} {
+		_, _, _, ok = scanStatement(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestIndexDDLParser(t *testing.T) {
+	ctx := context.Background()
+	p := NewIndexDDLParser(sql.NewMysqlParser())
+
+	stmt, parsed, remainder, err := p.ParseWithOptions(ctx, "create columnar index c on db.t (a, b); select 1;", ';', true, ast.ParserOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "create columnar index c on db.t (a, b)", parsed)
+	assert.Equal(t, " select 1", remainder)
+	injected, ok := stmt.(ast.InjectedStatement)
+	require.True(t, ok)
+	node := injected.Statement.(*createIndexNode)
+	assert.Equal(t, "db", node.dbName)
+	assert.Equal(t, "t", node.tableName)
+	assert.True(t, node.columnar)
+	assert.Equal(t, "CREATE COLUMNAR INDEX c ON t (a, b)", node.String())
+
+	_, _, _, err = p.ParseWithOptions(ctx, "create columnar index c on t (a); select 1", ';', false, ast.ParserOptions{})
+	assert.Error(t, err)
+
+	stmt, next, err := p.ParseOneWithOptions(ctx, "create columnar index c on t (a);select 1", ast.ParserOptions{})
+	require.NoError(t, err)
+	assert.IsType(t, ast.InjectedStatement{}, stmt)
+	assert.Equal(t, len("create columnar index c on t (a);"), next)
+
+	stmt, err = p.ParseSimple("create columnar index c on t (a);")
+	require.NoError(t, err)
+	assert.IsType(t, ast.InjectedStatement{}, stmt)
+
+	stmt, err = p.ParseSimple("create unique index i on t (a) include (b, `c d`)")
+	require.NoError(t, err)
+	node = stmt.(ast.InjectedStatement).Statement.(*createIndexNode)
+	assert.Equal(t, sql.IndexConstraint_Unique, node.idx.Constraint)
+	assert.Equal(t, []string{"b", "c d"}, node.include)
+	assert.Equal(t, "CREATE UNIQUE INDEX i ON t (a) INCLUDE (b, c d)", node.String())
+	_, err = p.ParseSimple("create index i on t (a) include b")
+	assert.Error(t, err)
+
+	stmt, err = p.ParseSimple("create index i on t (a) include (b) where b > 0 and c = ';';")
+	require.NoError(t, err)
+	node = stmt.(ast.InjectedStatement).Statement.(*createIndexNode)
+	assert.Equal(t, []string{"b"}, node.include)
+	assert.Equal(t, "b > 0 and c = ';'", node.predicate)
+	assert.Equal(t, "CREATE INDEX i ON t (a) INCLUDE (b) WHERE b > 0 and c = ';'", node.String())
+	for _, q := range []string{"create index i on t (a) where", "create columnar index i on t (a) where a > 0"} {
+		_, err = p.ParseSimple(q)
+		assert.Error(t, err, q)
+	}
+
+	// Statements without Dolt's extensions are left to the wrapped parser.
+	stmt, err = p.ParseSimple("create index c on t (a)")
+	require.NoError(t, err)
+	assert.IsType(t, &ast.AlterTable{}, stmt)
+	_, err = p.ParseSimple("create columnar c on t (a)")
+	assert.Error(t, err)
+	_, err = p.ParseSimple("create columnar index c on t")
+	assert.Error(t, err)
+}
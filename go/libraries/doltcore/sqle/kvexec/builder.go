@@ -39,6 +39,8 @@ type Builder struct{}
 var _ sql.NodeExecBuilder = (*Builder)(nil)
 
 func (b Builder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, error) {
+	stats := execStatsFromContext(ctx)
+	stats.recordPlan(ctx, n, r)
 
 	// TODO: join optimization limits should be relaxed:
 	//  - expression types supported
@@ -66,7 +68,35 @@ func (b Builder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, er
 							split := len(srcTags)
 							projections := append(srcTags, dstTags...)
 							rowJoiner := newRowJoiner([]schema.Schema{srcSchema, dstIter.Schema()}, []int{split}, projections, dstIter.NodeStore())
-							return newLookupKvIter(srcIter, dstIter, keyLookupMapper, rowJoiner, srcFilter, dstFilter, n.Filter, n.Op.IsLeftOuter(), n.Op.IsExcludeNulls())
+							iter, err := newLookupKvIter(srcIter, dstIter, keyLookupMapper, rowJoiner, srcFilter, dstFilter, n.Filter, n.Op.IsLeftOuter(), n.Op.IsExcludeNulls())
+							if err == nil {
+								stats.recordFastPath(FastPathLookupJoin)
+							}
+							return iter, err
+						}
+					}
+				}
+			}
+		case n.Op.IsHash():
+			if hl, ok := n.Right().(*plan.HashLookup); ok && !n.Op.IsCross() && !n.Op.IsExcludeNulls() {
+				if _, _, srcIter, _, srcSchema, _, srcTags, srcFilter, err := getSourceKv(ctx, n.Left(), true); err == nil && srcSchema != nil {
+					if dstMap, _, dstIter, _, dstSchema, _, dstTags, dstFilter, err := getSourceKv(ctx, hl.Child, true); err == nil && dstSchema != nil {
+						srcKeys := newHashKeyMapping(srcSchema, tupleExpressions(hl.LeftProbeKey))
+						dstKeys := newHashKeyMapping(dstSchema, tupleExpressions(hl.RightEntryKey))
+						if srcKeys.compatible(dstKeys) {
+							// conditions:
+							// (1) inner or left hash join
+							// (2) both sides are something we read KVs from
+							// (3) the keys are columns whose encodings are equal
+							//     exactly when their values are equal
+							split := len(srcTags)
+							projections := append(srcTags, dstTags...)
+							rowJoiner := newRowJoiner([]schema.Schema{srcSchema, dstSchema}, []int{split}, projections, dstMap.NodeStore())
+							iter, err := newHashJoinKvIter(srcIter, dstIter, srcKeys, dstKeys, rowJoiner, srcFilter, dstFilter, n.Filter, n.Op.IsLeftOuter())
+							if err == nil {
+								stats.recordFastPath(FastPathHashJoin)
+							}
+							return iter, err
 						}
 					}
 				}
@@ -85,6 +115,7 @@ func (b Builder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, er
 						var rowJoiner *prollyToSqlJoiner
 						rowJoiner = newRowJoiner([]schema.Schema{leftState.priSch, rightState.priSch}, []int{split}, projections, leftState.idxMap.NodeStore())
 						if iter, err := newMergeKvIter(leftState, rightState, rowJoiner, lrCmp, llCmp, filters, n.Op.IsLeftOuter(), n.Op.IsExcludeNulls()); err == nil {
+							stats.recordFastPath(FastPathMergeJoin)
 							return iter, nil
 						}
 					}
@@ -94,22 +125,138 @@ func (b Builder) Build(ctx *sql.Context, n sql.Node, r sql.Row) (sql.RowIter, er
 	case *plan.GroupBy:
 		if len(n.GroupByExprs) == 0 && len(n.SelectedExprs) == 1 {
 			if cnt, ok := n.SelectedExprs[0].(*aggregation.Count); ok {
-				if _, _, srcIter, _, srcSchema, _, _, srcFilter, err := getSourceKv(ctx, n.Child, true); err == nil && srcSchema != nil && srcFilter == nil {
-					iter, ok, err := newCountAggregationKvIter(srcIter, srcSchema, cnt.Child)
+				if srcMap, _, srcIter, _, srcSchema, _, _, srcFilter, err := getSourceKv(ctx, n.Child, true); err == nil && srcSchema != nil && srcFilter == nil {
+					srcIters := []prolly.MapIter{srcIter}
+					if isTableScan(n.Child) {
+						// full table scans are split into key ranges
+						// that are counted concurrently
+						if colIters, ok, err := columnarTableScan(ctx, n.Child, true); err != nil {
+							return nil, err
+						} else if ok {
+							srcIters = colIters
+						} else if splitIters, err := splitTableScan(ctx, srcMap); err != nil {
+							return nil, err
+						} else if splitIters != nil {
+							srcIters = splitIters
+						}
+					}
+					iter, ok, err := newCountAggregationKvIter(srcIters, srcSchema, cnt.Child)
 					if ok && err == nil {
 						// (1) no grouping expressions (returns one row)
 						// (2) only one COUNT expression with a literal or field reference
 						// (3) table or ita as child (no filters)
+						stats.recordFastPath(FastPathCountAgg)
 						return iter, nil
 					}
 				}
 			}
 		}
+		if srcMap, _, srcIter, _, srcSchema, _, _, srcFilter, err := getSourceKv(ctx, n.Child, true); err == nil && srcSchema != nil && srcFilter == nil {
+			if isTableScan(n.Child) {
+				if colIters, ok, err := columnarTableScan(ctx, n.Child, false); err != nil {
+					return nil, err
+				} else if ok {
+					srcIter = colIters[0]
+				}
+			}
+			iter, ok, err := newGroupAggKvIter(srcIter, srcSchema, n.GroupByExprs, n.SelectedExprs, srcMap.NodeStore())
+			if ok && err == nil {
+				// (1) grouping expressions are column references
+				// (2) SUM, AVG, MIN and MAX of column references,
+				//     and other column references
+				// (3) table or ita as child (no filters)
+				stats.recordFastPath(FastPathGroupAgg)
+				return iter, nil
+			}
+		}
 	default:
 	}
 	return nil, nil
 }
 
+// isTableScan returns whether |n| reads every row of a table.
+func isTableScan(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.TableAlias:
+		return isTableScan(n.Child)
+	case *plan.ResolvedTable:
+		return true
+	default:
+		return false
+	}
+}
+
+// columnarTableScan returns iterators over the columnar index of the table
+// scanned by |n| if one projects every column |n| reads. Columnar iterators
+// don't produce primary keys, so |n| must not read any key columns. If
+// |split| is true, the index is split into key ranges that can be read
+// concurrently.
+func columnarTableScan(ctx *sql.Context, n sql.Node, split bool) ([]prolly.MapIter, bool, error) {
+	var dt *sqle.DoltTable
+	switch n := n.(type) {
+	case *plan.TableAlias:
+		return columnarTableScan(ctx, n.Child, split)
+	case *plan.ResolvedTable:
+		switch t := n.UnderlyingTable().(type) {
+		case *sqle.WritableDoltTable:
+			dt = t.DoltTable
+		case *sqle.AlterableDoltTable:
+			dt = t.DoltTable
+		case *sqle.DoltTable:
+			dt = t
+		}
+	}
+	if dt == nil {
+		return nil, false, nil
+	}
+
+	table, err := dt.DoltTable(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	idx := index.ColumnarIndexForTags(sch, dt.ProjectedTags())
+	if idx == nil {
+		return nil, false, nil
+	}
+	set, err := table.GetIndexSet(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	idxRows, err := set.GetIndex(ctx, sch, idx.Schema(), idx.Name())
+	if err != nil {
+		return nil, false, err
+	}
+	columnar := durable.ProllyMapFromIndex(idxRows)
+
+	iterRange := func(start, stop val.Tuple) (prolly.MapIter, error) {
+		return index.NewColumnarIter(ctx, sch, idx, columnar, start, stop)
+	}
+	if split {
+		iters, err := splitKeyRanges(ctx, columnar, iterRange)
+		if err != nil || iters != nil {
+			return iters, err == nil, err
+		}
+	}
+	iter, err := iterRange(nil, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return []prolly.MapIter{iter}, true, nil
+}
+
+// tupleExpressions returns the fields of |e| if it is a tuple, or |e|
+// itself otherwise.
+func tupleExpressions(e sql.Expression) []sql.Expression {
+	if tup, ok := e.(expression.Tuple); ok {
+		return tup
+	}
+	return []sql.Expression{e}
+}
+
 func getIta(n sql.Node) (*plan.IndexedTableAccess, bool) {
 	switch n := n.(type) {
 	case *plan.TableAlias:
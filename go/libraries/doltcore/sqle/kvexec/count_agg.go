@@ -15,17 +15,25 @@
 package kvexec
 
 import (
+	"context"
 	"io"
+	"runtime"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/val"
 )
 
-func newCountAggregationKvIter(srcIter prolly.MapIter, sch schema.Schema, e sql.Expression) (sql.RowIter, bool, error) {
+// newCountAggregationKvIter returns an iterator which counts the rows of
+// |srcIters| for which |e| is not NULL. Each of |srcIters| is scanned
+// concurrently.
+func newCountAggregationKvIter(srcIters []prolly.MapIter, sch schema.Schema, e sql.Expression) (sql.RowIter, bool, error) {
 	var nullable bool
 	var idx int
 	var isKeyRef bool
@@ -52,7 +60,7 @@ func newCountAggregationKvIter(srcIter prolly.MapIter, sch schema.Schema, e sql.
 	}
 
 	return &countAggKvIter{
-		srcIter:  srcIter,
+		srcIters: srcIters,
 		nullable: nullable,
 		isKeyRef: isKeyRef,
 		idx:      idx,
@@ -60,7 +68,7 @@ func newCountAggregationKvIter(srcIter prolly.MapIter, sch schema.Schema, e sql.
 }
 
 type countAggKvIter struct {
-	srcIter  prolly.MapIter
+	srcIters []prolly.MapIter
 	nullable bool
 	isKeyRef bool
 	idx      int
@@ -76,13 +84,39 @@ func (l *countAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 	if l.done {
 		return nil, io.EOF
 	}
+	var cnt int64
+	if len(l.srcIters) == 1 {
+		var err error
+		cnt, err = l.count(ctx, l.srcIters[0])
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		eg, egCtx := errgroup.WithContext(ctx)
+		for _, iter := range l.srcIters {
+			iter := iter
+			eg.Go(func() error {
+				c, err := l.count(egCtx, iter)
+				atomic.AddInt64(&cnt, c)
+				return err
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return nil, err
+		}
+	}
+	l.done = true
+	return sql.Row{cnt}, nil
+}
+
+func (l *countAggKvIter) count(ctx context.Context, srcIter prolly.MapIter) (int64, error) {
 	var cnt int64
 	for {
-		k, v, err := l.srcIter.Next(ctx)
+		k, v, err := srcIter.Next(ctx)
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return nil, err
+			return 0, err
 		}
 		if l.nullable {
 			if l.isKeyRef && k.FieldIsNull(l.idx) ||
@@ -92,6 +126,36 @@ func (l *countAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 		cnt++
 	}
-	l.done = true
-	return sql.Row{cnt}, nil
+	return cnt, nil
+}
+
+// splitTableScan returns iterators over balanced key ranges of |m| that
+// can be scanned concurrently, or nil if |m| is too small to split.
+func splitTableScan(ctx context.Context, m prolly.Map) ([]prolly.MapIter, error) {
+	return splitKeyRanges(ctx, m, func(start, stop val.Tuple) (prolly.MapIter, error) {
+		return m.IterKeyRange(ctx, start, stop)
+	})
+}
+
+// splitKeyRanges splits |m| into balanced key ranges and returns the
+// iterators |iterRange| makes for each, or nil if |m| is too small to split.
+func splitKeyRanges(ctx context.Context, m prolly.Map, iterRange func(start, stop val.Tuple) (prolly.MapIter, error)) ([]prolly.MapIter, error) {
+	splits, err := m.SplitKeyRange(ctx, nil, nil, runtime.GOMAXPROCS(0))
+	if err != nil || len(splits) == 0 {
+		return nil, err
+	}
+	iters := make([]prolly.MapIter, len(splits)+1)
+	var start val.Tuple
+	for i := range iters {
+		var stop val.Tuple
+		if i < len(splits) {
+			stop = splits[i]
+		}
+		iters[i], err = iterRange(start, stop)
+		if err != nil {
+			return nil, err
+		}
+		start = stop
+	}
+	return iters, nil
 }
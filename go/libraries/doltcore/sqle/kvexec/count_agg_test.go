@@ -16,11 +16,13 @@ package kvexec
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 	"github.com/stretchr/testify/require"
 
@@ -130,6 +132,48 @@ func TestCountAgg(t *testing.T) {
 	}
 }
 
+// TestParallelCountAgg ensures that counting a large table splits the scan
+// into key ranges, and that the ranges sum to the full count.
+func TestParallelCountAgg(t *testing.T) {
+	setup := []string{
+		"create table xyz (x int primary key, y int, z varchar(10))",
+		"insert into xyz with recursive r(i) as (select 0 union all select i+1 from r where i < 199) " +
+			"select a.i*100+b.i, if(b.i % 3 = 0, null, b.i), if(a.i % 7 = 0, null, 'z') from r a join r b where b.i < 100",
+	}
+	engine, ctx := newKvexecTestEngine(t, setup)
+	for _, q := range []string{
+		"select count(y) from xyz",
+		"select count(z) from xyz",
+	} {
+		t.Run(q, func(t *testing.T) {
+			binder := planbuilder.New(ctx, engine.EngineAnalyzer().Catalog, engine.EngineEventScheduler(), engine.Parser)
+			node, _, _, qFlags, err := binder.Parse(q, nil, false)
+			require.NoError(t, err)
+			node, err = engine.EngineAnalyzer().Analyze(ctx, node, nil, qFlags)
+			require.NoError(t, err)
+
+			agg := getAgg(node)
+			require.NotNil(t, agg)
+
+			iter, err := Builder{}.Build(ctx, agg, nil)
+			require.NoError(t, err)
+			cnt, ok := iter.(*countAggKvIter)
+			require.True(t, ok)
+			if runtime.GOMAXPROCS(0) > 1 {
+				require.Greater(t, len(cnt.srcIters), 1)
+			}
+
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			expIter, err := rowexec.DefaultBuilder.Build(ctx, agg, nil)
+			require.NoError(t, err)
+			expected, err := sql.RowIterToRows(ctx, expIter)
+			require.NoError(t, err)
+			require.Equal(t, expected, rows)
+		})
+	}
+}
+
 func getAgg(n sql.Node) sql.Node {
 	var ret sql.Node
 	transform.NodeWithOpaque(n, func(n sql.Node) (sql.Node, transform.TreeIdentity, error) {
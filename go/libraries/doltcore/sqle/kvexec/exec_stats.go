@@ -0,0 +1,103 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Names of the kvexec operators which Builder substitutes for the default GMS executors.
+const (
+	FastPathLookupJoin = "lookup_join"
+	FastPathMergeJoin  = "merge_join"
+	FastPathHashJoin   = "hash_join"
+	FastPathCountAgg   = "count_agg"
+	FastPathGroupAgg   = "group_agg"
+)
+
+type execStatsKey struct{}
+
+// ExecStats records how Builder executed a single statement: the plan it was given and the fast paths it used. It is
+// attached to a context with WithExecStats, and is safe to read once the statement has finished executing.
+type ExecStats struct {
+	mu        sync.Mutex
+	plan      sql.Node
+	database  string
+	fastPaths []string
+}
+
+// WithExecStats returns a context which records the execution of the statement run with it into the returned
+// ExecStats.
+func WithExecStats(ctx context.Context) (context.Context, *ExecStats) {
+	stats := &ExecStats{}
+	return context.WithValue(ctx, execStatsKey{}, stats), stats
+}
+
+func execStatsFromContext(ctx context.Context) *ExecStats {
+	stats, _ := ctx.Value(execStatsKey{}).(*ExecStats)
+	return stats
+}
+
+// Plan returns the root of the analyzed plan of the statement, or nil if it was never built.
+func (s *ExecStats) Plan() sql.Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.plan
+}
+
+// Database returns the current database of the session when the statement's plan was built.
+func (s *ExecStats) Database() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.database
+}
+
+// FastPaths returns the distinct kvexec operators used to execute the statement, in the order they were first used.
+func (s *ExecStats) FastPaths() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.fastPaths...)
+}
+
+// recordPlan records |n| as the statement's plan if it is the first node built without a parent row, which is the
+// root of the plan. Subqueries and the children of the root are built later, or with a parent row.
+func (s *ExecStats) recordPlan(ctx *sql.Context, n sql.Node, r sql.Row) {
+	if s == nil || len(r) != 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.plan == nil {
+		s.plan = n
+		s.database = ctx.GetCurrentDatabase()
+	}
+}
+
+func (s *ExecStats) recordFastPath(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, fp := range s.fastPaths {
+		if fp == name {
+			return
+		}
+	}
+	s.fastPaths = append(s.fastPaths, name)
+}
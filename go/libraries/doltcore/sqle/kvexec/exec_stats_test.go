@@ -0,0 +1,50 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecStats(t *testing.T) {
+	// Recording without stats in the context is a no-op
+	ctx := sql.NewContext(context.Background())
+	stats := execStatsFromContext(ctx)
+	require.Nil(t, stats)
+	stats.recordPlan(ctx, plan.NewEmptyTableWithSchema(nil), nil)
+	stats.recordFastPath(FastPathLookupJoin)
+
+	parent, stats := WithExecStats(context.Background())
+	ctx = sql.NewContext(parent)
+	require.Equal(t, stats, execStatsFromContext(ctx))
+
+	root := plan.NewEmptyTableWithSchema(nil)
+	stats.recordPlan(ctx, plan.NewEmptyTableWithSchema(nil), sql.Row{1})
+	assert.Nil(t, stats.Plan())
+	stats.recordPlan(ctx, root, nil)
+	stats.recordPlan(ctx, plan.NewEmptyTableWithSchema(nil), nil)
+	assert.True(t, stats.Plan() == root)
+
+	stats.recordFastPath(FastPathLookupJoin)
+	stats.recordFastPath(FastPathCountAgg)
+	stats.recordFastPath(FastPathLookupJoin)
+	assert.Equal(t, []string{FastPathLookupJoin, FastPathCountAgg}, stats.FastPaths())
+}
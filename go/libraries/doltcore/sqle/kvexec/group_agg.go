@@ -0,0 +1,286 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+type aggKind uint8
+
+const (
+	// aggFirst is a grouping column, or any other column which
+	// takes the first non-NULL value in the group
+	aggFirst aggKind = iota
+	aggSum
+	aggAvg
+	aggMin
+	aggMax
+)
+
+type groupAggExpr struct {
+	kind  aggKind
+	field fieldRef
+}
+
+// aggState accumulates one aggregation of a group. SUM and AVG
+// are summed as float64s to match the GMS aggregation buffers.
+type aggState struct {
+	sum  float64
+	rows int64
+	// best is the tuple holding the current first, MIN or MAX value
+	best val.Tuple
+}
+
+// newGroupAggKvIter returns an iterator that groups the KV pairs of
+// |srcIter| by the columns of |groupBy| and evaluates |selected| for each
+// group, or false if any of the expressions is unsupported.
+func newGroupAggKvIter(srcIter prolly.MapIter, sch schema.Schema, groupBy, selected []sql.Expression, ns tree.NodeStore) (sql.RowIter, bool, error) {
+	keys := newHashKeyMapping(sch, groupBy)
+	if keys == nil {
+		return nil, false, nil
+	}
+
+	exprs := make([]groupAggExpr, len(selected))
+	var hasAgg bool
+	for i, e := range selected {
+		var kind aggKind
+		var child sql.Expression
+		switch e := e.(type) {
+		case *expression.GetField:
+			kind, child = aggFirst, e
+		case *aggregation.Sum:
+			kind, child = aggSum, e.Child
+		case *aggregation.Avg:
+			kind, child = aggAvg, e.Child
+		case *aggregation.Min:
+			kind, child = aggMin, e.Child
+		case *aggregation.Max:
+			kind, child = aggMax, e.Child
+		default:
+			return nil, false, nil
+		}
+		if agg, ok := e.(sql.WindowAdaptableExpression); ok && agg.Window() != nil {
+			return nil, false, nil
+		}
+
+		f, ok := getFieldRef(sch, child)
+		if !ok {
+			return nil, false, nil
+		}
+		switch kind {
+		case aggSum, aggAvg:
+			if !numericField(f) {
+				return nil, false, nil
+			}
+		case aggMin, aggMax:
+			if !orderedField(f) {
+				return nil, false, nil
+			}
+		}
+		hasAgg = hasAgg || kind != aggFirst
+		exprs[i] = groupAggExpr{kind: kind, field: f}
+	}
+	if !hasAgg {
+		// DISTINCT and plain GROUP BY queries
+		return nil, false, nil
+	}
+
+	return &groupAggKvIter{
+		srcIter: srcIter,
+		keys:    keys,
+		exprs:   exprs,
+		ns:      ns,
+	}, true, nil
+}
+
+type groupAggKvIter struct {
+	srcIter prolly.MapIter
+	keys    *hashKeyMapping
+	exprs   []groupAggExpr
+	ns      tree.NodeStore
+
+	// groups are in the order they were first seen, like
+	// the GMS grouping iterator
+	groups   [][]aggState
+	computed bool
+	pos      int
+}
+
+var _ sql.RowIter = (*groupAggKvIter)(nil)
+
+func (l *groupAggKvIter) Close(_ *sql.Context) error {
+	return nil
+}
+
+func (l *groupAggKvIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !l.computed {
+		if err := l.compute(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if l.pos >= len(l.groups) {
+		return nil, io.EOF
+	}
+	states := l.groups[l.pos]
+	l.pos++
+
+	row := make(sql.Row, len(l.exprs))
+	for i, e := range l.exprs {
+		var err error
+		s := states[i]
+		switch e.kind {
+		case aggSum:
+			if s.rows > 0 {
+				row[i] = s.sum
+			}
+		case aggAvg:
+			if s.rows > 0 {
+				row[i] = s.sum / float64(s.rows)
+			}
+		case aggFirst, aggMin, aggMax:
+			if s.best != nil {
+				row[i], err = tree.GetField(ctx, e.field.desc, e.field.idx, s.best, l.ns)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+func (l *groupAggKvIter) compute(ctx *sql.Context) error {
+	index := make(map[string]int)
+	for {
+		k, v, err := l.srcIter.Next(ctx)
+		if err == io.EOF || k == nil && err == nil {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		hk := l.keys.hashKey(k, v)
+		i, ok := index[string(hk)]
+		if !ok {
+			i = len(l.groups)
+			index[string(hk)] = i
+			l.groups = append(l.groups, make([]aggState, len(l.exprs)))
+		}
+		states := l.groups[i]
+
+		for j, e := range l.exprs {
+			s := &states[j]
+			switch e.kind {
+			case aggSum, aggAvg:
+				if f, ok := fieldFloat64(e.field, k, v); ok {
+					s.sum += f
+					s.rows++
+				}
+			case aggFirst, aggMin, aggMax:
+				tup := e.field.tuple(k, v)
+				if e.field.desc.IsNull(e.field.idx, tup) {
+					continue
+				}
+				if s.best == nil {
+					s.best = tup
+					continue
+				}
+				cmp := e.field.desc.CompareField(e.field.desc.GetField(e.field.idx, s.best), e.field.idx, tup)
+				if e.kind == aggMin && cmp > 0 || e.kind == aggMax && cmp < 0 {
+					s.best = tup
+				}
+			}
+		}
+	}
+
+	if len(l.groups) == 0 && len(l.keys.fields) == 0 {
+		// without grouping columns there is always one result row
+		l.groups = append(l.groups, make([]aggState, len(l.exprs)))
+	}
+	l.computed = true
+	return nil
+}
+
+// numericField returns whether |f| can be summed as a float64.
+func numericField(f fieldRef) bool {
+	switch f.typ().Enc {
+	case val.Int8Enc, val.Uint8Enc, val.Int16Enc, val.Uint16Enc,
+		val.Int32Enc, val.Uint32Enc, val.Int64Enc, val.Uint64Enc,
+		val.Float32Enc, val.Float64Enc:
+		return true
+	default:
+		return false
+	}
+}
+
+// orderedField returns whether the encoding of |f| orders its values
+// the same way SQL does.
+func orderedField(f fieldRef) bool {
+	switch f.typ().Enc {
+	case val.YearEnc, val.DateEnc, val.TimeEnc, val.DatetimeEnc:
+		return true
+	default:
+		return numericField(f)
+	}
+}
+
+// fieldFloat64 returns the value of |f| in (|k|, |v|) as a float64, or
+// false if it is NULL.
+func fieldFloat64(f fieldRef, k, v val.Tuple) (float64, bool) {
+	tup := f.tuple(k, v)
+	switch f.typ().Enc {
+	case val.Int8Enc:
+		n, ok := f.desc.GetInt8(f.idx, tup)
+		return float64(n), ok
+	case val.Uint8Enc:
+		n, ok := f.desc.GetUint8(f.idx, tup)
+		return float64(n), ok
+	case val.Int16Enc:
+		n, ok := f.desc.GetInt16(f.idx, tup)
+		return float64(n), ok
+	case val.Uint16Enc:
+		n, ok := f.desc.GetUint16(f.idx, tup)
+		return float64(n), ok
+	case val.Int32Enc:
+		n, ok := f.desc.GetInt32(f.idx, tup)
+		return float64(n), ok
+	case val.Uint32Enc:
+		n, ok := f.desc.GetUint32(f.idx, tup)
+		return float64(n), ok
+	case val.Int64Enc:
+		n, ok := f.desc.GetInt64(f.idx, tup)
+		return float64(n), ok
+	case val.Uint64Enc:
+		n, ok := f.desc.GetUint64(f.idx, tup)
+		return float64(n), ok
+	case val.Float32Enc:
+		n, ok := f.desc.GetFloat32(f.idx, tup)
+		return float64(n), ok
+	case val.Float64Enc:
+		return f.desc.GetFloat64(f.idx, tup)
+	default:
+		return 0, false
+	}
+}
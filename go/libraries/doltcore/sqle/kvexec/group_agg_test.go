@@ -0,0 +1,138 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGroupAgg ensures that we trigger the operator replacement for
+// expected query patterns, and that it returns the same rows as the
+// default aggregation operator.
+func TestGroupAgg(t *testing.T) {
+	setup := []string{
+		"create table xy (x int primary key, y int, z double, d datetime, s varchar(10), c varchar(10) collate utf8mb4_0900_ai_ci, m decimal(10,2))",
+		"create table ab (x int primary key, y int)",
+		"create table keyless (k int, l int)",
+		"insert into xy values (0,0,1.5,'2020-01-01','a','a',1), (1,1,null,'2021-01-01','b','A',2), (2,1,2.5,null,'a','b',3), (3,null,-1,'2019-01-01',null,null,null)",
+		"insert into keyless values (1,1), (1,1), (2,2), (null,null)",
+	}
+	tests := []struct {
+		name      string
+		query     string
+		doRowexec bool
+	}{
+		{
+			name:      "accept group by aggregation",
+			query:     "select y, sum(x), avg(z), min(d), max(x) from xy group by y",
+			doRowexec: true,
+		},
+		{
+			name:      "accept aggregation without grouping",
+			query:     "select sum(x), min(z), max(d) from xy",
+			doRowexec: true,
+		},
+		{
+			name:      "accept aggregation of empty table",
+			query:     "select sum(x), avg(y), min(y) from ab",
+			doRowexec: true,
+		},
+		{
+			name:      "accept group by string column",
+			query:     "select s, sum(z), max(z) from xy group by s",
+			doRowexec: true,
+		},
+		{
+			name:      "accept multiple grouping columns",
+			query:     "select y, s, avg(x) from xy group by y, s",
+			doRowexec: true,
+		},
+		{
+			name:      "accept keyless table",
+			query:     "select k, sum(l), avg(l) from keyless group by k",
+			doRowexec: true,
+		},
+		{
+			name:      "reject case-insensitive grouping column",
+			query:     "select c, sum(x) from xy group by c",
+			doRowexec: false,
+		},
+		{
+			name:      "reject decimal sum",
+			query:     "select y, sum(m) from xy group by y",
+			doRowexec: false,
+		},
+		{
+			name:      "accept indexed range child",
+			query:     "select y, sum(x) from xy where x > 0 group by y",
+			doRowexec: true,
+		},
+		{
+			name:      "reject filter child",
+			query:     "select y, sum(x) from xy where z > 0 group by y",
+			doRowexec: false,
+		},
+		{
+			name:      "reject complex aggregation expression",
+			query:     "select y, sum(x+1) from xy group by y",
+			doRowexec: false,
+		},
+		{
+			name:      "reject count",
+			query:     "select y, count(x) from xy group by y",
+			doRowexec: false,
+		},
+		{
+			name:      "reject distinct aggregation",
+			query:     "select y, sum(distinct x) from xy group by y",
+			doRowexec: false,
+		},
+	}
+
+	engine, ctx := newKvexecTestEngine(t, setup)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binder := planbuilder.New(ctx, engine.EngineAnalyzer().Catalog, engine.EventScheduler, engine.Parser)
+			node, _, _, qFlags, err := binder.Parse(tt.query, nil, false)
+			require.NoError(t, err)
+			node, err = engine.EngineAnalyzer().Analyze(ctx, node, nil, qFlags)
+			require.NoError(t, err)
+
+			agg := getAgg(node)
+			require.NotNil(t, agg)
+
+			iter, err := Builder{}.Build(ctx, agg, nil)
+			require.NoError(t, err)
+			_, ok := iter.(*groupAggKvIter)
+			require.Equalf(t, tt.doRowexec, ok, "expected do row exec: %t", tt.doRowexec)
+			if !ok {
+				return
+			}
+
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			expIter, err := rowexec.DefaultBuilder.Build(ctx, agg, nil)
+			require.NoError(t, err)
+			expected, err := sql.RowIterToRows(ctx, expIter)
+			require.NoError(t, err)
+			require.Equal(t, expected, rows)
+		})
+	}
+}
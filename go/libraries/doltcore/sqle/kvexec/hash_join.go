@@ -0,0 +1,322 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// hashJoinKvIter joins two KV sources on equality of their key fields.
+// The right (build) side is read into a hash table keyed by the raw bytes
+// of its key fields, and the left (probe) side is streamed against it.
+type hashJoinKvIter struct {
+	srcIter prolly.MapIter
+	srcKey  val.Tuple
+	srcVal  val.Tuple
+
+	// buildIter is drained into |table| on the first call to Next
+	buildIter prolly.MapIter
+	table     map[string][]kvPair
+	built     bool
+
+	srcKeys   *hashKeyMapping
+	buildKeys *hashKeyMapping
+
+	// matches are the build side pairs with the current probe key
+	matches []kvPair
+	pos     int
+	probing bool
+
+	// projections
+	joiner *prollyToSqlJoiner
+
+	srcFilter  sql.Expression
+	dstFilter  sql.Expression
+	joinFilter sql.Expression
+
+	// LEFT_JOIN impl details
+	isLeftJoin   bool
+	returnedARow bool
+}
+
+type kvPair struct {
+	key, val val.Tuple
+}
+
+var _ sql.RowIter = (*hashJoinKvIter)(nil)
+
+func newHashJoinKvIter(
+	srcIter, buildIter prolly.MapIter,
+	srcKeys, buildKeys *hashKeyMapping,
+	joiner *prollyToSqlJoiner,
+	srcFilter, dstFilter, joinFilter sql.Expression,
+	isLeftJoin bool,
+) (*hashJoinKvIter, error) {
+	if lit, ok := joinFilter.(*expression.Literal); ok {
+		if lit.Value() == true {
+			joinFilter = nil
+		}
+	}
+
+	return &hashJoinKvIter{
+		srcIter:    srcIter,
+		buildIter:  buildIter,
+		srcKeys:    srcKeys,
+		buildKeys:  buildKeys,
+		joiner:     joiner,
+		srcFilter:  srcFilter,
+		dstFilter:  dstFilter,
+		joinFilter: joinFilter,
+		isLeftJoin: isLeftJoin,
+	}, nil
+}
+
+func (l *hashJoinKvIter) Close(_ *sql.Context) error {
+	return nil
+}
+
+func (l *hashJoinKvIter) build(ctx *sql.Context) error {
+	l.table = make(map[string][]kvPair)
+	for {
+		k, v, err := l.buildIter.Next(ctx)
+		if err == io.EOF || k == nil && err == nil {
+			break
+		} else if err != nil {
+			return err
+		}
+		hk := string(l.buildKeys.hashKey(k, v))
+		l.table[hk] = append(l.table[hk], kvPair{key: k, val: v})
+	}
+	l.built = true
+	return nil
+}
+
+func (l *hashJoinKvIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !l.built {
+		if err := l.build(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for {
+		if !l.probing {
+			// (1) read the next KV pair from the probe side
+			// (2) find the build side pairs with the same key
+			l.returnedARow = false
+
+			var err error
+			l.srcKey, l.srcVal, err = l.srcIter.Next(ctx)
+			if err == io.EOF || l.srcKey == nil && err == nil {
+				return nil, io.EOF
+			} else if err != nil {
+				return nil, err
+			}
+
+			l.matches = l.table[string(l.srcKeys.hashKey(l.srcKey, l.srcVal))]
+			l.pos = 0
+			l.probing = true
+		}
+
+		var dstKey, dstVal val.Tuple
+		if l.pos < len(l.matches) {
+			dstKey, dstVal = l.matches[l.pos].key, l.matches[l.pos].val
+			l.pos++
+		} else {
+			l.probing = false
+			emitLeftJoinNullRow := l.isLeftJoin && !l.returnedARow
+			if !emitLeftJoinNullRow {
+				continue
+			}
+		}
+
+		ret, err := l.joiner.buildRow(ctx, l.srcKey, l.srcVal, dstKey, dstVal)
+		if err != nil {
+			return nil, err
+		}
+
+		// side-specific filters are currently hoisted
+		if l.srcFilter != nil {
+			res, err := sql.EvaluateCondition(ctx, l.srcFilter, ret[:l.joiner.kvSplits[0]])
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				// no build side pair can pass the filter either
+				l.probing = false
+				continue
+			}
+		}
+		if l.dstFilter != nil && dstKey != nil {
+			res, err := sql.EvaluateCondition(ctx, l.dstFilter, ret[l.joiner.kvSplits[0]:])
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				continue
+			}
+		}
+		if l.joinFilter != nil && dstKey != nil {
+			// the hash key can match on NULLs, which only the join
+			// filter can accept or reject
+			res, err := sql.EvaluateCondition(ctx, l.joinFilter, ret)
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				continue
+			}
+		}
+		l.returnedARow = true
+		return ret, nil
+	}
+}
+
+// hashKeyMapping reads the fields of a KV pair that make up a hash join
+// or grouping key.
+type hashKeyMapping struct {
+	fields []fieldRef
+	buf    []byte
+}
+
+// fieldRef locates a column in a KV pair.
+type fieldRef struct {
+	isKeyRef bool
+	idx      int
+	desc     val.TupleDesc
+	col      schema.Column
+}
+
+func (f fieldRef) typ() val.Type {
+	return f.desc.Types[f.idx]
+}
+
+func (f fieldRef) field(k, v val.Tuple) []byte {
+	if f.isKeyRef {
+		return f.desc.GetField(f.idx, k)
+	}
+	return f.desc.GetField(f.idx, v)
+}
+
+func (f fieldRef) tuple(k, v val.Tuple) val.Tuple {
+	if f.isKeyRef {
+		return k
+	}
+	return v
+}
+
+// getFieldRef returns the location of the column referenced by |e| in the
+// KV pairs of a table with schema |sch|, or false if |e| isn't a reference
+// to a stored column.
+func getFieldRef(sch schema.Schema, e sql.Expression) (fieldRef, bool) {
+	gf, ok := e.(*expression.GetField)
+	if !ok {
+		return fieldRef{}, false
+	}
+	col, ok := sch.GetAllCols().LowerNameToCol[strings.ToLower(gf.Name())]
+	if !ok || col.Virtual {
+		return fieldRef{}, false
+	}
+	if col.IsPartOfPK {
+		idx, _ := sch.GetPKCols().StoredIndexByTag(col.Tag)
+		return fieldRef{isKeyRef: true, idx: idx, desc: sch.GetKeyDescriptor(), col: col}, true
+	}
+	idx, _ := sch.GetNonPKCols().StoredIndexByTag(col.Tag)
+	if schema.IsKeyless(sch) {
+		// skip cardinality column
+		idx++
+	}
+	return fieldRef{idx: idx, desc: sch.GetValueDescriptor(), col: col}, true
+}
+
+// newHashKeyMapping returns a mapping for the columns referenced by |exprs|,
+// or nil if any of them isn't a column with a hashable encoding.
+func newHashKeyMapping(sch schema.Schema, exprs []sql.Expression) *hashKeyMapping {
+	fields := make([]fieldRef, len(exprs))
+	for i, e := range exprs {
+		f, ok := getFieldRef(sch, e)
+		if !ok || !hashableField(f) {
+			return nil
+		}
+		fields[i] = f
+	}
+	return &hashKeyMapping{fields: fields}
+}
+
+// compatible returns whether equal values of |m| and |other| have equal
+// hash keys.
+func (m *hashKeyMapping) compatible(other *hashKeyMapping) bool {
+	if m == nil || other == nil || len(m.fields) != len(other.fields) {
+		return false
+	}
+	for i := range m.fields {
+		if m.fields[i].typ().Enc != other.fields[i].typ().Enc {
+			return false
+		}
+		if collation(m.fields[i].col) != collation(other.fields[i].col) {
+			return false
+		}
+	}
+	return true
+}
+
+// hashKey returns the key fields of (|k|, |v|) concatenated into a single
+// buffer. The buffer is reused by the next call.
+func (m *hashKeyMapping) hashKey(k, v val.Tuple) []byte {
+	m.buf = m.buf[:0]
+	for _, f := range m.fields {
+		field := f.field(k, v)
+		if field == nil {
+			m.buf = append(m.buf, 0)
+			continue
+		}
+		m.buf = append(m.buf, 1)
+		m.buf = binary.AppendUvarint(m.buf, uint64(len(field)))
+		m.buf = append(m.buf, field...)
+	}
+	return m.buf
+}
+
+// hashableField returns whether two values of |f| are equal exactly when
+// their encoded bytes are equal.
+func hashableField(f fieldRef) bool {
+	switch f.typ().Enc {
+	case val.Int8Enc, val.Uint8Enc, val.Int16Enc, val.Uint16Enc,
+		val.Int32Enc, val.Uint32Enc, val.Int64Enc, val.Uint64Enc,
+		val.Bit64Enc, val.YearEnc, val.DateEnc, val.TimeEnc, val.DatetimeEnc:
+		return true
+	case val.StringEnc, val.ByteStringEnc:
+		// case-insensitive and padded collations compare unequal
+		// bytes as equal
+		c := collation(f.col)
+		return c == sql.Collation_utf8mb4_0900_bin || c == sql.Collation_binary
+	default:
+		return false
+	}
+}
+
+func collation(col schema.Column) sql.CollationID {
+	if st, ok := col.TypeInfo.ToSqlType().(sql.StringType); ok {
+		return st.Collation()
+	}
+	return sql.Collation_Unspecified
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvexec
+
+import (
+	"context"
+	"testing"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
+)
+
+// TestHashJoin ensures that we trigger the operator replacement for
+// expected query patterns, and that it returns the same rows as the
+// default join operator.
+func TestHashJoin(t *testing.T) {
+	setup := []string{
+		"create table xy (x int primary key, y int, z varchar(10), w varchar(10) collate utf8mb4_0900_ai_ci)",
+		"create table ab (a int primary key, b int, c varchar(10), d varchar(10) collate utf8mb4_0900_ai_ci)",
+		"create table uv (u bigint primary key, v bigint)",
+		"create table keyless (k int, l int)",
+		"insert into xy values (0,0,'a','a'), (1,1,'b','b'), (2,1,'c','c'), (3,null,null,null)",
+		"insert into ab values (0,1,'a','A'), (1,1,'b','B'), (2,2,'z','z'), (3,null,null,null)",
+		"insert into uv values (0,1), (1,2)",
+		"insert into keyless values (1,1), (1,1), (2,2), (null,null)",
+	}
+	tests := []struct {
+		name      string
+		join      string
+		doRowexec bool
+	}{
+		{
+			name:      "accept hash join on non-indexed columns",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy join ab on y = b",
+			doRowexec: true,
+		},
+		{
+			name:      "accept hash join with filters",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy join ab on y = b where x > 0 and a < 2",
+			doRowexec: true,
+		},
+		{
+			name:      "accept left hash join",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy left join ab on y = b",
+			doRowexec: true,
+		},
+		{
+			name:      "accept multi column hash join",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy join ab on y = b and z = c",
+			doRowexec: true,
+		},
+		{
+			name:      "accept keyless hash join",
+			join:      "select /*+ HASH_JOIN(xy,keyless) */ * from xy join keyless on y = l",
+			doRowexec: true,
+		},
+		{
+			name:      "reject case-insensitive collation",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy join ab on w = d",
+			doRowexec: false,
+		},
+		{
+			name:      "reject type incompatibility",
+			join:      "select /*+ HASH_JOIN(xy,uv) */ * from xy join uv on y = v",
+			doRowexec: false,
+		},
+		{
+			name:      "reject complex join expression",
+			join:      "select /*+ HASH_JOIN(xy,ab) */ * from xy join ab on y + 1 = b",
+			doRowexec: false,
+		},
+	}
+
+	engine, ctx := newKvexecTestEngine(t, setup)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binder := planbuilder.New(ctx, engine.EngineAnalyzer().Catalog, engine.EventScheduler, engine.Parser)
+			node, _, _, qFlags, err := binder.Parse(tt.join, nil, false)
+			require.NoError(t, err)
+			node, err = engine.EngineAnalyzer().Analyze(ctx, node, nil, qFlags)
+			require.NoError(t, err)
+
+			j := getJoin(node)
+			require.NotNil(t, j)
+
+			iter, err := Builder{}.Build(ctx, j, nil)
+			require.NoError(t, err)
+			_, ok := iter.(*hashJoinKvIter)
+			require.Equalf(t, tt.doRowexec, ok, "expected do row exec: %t", tt.doRowexec)
+			if !ok {
+				return
+			}
+
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			expIter, err := rowexec.DefaultBuilder.Build(ctx, j, nil)
+			require.NoError(t, err)
+			expected, err := sql.RowIterToRows(ctx, expIter)
+			require.NoError(t, err)
+			require.ElementsMatch(t, expected, rows)
+		})
+	}
+}
+
+func newKvexecTestEngine(t *testing.T, setup []string) (*gms.Engine, *sql.Context) {
+	dEnv := dtestutils.CreateTestEnv()
+	t.Cleanup(func() {
+		dEnv.DoltDB.Close()
+	})
+
+	tmpDir, err := dEnv.TempTableFilesDir()
+	require.NoError(t, err)
+
+	opts := editor.Options{Deaf: dEnv.DbEaFactory(), Tempdir: tmpDir}
+	db, err := sqle.NewDatabase(context.Background(), "dolt", dEnv.DbData(), opts)
+	require.NoError(t, err)
+
+	engine, ctx, err := sqle.NewTestEngine(dEnv, context.Background(), db)
+	require.NoError(t, err)
+
+	err = ctx.Session.SetSessionVariable(ctx, sql.AutoCommitSessionVar, false)
+	require.NoError(t, err)
+
+	for _, q := range setup {
+		_, iter, _, err := engine.Query(ctx, q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+	}
+	return engine, ctx
+}
@@ -184,6 +184,22 @@ func ProllyRowIterFromPartition(
 	partition doltTablePartition,
 ) (sql.RowIter, error) {
 	rows := durable.ProllyMapFromIndex(partition.rowData)
+	if partition.columnar != nil {
+		iter, err := index.NewColumnarIter(ctx, sch, partition.columnar, rows, partition.startKey, partition.endKey)
+		if err != nil {
+			return nil, err
+		}
+		kd, vd := sch.GetMapDescriptors()
+		return index.NewProllyRowIterForSchema(sch, iter, kd, vd, projections, rows.NodeStore()), nil
+	}
+	if partition.isKeyRange() {
+		iter, err := rows.IterKeyRange(ctx, partition.startKey, partition.endKey)
+		if err != nil {
+			return nil, err
+		}
+		return index.NewProllyRowIterForMap(sch, rows, iter, projections), nil
+	}
+
 	c, err := rows.Count()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,95 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Entry is a single statement recorded in the slow query log.
+type Entry struct {
+	Time         time.Time `json:"time"`
+	ConnectionID uint32    `json:"connection_id"`
+	User         string    `json:"user"`
+	Host         string    `json:"host"`
+	Database     string    `json:"database,omitempty"`
+	Query        string    `json:"query"`
+	DurationMs   float64   `json:"duration_ms"`
+	RowsSent     uint64    `json:"rows_sent"`
+	RowsAffected uint64    `json:"rows_affected"`
+	// Indexes are the indexes chosen by the plan, as table.index.
+	Indexes []string `json:"indexes,omitempty"`
+	// FastPaths are the kvexec operators used to execute the plan.
+	FastPaths []string `json:"fast_paths,omitempty"`
+	// Plan is the plan as shown by EXPLAIN PLAN, annotated with the actual row counts of operators which track them.
+	Plan  string `json:"plan,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Buffer holds the most recent entries of a slow query log.
+type Buffer struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+}
+
+// NewBuffer returns a Buffer which retains the last |size| entries added to it.
+func NewBuffer(size int) *Buffer {
+	return &Buffer{entries: make([]Entry, size)}
+}
+
+// Add adds |e| to the buffer, evicting the oldest entry if the buffer is full.
+func (b *Buffer) Add(e Entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) == 0 {
+		return
+	}
+	b.entries[b.next] = e
+	b.next++
+	if b.next == len(b.entries) {
+		b.next = 0
+		b.full = true
+	}
+}
+
+// Entries returns the entries in the buffer, oldest first.
+func (b *Buffer) Entries() []Entry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([]Entry(nil), b.entries[:b.next]...)
+	}
+	ret := make([]Entry, 0, len(b.entries))
+	ret = append(ret, b.entries[b.next:]...)
+	return append(ret, b.entries[:b.next]...)
+}
+
+var recent atomic.Pointer[Buffer]
+
+// SetRecent sets the buffer which backs the dolt_slow_queries system table. It is set by sql-server when its slow
+// query log is enabled.
+func SetRecent(b *Buffer) {
+	recent.Store(b)
+}
+
+// Recent returns the buffer which backs the dolt_slow_queries system table, or nil if the slow query log is not
+// enabled.
+func Recent() *Buffer {
+	return recent.Load()
+}
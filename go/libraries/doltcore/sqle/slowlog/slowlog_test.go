@@ -0,0 +1,49 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func queries(entries []Entry) []string {
+	var ret []string
+	for _, e := range entries {
+		ret = append(ret, e.Query)
+	}
+	return ret
+}
+
+func TestBuffer(t *testing.T) {
+	b := NewBuffer(3)
+	assert.Empty(t, b.Entries())
+
+	b.Add(Entry{Query: "a"})
+	b.Add(Entry{Query: "b"})
+	assert.Equal(t, []string{"a", "b"}, queries(b.Entries()))
+
+	b.Add(Entry{Query: "c"})
+	assert.Equal(t, []string{"a", "b", "c"}, queries(b.Entries()))
+
+	b.Add(Entry{Query: "d"})
+	b.Add(Entry{Query: "e"})
+	assert.Equal(t, []string{"c", "d", "e"}, queries(b.Entries()))
+
+	empty := NewBuffer(0)
+	empty.Add(Entry{Query: "a"})
+	assert.Empty(t, empty.Entries())
+}
@@ -461,6 +461,11 @@ func GenerateCreateTableStatement(tblName string, sch schema.Schema, fks []doltd
 		if isPrimaryKeyIndex(index, sch) {
 			continue
 		}
+		// Columnar and partial indexes have no CREATE TABLE syntax; they're created with CREATE COLUMNAR INDEX and
+		// CREATE INDEX ... WHERE.
+		if index.IsColumnar() || index.Predicate() != "" {
+			continue
+		}
 		colStmts = append(colStmts, GenerateCreateTableIndexDefinition(index))
 	}
 
@@ -90,7 +90,7 @@ func BasicSelectTests() []SelectTest {
 	var headCommitHash string
 	switch types.Format_Default {
 	case types.Format_DOLT:
-		headCommitHash = "ias4mf52sgeig337ce2le7ov9vpltppr"
+		headCommitHash = "db5g6o4pb7pb7nhk8ec19p2s2shdasfj"
 	case types.Format_LD_1:
 		headCommitHash = "73hc2robs4v0kt9taoe3m5hd49dmrgun"
 	}
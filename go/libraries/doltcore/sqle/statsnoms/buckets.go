@@ -0,0 +1,203 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsnoms
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/stats"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/statspro"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// sharedBucketsDataset is the statistics dataset holding the buckets of
+// every branch keyed by (chunk address, column types). HEAD is not a valid
+// branch name, so the dataset never collides with a branch's statistics.
+const sharedBucketsDataset = "HEAD"
+
+var sharedBucketsKeyDesc = val.NewTupleDescriptor(
+	val.Type{Enc: val.StringEnc},
+	val.Type{Enc: val.StringEnc},
+)
+
+func (n *NomsStatsDatabase) GetBucket(ctx context.Context, chunk hash.Hash, typs string, tb *val.TupleBuilder) (sql.HistogramBucket, bool, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err := n.initSharedBuckets(ctx); err != nil {
+		return nil, false, err
+	}
+
+	ns := n.sharedBuckets.NodeStore()
+	kb := val.NewTupleBuilder(sharedBucketsKeyDesc)
+	kb.PutString(0, chunk.String())
+	kb.PutString(1, typs)
+
+	var value val.Tuple
+	err := n.sharedBuckets.Get(ctx, kb.Build(ns.Pool()), func(_, v val.Tuple) error {
+		value = v
+		return nil
+	})
+	if err != nil || value == nil {
+		return nil, false, err
+	}
+
+	_, vd := schema.StatsTableDoltSchema.GetMapDescriptors()
+	if version, _ := vd.GetInt64(0, value); version != schema.StatsVersion {
+		// written by an incompatible client, sample the chunk again
+		return nil, false, nil
+	}
+	b, err := getBucketValue(ctx, ns, vd, value, tb)
+	if err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+func (n *NomsStatsDatabase) PutBuckets(ctx context.Context, dStats *statspro.DoltStats) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err := n.initSharedBuckets(ctx); err != nil {
+		return err
+	}
+
+	_, vd := schema.StatsTableDoltSchema.GetMapDescriptors()
+	ns := n.sharedBuckets.NodeStore()
+	kb := val.NewTupleBuilder(sharedBucketsKeyDesc)
+	vb := val.NewTupleBuilder(vd)
+
+	typsKey := statspro.BucketTypesKey(dStats.Types())
+	typesB := strings.Builder{}
+	sep := ""
+	for _, t := range dStats.Types() {
+		typesB.WriteString(sep + t.String())
+		sep = "\n"
+	}
+	typesStr := typesB.String()
+	columnsStr := strings.Join(dStats.Columns(), ",")
+	for _, h := range dStats.Hist {
+		chunk := statspro.DoltBucketChunk(h)
+		if chunk.IsEmpty() {
+			continue
+		}
+		kb.PutString(0, chunk.String())
+		kb.PutString(1, typsKey)
+		if err := putBucketValue(ctx, ns, vb, h, columnsStr, typesStr, dStats.Tb); err != nil {
+			return err
+		}
+		if err := n.sharedBuckets.Put(ctx, kb.Build(ns.Pool()), vb.Build(ns.Pool())); err != nil {
+			return err
+		}
+	}
+	n.sharedBucketsDirty = true
+	return nil
+}
+
+// initSharedBuckets opens the shared bucket map for editing.
+func (n *NomsStatsDatabase) initSharedBuckets(ctx context.Context) error {
+	if n.sharedBuckets != nil {
+		return nil
+	}
+	ddb := n.destDb.DbData().Ddb
+	m, err := ddb.GetStatistics(ctx, sharedBucketsDataset)
+	if errors.Is(err, doltdb.ErrNoStatistics) || errors.Is(err, datas.ErrNoBranchStats) {
+		_, vd := schema.StatsTableDoltSchema.GetMapDescriptors()
+		m, err = prolly.NewMapFromTuples(ctx, ddb.NodeStore(), sharedBucketsKeyDesc, vd)
+	}
+	if err != nil {
+		return err
+	}
+	n.sharedBuckets = m.Mutate()
+	return nil
+}
+
+// flushSharedBuckets persists buckets added since the last flush.
+func (n *NomsStatsDatabase) flushSharedBuckets(ctx context.Context) error {
+	if !n.sharedBucketsDirty {
+		return nil
+	}
+	m, err := n.sharedBuckets.Map(ctx)
+	if err != nil {
+		return err
+	}
+	if err := n.destDb.DbData().Ddb.SetStatisics(ctx, sharedBucketsDataset, m.HashOf()); err != nil {
+		return err
+	}
+	n.sharedBuckets = m.Mutate()
+	n.sharedBucketsDirty = false
+	return nil
+}
+
+// getBucketValue reads a bucket written by putBucketValue.
+func getBucketValue(ctx context.Context, ns tree.NodeStore, vd val.TupleDesc, v val.Tuple, tb *val.TupleBuilder) (statspro.DoltBucket, error) {
+	row := make(sql.Row, vd.Count())
+	for i := range row {
+		f, err := tree.GetField(ctx, vd, i, v, ns)
+		if err != nil {
+			return statspro.DoltBucket{}, err
+		}
+		row[i] = f
+	}
+
+	boundRow, err := DecodeRow(ctx, ns, row[7].(string), tb)
+	if err != nil {
+		return statspro.DoltBucket{}, err
+	}
+
+	var mcvs []sql.Row
+	var mcvCnts []uint64
+	for i, c := range strings.Split(row[14].(string), ",") {
+		if i >= 4 || c == "" || c == "0" || row[10+i] == nil {
+			break
+		}
+		cnt, err := strconv.Atoi(c)
+		if err != nil {
+			return statspro.DoltBucket{}, err
+		}
+		mcv, err := DecodeRow(ctx, ns, row[10+i].(string), tb)
+		if err != nil {
+			return statspro.DoltBucket{}, err
+		}
+		mcvs = append(mcvs, mcv)
+		mcvCnts = append(mcvCnts, uint64(cnt))
+	}
+
+	return statspro.DoltBucket{
+		Chunk:   hash.Parse(row[1].(string)),
+		Created: row[9].(time.Time),
+		Bucket: &stats.Bucket{
+			RowCnt:      uint64(row[2].(int64)),
+			DistinctCnt: uint64(row[3].(int64)),
+			NullCnt:     uint64(row[4].(int64)),
+			McvVals:     mcvs,
+			McvsCnt:     mcvCnts,
+			BoundCnt:    uint64(row[8].(int64)),
+			BoundVal:    boundRow,
+		},
+	}, nil
+}
@@ -128,6 +128,9 @@ type NomsStatsDatabase struct {
 	tableHashes  []map[string]hash.Hash
 	schemaHashes []map[string]hash.Hash
 	dirty        []*prolly.MutableMap
+	// sharedBuckets are the buckets of every branch by chunk address
+	sharedBuckets      *prolly.MutableMap
+	sharedBucketsDirty bool
 }
 
 var _ statspro.Database = (*NomsStatsDatabase)(nil)
@@ -137,7 +140,9 @@ func (n *NomsStatsDatabase) Close() error {
 }
 
 func (n *NomsStatsDatabase) Branches() []string {
-	return n.branches
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.branches...)
 }
 
 func (n *NomsStatsDatabase) LoadBranchStats(ctx *sql.Context, branch string) error {
@@ -165,22 +170,26 @@ func (n *NomsStatsDatabase) LoadBranchStats(ctx *sql.Context, branch string) err
 
 	statsMap, err := n.destDb.DbData().Ddb.GetStatistics(ctx, branch)
 	if errors.Is(err, doltdb.ErrNoStatistics) {
-		return n.trackBranch(ctx, branch)
+		return n.lockedTrackBranch(ctx, branch)
 	} else if errors.Is(err, datas.ErrNoBranchStats) {
-		return n.trackBranch(ctx, branch)
+		return n.lockedTrackBranch(ctx, branch)
 	} else if err != nil {
 		return err
 	}
 	if cnt, err := statsMap.Count(); err != nil {
 		return err
 	} else if cnt == 0 {
-		return n.trackBranch(ctx, branch)
+		return n.lockedTrackBranch(ctx, branch)
 	}
 
 	doltStats, err := loadStats(ctx, branchQDb, statsMap)
 	if err != nil {
 		return err
 	}
+
+	// sessions load branches concurrently with reads of other branches
+	n.mu.Lock()
+	defer n.mu.Unlock()
 	n.branches = append(n.branches, branch)
 	n.stats = append(n.stats, doltStats)
 	n.dirty = append(n.dirty, nil)
@@ -314,6 +323,12 @@ func (n *NomsStatsDatabase) trackBranch(ctx context.Context, branch string) erro
 	return n.destDb.DbData().Ddb.SetStatisics(ctx, branch, newMap.HashOf())
 }
 
+func (n *NomsStatsDatabase) lockedTrackBranch(ctx context.Context, branch string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.trackBranch(ctx, branch)
+}
+
 func (n *NomsStatsDatabase) initMutable(ctx context.Context, i int) error {
 	statsMap, err := n.destDb.DbData().Ddb.GetStatistics(ctx, n.branches[i])
 	if err != nil {
@@ -402,6 +417,10 @@ func (n *NomsStatsDatabase) Flush(ctx context.Context, branch string) error {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
+	if err := n.flushSharedBuckets(ctx); err != nil {
+		return err
+	}
+
 	for i, b := range n.branches {
 		if strings.EqualFold(b, branch) {
 			if n.dirty[i] != nil {
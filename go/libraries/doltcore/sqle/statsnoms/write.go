@@ -112,6 +112,7 @@ func putIndexRows(ctx context.Context, statsMap *prolly.MutableMap, dStats *stat
 		sep = "\n"
 	}
 	typesStr := typesB.String()
+	columnsStr := strings.Join(dStats.Columns(), ",")
 
 	var pos int64
 	for _, h := range dStats.Hist {
@@ -120,32 +121,9 @@ func putIndexRows(ctx context.Context, statsMap *prolly.MutableMap, dStats *stat
 		keyBuilder.PutString(2, qual.Idx)
 		keyBuilder.PutInt64(3, pos)
 
-		valueBuilder.PutInt64(0, schema.StatsVersion)
-		valueBuilder.PutString(1, statspro.DoltBucketChunk(h).String())
-		valueBuilder.PutInt64(2, int64(h.RowCount()))
-		valueBuilder.PutInt64(3, int64(h.DistinctCount()))
-		valueBuilder.PutInt64(4, int64(h.NullCount()))
-		valueBuilder.PutString(5, strings.Join(dStats.Columns(), ","))
-		valueBuilder.PutString(6, typesStr)
-		boundRow, err := EncodeRow(ctx, statsMap.NodeStore(), h.UpperBound(), dStats.Tb)
-		if err != nil {
+		if err := putBucketValue(ctx, statsMap.NodeStore(), valueBuilder, h, columnsStr, typesStr, dStats.Tb); err != nil {
 			return err
 		}
-		valueBuilder.PutString(7, string(boundRow))
-		valueBuilder.PutInt64(8, int64(h.BoundCount()))
-		valueBuilder.PutDatetime(9, statspro.DoltBucketCreated(h))
-		for i, r := range h.Mcvs() {
-			mcvRow, err := EncodeRow(ctx, statsMap.NodeStore(), r, dStats.Tb)
-			if err != nil {
-				return err
-			}
-			valueBuilder.PutString(10+i, string(mcvRow))
-		}
-		var mcvCntsRow sql.Row
-		for _, v := range h.McvCounts() {
-			mcvCntsRow = append(mcvCntsRow, int(v))
-		}
-		valueBuilder.PutString(14, stats.StringifyKey(mcvCntsRow, mcvsTypes))
 
 		key := keyBuilder.Build(pool)
 		value := valueBuilder.Build(pool)
@@ -155,6 +133,38 @@ func putIndexRows(ctx context.Context, statsMap *prolly.MutableMap, dStats *stat
 	return nil
 }
 
+// putBucketValue writes |h| into |vb| with the value layout of
+// schema.StatsTableDoltSchema.
+func putBucketValue(ctx context.Context, ns tree.NodeStore, vb *val.TupleBuilder, h sql.HistogramBucket, columnsStr, typesStr string, tb *val.TupleBuilder) error {
+	vb.PutInt64(0, schema.StatsVersion)
+	vb.PutString(1, statspro.DoltBucketChunk(h).String())
+	vb.PutInt64(2, int64(h.RowCount()))
+	vb.PutInt64(3, int64(h.DistinctCount()))
+	vb.PutInt64(4, int64(h.NullCount()))
+	vb.PutString(5, columnsStr)
+	vb.PutString(6, typesStr)
+	boundRow, err := EncodeRow(ctx, ns, h.UpperBound(), tb)
+	if err != nil {
+		return err
+	}
+	vb.PutString(7, string(boundRow))
+	vb.PutInt64(8, int64(h.BoundCount()))
+	vb.PutDatetime(9, statspro.DoltBucketCreated(h))
+	for i, r := range h.Mcvs() {
+		mcvRow, err := EncodeRow(ctx, ns, r, tb)
+		if err != nil {
+			return err
+		}
+		vb.PutString(10+i, string(mcvRow))
+	}
+	var mcvCntsRow sql.Row
+	for _, v := range h.McvCounts() {
+		mcvCntsRow = append(mcvCntsRow, int(v))
+	}
+	vb.PutString(14, stats.StringifyKey(mcvCntsRow, mcvsTypes))
+	return nil
+}
+
 func EncodeRow(ctx context.Context, ns tree.NodeStore, r sql.Row, tb *val.TupleBuilder) ([]byte, error) {
 	for i, v := range r {
 		if v == nil {
@@ -27,6 +27,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
 )
 
 const (
@@ -179,7 +180,7 @@ func (p *Provider) RefreshTableStatsWithBranch(ctx *sql.Context, table sql.Table
 			curStat = NewDoltStats()
 			curStat.Statistic.Qual = qual
 		}
-		idxMeta, err := newIdxMeta(ctx, curStat, dTab, idx, cols)
+		idxMeta, err := newIdxMeta(ctx, curStat, statDb, dTab, idx, cols)
 		if err != nil {
 			return err
 		}
@@ -194,17 +195,15 @@ func (p *Provider) RefreshTableStatsWithBranch(ctx *sql.Context, table sql.Table
 	// merge new chunks with preexisting chunks
 	for _, idxMeta := range idxMetas {
 		stat := newTableStats[idxMeta.qual]
-		targetChunks, err := MergeNewChunks(idxMeta.allAddrs, idxMeta.keepChunks, stat.Hist)
-		if err != nil {
+		if ok, err := mergeIndexStats(idxMeta, stat); err != nil {
 			return err
-		}
-		if targetChunks == nil {
+		} else if !ok {
 			// empty table
 			continue
 		}
-		stat.SetChunks(idxMeta.allAddrs)
-		stat.Hist = targetChunks
-		stat.UpdateActive()
+		if err := statDb.PutBuckets(ctx, stat); err != nil {
+			return err
+		}
 		if err := statDb.SetStat(ctx, branch, idxMeta.qual, stat); err != nil {
 			return err
 		}
@@ -214,6 +213,35 @@ func (p *Provider) RefreshTableStatsWithBranch(ctx *sql.Context, table sql.Table
 	return statDb.Flush(ctx, branch)
 }
 
+// mergeIndexStats completes |stat|, which holds the buckets sampled for
+// |meta.newNodes|, with the buckets |meta| kept from the previous statistic
+// or found among the shared buckets. Returns false for an empty index.
+func mergeIndexStats(meta indexMeta, stat *DoltStats) (bool, error) {
+	oldChunks := make([]sql.HistogramBucket, 0, len(meta.keepChunks)+len(meta.sharedChunks))
+	oldChunks = append(oldChunks, meta.keepChunks...)
+	oldChunks = append(oldChunks, meta.sharedChunks...)
+	targetChunks, err := MergeNewChunks(meta.allAddrs, oldChunks, stat.Hist)
+	if err != nil {
+		return false, err
+	}
+	if targetChunks == nil {
+		return false, nil
+	}
+	stat.SetChunks(meta.allAddrs)
+	stat.Hist = targetChunks
+	stat.UpdateActive()
+
+	// sampling only counts the new chunks
+	var rows, distinct uint64
+	for _, b := range targetChunks {
+		rows += b.RowCount()
+		distinct += b.DistinctCount()
+	}
+	stat.Statistic.RowCnt = rows
+	stat.Statistic.DistinctCnt = distinct
+	return true, nil
+}
+
 // BranchQualifiedDatabase returns a branch qualified database. If the database
 // is already branch suffixed no duplication is applied.
 func BranchQualifiedDatabase(db, branch string) string {
@@ -260,7 +288,10 @@ func GetLatestTable(ctx *sql.Context, tableName string, sqlDb sql.Database) (sql
 	return sqlTable, dTab, nil
 }
 
-func newIdxMeta(ctx *sql.Context, curStats *DoltStats, doltTable *doltdb.Table, sqlIndex sql.Index, cols []string) (indexMeta, error) {
+// newIdxMeta partitions the histogram level chunks of |sqlIndex| into
+// chunks |curStats| already summarizes, chunks |statDb| has a shared
+// bucket for, and chunks that need to be sampled.
+func newIdxMeta(ctx *sql.Context, curStats *DoltStats, statDb Database, doltTable *doltdb.Table, sqlIndex sql.Index, cols []string) (indexMeta, error) {
 	var idx durable.Index
 	var err error
 	if strings.EqualFold(sqlIndex.ID(), "PRIMARY") {
@@ -289,8 +320,16 @@ func newIdxMeta(ctx *sql.Context, curStats *DoltStats, doltTable *doltdb.Table,
 		return indexMeta{}, err
 	}
 
+	var typs []sql.Type
+	for _, cet := range sqlIndex.ColumnExpressionTypes() {
+		typs = append(typs, cet.Type)
+	}
+	typsKey := BucketTypesKey(typs)
+	tb := val.NewTupleBuilder(prollyMap.KeyDesc().PrefixDesc(len(cols)))
+
 	var addrs []hash.Hash
 	var keepChunks []sql.HistogramBucket
+	var sharedChunks []sql.HistogramBucket
 	var missingAddrs float64
 	var missingChunks []tree.Node
 	var missingOffsets []updateOrdinal
@@ -307,12 +346,16 @@ func newIdxMeta(ctx *sql.Context, curStats *DoltStats, doltTable *doltdb.Table,
 		}
 
 		addrs = append(addrs, n.HashOf())
-		if bucketIdx, ok := curStats.Active[n.HashOf()]; !ok {
+		if bucketIdx, ok := curStats.Active[n.HashOf()]; ok {
+			keepChunks = append(keepChunks, curStats.Hist[bucketIdx])
+		} else if b, ok, err := statDb.GetBucket(ctx, n.HashOf(), typsKey, tb); err != nil {
+			return indexMeta{}, err
+		} else if ok {
+			sharedChunks = append(sharedChunks, b)
+		} else {
 			missingChunks = append(missingChunks, n)
 			missingOffsets = append(missingOffsets, updateOrdinal{offset, offset + uint64(treeCnt)})
 			missingAddrs++
-		} else {
-			keepChunks = append(keepChunks, curStats.Hist[bucketIdx])
 		}
 		offset += uint64(treeCnt)
 	}
@@ -338,6 +381,7 @@ func newIdxMeta(ctx *sql.Context, curStats *DoltStats, doltTable *doltdb.Table,
 		updateOrdinals: missingOffsets,
 		keepChunks:     keepChunks,
 		dropChunks:     dropChunks,
+		sharedChunks:   sharedChunks,
 		allAddrs:       addrs,
 	}, nil
 }
@@ -16,6 +16,7 @@ package statspro
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -24,10 +25,13 @@ import (
 	types2 "github.com/dolthub/go-mysql-server/sql/types"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 const asyncAutoRefreshStats = "async_auto_refresh_stats"
 
+var errDatabaseUpdating = errors.New("database already being updated")
+
 func (p *Provider) InitAutoRefresh(ctxFactory func(ctx context.Context) (*sql.Context, error), dbName string, bThreads *sql.BackgroundThreads) error {
 	_, threshold, _ := sql.SystemVariables.GetGlobal(dsess.DoltStatsAutoRefreshThreshold)
 	_, interval, _ := sql.SystemVariables.GetGlobal(dsess.DoltStatsAutoRefreshInterval)
@@ -81,7 +85,12 @@ func (p *Provider) InitAutoRefreshWithParams(ctxFactory func(ctx context.Context
 					sqlCtx.GetLogger().Debugf("statistics refresh error: database not found %s", dbName)
 					return
 				}
-				for _, branch := range branches {
+				for _, branch := range p.refreshBranches(dbName, branches) {
+					if dropDbCtx.Err() != nil {
+						// stopped while checking other branches
+						ticker.Stop()
+						return
+					}
 					if br, ok, err := ddb.HasBranch(ctx, branch); ok {
 						sqlCtx.GetLogger().Debugf("starting statistics refresh check for '%s': %s", dbName, time.Now().String())
 						// update WORKING session references
@@ -91,10 +100,24 @@ func (p *Provider) InitAutoRefreshWithParams(ctxFactory func(ctx context.Context
 							return
 						}
 
-						if err := p.checkRefresh(sqlCtx, sqlDb, dbName, br, updateThresh); err != nil {
+						// skip branches whose root has not moved since the last check
+						rootHash, err := branchRootHash(sqlCtx, sqlDb)
+						if err != nil {
+							sqlCtx.GetLogger().Debugf("statistics refresh error: %s", err.Error())
+							return
+						}
+						if p.getBranchRoot(dbName, br) == rootHash {
+							continue
+						}
+
+						if err := p.checkRefresh(sqlCtx, sqlDb, dbName, br, updateThresh); errors.Is(err, errDatabaseUpdating) {
+							// a session is loading the branch, check it next time
+							continue
+						} else if err != nil {
 							sqlCtx.GetLogger().Debugf("statistics refresh error: %s", err.Error())
 							return
 						}
+						p.setBranchRoot(dbName, br, rootHash)
 					} else if err != nil {
 						sqlCtx.GetLogger().Debugf("statistics refresh error: branch check error %s", err.Error())
 					} else {
@@ -106,9 +129,73 @@ func (p *Provider) InitAutoRefreshWithParams(ctxFactory func(ctx context.Context
 	})
 }
 
+// refreshBranches returns the branches a refresh thread checks: the
+// branches it was started with, and any other branch whose statistics
+// |dbName| tracks, such as branches sessions have since checked out.
+func (p *Provider) refreshBranches(dbName string, branches []string) []string {
+	statDb, ok := p.getStatDb(dbName)
+	if !ok {
+		return branches
+	}
+	ret := append([]string(nil), branches...)
+	for _, tracked := range statDb.Branches() {
+		var found bool
+		for _, b := range ret {
+			if strings.EqualFold(b, tracked) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, tracked)
+		}
+	}
+	return ret
+}
+
+// branchRootHash returns the hash of |sqlDb|'s working root. Statistics are
+// unchanged when the root is unchanged, and a changed root is diffed
+// against the previous statistics table by table and chunk by chunk.
+func branchRootHash(ctx *sql.Context, sqlDb sql.Database) (hash.Hash, error) {
+	dSqlDb, ok := sqlDb.(dsess.SqlDatabase)
+	if !ok {
+		return hash.Hash{}, fmt.Errorf("expected dsess.SqlDatabase, found %T", sqlDb)
+	}
+	root, err := dSqlDb.GetRoot(ctx)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	return root.HashOf()
+}
+
+func (p *Provider) getBranchRoot(db, branch string) hash.Hash {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.branchRoots[strings.ToLower(BranchQualifiedDatabase(db, branch))]
+}
+
+func (p *Provider) setBranchRoot(db, branch string, h hash.Hash) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.branchRoots[strings.ToLower(BranchQualifiedDatabase(db, branch))] = h
+}
+
+// resetBranchRoots forces the next refresh check of every branch of |db|
+// to diff its tables against the stored statistics.
+func (p *Provider) resetBranchRoots(db string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	prefix := strings.ToLower(db) + "/"
+	for k := range p.branchRoots {
+		if strings.HasPrefix(k, prefix) {
+			delete(p.branchRoots, k)
+		}
+	}
+}
+
 func (p *Provider) checkRefresh(ctx *sql.Context, sqlDb sql.Database, dbName, branch string, updateThresh float64) error {
 	if !p.TryLockForUpdate(branch, dbName, "") {
-		return fmt.Errorf("database already being updated: %s/%s", branch, dbName)
+		return fmt.Errorf("%w: %s/%s", errDatabaseUpdating, branch, dbName)
 	}
 	defer p.UnlockTable(branch, dbName, "")
 
@@ -217,13 +304,13 @@ func (p *Provider) checkRefresh(ctx *sql.Context, sqlDb sql.Database, dbName, br
 			}
 			ctx.GetLogger().Debugf("statistics refresh index: %s", qual.String())
 
-			updateMeta, err := newIdxMeta(ctx, curStat, dTab, index, curStat.Columns())
+			updateMeta, err := newIdxMeta(ctx, curStat, statDb, dTab, index, curStat.Columns())
 			if err != nil {
 				ctx.GetLogger().Debugf("statistics refresh error: %s", err.Error())
 				continue
 			}
 			curCnt := float64(len(curStat.Active))
-			updateCnt := float64(len(updateMeta.newNodes))
+			updateCnt := float64(len(updateMeta.newNodes) + len(updateMeta.sharedChunks))
 			deleteCnt := float64(len(curStat.Active) - len(updateMeta.keepChunks))
 			ctx.GetLogger().Debugf("statistics current: %d, new: %d, delete: %d", int(curCnt), int(updateCnt), int(deleteCnt))
 
@@ -249,11 +336,19 @@ func (p *Provider) checkRefresh(ctx *sql.Context, sqlDb sql.Database, dbName, br
 		for _, updateMeta := range idxMetas {
 			stat := newTableStats[updateMeta.qual]
 			if stat != nil {
+				if err := statDb.PutBuckets(ctx, stat); err != nil {
+					return err
+				}
 				var err error
 				if _, ok := statDb.GetStat(branch, updateMeta.qual); !ok {
-					err = statDb.SetStat(ctx, branch, updateMeta.qual, stat)
+					if _, err = mergeIndexStats(updateMeta, stat); err == nil {
+						err = statDb.SetStat(ctx, branch, updateMeta.qual, stat)
+					}
 				} else {
-					err = statDb.ReplaceChunks(ctx, branch, updateMeta.qual, updateMeta.allAddrs, updateMeta.dropChunks, stat.Hist)
+					newChunks := make([]sql.HistogramBucket, 0, len(stat.Hist)+len(updateMeta.sharedChunks))
+					newChunks = append(newChunks, stat.Hist...)
+					newChunks = append(newChunks, updateMeta.sharedChunks...)
+					err = statDb.ReplaceChunks(ctx, branch, updateMeta.qual, updateMeta.allAddrs, updateMeta.dropChunks, newChunks)
 				}
 				if err != nil {
 					return err
@@ -0,0 +1,179 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statspro
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+const asyncLoadBranchStats = "async_load_branch_stats"
+
+// trackSessionBranch starts tracking statistics for |branch| the first
+// time a session reads statistics from it. The branch's persisted
+// statistics are loaded in the background, and indexes whose every
+// histogram chunk has already been sampled on another branch are filled
+// in from the shared buckets. A running refresh thread keeps the branch
+// current from then on.
+func (p *Provider) trackSessionBranch(ctx *sql.Context, db, branch string) {
+	if branch == "" {
+		return
+	}
+	dbName := strings.ToLower(db)
+	p.mu.Lock()
+	ctxFactory, bThreads := p.ctxFactory, p.bThreads
+	p.mu.Unlock()
+	if ctxFactory == nil || bThreads == nil {
+		return
+	}
+
+	statDb, ok := p.getStatDb(dbName)
+	if !ok || isTrackedBranch(statDb, branch) {
+		return
+	}
+
+	if !p.TryLockForUpdate(branch, dbName, "") {
+		// another session or the refresh thread is already on it
+		return
+	}
+
+	err := bThreads.Add(fmt.Sprintf("%s_%s_%s", asyncLoadBranchStats, dbName, branch), func(ctx context.Context) {
+		defer p.UnlockTable(branch, dbName, "")
+		if isTrackedBranch(statDb, branch) {
+			return
+		}
+		sqlCtx, err := ctxFactory(ctx)
+		if err != nil {
+			return
+		}
+		if err := p.loadSessionBranch(sqlCtx, statDb, dbName, branch); err != nil {
+			sqlCtx.GetLogger().Debugf("statistics branch tracking error for %s/%s: %s", dbName, branch, err.Error())
+		}
+	})
+	if err != nil {
+		p.UnlockTable(branch, dbName, "")
+		ctx.GetLogger().Debugf("statistics branch tracking error for %s/%s: %s", dbName, branch, err.Error())
+	}
+}
+
+func (p *Provider) loadSessionBranch(ctx *sql.Context, statDb Database, dbName, branch string) error {
+	if err := statDb.LoadBranchStats(ctx, branch); err != nil {
+		return err
+	}
+
+	dSess := dsess.DSessFromSess(ctx.Session)
+	sqlDb, err := dSess.Provider().Database(ctx, BranchQualifiedDatabase(dbName, branch))
+	if err != nil {
+		return err
+	}
+	if err := p.seedBranchStats(ctx, statDb, sqlDb, dbName, branch); err != nil {
+		return err
+	}
+	return statDb.Flush(ctx, branch)
+}
+
+// seedBranchStats builds statistics for the indexes on |branch| that have
+// none, using only shared buckets. Indexes with chunks no
+// branch has sampled yet are left for the refresh thread.
+func (p *Provider) seedBranchStats(ctx *sql.Context, statDb Database, sqlDb sql.Database, dbName, branch string) error {
+	tables, err := sqlDb.GetTableNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		sqlTable, dTab, err := GetLatestTable(ctx, table, sqlDb)
+		if err != nil {
+			return err
+		}
+		iat, ok := sqlTable.(sql.IndexAddressableTable)
+		if !ok {
+			continue
+		}
+		indexes, err := iat.GetIndexes(ctx)
+		if err != nil {
+			return err
+		}
+
+		var schemaName string
+		if schTab, ok := sqlTable.(sql.DatabaseSchemaTable); ok {
+			schemaName = strings.ToLower(schTab.DatabaseSchema().SchemaName())
+		}
+
+		tablePrefix := fmt.Sprintf("%s.", strings.ToLower(table))
+		var idxMetas []indexMeta
+		for _, idx := range indexes {
+			qual := sql.NewStatQualifier(dbName, schemaName, table, strings.ToLower(idx.ID()))
+			if _, ok := statDb.GetStat(branch, qual); ok {
+				continue
+			}
+			cols := make([]string, len(idx.Expressions()))
+			for i, c := range idx.Expressions() {
+				cols[i] = strings.TrimPrefix(strings.ToLower(c), tablePrefix)
+			}
+			curStat := NewDoltStats()
+			curStat.Statistic.Qual = qual
+			meta, err := newIdxMeta(ctx, curStat, statDb, dTab, idx, cols)
+			if err != nil {
+				return err
+			}
+			if len(meta.newNodes) > 0 || len(meta.sharedChunks) == 0 {
+				continue
+			}
+			idxMetas = append(idxMetas, meta)
+		}
+		if len(idxMetas) == 0 {
+			continue
+		}
+
+		schHash, err := dTab.GetSchemaHash(ctx)
+		if err != nil {
+			return err
+		}
+		if err := statDb.SetSchemaHash(ctx, branch, table, schHash); err != nil {
+			return err
+		}
+
+		// no chunks are sampled, only the lower bound is read
+		newTableStats, err := createNewStatsBuckets(ctx, sqlTable, dTab, indexes, idxMetas)
+		if err != nil {
+			return err
+		}
+		for _, meta := range idxMetas {
+			stat := newTableStats[meta.qual]
+			if _, err := mergeIndexStats(meta, stat); err != nil {
+				return err
+			}
+			if err := statDb.SetStat(ctx, branch, meta.qual, stat); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isTrackedBranch(statDb Database, branch string) bool {
+	for _, b := range statDb.Branches() {
+		if strings.EqualFold(b, branch) {
+			return true
+		}
+	}
+	return false
+}
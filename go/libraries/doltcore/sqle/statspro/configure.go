@@ -33,6 +33,10 @@ var helpMsg = "call dolt_stats_purge() to reset statistics"
 
 func (p *Provider) Configure(ctx context.Context, ctxFactory func(ctx context.Context) (*sql.Context, error), bThreads *sql.BackgroundThreads, dbs []dsess.SqlDatabase) error {
 	p.SetStarter(NewStatsInitDatabaseHook(p, ctxFactory, bThreads))
+	p.mu.Lock()
+	p.ctxFactory = ctxFactory
+	p.bThreads = bThreads
+	p.mu.Unlock()
 
 	if _, disabled, _ := sql.SystemVariables.GetGlobal(dsess.DoltStatsMemoryOnly); disabled == int8(1) {
 		return nil
@@ -100,9 +104,10 @@ func (p *Provider) Configure(ctx context.Context, ctxFactory func(ctx context.Co
 	return eg.Wait()
 }
 
-// getStatsBranches returns the set of branches whose statistics are tracked.
-// The order of precedence is (1) global variable, (2) session current branch,
-// (3) engine default branch.
+// getStatsBranches returns the set of branches whose statistics are loaded
+// on startup. The order of precedence is (1) global variable, (2) session
+// current branch, (3) engine default branch. Other branches are tracked as
+// sessions read from them.
 func (p *Provider) getStatsBranches(ctx *sql.Context) []string {
 	dSess := dsess.DSessFromSess(ctx.Session)
 	var branches []string
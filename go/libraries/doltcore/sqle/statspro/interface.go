@@ -24,6 +24,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/val"
 )
 
 // Database is a backing store for a collection of DoltStats.
@@ -46,6 +47,13 @@ type Database interface {
 	// ReplaceChunks is an update interface that lets a stats implementation
 	// decide how to edit stats for a stats refresh.
 	ReplaceChunks(ctx context.Context, branch string, qual sql.StatQualifier, targetHashes []hash.Hash, dropChunks, newChunks []sql.HistogramBucket) error
+	// GetBucket returns the bucket stored by PutBuckets for the histogram
+	// chunk |chunk| of an index with column types |typs|, decoding its
+	// rows with |tb|.
+	GetBucket(ctx context.Context, chunk hash.Hash, typs string, tb *val.TupleBuilder) (sql.HistogramBucket, bool, error)
+	// PutBuckets stores the buckets of |stats| by chunk address, so that
+	// any branch whose index contains the same chunk can reuse them.
+	PutBuckets(ctx context.Context, stats *DoltStats) error
 	// Flush instructs the database to sync any partial state to disk
 	Flush(ctx context.Context, branch string) error
 	// Close finalizes any file references.
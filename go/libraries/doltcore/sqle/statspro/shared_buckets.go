@@ -0,0 +1,41 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statspro
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Histogram buckets are content-addressed. A bucket summarizes one prolly
+// chunk at the histogram level of an index, and the chunk's address fixes
+// the keys inside it, so a bucket computed on one branch is valid for any
+// other branch whose index tree contains the same chunk. Every bucket a
+// provider computes is stored with Database.PutBuckets, and branches that
+// share history only sample the chunks that differ between them.
+
+// BucketTypesKey returns the column types component of a shared bucket's
+// key. The same chunk decodes differently for indexes of different types.
+func BucketTypesKey(typs []sql.Type) string {
+	b := strings.Builder{}
+	for i, t := range typs {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(t.String())
+	}
+	return b.String()
+}
@@ -42,7 +42,10 @@ type indexMeta struct {
 	updateOrdinals []updateOrdinal
 	keepChunks     []sql.HistogramBucket
 	dropChunks     []sql.HistogramBucket
-	allAddrs       []hash.Hash
+	// sharedChunks are buckets missing from the current statistic that
+	// another branch has already sampled
+	sharedChunks []sql.HistogramBucket
+	allAddrs     []hash.Hash
 }
 
 type updateOrdinal struct {
@@ -59,6 +62,7 @@ func NewProvider(pro *sqle.DoltDatabaseProvider, sf StatsFactory) *Provider {
 		analyzeCtxCancelers: make(map[string]context.CancelFunc),
 		status:              make(map[string]string),
 		lockedTables:        make(map[string]bool),
+		branchRoots:         make(map[string]hash.Hash),
 	}
 }
 
@@ -75,6 +79,13 @@ type Provider struct {
 	starter             sqle.InitDatabaseHook
 	status              map[string]string
 	lockedTables        map[string]bool
+	// branchRoots are the root hashes of the most recent refresh check
+	// for each database branch
+	branchRoots map[string]hash.Hash
+	// ctxFactory and bThreads run the background loads of branches
+	// that sessions read from
+	ctxFactory func(ctx context.Context) (*sql.Context, error)
+	bThreads   *sql.BackgroundThreads
 }
 
 // each database has one statistics table that is a collection of the
@@ -148,6 +159,7 @@ func (p *Provider) CancelRefreshThread(dbName string) {
 		cancel()
 	}
 	p.mu.Unlock()
+	p.resetBranchRoots(dbName)
 	p.UpdateStatus(dbName, fmt.Sprintf("cancelled thread: %s", dbName))
 
 }
@@ -177,6 +189,7 @@ func (p *Provider) GetTableStats(ctx *sql.Context, db string, table sql.Table) (
 	if err != nil {
 		return nil, nil
 	}
+	p.trackSessionBranch(ctx, db, branch)
 
 	var schemaName string
 	if schTab, ok := table.(sql.DatabaseSchemaTable); ok {
@@ -199,6 +212,7 @@ func (p *Provider) GetTableDoltStats(ctx *sql.Context, branch, db, schema, table
 		if err != nil {
 			return nil, nil
 		}
+		p.trackSessionBranch(ctx, db, branch)
 	}
 
 	var ret []sql.Statistic
@@ -264,6 +278,7 @@ func (p *Provider) getQualStats(ctx *sql.Context, qual sql.StatQualifier) (*Dolt
 	if err != nil {
 		return nil, false
 	}
+	p.trackSessionBranch(ctx, qual.Db(), branch)
 
 	return statDb.GetStat(branch, qual)
 }
@@ -286,6 +301,7 @@ func (p *Provider) DropBranchDbStats(ctx *sql.Context, branch, db string, flush
 	defer p.mu.Unlock()
 
 	p.status[db] = "dropped"
+	delete(p.branchRoots, strings.ToLower(BranchQualifiedDatabase(db, branch)))
 
 	return statDb.DeleteBranchStats(ctx, branch, flush)
 }
@@ -321,6 +337,7 @@ func (p *Provider) DropStats(ctx *sql.Context, qual sql.StatQualifier, _ []strin
 
 	if _, ok := statDb.GetStat(branch, qual); ok {
 		statDb.DeleteStats(ctx, branch, qual)
+		p.setBranchRoot(qual.Db(), branch, hash.Hash{})
 		p.UpdateStatus(qual.Db(), fmt.Sprintf("dropped statisic: %s", qual.String()))
 	}
 
@@ -345,6 +362,7 @@ func (p *Provider) RowCount(ctx *sql.Context, db string, table sql.Table) (uint6
 	if err != nil {
 		return 0, err
 	}
+	p.trackSessionBranch(ctx, db, branch)
 
 	var schemaName string
 	if schTab, ok := table.(sql.DatabaseSchemaTable); ok {
@@ -370,6 +388,7 @@ func (p *Provider) DataLength(ctx *sql.Context, db string, table sql.Table) (uin
 	if err != nil {
 		return 0, err
 	}
+	p.trackSessionBranch(ctx, db, branch)
 
 	var schemaName string
 	if schTab, ok := table.(sql.DatabaseSchemaTable); ok {
@@ -211,6 +211,13 @@ var DoltSystemVariables = []sql.SystemVariable{
 		Type:    types.NewSystemBoolType("dolt_dont_merge_json"),
 		Default: int8(0),
 	},
+	&sql.MysqlSystemVariable{ // If true, merges follow tables and columns that were dropped and recreated under a new name.
+		Name:    "dolt_merge_detect_renames",
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Type:    types.NewSystemBoolType("dolt_merge_detect_renames"),
+		Default: int8(0),
+	},
 	&sql.MysqlSystemVariable{
 		Name:    "dolt_optimize_json",
 		Dynamic: true,
@@ -445,6 +452,13 @@ func AddDoltSystemVariables() {
 			Type:    types.NewSystemBoolType("dolt_dont_merge_json"),
 			Default: int8(0),
 		},
+		&sql.MysqlSystemVariable{
+			Name:    "dolt_merge_detect_renames",
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+			Type:    types.NewSystemBoolType("dolt_merge_detect_renames"),
+			Default: int8(0),
+		},
 		&sql.MysqlSystemVariable{
 			Name:    dsess.DoltStatsAutoRefreshEnabled,
 			Dynamic: true,
@@ -17,12 +17,14 @@ package sqle
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"os"
 	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -41,6 +43,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/writer"
@@ -48,6 +51,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
 	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
 )
 
 const (
@@ -209,6 +213,19 @@ func (t *DoltTable) LookupForExpressions(ctx *sql.Context, exprs ...sql.Expressi
 		}
 	}
 
+	if t.overriddenSchema == nil {
+		tbl, err := t.DoltTable(ctx)
+		if err != nil {
+			return sql.IndexLookup{}, nil, nil, false, err
+		}
+		lookup, ok, err := index.PartialIndexLookup(ctx, t.db.Name(), t.tableName, tbl, t.sch, exprs)
+		if err != nil || !ok {
+			return sql.IndexLookup{}, nil, nil, false, err
+		}
+		// partial index lookups are not exact, so every filter is kept
+		return lookup, nil, expression.JoinAnd(exprs...), true, nil
+	}
+
 	return sql.IndexLookup{}, nil, nil, false, nil
 
 }
@@ -554,6 +571,12 @@ func (t *DoltTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 		return nil, err
 	}
 
+	if t.overriddenSchema == nil && types.IsFormat_DOLT(table.Format()) {
+		if idx := index.ColumnarIndexForTags(t.sch, t.projectedCols); idx != nil {
+			return columnarPartitions(ctx, table, t.sch, idx)
+		}
+	}
+
 	rows, err := table.GetRowData(ctx)
 	if err != nil {
 		return nil, err
@@ -566,6 +589,27 @@ func (t *DoltTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 	return newDoltTablePartitionIter(rows, partitions...), nil
 }
 
+// columnarPartitions returns partitions which read the rows of |table| from its columnar index |idx|, for scans
+// that only project columns of |idx|.
+func columnarPartitions(ctx *sql.Context, table *doltdb.Table, sch schema.Schema, idx schema.Index) (sql.PartitionIter, error) {
+	set, err := table.GetIndexSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	columnar, err := set.GetIndex(ctx, sch, idx.Schema(), idx.Name())
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := partitionsFromRows(ctx, columnar)
+	if err != nil {
+		return nil, err
+	}
+	for i := range partitions {
+		partitions[i].columnar = idx
+	}
+	return newDoltTablePartitionIter(columnar, partitions...), nil
+}
+
 func (t *DoltTable) IsTemporary() bool {
 	return false
 }
@@ -1476,6 +1520,14 @@ type doltTablePartition struct {
 	// half-open index range of partition: [start, end)
 	start, end uint64
 
+	// half-open key range of partition: [startKey, endKey). If either
+	// is set, the partition is read by key range rather than by index.
+	startKey, endKey val.Tuple
+
+	// columnar is set if |rowData| is this columnar index rather
+	// than the table's primary index
+	columnar schema.Index
+
 	rowData durable.Index
 }
 
@@ -1490,9 +1542,48 @@ func partitionsFromRows(ctx context.Context, rows durable.Index) ([]doltTablePar
 		}, nil
 	}
 
+	if types.IsFormat_DOLT(rows.Format()) {
+		return partitionsFromProllyRows(ctx, rows)
+	}
 	return partitionsFromTableRows(rows)
 }
 
+// partitionsFromProllyRows splits |rows| into key ranges at the boundaries of
+// its internal nodes, so that each partition is a balanced set of whole subtrees.
+func partitionsFromProllyRows(ctx context.Context, rows durable.Index) ([]doltTablePartition, error) {
+	numElements, err := rows.Count()
+	if err != nil {
+		return nil, err
+	}
+	numPartitions := uint64(partitionMultiplier * runtime.NumCPU())
+	if numElements/numPartitions > MaxRowsPerPartition {
+		numPartitions = numElements/MaxRowsPerPartition + 1
+	} else if numElements/numPartitions < MinRowsPerPartition {
+		numPartitions = numElements / MinRowsPerPartition
+	}
+
+	m := durable.ProllyMapFromIndex(rows)
+	splits, err := m.SplitKeyRange(ctx, nil, nil, int(numPartitions))
+	if err != nil {
+		return nil, err
+	}
+	if len(splits) == 0 {
+		return []doltTablePartition{{start: 0, end: numElements, rowData: rows}}, nil
+	}
+
+	partitions := make([]doltTablePartition, len(splits)+1)
+	for i := range partitions {
+		partitions[i].rowData = rows
+		if i > 0 {
+			partitions[i].startKey = splits[i-1]
+		}
+		if i < len(splits) {
+			partitions[i].endKey = splits[i]
+		}
+	}
+	return partitions, nil
+}
+
 func partitionsFromTableRows(rows durable.Index) ([]doltTablePartition, error) {
 	numElements, err := rows.Count()
 	if err != nil {
@@ -1531,9 +1622,19 @@ func partitionsFromTableRows(rows durable.Index) ([]doltTablePartition, error) {
 
 // Key returns the key for this partition, which must uniquely identity the partition.
 func (p doltTablePartition) Key() []byte {
+	if p.columnar != nil {
+		return []byte(p.columnar.Name() + ": " + hex.EncodeToString(p.startKey) + " >= k < " + hex.EncodeToString(p.endKey))
+	}
+	if p.isKeyRange() {
+		return []byte(hex.EncodeToString(p.startKey) + " >= k < " + hex.EncodeToString(p.endKey))
+	}
 	return []byte(strconv.FormatUint(p.start, 10) + " >= i < " + strconv.FormatUint(p.end, 10))
 }
 
+func (p doltTablePartition) isKeyRange() bool {
+	return p.startKey != nil || p.endKey != nil
+}
+
 // IteratorForPartition returns a types.MapIterator implementation which will iterate through the values
 // for index = start; index < end.  This iterator is not thread safe and should only be used from a single go routine
 // unless paired with a mutex
@@ -1774,7 +1875,7 @@ func (t *AlterableDoltTable) RewriteInserter(
 
 	isModifyColumn := newColumn != nil && oldColumn != nil
 	if isColumnDrop(oldSchema, newSchema) {
-		newSch, err = dropIndexesOnDroppedColumn(newSch, oldSch, oldSchema, newSchema, err)
+		newSch, err = dropIndexesOnDroppedColumn(ctx, t.tableName, newSch, oldSch, oldSchema, newSchema, err)
 		if err != nil {
 			return nil, err
 		}
@@ -1783,6 +1884,11 @@ func (t *AlterableDoltTable) RewriteInserter(
 		if err != nil {
 			return nil, err
 		}
+		if oldColumn.Name != newColumn.Name {
+			if err = renamePartialIndexColumn(ctx, t.tableName, oldSch, newSch, oldColumn.Name, newColumn.Name); err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		// we need a temp version of a sql.Table here to get key columns
 		newTbl, err := t.db.newDoltTable(t.Name(), newSch, dt)
@@ -1985,10 +2091,25 @@ func modifyFulltextIndexesForRewrite(ctx *sql.Context, keyCols fulltext.KeyColum
 	return newSch, nil
 }
 
-// dropIndexesOnDroppedColumn removes from the schema any indexes which contain a dropped column.
-func dropIndexesOnDroppedColumn(newSch schema.Schema, oldSch schema.Schema, oldSchema sql.PrimaryKeySchema, newSchema sql.PrimaryKeySchema, err error) (schema.Schema, error) {
+// dropIndexesOnDroppedColumn removes from the schema any indexes which contain a dropped column, or whose partial
+// index predicate refers to it.
+func dropIndexesOnDroppedColumn(ctx *sql.Context, tableName string, newSch schema.Schema, oldSch schema.Schema, oldSchema sql.PrimaryKeySchema, newSchema sql.PrimaryKeySchema, err error) (schema.Schema, error) {
 	newSch = schema.CopyIndexes(oldSch, newSch)
 	droppedCol := getDroppedColumn(oldSchema, newSchema)
+	for _, index := range newSch.Indexes().AllIndexes() {
+		if index.Predicate() == "" {
+			continue
+		}
+		ok, err := expranalysis.IndexPredicateReferencesColumn(ctx, tableName, oldSch, index.Predicate(), droppedCol.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			if _, err = newSch.Indexes().RemoveIndex(index.Name()); err != nil {
+				return nil, err
+			}
+		}
+	}
 	for _, index := range newSch.Indexes().IndexesWithColumn(droppedCol.Name) {
 		_, err = newSch.Indexes().RemoveIndex(index.Name())
 		if err != nil {
@@ -1999,12 +2120,68 @@ func dropIndexesOnDroppedColumn(newSch schema.Schema, oldSch schema.Schema, oldS
 		// remaining columns
 		if index.IsFullText() {
 			modifyFulltextIndexForColumnDrop(index, newSch, droppedCol)
+		} else if droppedTag := oldSch.GetAllCols().NameToCol[droppedCol.Name].Tag; !slices.Contains(index.IndexedColumnTags(), droppedTag) {
+			// the dropped column was only included in the index, so the index is kept without it
+			modifyIndexForIncludedColumnDrop(index, newSch, droppedTag)
 		}
 	}
 
 	return newSch, nil
 }
 
+// renamePartialIndexColumn rewrites the predicates of the partial indexes of |newSch| that refer to the column
+// |oldName| of |oldSch| to refer to |newName| instead.
+func renamePartialIndexColumn(ctx *sql.Context, tableName string, oldSch, newSch schema.Schema, oldName, newName string) error {
+	for _, index := range newSch.Indexes().AllIndexes() {
+		if index.Predicate() == "" {
+			continue
+		}
+		predicate, err := expranalysis.RenameIndexPredicateColumn(ctx, tableName, oldSch, index.Predicate(), oldName, newName)
+		if err != nil {
+			return err
+		}
+		if predicate == index.Predicate() {
+			continue
+		}
+		if _, err = newSch.Indexes().RemoveIndex(index.Name()); err != nil {
+			return err
+		}
+		_, err = newSch.Indexes().AddIndexByColTags(index.Name(), index.IndexedColumnTags(), index.PrefixLengths(), schema.IndexProperties{
+			IsUnique:      index.IsUnique(),
+			IsUserDefined: index.IsUserDefined(),
+			Comment:       index.Comment(),
+			IncludedTags:  index.IncludedColumnTags(),
+			Predicate:     predicate,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modifyIndexForIncludedColumnDrop re-adds |index| to the schema without the dropped included column |droppedTag|.
+func modifyIndexForIncludedColumnDrop(index schema.Index, newSch schema.Schema, droppedTag uint64) {
+	var includedTags []uint64
+	for _, tag := range index.IncludedColumnTags() {
+		if tag != droppedTag {
+			includedTags = append(includedTags, tag)
+		}
+	}
+	newSch.Indexes().AddIndexByColNames(
+		index.Name(),
+		index.ColumnNames(),
+		index.PrefixLengths(),
+		schema.IndexProperties{
+			IsUnique:      index.IsUnique(),
+			IsSpatial:     index.IsSpatial(),
+			IsUserDefined: index.IsUserDefined(),
+			Comment:       index.Comment(),
+			IncludedTags:  includedTags,
+			Predicate:     index.Predicate(),
+		})
+}
+
 // modifyFulltextIndexForColumnDrop modifies a fulltext index to remove a column that was dropped, adding it to the
 // schema's indexes only if there are still remaining columns in the index after the drop
 func modifyFulltextIndexForColumnDrop(index schema.Index, newSch schema.Schema, droppedCol *sql.Column) {
@@ -2077,6 +2254,17 @@ func modifyIndexesForTableRewrite(ctx *sql.Context, oldSch schema.Schema, oldCol
 			prefixLengths = nil
 		}
 
+		var includedTags []uint64
+		for _, tag := range index.IncludedColumnTags() {
+			colName := oldSch.GetAllCols().TagToCol[tag].Name
+			if strings.EqualFold(oldColumn.Name, colName) {
+				colName = newColumn.Name
+			}
+			if col, ok := newSch.GetAllCols().GetByName(colName); ok {
+				includedTags = append(includedTags, col.Tag)
+			}
+		}
+
 		newSch.Indexes().AddIndexByColNames(
 			index.Name(),
 			colNames,
@@ -2085,8 +2273,11 @@ func modifyIndexesForTableRewrite(ctx *sql.Context, oldSch schema.Schema, oldCol
 				IsUnique:           index.IsUnique(),
 				IsSpatial:          index.IsSpatial(),
 				IsFullText:         index.IsFullText(),
+				IsColumnar:         index.IsColumnar(),
 				IsUserDefined:      index.IsUserDefined(),
 				Comment:            index.Comment(),
+				IncludedTags:       includedTags,
+				Predicate:          index.Predicate(),
 				FullTextProperties: index.FullTextProperties(),
 			})
 	}
@@ -2324,6 +2515,20 @@ func (t *AlterableDoltTable) ModifyColumn(ctx *sql.Context, columnName string, c
 		return err
 	}
 
+	if existingCol.Name != col.Name {
+		newSch, err := updatedTable.GetSchema(ctx)
+		if err != nil {
+			return err
+		}
+		if err = renamePartialIndexColumn(ctx, t.tableName, sch, newSch, existingCol.Name, col.Name); err != nil {
+			return err
+		}
+		updatedTable, err = updatedTable.UpdateSchema(ctx, newSch)
+		if err != nil {
+			return err
+		}
+	}
+
 	// For auto columns modified to be auto increment, we have more work to do
 	if !existingCol.AutoIncrement && col.AutoIncrement {
 		// TODO: delegate this to tracker?
@@ -2552,16 +2757,6 @@ func (t *AlterableDoltTable) CreateFulltextIndex(ctx *sql.Context, idx sql.Index
 
 // createIndex handles the common functionality between CreateIndex and CreateFulltextIndex.
 func (t *AlterableDoltTable) createIndex(ctx *sql.Context, idx sql.IndexDef, keyCols fulltext.KeyColumns, tableNames fulltext.IndexTableNames) error {
-	columns := make([]string, len(idx.Columns))
-	for i, indexCol := range idx.Columns {
-		columns[i] = indexCol.Name
-	}
-
-	table, err := t.DoltTable.DoltTable(ctx)
-	if err != nil {
-		return err
-	}
-
 	var keyPositions []uint16
 	if len(keyCols.Positions) > 0 {
 		keyPositions = make([]uint16, len(keyCols.Positions))
@@ -2570,7 +2765,7 @@ func (t *AlterableDoltTable) createIndex(ctx *sql.Context, idx sql.IndexDef, key
 		}
 	}
 
-	ret, err := creation.CreateIndex(ctx, table, t.Name(), idx.Name, columns, allocatePrefixLengths(idx.Columns), schema.IndexProperties{
+	return t.createIndexWithProperties(ctx, idx, schema.IndexProperties{
 		IsUnique:      idx.Constraint == sql.IndexConstraint_Unique,
 		IsSpatial:     idx.Constraint == sql.IndexConstraint_Spatial,
 		IsFullText:    idx.Constraint == sql.IndexConstraint_Fulltext,
@@ -2586,7 +2781,23 @@ func (t *AlterableDoltTable) createIndex(ctx *sql.Context, idx sql.IndexDef, key
 			KeyName:          keyCols.Name,
 			KeyPositions:     keyPositions,
 		},
-	}, t.opts)
+	})
+}
+
+// createIndexWithProperties creates the index |idx| with the given |props|, which may describe kinds of indexes that
+// sql.IndexDef can't, such as columnar indexes.
+func (t *AlterableDoltTable) createIndexWithProperties(ctx *sql.Context, idx sql.IndexDef, props schema.IndexProperties) error {
+	columns := make([]string, len(idx.Columns))
+	for i, indexCol := range idx.Columns {
+		columns[i] = indexCol.Name
+	}
+
+	table, err := t.DoltTable.DoltTable(ctx)
+	if err != nil {
+		return err
+	}
+
+	ret, err := creation.CreateIndex(ctx, table, t.Name(), idx.Name, columns, allocatePrefixLengths(idx.Columns), props, t.opts)
 	if err != nil {
 		return err
 	}
@@ -3329,6 +3540,10 @@ func FindIndexWithPrefix(sch schema.Schema, prefixCols []string) (schema.Index,
 	colLen := len(prefixCols)
 	var indexesWithLen []idxWithLen
 	for _, idx := range indexes {
+		// partial indexes only hold some rows, so they can't be used to check references
+		if idx.IsColumnar() || idx.Predicate() != "" {
+			continue
+		}
 		idxCols := lowercaseSlice(idx.ColumnNames())
 		if ok, prefixCount := colsAreIndexSubset(prefixCols, idxCols); ok && prefixCount == colLen {
 			indexesWithLen = append(indexesWithLen, idxWithLen{idx, len(idxCols)})
@@ -15,12 +15,75 @@
 package sqle
 
 import (
+	"context"
+	"io"
 	"testing"
 
+	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dtestutils"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 )
 
 func TestMinRowsPerPartitionInTests(t *testing.T) {
 	// If this fails then the method for determining if we are running in a test doesn't work all the time.
 	assert.Equal(t, uint64(2), MinRowsPerPartition)
 }
+
+func TestDoltTablePartitions(t *testing.T) {
+	dEnv := dtestutils.CreateTestEnv()
+	defer dEnv.DoltDB.Close()
+	tmpDir, err := dEnv.TempTableFilesDir()
+	require.NoError(t, err)
+	opts := editor.Options{Deaf: dEnv.DbEaFactory(), Tempdir: tmpDir}
+	db, err := NewDatabase(context.Background(), "dolt", dEnv.DbData(), opts)
+	require.NoError(t, err)
+
+	engine, ctx, err := NewTestEngine(dEnv, context.Background(), db)
+	require.NoError(t, err)
+
+	for _, q := range []string{
+		"create table xy (x int primary key, y varchar(20))",
+		"insert into xy with recursive r(i) as (select 0 union all select i+1 from r where i < 199) " +
+			"select a.i*100+b.i, concat('row ', a.i*100+b.i) from r a join r b where b.i < 100",
+	} {
+		_, iter, _, err := engine.Query(ctx, q)
+		require.NoError(t, err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+	}
+
+	tbl, ok, err := db.GetTableInsensitive(ctx, "xy")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	parts, err := tbl.Partitions(ctx)
+	require.NoError(t, err)
+	keys := make(map[string]struct{})
+	var rows []sql.Row
+	for {
+		p, err := parts.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		keys[string(p.Key())] = struct{}{}
+
+		iter, err := tbl.PartitionRows(ctx, p)
+		require.NoError(t, err)
+		r, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err)
+		assert.NotEmpty(t, r)
+		rows = append(rows, r...)
+	}
+	require.NoError(t, parts.Close(ctx))
+
+	// each partition is a distinct key range, and together they hold every row in order
+	assert.Greater(t, len(keys), 1)
+	require.Len(t, rows, 20_000)
+	for i, r := range rows {
+		assert.Equal(t, int32(i), r[0])
+	}
+}
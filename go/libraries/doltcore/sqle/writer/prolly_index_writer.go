@@ -25,6 +25,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/val"
@@ -271,11 +272,21 @@ type prollySecondaryIndexWriter struct {
 	// keyBld builds key tuples for the secondary index
 	keyBld *val.TupleBuilder
 
+	// valMap is a mapping from sql.Row fields to the
+	// included columns stored in this index's values
+	valMap val.OrdinalMapping
+	// valBld builds value tuples for the secondary index
+	valBld *val.TupleBuilder
+
 	// pkMap is a mapping from secondary index keys to
 	// primary key clustered index keys
 	pkMap val.OrdinalMapping
 	// pkBld builds key tuples for primary key index
 	pkBld *val.TupleBuilder
+
+	// predicate selects the rows stored in a partial index,
+	// it is nil for indexes that store every row
+	predicate sql.Expression
 }
 
 var _ indexWriter = prollySecondaryIndexWriter{}
@@ -290,6 +301,9 @@ func (m prollySecondaryIndexWriter) Map(ctx context.Context) (prolly.MapInterfac
 
 func (m prollySecondaryIndexWriter) ValidateKeyViolations(ctx context.Context, sqlRow sql.Row) error {
 	if m.unique {
+		if ok, err := index.PredicateMatchesRow(ctx, m.predicate, sqlRow); err != nil || !ok {
+			return err
+		}
 		if err := m.checkForUniqueKeyErr(ctx, sqlRow); err != nil {
 			return err
 		}
@@ -317,12 +331,24 @@ func (m prollySecondaryIndexWriter) keyFromRow(ctx context.Context, sqlRow sql.R
 	return m.keyBld.Build(sharePool), nil
 }
 
+// valueFromRow builds the value tuple holding the included columns of |sqlRow|.
+func (m prollySecondaryIndexWriter) valueFromRow(ctx context.Context, sqlRow sql.Row) (val.Tuple, error) {
+	return secondaryValueFromRow(ctx, m.mut.NodeStore(), m.valMap, m.valBld, sqlRow)
+}
+
 func (m prollySecondaryIndexWriter) Insert(ctx context.Context, sqlRow sql.Row) error {
+	if ok, err := index.PredicateMatchesRow(ctx, m.predicate, sqlRow); err != nil || !ok {
+		return err
+	}
 	k, err := m.keyFromRow(ctx, sqlRow)
 	if err != nil {
 		return err
 	}
-	return m.mut.Put(ctx, k, val.EmptyTuple)
+	v, err := m.valueFromRow(ctx, sqlRow)
+	if err != nil {
+		return err
+	}
+	return m.mut.Put(ctx, k, v)
 }
 
 func (m prollySecondaryIndexWriter) checkForUniqueKeyErr(ctx context.Context, sqlRow sql.Row) error {
@@ -377,6 +403,9 @@ func (m prollySecondaryIndexWriter) checkForUniqueKeyErr(ctx context.Context, sq
 }
 
 func (m prollySecondaryIndexWriter) Delete(ctx context.Context, sqlRow sql.Row) error {
+	if ok, err := index.PredicateMatchesRow(ctx, m.predicate, sqlRow); err != nil || !ok {
+		return err
+	}
 	k := m.keyBld.Build(sharePool)
 	k, err := m.keyFromRow(ctx, sqlRow)
 	if err != nil {
@@ -386,6 +415,17 @@ func (m prollySecondaryIndexWriter) Delete(ctx context.Context, sqlRow sql.Row)
 }
 
 func (m prollySecondaryIndexWriter) Update(ctx context.Context, oldRow sql.Row, newRow sql.Row) error {
+	if m.predicate != nil {
+		// rows of a partial index may move in or out of it
+		if err := m.Delete(ctx, oldRow); err != nil {
+			return err
+		}
+		if err := m.ValidateKeyViolations(ctx, newRow); err != nil {
+			return err
+		}
+		return m.Insert(ctx, newRow)
+	}
+
 	oldKey, err := m.keyFromRow(ctx, oldRow)
 	if err != nil {
 		return err
@@ -407,7 +447,11 @@ func (m prollySecondaryIndexWriter) Update(ctx context.Context, oldRow sql.Row,
 	if err != nil {
 		return err
 	}
-	return m.mut.Put(ctx, newKey, val.EmptyTuple)
+	newVal, err := m.valueFromRow(ctx, newRow)
+	if err != nil {
+		return err
+	}
+	return m.mut.Put(ctx, newKey, newVal)
 }
 
 func (m prollySecondaryIndexWriter) Commit(ctx context.Context) error {
@@ -427,6 +471,21 @@ func (m prollySecondaryIndexWriter) IterRange(ctx context.Context, rng prolly.Ra
 	return m.mut.IterRange(ctx, rng)
 }
 
+// secondaryValueFromRow builds a secondary index value tuple from the fields of |sqlRow| mapped by |valMap|. Indexes
+// without included columns store empty values.
+func secondaryValueFromRow(ctx context.Context, ns tree.NodeStore, valMap val.OrdinalMapping, valBld *val.TupleBuilder, sqlRow sql.Row) (val.Tuple, error) {
+	if len(valMap) == 0 {
+		return val.EmptyTuple, nil
+	}
+	for to := range valMap {
+		from := valMap.MapOrdinal(to)
+		if err := tree.PutField(ctx, ns, valBld, to, sqlRow[from]); err != nil {
+			return nil, err
+		}
+	}
+	return valBld.Build(sharePool), nil
+}
+
 // FormatKeyForUniqKeyErr formats the given tuple |key| using |d|. The resulting
 // string is suitable for use in a sql.UniqueKeyError
 func FormatKeyForUniqKeyErr(key val.Tuple, d val.TupleDesc, sqlRow sql.Row) string {
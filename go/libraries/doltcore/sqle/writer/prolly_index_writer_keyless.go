@@ -20,6 +20,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/val"
@@ -192,6 +193,9 @@ type prollyKeylessSecondaryWriter struct {
 	prefixBld *val.TupleBuilder
 	hashBld   *val.TupleBuilder
 	keyMap    val.OrdinalMapping
+	valBld    *val.TupleBuilder
+	valMap    val.OrdinalMapping
+	predicate sql.Expression
 }
 
 var _ indexWriter = prollyKeylessSecondaryWriter{}
@@ -236,6 +240,9 @@ func (writer prollyKeylessSecondaryWriter) trimKeyPart(to int, keyPart interface
 
 // Insert implements the interface indexWriter.
 func (writer prollyKeylessSecondaryWriter) Insert(ctx context.Context, sqlRow sql.Row) error {
+	if ok, err := index.PredicateMatchesRow(ctx, writer.predicate, sqlRow); err != nil || !ok {
+		return err
+	}
 	for to := range writer.keyMap {
 		from := writer.keyMap.MapOrdinal(to)
 		keyPart := writer.trimKeyPart(to, sqlRow[from])
@@ -266,7 +273,11 @@ func (writer prollyKeylessSecondaryWriter) Insert(ctx context.Context, sqlRow sq
 		writer.prefixBld.Recycle()
 	}
 
-	return writer.mut.Put(ctx, indexKey, val.EmptyTuple)
+	indexVal, err := secondaryValueFromRow(ctx, writer.mut.NodeStore(), writer.valMap, writer.valBld, sqlRow)
+	if err != nil {
+		return err
+	}
+	return writer.mut.Put(ctx, indexKey, indexVal)
 }
 
 func (writer prollyKeylessSecondaryWriter) checkForUniqueKeyError(ctx context.Context, prefixKey val.Tuple, sqlRow sql.Row) error {
@@ -301,6 +312,9 @@ func (writer prollyKeylessSecondaryWriter) checkForUniqueKeyError(ctx context.Co
 
 // Delete implements the interface indexWriter.
 func (writer prollyKeylessSecondaryWriter) Delete(ctx context.Context, sqlRow sql.Row) error {
+	if ok, err := index.PredicateMatchesRow(ctx, writer.predicate, sqlRow); err != nil || !ok {
+		return err
+	}
 	hashId, cardRow, err := writer.primary.tuplesFromRow(ctx, sqlRow)
 	if err != nil {
 		return err
@@ -26,6 +26,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/pool"
+	"github.com/dolthub/dolt/go/store/prolly"
 	"github.com/dolthub/dolt/go/store/val"
 )
 
@@ -70,7 +71,7 @@ func getSecondaryProllyIndexWriters(ctx context.Context, t *doltdb.Table, schSta
 	writers := make(map[string]indexWriter)
 
 	for _, def := range schState.SecIndexes {
-		if def.IsFullText {
+		if def.IsFullText || def.IsColumnar {
 			continue
 		}
 		defName := def.Name
@@ -80,7 +81,7 @@ func getSecondaryProllyIndexWriters(ctx context.Context, t *doltdb.Table, schSta
 		}
 		idxMap := durable.MapFromIndex(idxRows)
 
-		keyDesc, _ := idxMap.Descriptors()
+		keyDesc, valDesc := idxMap.Descriptors()
 
 		// mapping from secondary index key to primary key
 		writers[defName] = prollySecondaryIndexWriter{
@@ -91,8 +92,11 @@ func getSecondaryProllyIndexWriters(ctx context.Context, t *doltdb.Table, schSta
 			idxCols:       def.Count,
 			keyMap:        def.KeyMapping,
 			keyBld:        val.NewTupleBuilder(keyDesc),
+			valMap:        def.ValMapping,
+			valBld:        val.NewTupleBuilder(valDesc),
 			pkMap:         def.PkMapping,
 			pkBld:         val.NewTupleBuilder(schState.PkKeyDesc),
+			predicate:     def.Predicate,
 		}
 	}
 
@@ -108,7 +112,7 @@ func getSecondaryKeylessProllyWriters(ctx context.Context, t *doltdb.Table, schS
 	writers := make(map[string]indexWriter)
 
 	for _, def := range schState.SecIndexes {
-		if def.IsFullText {
+		if def.IsFullText || def.IsColumnar {
 			continue
 		}
 		defName := def.Name
@@ -118,7 +122,7 @@ func getSecondaryKeylessProllyWriters(ctx context.Context, t *doltdb.Table, schS
 		}
 		m := durable.ProllyMapFromIndex(idxRows)
 
-		keyDesc, _ := m.Descriptors()
+		keyDesc, valDesc := m.Descriptors()
 
 		writers[defName] = prollyKeylessSecondaryWriter{
 			name:          defName,
@@ -131,6 +135,9 @@ func getSecondaryKeylessProllyWriters(ctx context.Context, t *doltdb.Table, schS
 			prefixBld:     val.NewTupleBuilder(keyDesc.PrefixDesc(def.Count)),
 			hashBld:       val.NewTupleBuilder(val.NewTupleDescriptor(val.Type{Enc: val.Hash128Enc})),
 			keyMap:        def.KeyMapping,
+			valBld:        val.NewTupleBuilder(valDesc),
+			valMap:        def.ValMapping,
+			predicate:     def.Predicate,
 		}
 	}
 
@@ -348,6 +355,11 @@ func (w *prollyTableWriter) table(ctx context.Context) (t *doltdb.Table, err err
 		return nil, err
 	}
 
+	s, err = w.syncColumnarIndexes(ctx, s, pm)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, wrSecondary := range w.secondary {
 		sm, err := wrSecondary.Map(ctx)
 		if err != nil {
@@ -369,6 +381,20 @@ func (w *prollyTableWriter) table(ctx context.Context) (t *doltdb.Table, err err
 	return t, nil
 }
 
+// syncColumnarIndexes updates the columnar indexes in |s| to reflect the edits made to the primary index |pm|.
+// Columnar indexes are not maintained row by row, so they're synced from the diff of the primary index instead.
+func (w *prollyTableWriter) syncColumnarIndexes(ctx context.Context, s durable.IndexSet, pm prolly.MapInterface) (durable.IndexSet, error) {
+	to, ok := pm.(prolly.Map)
+	if !ok || !w.sch.Indexes().ContainsColumnarIndex() {
+		return s, nil
+	}
+	rows, err := w.tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return index.SyncColumnarIndexes(ctx, w.sch, s, durable.ProllyMapFromIndex(rows), to)
+}
+
 func (w *prollyTableWriter) flush(ctx *sql.Context) error {
 	ws, err := w.flusher.FlushWithAutoIncrementOverrides(ctx, w.setAutoIncrement, w.nextAutoIncrementValue)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 	"github.com/dolthub/dolt/go/store/val"
 )
@@ -84,6 +85,13 @@ func newWriterSchema(ctx *sql.Context, t *doltdb.Table, tableName string, dbName
 	for _, def := range definitions {
 		keyMap, valMap := ordinalMappingsFromSchema(schState.PkSchema.Schema, def.Schema())
 		pkMap := makeIndexToIndexMapping(def.Schema().GetPKCols(), schState.DoltSchema.GetPKCols())
+		var predicate sql.Expression
+		if def.Predicate() != "" {
+			predicate, err = expranalysis.ResolveIndexPredicate(ctx, tableName, schState.DoltSchema, def.Predicate())
+			if err != nil {
+				return nil, err
+			}
+		}
 		idxState := dsess.IndexState{
 			KeyMapping:    keyMap,
 			ValMapping:    valMap,
@@ -92,9 +100,11 @@ func newWriterSchema(ctx *sql.Context, t *doltdb.Table, tableName string, dbName
 			Schema:        def.Schema(),
 			Count:         def.Count(),
 			IsFullText:    def.IsFullText(),
+			IsColumnar:    def.IsColumnar(),
 			IsUnique:      def.IsUnique(),
 			IsSpatial:     def.IsSpatial(),
 			PrefixLengths: def.PrefixLengths(),
+			Predicate:     predicate,
 		}
 		schState.SecIndexes = append(schState.SecIndexes, idxState)
 	}
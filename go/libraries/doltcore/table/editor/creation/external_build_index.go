@@ -58,6 +58,10 @@ func BuildProllyIndexExternal(ctx *sql.Context, vrw types.ValueReadWriter, ns tr
 	if err != nil {
 		return nil, err
 	}
+	pred, err := index.NewIndexPredicate(ctx, tableName, sch, idx, ns)
+	if err != nil {
+		return nil, err
+	}
 
 	sorter := sort.NewTupleSorter(batchSize, fileMax, func(t1, t2 val.Tuple) bool {
 		return secondary.KeyDesc().Compare(t1, t2) < 0
@@ -72,6 +76,12 @@ func BuildProllyIndexExternal(ctx *sql.Context, vrw types.ValueReadWriter, ns tr
 			return nil, err
 		}
 
+		if ok, err := pred.Matches(ctx, k, v); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
 		idxKey, err := secondaryBld.SecondaryKeyFromRow(ctx, k, v)
 		if err != nil {
 			return nil, err
@@ -107,9 +117,55 @@ func BuildProllyIndexExternal(ctx *sql.Context, vrw types.ValueReadWriter, ns tr
 		return nil, tupIter.err
 	}
 
+	if len(idx.IncludedColumnTags()) > 0 {
+		ret, err = putIncludedColumns(ctx, sch, idx, primary, ret, secondaryBld, pred, uniqCb != nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return durable.IndexFromProllyMap(ret), nil
 }
 
+// putIncludedColumns fills in the values of |secondary| with the included columns of |idx|. Only keys are presorted
+// by BuildProllyIndexExternal, so values are written by a second pass over |primary|.
+func putIncludedColumns(ctx *sql.Context, sch schema.Schema, idx schema.Index, primary, secondary prolly.Map, secondaryBld index.SecondaryKeyBuilder, pred index.IndexPredicate, skipNulls bool) (prolly.Map, error) {
+	iter, err := primary.IterAll(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	prefixDesc := secondary.KeyDesc().PrefixDesc(idx.Count())
+	valueBld := index.NewSecondaryValueBuilder(sch, idx, primary.Pool())
+
+	mut := secondary.Mutate()
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return prolly.Map{}, err
+		}
+
+		if ok, err := pred.Matches(ctx, k, v); err != nil {
+			return prolly.Map{}, err
+		} else if !ok {
+			continue
+		}
+
+		idxKey, err := secondaryBld.SecondaryKeyFromRow(ctx, k, v)
+		if err != nil {
+			return prolly.Map{}, err
+		}
+		if skipNulls && prefixDesc.HasNulls(idxKey) {
+			continue
+		}
+		if err = mut.Put(ctx, idxKey, valueBld.SecondaryValueFromRow(v)); err != nil {
+			return prolly.Map{}, err
+		}
+	}
+	return mut.Map(ctx)
+}
+
 type tupleIterWithCb struct {
 	iter sort.KeyIter
 	err  error
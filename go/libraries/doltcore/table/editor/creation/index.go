@@ -82,9 +82,10 @@ func CreateIndex(
 		return nil, fmt.Errorf("invalid index name `%s`", indexName)
 	}
 
-	// if an index was already created for the column set but was not generated by the user then we replace it
+	// if an index was already created for the column set but was not generated by the user then we replace it,
+	// unless the new index can't serve the foreign key the existing one was created for
 	existingIndex, ok := sch.Indexes().GetIndexByColumnNames(realColNames...)
-	if ok && !existingIndex.IsUserDefined() {
+	if ok && !existingIndex.IsUserDefined() && !props.IsColumnar && props.Predicate == "" {
 		_, err = sch.Indexes().RemoveIndex(existingIndex.Name())
 		if err != nil {
 			return nil, err
@@ -105,6 +106,11 @@ func CreateIndex(
 	if err != nil {
 		return nil, err
 	}
+	if index.IsColumnar() {
+		if err = validateColumnarIndex(table, sch, index); err != nil {
+			return nil, err
+		}
+	}
 
 	// update the table schema with the new index
 	newTable, err := table.UpdateSchema(ctx, sch)
@@ -132,9 +138,19 @@ func CreateIndex(
 	}, nil
 }
 
+func validateColumnarIndex(table *doltdb.Table, sch schema.Schema, idx schema.Index) error {
+	if table.Format() != types.Format_DOLT {
+		return fmt.Errorf("columnar indexes are not supported by this storage format")
+	}
+	return index.ValidateColumnarIndex(sch, idx)
+}
+
 func BuildSecondaryIndex(ctx *sql.Context, tbl *doltdb.Table, idx schema.Index, tableName string, opts editor.Options) (durable.Index, error) {
 	switch tbl.Format() {
 	case types.Format_LD_1:
+		if idx.Predicate() != "" {
+			return nil, fmt.Errorf("partial indexes are not supported by this storage format")
+		}
 		m, err := editor.RebuildIndex(ctx, tbl, idx.Name(), opts)
 		if err != nil {
 			return nil, err
@@ -169,6 +185,14 @@ func BuildSecondaryProllyIndex(
 	idx schema.Index,
 	primary prolly.Map,
 ) (durable.Index, error) {
+	if idx.IsColumnar() {
+		m, err := index.BuildColumnarIndex(ctx, ns, sch, idx, primary)
+		if err != nil {
+			return nil, err
+		}
+		return durable.IndexFromProllyMap(m), nil
+	}
+
 	var uniqCb DupEntryCb
 	if idx.IsUnique() {
 		kd := idx.Schema().GetKeyDescriptor()
@@ -231,6 +255,11 @@ func BuildUniqueProllyIndex(
 	if err != nil {
 		return nil, err
 	}
+	valueBld := index.NewSecondaryValueBuilder(sch, idx, p)
+	pred, err := index.NewIndexPredicate(ctx, tableName, sch, idx, ns)
+	if err != nil {
+		return nil, err
+	}
 
 	mut := secondary.Mutate()
 	for {
@@ -242,6 +271,12 @@ func BuildUniqueProllyIndex(
 			return nil, err
 		}
 
+		if ok, err := pred.Matches(ctx, k, v); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
 		idxKey, err := secondaryBld.SecondaryKeyFromRow(ctx, k, v)
 		if err != nil {
 			return nil, err
@@ -262,7 +297,7 @@ func BuildUniqueProllyIndex(
 			return nil, err
 		}
 
-		if err = mut.Put(ctx, idxKey, val.EmptyTuple); err != nil {
+		if err = mut.Put(ctx, idxKey, valueBld.SecondaryValueFromRow(v)); err != nil {
 			return nil, err
 		}
 	}
@@ -0,0 +1,96 @@
+// Copyright 2026 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlexport
+
+import (
+	"context"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+)
+
+// DefaultResultTableName is the table name used in the insert statements for a result set whose columns don't all
+// come from the same known table.
+const DefaultResultTableName = "result"
+
+// SqlResultWriter is a SqlRowWriter that writes a SQL insert statement for each row of a query result set. Unlike
+// SqlExportWriter, it needs no table in a root value, so it can be used for any result set, including those returned by
+// a remote server.
+type SqlResultWriter struct {
+	tableName string
+	sch       schema.Schema
+	wr        io.WriteCloser
+}
+
+var _ table.SqlRowWriter = (*SqlResultWriter)(nil)
+
+// NewSqlResultWriter returns a new SqlResultWriter for the result set schema given. The insert statements are for the
+// table that all columns of |sqlSch| come from, or for DefaultResultTableName if they don't come from a single table.
+func NewSqlResultWriter(wr io.WriteCloser, sqlSch sql.Schema) (*SqlResultWriter, error) {
+	cols := schema.NewColCollection()
+	for i, col := range sqlSch {
+		doltCol, err := sqlutil.ToDoltCol(uint64(i), col)
+		if err != nil {
+			return nil, err
+		}
+		cols = cols.Append(doltCol)
+	}
+
+	return &SqlResultWriter{
+		tableName: resultTableName(sqlSch),
+		sch:       schema.UnkeyedSchemaFromCols(cols),
+		wr:        wr,
+	}, nil
+}
+
+// resultTableName returns the name of the table that all columns of |sqlSch| come from, or DefaultResultTableName.
+func resultTableName(sqlSch sql.Schema) string {
+	if len(sqlSch) == 0 || sqlSch[0].Source == "" {
+		return DefaultResultTableName
+	}
+	for _, col := range sqlSch[1:] {
+		if col.Source != sqlSch[0].Source {
+			return DefaultResultTableName
+		}
+	}
+	return sqlSch[0].Source
+}
+
+func (w *SqlResultWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	if r == nil {
+		return nil
+	}
+
+	stmt, err := sqlfmt.SqlRowAsInsertStmt(r, w.tableName, w.sch)
+	if err != nil {
+		return err
+	}
+
+	return iohelp.WriteLine(w.wr, stmt)
+}
+
+// Close should flush all writes, release resources being held
+func (w *SqlResultWriter) Close(ctx context.Context) error {
+	if w.wr != nil {
+		return w.wr.Close()
+	}
+	return nil
+}
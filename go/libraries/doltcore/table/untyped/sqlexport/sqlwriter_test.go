@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -124,6 +125,59 @@ func TestEndToEnd(t *testing.T) {
 	}
 }
 
+func TestSqlResultWriter(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		sch            sql.Schema
+		rows           []sql.Row
+		expectedOutput string
+	}{
+		{
+			name: "columns from one table",
+			sch: sql.Schema{
+				{Name: "id", Type: types.Int64, Source: "people"},
+				{Name: "name", Type: types.LongText, Source: "people", Nullable: true},
+			},
+			rows: []sql.Row{{int64(1), "Bill 'Billy' Billerson"}, {int64(2), nil}},
+			expectedOutput: "INSERT INTO `people` (`id`,`name`) VALUES (1,'Bill \\'Billy\\' Billerson');\n" +
+				"INSERT INTO `people` (`id`,`name`) VALUES (2,NULL);\n",
+		},
+		{
+			name: "columns from more than one table",
+			sch: sql.Schema{
+				{Name: "id", Type: types.Int64, Source: "people"},
+				{Name: "title", Type: types.LongText, Source: "titles"},
+			},
+			rows:           []sql.Row{{int64(1), "Mr"}},
+			expectedOutput: "INSERT INTO `result` (`id`,`title`) VALUES (1,'Mr');\n",
+		},
+		{
+			name: "no rows",
+			sch: sql.Schema{
+				{Name: "id", Type: types.Int64, Source: "people"},
+			},
+			expectedOutput: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stringWr StringBuilderCloser
+			w, err := NewSqlResultWriter(&stringWr, tt.sch)
+			require.NoError(t, err)
+
+			for _, r := range tt.rows {
+				assert.NoError(t, w.WriteSqlRow(ctx, r))
+			}
+
+			assert.NoError(t, w.Close(ctx))
+			assert.Equal(t, tt.expectedOutput, stringWr.String())
+		})
+	}
+}
+
 func rs(rs ...row.Row) []row.Row {
 	return rs
 }
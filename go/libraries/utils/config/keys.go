@@ -31,6 +31,8 @@ var ConfigOptions = map[string]struct{}{
 	PushAutoSetupRemote:   {},
 	ProfileKey:            {},
 	VersionCheckDisabled:  {},
+	OIDCIssuerKey:         {},
+	OIDCClientIDKey:       {},
 }
 
 const UserEmailKey = "user.email"
@@ -68,3 +70,7 @@ const VersionCheckDisabled = "versioncheck.disabled"
 const SignCommitsKey = "commit.gpgsign"
 
 const GPGSigningKeyKey = "user.signingkey"
+
+const OIDCIssuerKey = "oidc.issuer"
+
+const OIDCClientIDKey = "oidc.client_id"
@@ -26,6 +26,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/server"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/commands"
@@ -119,8 +120,48 @@ func BenchmarkSelectRandomRanges(b *testing.B) {
 	})
 }
 
+// BenchmarkHashJoin compares the kvexec hash join with the default GMS
+// hash join on an unindexed equality condition.
+func BenchmarkHashJoin(b *testing.B) {
+	getQuery := func(int) string {
+		return "SELECT /*+ HASH_JOIN(a,b) */ a.id, b.id FROM sbtest1 a JOIN sbtest1 b ON a.pad = b.pad"
+	}
+	b.Run("kvexec", func(b *testing.B) {
+		benchmarkSysbenchQuery(b, getQuery)
+	})
+	b.Run("rowexec", func(b *testing.B) {
+		benchmarkRowexecQuery(b, getQuery)
+	})
+}
+
+// BenchmarkGroupByAggregation compares the kvexec grouped aggregation with
+// the default GMS aggregation.
+func BenchmarkGroupByAggregation(b *testing.B) {
+	getQuery := func(int) string {
+		return "SELECT k, sum(id), avg(id), min(id), max(id) FROM sbtest1 GROUP BY k"
+	}
+	b.Run("kvexec", func(b *testing.B) {
+		benchmarkSysbenchQuery(b, getQuery)
+	})
+	b.Run("rowexec", func(b *testing.B) {
+		benchmarkRowexecQuery(b, getQuery)
+	})
+}
+
 func benchmarkSysbenchQuery(b *testing.B, getQuery func(int) string) {
 	ctx, eng := setupBenchmark(b, dEnv)
+	runBenchmarkQueries(b, ctx, eng, getQuery)
+}
+
+// benchmarkRowexecQuery runs the benchmark without the kvexec operators,
+// using only the default GMS executors.
+func benchmarkRowexecQuery(b *testing.B, getQuery func(int) string) {
+	ctx, eng := setupBenchmark(b, dEnv)
+	eng.GetUnderlyingEngine().Analyzer.ExecBuilder = rowexec.DefaultBuilder
+	runBenchmarkQueries(b, ctx, eng, getQuery)
+}
+
+func runBenchmarkQueries(b *testing.B, ctx *sql.Context, eng *engine.SqlEngine, getQuery func(int) string) {
 	for i := 0; i < b.N; i++ {
 		schema, iter, _, err := eng.Query(ctx, getQuery(i))
 		require.NoError(b, err)
@@ -169,6 +169,7 @@ type RebaseState struct {
 	emptyCommitHandling        uint8
 	lastAttemptedStep          float32
 	rebasingStarted            bool
+	strategyOption             string
 }
 
 func (rs *RebaseState) PreRebaseWorkingAddr() hash.Hash {
@@ -206,12 +207,26 @@ func (rs *RebaseState) EmptyCommitHandling(_ context.Context) uint8 {
 	return rs.emptyCommitHandling
 }
 
+func (rs *RebaseState) StrategyOption(_ context.Context) string {
+	return rs.strategyOption
+}
+
+// SchemaConflictResolution records how a column schema conflict was resolved in a table that still has other
+// unresolved schema conflicts.
+type SchemaConflictResolution struct {
+	TableName  string
+	ColumnName string
+	Resolution string
+}
+
 type MergeState struct {
-	preMergeWorkingAddr *hash.Hash
-	fromCommitAddr      *hash.Hash
-	fromCommitSpec      string
-	unmergableTables    []string
-	isCherryPick        bool
+	preMergeWorkingAddr     *hash.Hash
+	fromCommitAddr          *hash.Hash
+	fromCommitSpec          string
+	unmergableTables        []string
+	resolvedSchemaConflicts []SchemaConflictResolution
+	isCherryPick            bool
+	strategyOption          string
 
 	nomsMergeStateRef *types.Ref
 	nomsMergeState    *types.Struct
@@ -317,6 +332,20 @@ func (ms *MergeState) UnmergableTables(ctx context.Context, vr types.ValueReader
 	return nil, nil
 }
 
+func (ms *MergeState) ResolvedSchemaConflicts(ctx context.Context, vr types.ValueReader) ([]SchemaConflictResolution, error) {
+	if vr.Format().UsesFlatbuffers() {
+		return ms.resolvedSchemaConflicts, nil
+	}
+	return nil, nil
+}
+
+func (ms *MergeState) StrategyOption(_ context.Context, vr types.ValueReader) string {
+	if vr.Format().UsesFlatbuffers() {
+		return ms.strategyOption
+	}
+	return ""
+}
+
 type dsHead interface {
 	TypeName() string
 	Addr() hash.Hash
@@ -443,6 +472,21 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 			ret.MergeState.unmergableTables[i] = string(mergeState.UnmergableTables(i))
 		}
 		ret.MergeState.isCherryPick = mergeState.IsCherryPick()
+		ret.MergeState.strategyOption = string(mergeState.StrategyOption())
+		if n := mergeState.ResolvedSchemaConflictsLength(); n > 0 {
+			ret.MergeState.resolvedSchemaConflicts = make([]SchemaConflictResolution, n)
+			var res serial.SchemaConflictResolution
+			for i := range ret.MergeState.resolvedSchemaConflicts {
+				if _, err := mergeState.TryResolvedSchemaConflicts(&res, i); err != nil {
+					return nil, err
+				}
+				ret.MergeState.resolvedSchemaConflicts[i] = SchemaConflictResolution{
+					TableName:  string(res.TableName()),
+					ColumnName: string(res.ColumnName()),
+					Resolution: string(res.Resolution()),
+				}
+			}
+		}
 	}
 
 	rebaseState, err := h.msg.TryRebaseState(nil)
@@ -458,6 +502,7 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 			rebaseState.EmptyCommitHandling(),
 			rebaseState.LastAttemptedStep(),
 			rebaseState.RebasingStarted(),
+			string(rebaseState.StrategyOption()),
 		)
 	}
 
@@ -175,12 +175,25 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		fromaddroff := builder.CreateByteVector((*mergeState.fromCommitAddr)[:])
 		fromspecoff := builder.CreateString(mergeState.fromCommitSpec)
 		unmergableoff := SerializeStringVector(builder, mergeState.unmergableTables)
+		var resolvedoff, strategyOptionOff flatbuffers.UOffsetT
+		if len(mergeState.resolvedSchemaConflicts) > 0 {
+			resolvedoff = serializeSchemaConflictResolutions(builder, mergeState.resolvedSchemaConflicts)
+		}
+		if mergeState.strategyOption != "" {
+			strategyOptionOff = builder.CreateString(mergeState.strategyOption)
+		}
 		serial.MergeStateStart(builder)
 		serial.MergeStateAddPreWorkingRootAddr(builder, prerootaddroff)
 		serial.MergeStateAddFromCommitAddr(builder, fromaddroff)
 		serial.MergeStateAddFromCommitSpecStr(builder, fromspecoff)
 		serial.MergeStateAddUnmergableTables(builder, unmergableoff)
 		serial.MergeStateAddIsCherryPick(builder, mergeState.isCherryPick)
+		if resolvedoff != 0 {
+			serial.MergeStateAddResolvedSchemaConflicts(builder, resolvedoff)
+		}
+		if strategyOptionOff != 0 {
+			serial.MergeStateAddStrategyOption(builder, strategyOptionOff)
+		}
 		mergeStateOff = serial.MergeStateEnd(builder)
 	}
 
@@ -188,6 +201,10 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		preRebaseRootAddrOffset := builder.CreateByteVector((*rebaseState.preRebaseWorkingAddr)[:])
 		ontoAddrOffset := builder.CreateByteVector((*rebaseState.ontoCommitAddr)[:])
 		branchOffset := builder.CreateString(rebaseState.branch)
+		var strategyOptionOffset flatbuffers.UOffsetT
+		if rebaseState.strategyOption != "" {
+			strategyOptionOffset = builder.CreateString(rebaseState.strategyOption)
+		}
 		serial.RebaseStateStart(builder)
 		serial.RebaseStateAddPreWorkingRootAddr(builder, preRebaseRootAddrOffset)
 		serial.RebaseStateAddBranch(builder, branchOffset)
@@ -196,6 +213,9 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		serial.RebaseStateAddEmptyCommitHandling(builder, rebaseState.emptyCommitHandling)
 		serial.RebaseStateAddLastAttemptedStep(builder, rebaseState.lastAttemptedStep)
 		serial.RebaseStateAddRebasingStarted(builder, rebaseState.rebasingStarted)
+		if strategyOptionOffset != 0 {
+			serial.RebaseStateAddStrategyOption(builder, strategyOptionOffset)
+		}
 		rebaseStateOffset = serial.RebaseStateEnd(builder)
 	}
 
@@ -234,15 +254,19 @@ func NewMergeState(
 	commit *Commit,
 	commitSpecStr string,
 	unmergableTables []string,
+	resolvedSchemaConflicts []SchemaConflictResolution,
 	isCherryPick bool,
+	strategyOption string,
 ) (*MergeState, error) {
 	if vrw.Format().UsesFlatbuffers() {
 		ms := &MergeState{
-			preMergeWorkingAddr: new(hash.Hash),
-			fromCommitAddr:      new(hash.Hash),
-			fromCommitSpec:      commitSpecStr,
-			unmergableTables:    unmergableTables,
-			isCherryPick:        isCherryPick,
+			preMergeWorkingAddr:     new(hash.Hash),
+			fromCommitAddr:          new(hash.Hash),
+			fromCommitSpec:          commitSpecStr,
+			unmergableTables:        unmergableTables,
+			resolvedSchemaConflicts: resolvedSchemaConflicts,
+			isCherryPick:            isCherryPick,
+			strategyOption:          strategyOption,
 		}
 		*ms.preMergeWorkingAddr = preMergeWorking.TargetHash()
 		*ms.fromCommitAddr = commit.Addr()
@@ -264,7 +288,27 @@ func NewMergeState(
 	}
 }
 
-func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string, commitBecomesEmptyHandling uint8, emptyCommitHandling uint8, lastAttemptedStep float32, rebasingStarted bool) *RebaseState {
+// serializeSchemaConflictResolutions writes |resolutions| to |b| as a vector of SchemaConflictResolution tables.
+func serializeSchemaConflictResolutions(b *flatbuffers.Builder, resolutions []SchemaConflictResolution) flatbuffers.UOffsetT {
+	offs := make([]flatbuffers.UOffsetT, len(resolutions))
+	for j := len(resolutions) - 1; j >= 0; j-- {
+		tableoff := b.CreateString(resolutions[j].TableName)
+		columnoff := b.CreateString(resolutions[j].ColumnName)
+		resolutionoff := b.CreateString(resolutions[j].Resolution)
+		serial.SchemaConflictResolutionStart(b)
+		serial.SchemaConflictResolutionAddTableName(b, tableoff)
+		serial.SchemaConflictResolutionAddColumnName(b, columnoff)
+		serial.SchemaConflictResolutionAddResolution(b, resolutionoff)
+		offs[j] = serial.SchemaConflictResolutionEnd(b)
+	}
+	serial.MergeStateStartResolvedSchemaConflictsVector(b, len(resolutions))
+	for j := len(resolutions) - 1; j >= 0; j-- {
+		b.PrependUOffsetT(offs[j])
+	}
+	return b.EndVector(len(resolutions))
+}
+
+func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch string, commitBecomesEmptyHandling uint8, emptyCommitHandling uint8, lastAttemptedStep float32, rebasingStarted bool, strategyOption string) *RebaseState {
 	return &RebaseState{
 		preRebaseWorkingAddr:       &preRebaseWorkingRoot,
 		ontoCommitAddr:             &commitAddr,
@@ -273,6 +317,7 @@ func NewRebaseState(preRebaseWorkingRoot hash.Hash, commitAddr hash.Hash, branch
 		emptyCommitHandling:        emptyCommitHandling,
 		lastAttemptedStep:          lastAttemptedStep,
 		rebasingStarted:            rebasingStarted,
+		strategyOption:             strategyOption,
 	}
 }
 
@@ -29,6 +29,8 @@ import (
 	"sort"
 
 	"github.com/golang/snappy"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/dolthub/dolt/go/store/chunks"
@@ -336,6 +338,12 @@ func (tr tableReader) readAtOffsetsWithCB(
 	readLength := rb.End() - rb.Start()
 	buff := make([]byte, readLength)
 
+	ctx, span := tracer.Start(ctx, "nbs.tableReader.readBatch", trace.WithAttributes(
+		attribute.Int("num_chunks", len(rb)),
+		attribute.Int64("read_length", int64(readLength)),
+	))
+	// the span covers the callbacks for the chunks read, as well as the read itself
+	defer span.End()
 	n, err := tr.r.ReadAtWithStats(ctx, buff, int64(rb.Start()), stats)
 	if err != nil {
 		return err
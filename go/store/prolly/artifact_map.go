@@ -82,7 +82,7 @@ func NewArtifactMap(node tree.Node, ns tree.NodeStore, srcKeyDesc val.TupleDesc)
 	}
 	return ArtifactMap{
 		tuples:     tuples,
-		srcKeyDesc: srcKeyDesc,
+		srcKeyDesc: srcKeyDesc.WithoutFixedAccess(),
 		keyDesc:    keyDesc,
 		valDesc:    valDesc,
 	}
@@ -121,7 +121,7 @@ func NewArtifactMapFromTuples(ctx context.Context, ns tree.NodeStore, srcKeyDesc
 	}
 	return ArtifactMap{
 		tuples:     tuples,
-		srcKeyDesc: srcKeyDesc,
+		srcKeyDesc: srcKeyDesc.WithoutFixedAccess(),
 		keyDesc:    kd,
 		valDesc:    vd,
 	}, nil
@@ -345,11 +345,13 @@ type ArtifactsEditor struct {
 // of |srcKey|, the primary key fields from the original table, followed by the hash of the source root, |srcRootish|,
 // and then the artifact type, |artType|.
 func (wr *ArtifactsEditor) BuildArtifactKey(_ context.Context, srcKey val.Tuple, srcRootish hash.Hash, artType ArtifactType) val.Tuple {
-	for i := 0; i < srcKey.Count(); i++ {
+	// |srcKey| may have fewer fields than the descriptor if its trailing fields are NULL
+	numPks := wr.srcKeyDesc.Count()
+	for i := 0; i < numPks; i++ {
 		wr.artKB.PutRaw(i, srcKey.GetField(i))
 	}
-	wr.artKB.PutCommitAddr(srcKey.Count(), srcRootish)
-	wr.artKB.PutUint8(srcKey.Count()+1, uint8(artType))
+	wr.artKB.PutCommitAddr(numPks, srcRootish)
+	wr.artKB.PutUint8(numPks+1, uint8(artType))
 	return wr.artKB.Build(wr.pool)
 }
 
@@ -495,6 +497,10 @@ type ConflictArtifact struct {
 type ConflictMetadata struct {
 	// BaseRootIsh is the target hash of the working set holding the base value for the conflict.
 	BaseRootIsh hash.Hash `json:"bc"`
+	// BaseTable and TheirTable are the names of the table in the base and their root-ish, if the table was renamed
+	// since the merge base. They are empty when the table has the same name in every root.
+	BaseTable  string `json:"bt,omitempty"`
+	TheirTable string `json:"tt,omitempty"`
 }
 
 // ConstraintViolationMeta is the json metadata for foreign key constraint violations
@@ -589,7 +595,7 @@ func (itr artifactIterImpl) getSrcKeyFromArtKey(k val.Tuple) val.Tuple {
 	for i := 0; i < itr.numPks; i++ {
 		itr.tb.PutRaw(i, k.GetField(i))
 	}
-	return itr.tb.Build(itr.pool)
+	return itr.tb.BuildPermissive(itr.pool)
 }
 
 // Artifact is a struct representing an artifact in the artifacts table
@@ -608,8 +614,14 @@ type Artifact struct {
 
 func mergeArtifactsDescriptorsFromSource(srcKd val.TupleDesc) (kd, vd val.TupleDesc) {
 	// artifact key consists of keys of source schema, followed by target branch
-	// commit hash, and artifact type.
-	keyTypes := srcKd.Types
+	// commit hash, and artifact type. The source key fields are nullable, as a
+	// constraint violation can be recorded for a row with a NULL in a column
+	// that a merge made part of the primary key.
+	keyTypes := make([]val.Type, 0, len(srcKd.Types)+2)
+	for _, typ := range srcKd.Types {
+		typ.Nullable = true
+		keyTypes = append(keyTypes, typ)
+	}
 
 	// source branch commit hash
 	keyTypes = append(keyTypes, val.Type{Enc: val.CommitAddrEnc, Nullable: false})
@@ -266,6 +266,66 @@ func TestVisitMapLevelOrder(t *testing.T) {
 	}
 }
 
+func TestSplitKeyRange(t *testing.T) {
+	scales := []int{
+		20,
+		2000,
+		20_000,
+		100_000,
+	}
+	for _, s := range scales {
+		t.Run("scale "+strconv.Itoa(s), func(t *testing.T) {
+			ctx := context.Background()
+			tm, tuples := makeProllyMap(t, s)
+			m := tm.(Map)
+			for _, n := range []int{1, 2, 4, 16} {
+				splits, err := m.SplitKeyRange(ctx, nil, nil, n)
+				require.NoError(t, err)
+				assert.Less(t, len(splits), max(n, 1))
+				counts := countSplitRanges(t, m, nil, nil, splits)
+				assert.Equal(t, tuples, concatSplitRanges(counts))
+				if s >= 20_000 && n > 1 {
+					// leaf nodes hold hundreds of tuples, so
+					// large maps should split into balanced ranges
+					require.Len(t, counts, n)
+					for _, c := range counts {
+						assert.Greater(t, len(c), s/(2*n))
+						assert.Less(t, len(c), 2*s/n)
+					}
+				}
+
+				start, stop := tuples[s/4][0], tuples[3*s/4][0]
+				splits, err = m.SplitKeyRange(ctx, start, stop, n)
+				require.NoError(t, err)
+				counts = countSplitRanges(t, m, start, stop, splits)
+				assert.Equal(t, tuples[s/4:3*s/4], concatSplitRanges(counts))
+			}
+		})
+	}
+}
+
+// countSplitRanges returns the tuples of each sub-range of [|start|, |stop|) split by |splits|.
+func countSplitRanges(t *testing.T, m Map, start, stop val.Tuple, splits []val.Tuple) (ranges [][][2]val.Tuple) {
+	ctx := context.Background()
+	bounds := append(append([]val.Tuple{start}, splits...), stop)
+	for i := 0; i < len(bounds)-1; i++ {
+		if bounds[i] != nil && bounds[i+1] != nil {
+			require.Less(t, m.keyDesc.Compare(bounds[i], bounds[i+1]), 0)
+		}
+		iter, err := m.IterKeyRange(ctx, bounds[i], bounds[i+1])
+		require.NoError(t, err)
+		ranges = append(ranges, iterOrdinalRange(t, ctx, iter))
+	}
+	return
+}
+
+func concatSplitRanges(ranges [][][2]val.Tuple) (tuples [][2]val.Tuple) {
+	for _, r := range ranges {
+		tuples = append(tuples, r...)
+	}
+	return
+}
+
 func TestNewEmptyNode(t *testing.T) {
 	s := message.NewProllyMapSerializer(val.TupleDesc{}, sharedPool)
 	msg := s.Serialize(nil, nil, nil, 0)
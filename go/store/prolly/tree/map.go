@@ -495,6 +495,106 @@ func (t StaticMap[K, V, O]) getKeyRangeCursors(ctx context.Context, startInclusi
 	return
 }
 
+// splitKeyRangeFactor is the minimum number of subtrees per sub-range that
+// SplitKeyRange reads before choosing split keys.
+const splitKeyRangeFactor = 8
+
+// SplitKeyRange returns up to |n|-1 keys which split the key range [|start|, |stop|) into sub-ranges
+// holding roughly equal numbers of tuples. The split keys are subtree boundaries of the highest level
+// of internal nodes with enough subtrees within the range to balance the sub-ranges, so finding them
+// reads only a handful of nodes. A nil |start| or |stop| leaves that end of the range unbounded. Fewer
+// keys, or none, are returned if the range spans too few subtrees to split it further.
+func (t StaticMap[K, V, O]) SplitKeyRange(ctx context.Context, start, stop K, n int) ([]K, error) {
+	if n < 2 || t.Root.IsLeaf() {
+		return nil, nil
+	}
+
+	// inRange returns whether the subtree of |nd| at |i| may hold keys within the range
+	inRange := func(nd Node, i int) bool {
+		if len(start) > 0 && t.Order.Compare(K(nd.GetKey(i)), start) < 0 {
+			return false
+		}
+		if len(stop) > 0 && i > 0 && t.Order.Compare(K(nd.GetKey(i-1)), stop) >= 0 {
+			return false
+		}
+		return true
+	}
+
+	level := []Node{t.Root}
+	for {
+		var cnt int
+		for _, nd := range level {
+			for i := 0; i < nd.Count(); i++ {
+				if inRange(nd, i) {
+					cnt++
+				}
+			}
+		}
+		if cnt >= n*splitKeyRangeFactor || len(level) == 0 || level[0].Level() == 1 {
+			break
+		}
+
+		var children []Node
+		for _, nd := range level {
+			for i := 0; i < nd.Count(); i++ {
+				if !inRange(nd, i) {
+					continue
+				}
+				child, err := t.NodeStore.Read(ctx, nd.getAddress(i))
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, child)
+			}
+		}
+		level = children
+	}
+
+	// each subtree's last key is the boundary between it and the next subtree
+	var keys []K
+	var counts []uint64
+	var total uint64
+	for _, nd := range level {
+		nd, err := nd.loadSubtrees()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < nd.Count(); i++ {
+			if !inRange(nd, i) {
+				continue
+			}
+			c, err := nd.getSubtreeCount(i)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, K(nd.GetKey(i)))
+			counts = append(counts, c)
+			total += c
+		}
+	}
+
+	var splits []K
+	var cum uint64
+	next := 1
+	for i := 0; i < len(keys)-1 && next < n; i++ {
+		cum += counts[i]
+		if cum*uint64(n) < total*uint64(next) {
+			continue
+		}
+		k := keys[i]
+		if len(stop) > 0 && t.Order.Compare(k, stop) >= 0 {
+			break
+		}
+		if len(start) == 0 || t.Order.Compare(k, start) > 0 {
+			splits = append(splits, k)
+		}
+		for next < n && cum*uint64(n) >= total*uint64(next) {
+			next++
+		}
+	}
+	return splits, nil
+}
+
 // GetOrdinalForKey returns the smallest ordinal position at which the key >= |query|.
 func (t StaticMap[K, V, O]) GetOrdinalForKey(ctx context.Context, query K) (uint64, error) {
 	cur, err := newCursorAtKey(ctx, t.NodeStore, t.Root, query, t.Order)
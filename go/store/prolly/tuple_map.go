@@ -20,6 +20,10 @@ import (
 	"io"
 	"strings"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/dolthub/dolt/go/store/prolly/message"
 
 	"github.com/dolthub/dolt/go/store/hash"
@@ -29,6 +33,8 @@ import (
 	"github.com/dolthub/dolt/go/store/val"
 )
 
+var tracer = otel.Tracer("github.com/dolthub/dolt/go/store/prolly")
+
 type Map struct {
 	tuples  tree.StaticMap[val.Tuple, val.Tuple, val.TupleDesc]
 	keyDesc val.TupleDesc
@@ -112,6 +118,11 @@ func MutateMapWithTupleIter(ctx context.Context, m Map, iter TupleIter) (Map, er
 }
 
 func DiffMaps(ctx context.Context, from, to Map, considerAllRowsModified bool, cb tree.DiffFn) error {
+	ctx, span := tracer.Start(ctx, "prolly.DiffMaps", trace.WithAttributes(
+		attribute.Int("from_height", from.Height()),
+		attribute.Int("to_height", to.Height()),
+	))
+	defer span.End()
 	return tree.DiffOrderedTrees(ctx, from.tuples, to.tuples, considerAllRowsModified, makeDiffCallBack(from, to, cb))
 }
 
@@ -167,6 +178,8 @@ func makeDiffCallBack(from, to Map, innerCb tree.DiffFn) tree.DiffFn {
 }
 
 func MergeMaps(ctx context.Context, left, right, base Map, cb tree.CollisionFn) (Map, tree.MergeStats, error) {
+	ctx, span := tracer.Start(ctx, "prolly.MergeMaps")
+	defer span.End()
 	serializer := message.NewProllyMapSerializer(left.valDesc, base.NodeStore().Pool())
 	// TODO: MergeMaps does not properly detect merge conflicts when one side adds a NULL to the end of its tuple.
 	// To fix this, accurate values of `leftSchemaChanged` and `rightSchemaChanged` must be computed.
@@ -346,6 +359,14 @@ func (m Map) IterKeyRange(ctx context.Context, start, stop val.Tuple) (MapIter,
 	return m.tuples.IterKeyRange(ctx, start, stop)
 }
 
+// SplitKeyRange returns up to |n|-1 keys which split the key range [|start|, |stop|)
+// into sub-ranges of roughly equal size, using the subtree boundaries of the Map's
+// internal nodes. Each sub-range can be read with IterKeyRange. If |start| and/or
+// |stop| is nil, the range is open towards that end.
+func (m Map) SplitKeyRange(ctx context.Context, start, stop val.Tuple, n int) ([]val.Tuple, error) {
+	return m.tuples.SplitKeyRange(ctx, start, stop, n)
+}
+
 // GetOrdinalForKey returns the smallest ordinal position at which the key >=
 // |query|.
 func (m Map) GetOrdinalForKey(ctx context.Context, query val.Tuple) (uint64, error) {
@@ -20,6 +20,9 @@ import (
 	"strconv"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/dolthub/dolt/go/store/prolly/message"
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/val"
@@ -336,6 +339,10 @@ func (f ProllyFlusher) ApplyMutationsWithSerializer(
 	serializer message.Serializer,
 	m *GenericMutableMap[Map, tree.StaticMap[val.Tuple, val.Tuple, val.TupleDesc]],
 ) (tree.StaticMap[val.Tuple, val.Tuple, val.TupleDesc], error) {
+	ctx, span := tracer.Start(ctx, "prolly.ApplyMutations", trace.WithAttributes(
+		attribute.Int("pending", m.tuples.Edits.Count()),
+	))
+	defer span.End()
 	newRoot, err := tree.ApplyMutations(ctx, m.NodeStore(), m.tuples.Static.GetRoot(), m.keyDesc, serializer, m.tuples.Mutations())
 	if err != nil {
 		return tree.StaticMap[val.Tuple, val.Tuple, val.TupleDesc]{}, err
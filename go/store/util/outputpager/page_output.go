@@ -39,6 +39,9 @@ import (
 var (
 	noPager bool
 	testing = false
+	// command is the pager program and its arguments, set by SetCommand. When it's empty, less is used, or more if
+	// less isn't installed.
+	command []string
 )
 
 type Pager struct {
@@ -61,8 +64,9 @@ func Start() *Pager {
 	var err error
 	var cmd *exec.Cmd
 
-	lessPath, err = exec.LookPath("less")
-	if err != nil {
+	if len(command) > 0 {
+		cmd = exec.Command(command[0], command[1:]...)
+	} else if lessPath, err = exec.LookPath("less"); err != nil {
 		lessPath, err = exec.LookPath("more")
 		d.Chk.NoError(err)
 		cmd = exec.Command(lessPath)
@@ -81,7 +85,13 @@ func Start() *Pager {
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = stdin
-	cmd.Start()
+	if err = cmd.Start(); err != nil && len(command) > 0 {
+		// a pager set with SetCommand may not exist, in which case output isn't paged
+		fmt.Fprintf(os.Stderr, "error starting pager %s: %s\n", command[0], err)
+		stdin.Close()
+		stdout.Close()
+		return &Pager{os.Stdout, nil, nil, nil, nil}
+	}
 
 	p := &Pager{stdout, stdin, stdout, &sync.Mutex{}, make(chan struct{})}
 
@@ -135,6 +145,27 @@ func RegisterOutputpagerFlags(flags *flag.FlagSet) {
 	flags.BoolVar(&noPager, "no-pager", false, "suppress paging functionality")
 }
 
+// SetEnabled turns paging on or off for the rest of the process.
+func SetEnabled(enabled bool) {
+	noPager = !enabled
+}
+
+// Enabled returns whether output is paged when stdout is a terminal.
+func Enabled() bool {
+	return !noPager
+}
+
+// SetCommand sets the pager program and its arguments that output is paged with. An empty command restores the
+// default pager.
+func SetCommand(cmd []string) {
+	command = cmd
+}
+
+// Command returns the pager program and its arguments set with SetCommand, or nil if the default pager is used.
+func Command() []string {
+	return command
+}
+
 func IsStdoutTty() bool {
 	return goisatty.IsTerminal(os.Stdout.Fd())
 }
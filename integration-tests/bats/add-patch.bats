@@ -274,6 +274,67 @@ teardown() {
   [[ "$output" =~ "| 8 |" ]] || false
 }
 
+# bats test_tags=no_lambda
+@test "add-patch: split hunks" {
+  # Like "n then a", but with the changes grouped into hunks, which are split to get to their first change.
+  run $BATS_TEST_DIRNAME/add-patch-expect/hunks_split.expect
+  [ $status -eq 0 ]
+
+  run dolt sql -q "select sum(pk) as s from colors AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 4 |" ]] || false
+
+  run dolt sql -q "select pk, y from coordinates AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | 2.2     |"   ]] || false
+  [[ "$output" =~ "| 2  | 4.4     |"   ]] || false
+  [[ "$output" =~ "| 3  | 100.001 |"   ]] || false
+  [[ "$output" =~ "| 4  | 23.32   |"   ]] || false
+
+  run dolt sql -q "select pk, name from names AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | neil |" ]] || false
+  [[ "$output" =~ "| 3  | jane |" ]] || false
+  [[ "$output" =~ "| 4  | john |" ]] || false
+  run dolt sql -q "select sum(pk) as s from names AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 8 |" ]] || false
+}
+
+# bats test_tags=no_lambda
+@test "add-patch: split hunks with restarts" {
+  # Like "y/n repeatedly with restarts", but with the changes grouped into hunks, which are split along the way.
+  run $BATS_TEST_DIRNAME/add-patch-expect/hunks_split_restart.expect
+  [ $status -eq 0 ]
+
+  run dolt sql -q "select name from colors AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "Red"     ]] || false
+  [[ "$output" =~ "SkyBlue" ]] || false
+  [[ "$output" =~ "Yellow"  ]] || false
+  [[ ! "$output" =~ "Green" ]] || false
+
+  run dolt sql -q "select pk, y from coordinates AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | 2.2     |"   ]] || false
+  [[ "$output" =~ "| 3  | 100.001 |"   ]] || false
+  [[ "$output" =~ "| 4  | 23.32   |"   ]] || false
+
+  run dolt sql -q "select pk, name from names AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | joey |" ]] || false
+  [[ "$output" =~ "| 2  | sami |" ]] || false
+  [[ "$output" =~ "| 3  | jane |" ]] || false
+  [[ "$output" =~ "| 4  | john |" ]] || false
+}
+
+# bats test_tags=no_lambda
+@test "add-patch: hunks requires patch" {
+  run dolt add --hunks names
+  [ $status -eq 1 ]
+  [[ "$output" =~ "--hunks requires --patch" ]] || false
+}
+
 # bats test_tags=no_lambda
 @test "add-patch: keyless table" {
   dolt add .
@@ -324,3 +385,57 @@ teardown() {
   [[ "$output" =~ "15" ]] || false
 }
 
+
+@test "add-patch: where stages matching rows" {
+  run dolt add --where "pk > 2" names
+  [ $status -eq 0 ]
+
+  run dolt sql -q "select pk, name from names AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | neil |" ]] || false
+  [[ "$output" =~ "| 2  | sami |" ]] || false
+  [[ "$output" =~ "| 3  | jane |" ]] || false
+  [[ "$output" =~ "| 4  | john |" ]] || false
+
+  # Other tables are untouched.
+  run dolt sql -q "select count(*) from dolt_workspace_colors where staged"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 0        |" ]] || false
+}
+
+@test "add-patch: where matches removed rows by their old values" {
+  run dolt add --where "name = 'sami' OR name = 'joey'" names
+  [ $status -eq 0 ]
+
+  run dolt sql -q "select pk, name from names AS OF STAGED"
+  [ $status -eq 0 ]
+  [[ "$output" =~ "| 1  | joey |" ]] || false
+  [[ "$output" =~ "| 3  | jane |" ]] || false
+  [[ ! "$output" =~ "sami" ]] || false
+  [[ ! "$output" =~ "john" ]] || false
+
+  # Nothing matches, nothing is staged.
+  run dolt add --where "red = 42" colors
+  [ $status -eq 0 ]
+  run dolt diff --staged --stat colors
+  [ $status -eq 0 ]
+  [ "$output" = "" ]
+}
+
+@test "add-patch: where errors" {
+  run dolt add --where "pk > 2"
+  [ $status -eq 1 ]
+  [[ "$output" =~ "--where requires exactly one table" ]] || false
+
+  run dolt add --where "pk > 2" names colors
+  [ $status -eq 1 ]
+  [[ "$output" =~ "--where requires exactly one table" ]] || false
+
+  run dolt add -p --where "pk > 2" names
+  [ $status -eq 1 ]
+  [[ "$output" =~ "--patch and --where are mutually exclusive" ]] || false
+
+  run dolt add --where "nosuchcol = 1" names
+  [ $status -eq 1 ]
+  [[ "$output" =~ "could not be found" ]] || false
+}
@@ -183,3 +183,30 @@ SQL
     [[ "${lines[9]}" =~ "| sub  | 2   |" ]] || false
     [[ "${lines[10]}" =~ "| zzz  | 4   |" ]] || false
 }
+
+@test "blame: --cells annotates each column of each row" {
+    run dolt blame --cells blame_test
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" =~ "| pk | column_name | commit" ]] || false
+    [[ "${lines[3]}" =~ "| 1  | pk          |" ]] || false
+    [[ "${lines[3]}" =~ "create blame_test table" ]] || false
+    [[ "${lines[5]}" =~ "| 2  | pk          |" ]] || false
+    [[ "${lines[5]}" =~ "add richard to blame_test" ]] || false
+    [[ "${lines[6]}" =~ "| 2  | name        |" ]] || false
+    [[ "${lines[6]}" =~ "replace richard with harry" ]] || false
+
+    run dolt blame --cells HEAD~2 blame_test
+    [ "$status" -eq 0 ]
+    [[ "${lines[6]}" =~ "| 2  | name        |" ]] || false
+    [[ "${lines[6]}" =~ "add richard to blame_test" ]] || false
+}
+
+@test "blame: --cells on a keyless table" {
+    dolt sql -q "create table keyless (a int, b int)"
+    dolt add .
+    dolt commit -m "add keyless table"
+
+    run dolt blame --cells keyless
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unable to blame cells of a table without a primary key" ]] || false
+}
@@ -573,12 +573,20 @@ teardown() {
     dolt sql -q "ALTER TABLE test MODIFY COLUMN v int"
     dolt commit -am "alter table test modify column v"
 
-    # TODO: Incompatible type changes currently trigger an error response, instead of
-    #       being tracked as a schema conflict artifact. Once we fix that, update this test.
+    # Incompatible type changes are tracked as schema conflicts
     dolt checkout main
     run dolt cherry-pick branch1
     [ $status -eq 1 ]
-    [[ $output =~ "merge aborted: schema conflict found for table test" ]] || false
+    [[ $output =~ "Unable to apply commit cleanly due to conflicts or constraint violations" ]] || false
+
+    run dolt sql -q "SELECT table_name FROM dolt_schema_conflicts" -r csv
+    [ $status -eq 0 ]
+    [[ $output =~ "test" ]] || false
+
+    dolt cherry-pick --abort
+    run dolt sql -q "SELECT * FROM dolt_schema_conflicts" -r csv
+    [ $status -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
 }
 
 @test "cherry-pick: commit with ALTER TABLE drop column" {
@@ -615,6 +623,9 @@ teardown() {
 
     dolt checkout main
     run dolt cherry-pick branch1
-    [ $status -eq 1 ]
-    [[ $output =~ "error: cannot merge because table test has different primary keys" ]] || false
+    [ $status -eq 0 ]
+
+    run dolt sql -q "SHOW CREATE TABLE test;"
+    [ $status -eq 0 ]
+    [[ $output =~ 'PRIMARY KEY (`pk`,`v`)' ]] || false
 }
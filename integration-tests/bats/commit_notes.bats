@@ -0,0 +1,173 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql <<SQL
+CREATE TABLE test (
+    pk int primary key
+);
+INSERT INTO test VALUES (0),(1),(2);
+SQL
+    dolt add .
+    dolt commit -m "created table test"
+    dolt sql -q "INSERT INTO test VALUES (3)"
+    dolt commit -am "made changes"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+get_head_commit() {
+    dolt log -n 1 | grep -m 1 commit | awk '{print $2}'
+}
+
+@test "commit_notes: add, show, list and remove notes" {
+    head=$(get_head_commit)
+
+    run dolt notes add -m "first line
+second line"
+    [ $status -eq 0 ]
+
+    run dolt notes show
+    [ $status -eq 0 ]
+    [ "${lines[0]}" = "first line" ]
+    [ "${lines[1]}" = "second line" ]
+
+    run dolt notes
+    [ $status -eq 0 ]
+    [ "$output" = "$head first line" ]
+
+    run dolt notes list HEAD
+    [ $status -eq 0 ]
+    [ "$output" = "$head first line" ]
+
+    # notes don't change the annotated commit
+    [ "$(get_head_commit)" = "$head" ]
+
+    run dolt notes add -m "another note"
+    [ $status -eq 1 ]
+    [[ "$output" =~ "found existing notes for object HEAD" ]] || false
+
+    dolt notes add -f -m "another note"
+    run dolt notes show $head
+    [ $status -eq 0 ]
+    [ "$output" = "another note" ]
+
+    dolt notes add -m "parent note" HEAD~1
+    run dolt notes list
+    [ $status -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "$output" =~ "$head another note" ]] || false
+    [[ "$output" =~ "parent note" ]] || false
+
+    dolt notes remove
+    run dolt notes show
+    [ $status -eq 1 ]
+    [[ "$output" =~ "no note found for object HEAD" ]] || false
+
+    run dolt notes remove
+    [ $status -eq 1 ]
+    [[ "$output" =~ "object HEAD has no note" ]] || false
+
+    run dolt status
+    [ $status -eq 0 ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+}
+
+@test "commit_notes: notes refs" {
+    dolt notes add -m "default note"
+    dolt notes add --ref review -m "review note"
+
+    run dolt notes show
+    [ $status -eq 0 ]
+    [ "$output" = "default note" ]
+
+    run dolt notes show --ref review
+    [ $status -eq 0 ]
+    [ "$output" = "review note" ]
+
+    run dolt notes show --ref refs/notes/review
+    [ $status -eq 0 ]
+    [ "$output" = "review note" ]
+
+    run dolt sql -q "SELECT ref, note FROM dolt_notes ORDER BY ref" -r csv
+    [ $status -eq 0 ]
+    [ "${lines[1]}" = "commits,default note" ]
+    [ "${lines[2]}" = "review,review note" ]
+
+    run dolt notes add --ref refs/heads/main -m "note"
+    [ $status -eq 1 ]
+    [[ "$output" =~ "outside of refs/notes/" ]] || false
+
+    run dolt branch
+    [ $status -eq 0 ]
+    [[ ! "$output" =~ "review" ]] || false
+    [[ ! "$output" =~ "commits" ]] || false
+}
+
+@test "commit_notes: push, clone and fetch notes" {
+    head=$(get_head_commit)
+    mkdir remote
+    dolt remote add origin file://remote
+    dolt push origin main
+    dolt notes add -m "pushed note"
+
+    run dolt push origin refs/notes/commits
+    [ $status -eq 0 ]
+
+    cd ..
+    dolt clone file://./dolt-repo-$$/remote notes-clone
+    cd notes-clone
+    run dolt notes
+    [ $status -eq 0 ]
+    [ "$output" = "$head pushed note" ]
+    [ "$(get_head_commit)" = "$head" ]
+
+    cd ../dolt-repo-$$
+    dolt notes add -f -m "updated note"
+    dolt push origin refs/notes/commits
+
+    cd ../notes-clone
+    dolt fetch
+    run dolt notes show
+    [ $status -eq 0 ]
+    [ "$output" = "updated note" ]
+
+    # notes that have diverged from the remote's aren't pushed or fetched without --force
+    dolt notes add -f -m "clone note"
+    cd ../dolt-repo-$$
+    dolt notes add -f -m "local note"
+    dolt push origin refs/notes/commits
+
+    cd ../notes-clone
+    run dolt push origin refs/notes/commits
+    [ $status -eq 1 ]
+    [[ "$output" =~ "rejected" ]] || false
+
+    run dolt fetch
+    [ $status -eq 0 ]
+    [[ "$output" =~ "not updating refs/notes/commits" ]] || false
+    run dolt notes show
+    [ "$output" = "clone note" ]
+
+    dolt push --force origin refs/notes/commits
+    cd ../dolt-repo-$$
+    run dolt fetch
+    [ $status -eq 0 ]
+    [[ "$output" =~ "not updating refs/notes/commits" ]] || false
+    run dolt notes show
+    [ "$output" = "local note" ]
+}
+
+@test "commit_notes: cannot set upstream for notes" {
+    mkdir remote
+    dolt remote add origin file://remote
+    dolt notes add -m "note"
+    run dolt push --set-upstream origin refs/notes/commits
+    [ $status -eq 1 ]
+    [[ "$output" =~ "cannot set upstream for notes" ]] || false
+}
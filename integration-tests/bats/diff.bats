@@ -2124,3 +2124,33 @@ EOF
     # Count the line numbers to make sure there are no schema changes output
     [ "${#lines[@]}" -eq 11 ]
 }
+
+@test "diff: --find-renames matches a table dropped and recreated under a new name" {
+    dolt sql -q "create table t (pk int primary key, name varchar(20), score int)"
+    dolt sql -q "insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30), (4, 'd', 40)"
+    dolt commit -Am "create t"
+
+    dolt sql -q "create table people (pk int primary key, name varchar(20), points int)"
+    dolt sql -q "insert into people select * from t"
+    dolt sql -q "drop table t"
+
+    run dolt diff --summary
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| t          | dropped   |" ]] || false
+    [[ "$output" =~ "| people     | added     |" ]] || false
+
+    run dolt diff --summary --find-renames
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| t -> people | renamed   | false       | true          |" ]] || false
+    [[ ! "$output" =~ "dropped" ]] || false
+
+    run dolt diff --find-renames -r sql
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'RENAME TABLE `t` TO `people`;' ]] || false
+
+    dolt sql -q "update people set points = 35 where pk = 3"
+    run dolt sql -r csv -q "select to_pk, to_points, from_pk, from_score, diff_type from dolt_diff('--find-renames', 'HEAD', 'WORKING', 'people')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3,35,3,30,modified" ]] || false
+    [ "${#lines[@]}" -eq 2 ]
+}
@@ -1043,4 +1043,41 @@ export NO_COLOR=1
     [[  "${lines[18]}" =~ "|/" ]] || false                               # |/
     [[  "${lines[19]}" =~ "* commit" ]] || false                         # *  commit Initialize data repository
 
-}
\ No newline at end of file
+}
+@test "log: --row shows the commits that changed a row" {
+    dolt sql -q "create table t (id int, k varchar(5), v int, primary key (id, k))"
+    dolt sql -q "insert into t values (1, 'a', 1), (2, 'b', 2)"
+    dolt commit -Am "add rows"
+    dolt sql -q "update t set v = 10 where id = 1"
+    dolt commit -am "update row 1"
+    dolt sql -q "update t set v = 20 where id = 2"
+    dolt commit -am "update row 2"
+
+    run dolt log --row t --pk 'id=1,k=a'
+    [ "$status" -eq 0 ]
+    [[ "${lines[0]}" =~ "commit " ]] || false
+    [[ "${lines[3]}" =~ "update row 1" ]] || false
+    [[ "${lines[4]}" =~ '- {"k": "a", "v": 1, "id": 1}' ]] || false
+    [[ "${lines[5]}" =~ '+ {"k": "a", "v": 10, "id": 1}' ]] || false
+    [[ "${lines[9]}" =~ "add rows" ]] || false
+    [[ "${lines[10]}" =~ '+ {"k": "a", "v": 1, "id": 1}' ]] || false
+    [ "${#lines[@]}" -eq 11 ]
+    [[ ! "$output" =~ "update row 2" ]] || false
+
+    run dolt log --row t --pk 'id=1,k=a' -n 1 HEAD~2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "add rows" ]] || false
+    [[ ! "$output" =~ "update row 1" ]] || false
+
+    run dolt log --row t --pk 'id=3,k=c'
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+
+    run dolt log --row t --pk 'id=1'
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "missing value for primary key column 'k'" ]] || false
+
+    run dolt log --row t
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--row requires the primary key of the row to be given with --pk" ]] || false
+}
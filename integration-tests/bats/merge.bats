@@ -1360,3 +1360,108 @@ SQL
     run dolt merge b1
     log_status_eq 0
 }
+
+@test "merge: octopus merge of several branches" {
+    dolt sql -q "create table a (pk int primary key, v int); create table b (pk int primary key, v int);"
+    dolt commit -Am "created tables"
+    dolt checkout -b b1
+    dolt sql -q "insert into a values (1, 1)"
+    dolt commit -am "b1"
+    dolt checkout -b b2 main
+    dolt sql -q "insert into b values (1, 1)"
+    dolt commit -am "b2"
+    dolt checkout -b b3 main
+    dolt sql -q "update b set v = 1"
+    dolt sql -q "insert into a values (3, 3)"
+    dolt commit -am "b3"
+    dolt checkout main
+
+    run dolt merge b1 b2 b3
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Merge made by the 'octopus' strategy." ]] || false
+
+    run dolt log -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Merge branches 'b1', 'b2' and 'b3' into main" ]] || false
+    [[ "$output" =~ "Merge:" ]] || false
+
+    run dolt sql -q "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4" ]] || false
+
+    run dolt log --graph --oneline
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Merge branches" ]] || false
+}
+
+@test "merge: octopus merge of conflicting branches fails" {
+    dolt sql -q "create table t (pk int primary key, v int); insert into t values (1, 1);"
+    dolt commit -Am "created table"
+    dolt checkout -b b1
+    dolt sql -q "update t set v = 10"
+    dolt commit -am "b1"
+    dolt checkout -b b2 main
+    dolt sql -q "update t set v = 20"
+    dolt commit -am "b2"
+    dolt checkout -b b3 main
+    dolt sql -q "update t set v = 30"
+    dolt commit -am "b3"
+    dolt checkout main
+
+    run dolt merge b1 b2 b3
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "table t: b1 and b2" ]] || false
+    [[ "$output" =~ "table t: b1 and b3" ]] || false
+    [[ "$output" =~ "table t: b2 and b3" ]] || false
+
+    run dolt status
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+}
+
+@test "merge: -X theirs and -X ours resolve conflicting rows" {
+    dolt sql -q "create table t (pk int primary key, c1 int, c2 int); insert into t values (1, 1, 1);"
+    dolt commit -Am "created table"
+    dolt checkout -b right
+    dolt sql -q "update t set c1 = 10, c2 = 10"
+    dolt commit -am "right"
+    dolt checkout -b left main
+    dolt sql -q "update t set c1 = 100"
+    dolt commit -am "left"
+    dolt branch left2
+
+    run dolt merge -X theirs right
+    [ "$status" -eq 0 ]
+    run dolt sql -q "select * from t" -r csv
+    [[ "$output" =~ "1,10,10" ]] || false
+
+    dolt checkout left2
+    run dolt merge --strategy-option=ours right
+    [ "$status" -eq 0 ]
+    run dolt sql -q "select * from t" -r csv
+    [[ "$output" =~ "1,100,10" ]] || false
+
+    run dolt merge -X mine right
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "unsupported strategy option 'mine'" ]] || false
+}
+
+@test "merge: --strategy=ours records the merge but keeps our tables" {
+    dolt sql -q "create table t (pk int primary key, v int); insert into t values (1, 1);"
+    dolt commit -Am "created table"
+    dolt checkout -b other
+    dolt sql -q "insert into t values (2, 2)"
+    dolt commit -am "other"
+    dolt checkout main
+
+    run dolt merge -s ours other
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Merge made by the 'ours' strategy." ]] || false
+
+    run dolt sql -q "select count(*) from t" -r csv
+    [[ "$output" =~ "1" ]] || false
+    run dolt sql -q "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD')" -r csv
+    [[ "$output" =~ "2" ]] || false
+
+    run dolt merge other
+    [[ "$output" =~ "up to date" ]] || [[ "$output" =~ "up-to-date" ]] || false
+}
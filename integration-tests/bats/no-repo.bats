@@ -28,6 +28,7 @@ teardown() {
     [[ "$output" =~ "add - Add table changes to the list of staged table changes." ]] || false
     [[ "$output" =~ "diff - Diff a table." ]] || false
     [[ "$output" =~ "reset - Remove table changes from the list of staged table changes." ]] || false
+    [[ "$output" =~ "restore - Restore tables or rows in the working set." ]] || false
     [[ "$output" =~ "clean - Remove untracked tables from working set." ]] || false
     [[ "$output" =~ "commit - Record changes to the repository." ]] || false
     [[ "$output" =~ "sql - Run a SQL query against tables in repository." ]] || false
@@ -52,6 +53,7 @@ teardown() {
     [[ "$output" =~ "schema - Commands for showing and importing table schemas." ]] || false
     [[ "$output" =~ "table - Commands for copying, renaming, deleting, and exporting tables." ]] || false
     [[ "$output" =~ "tag - Create, list, delete tags." ]] || false
+    [[ "$output" =~ "notes - Add or inspect commit notes." ]] || false
     [[ "$output" =~ "blame - Show what revision and author last modified each row of a table." ]] || false
     [[ "$output" =~ "constraints - Commands for handling constraints." ]] || false
     [[ "$output" =~ "migrate - Executes a database migration to use the latest Dolt data format." ]] || false
@@ -264,7 +264,7 @@ teardown() {
     [[ "$output" =~ 'error: cannot merge because table t has different primary keys' ]] || false
 }
 
-@test "primary-key-changes: merge on branch with primary key added throws an error" {
+@test "primary-key-changes: merge on branch with primary key added re-keys the other branch" {
     dolt sql -q "create table t(pk int, val1 int, val2 int)"
     dolt add .
     dolt sql -q "INSERT INTO t values (1,1,1)"
@@ -287,11 +287,18 @@ teardown() {
     dolt commit -am "cm3"
 
     run dolt merge test -m "merge other"
-    [ "$status" -eq 1 ]
-    [[ "$output" =~ 'error: cannot merge because table t has different primary keys' ]] || false
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "describe t;"
+    [[ "$output" =~ 'PRI' ]] || false
+
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,1,1" ]
+    [ "${lines[2]}" = "2,2,2" ]
 }
 
-@test "primary-key-changes: merge on branches with different primary key from ancestor throws an error" {
+@test "primary-key-changes: merge on branches that both added the same primary key" {
     dolt sql -q "create table t(pk int, val1 int, val2 int)"
     dolt add .
     dolt sql -q "INSERT INTO t values (1,1,1)"
@@ -315,9 +322,15 @@ teardown() {
     dolt commit -am "cm3"
 
     run dolt merge test -m "merge other"
-    [ "$status" -eq 1 ]
+    [ "$status" -eq 0 ]
 
-    [[ "$output" =~ 'error: cannot merge because table t has different primary keys' ]] || false
+    run dolt sql -q "describe t;"
+    [[ "$output" =~ 'PRI' ]] || false
+
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,1,1" ]
+    [ "${lines[2]}" = "2,2,2" ]
 }
 
 @test "primary-key-changes: diff on primary key schema change shows schema level diff but does not show row level diff" {
@@ -529,7 +542,7 @@ SQL
     [[ "$output" =~ "key column 'pk1' doesn't exist in table" ]] || false
 }
 
-@test "primary-key-changes: same primary key set in different order is detected and blocked on merge" {
+@test "primary-key-changes: same primary key set in different order is detected on merge" {
     dolt sql -q "CREATE table t (pk int, val int, primary key (pk, val))"
     dolt add .
     dolt commit -am "cm1"
@@ -555,12 +568,11 @@ SQL
     dolt commit -am "insert"
 
     run dolt merge test -m "merge other"
-    [ "$status" -eq 1 ]
-    [[ "$output" =~ 'error: cannot merge because table t has different primary keys' ]] || false
+    [ "$status" -eq 0 ]
 
-    run dolt sql -q "call dolt_merge('test')"
-    [ "$status" -eq 1 ]
-    [[ "$output" =~ 'error: cannot merge because table t has different primary keys' ]] || false
+    run dolt sql -q "select * from t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,1" ]] || false
 
     skip "Dolt doesn't correctly store primary key order if it doesn't match the column order"
 }
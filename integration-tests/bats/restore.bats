@@ -0,0 +1,104 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql -q "CREATE TABLE test(pk BIGINT PRIMARY KEY, v1 BIGINT, KEY v1_idx (v1))"
+    dolt sql -q "INSERT INTO test VALUES (1, 1), (2, 2), (3, 3), (4, 4)"
+    dolt add -A
+    dolt commit -m "Created table"
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "restore: restores tables from the staged tables" {
+    dolt sql -q "UPDATE test SET v1 = v1 * 10"
+    dolt add test
+    dolt sql -q "DELETE FROM test"
+
+    dolt restore test
+    run dolt sql -q "SELECT * FROM test ORDER BY pk" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "1,10" ]] || false
+    [[ "$output" =~ "4,40" ]] || false
+    [[ "${#lines[@]}" = "5" ]] || false
+
+    run dolt status
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "Changes to be committed" ]] || false
+    [[ ! "$output" =~ "Changes not staged for commit" ]] || false
+}
+
+@test "restore: restores tables from a revision" {
+    dolt sql -q "UPDATE test SET v1 = v1 * 10"
+    dolt add test
+    dolt sql -q "DELETE FROM test"
+
+    dolt restore --source HEAD test
+    run dolt sql -q "SELECT * FROM test ORDER BY pk" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "1,1" ]] || false
+    [[ "$output" =~ "4,4" ]] || false
+    [[ ! "$output" =~ "1,10" ]] || false
+
+    # the staged tables are left alone
+    run dolt sql -q "SELECT * FROM test AS OF STAGED WHERE pk = 1" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "1,10" ]] || false
+}
+
+@test "restore: restores rows matching a predicate" {
+    dolt sql -q "UPDATE test SET v1 = v1 * 10"
+    dolt sql -q "DELETE FROM test WHERE pk = 3"
+    dolt sql -q "INSERT INTO test VALUES (5, 5)"
+
+    dolt restore -s HEAD --where "pk >= 3" test
+    run dolt sql -q "SELECT * FROM test ORDER BY pk" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "1,10" ]] || false
+    [[ "$output" =~ "2,20" ]] || false
+    [[ "$output" =~ "3,3" ]] || false
+    [[ "$output" =~ "4,4" ]] || false
+    [[ ! "$output" =~ "5,5" ]] || false
+    [[ "${#lines[@]}" = "5" ]] || false
+
+    run dolt sql -q "SELECT pk FROM test WHERE v1 = 3" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "3" ]] || false
+    [[ "${#lines[@]}" = "2" ]] || false
+
+    dolt restore --source HEAD~0 --where "v1 = 1 or v1 = 2" test
+    run dolt status
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+}
+
+@test "restore: errors" {
+    run dolt restore
+    [ "$status" -ne "0" ]
+
+    run dolt restore nosuch
+    [ "$status" -ne "0" ]
+    [[ "$output" =~ "table nosuch does not exist in the staged tables" ]] || false
+
+    run dolt restore --source nosuch test
+    [ "$status" -ne "0" ]
+    [[ "$output" =~ "branch not found: nosuch" ]] || false
+
+    run dolt restore --where "pk = 1" test test
+    [ "$status" -ne "0" ]
+    [[ "$output" =~ "--where requires exactly one table" ]] || false
+
+    run dolt restore --where "nosuch = 1" test
+    [ "$status" -ne "0" ]
+    [[ "$output" =~ "invalid predicate" ]] || false
+
+    dolt sql -q "ALTER TABLE test ADD COLUMN v2 int"
+    run dolt restore --where "pk = 1" test
+    [ "$status" -ne "0" ]
+    [[ "$output" =~ "the schema of table test has changed" ]] || false
+}
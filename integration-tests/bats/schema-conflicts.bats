@@ -105,3 +105,36 @@ setup_schema_conflict() {
     [ "$status" -eq 0 ]
     [[ "$output" =~ "datetime" ]] || false
 }
+
+@test "schema-conflicts: resolve column schema conflict with dolt_schema_conflicts_resolve" {
+    dolt sql -q "create table t (pk int primary key, c0 varchar(10));"
+    dolt sql -q "insert into t values (1, 'one')"
+    dolt commit -Am "new table t"
+    dolt branch other
+    dolt sql -q "alter table t modify c0 varchar(20)"
+    dolt commit -am "alter table t on branch main"
+    dolt checkout other
+    dolt sql -q "alter table t modify c0 varchar(30)"
+    dolt sql -q "insert into t values (2, 'a much longer value')"
+    dolt commit -am "alter table t on branch other"
+    dolt checkout main
+
+    run dolt merge other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "CONFLICT (schema)" ]] || false
+
+    dolt sql -q "call dolt_schema_conflicts_resolve('t', 'column', 'c0', 'widen')"
+
+    run dolt sql -q "select count(*) from dolt_schema_conflicts" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "0" ]] || false
+
+    run dolt schema show t
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "varchar(30)" ]] || false
+
+    dolt commit -am "merged other"
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2,a much longer value" ]] || false
+}
@@ -231,6 +231,65 @@ get_commit_hash_at() {
     [[ ! -z $(echo "$staged" | grep "testtable") ]] || false
 }
 
+@test "sql-local-remote: verify dolt add --where behavior." {
+    start_sql_server altDB
+    cd altDB
+
+    run dolt --verbose-engine-setup --user dolt --password "" add --where "pk > 1" table2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "starting remote mode" ]] || false
+
+    stop_sql_server 1
+
+    run dolt sql -q "select pk from table2 AS OF STAGED"
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "| 1  |" ]] || false
+    [[ "$output" =~ "| 2  |" ]] || false
+    [[ "$output" =~ "| 3  |" ]] || false
+}
+
+@test "sql-local-remote: verify dolt restore --where behavior." {
+    start_sql_server altDB
+    cd altDB
+
+    run dolt --verbose-engine-setup --user dolt --password "" restore --where "pk > 1" table2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "starting remote mode" ]] || false
+
+    stop_sql_server 1
+
+    run dolt sql -q "select pk from table2"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| 1  |" ]] || false
+    [[ ! "$output" =~ "| 2  |" ]] || false
+    [[ ! "$output" =~ "| 3  |" ]] || false
+}
+
+@test "sql-local-remote: verify dolt sql result formats" {
+    cd altDB
+    run dolt --verbose-engine-setup sql -r insert -q "select pk from table1 order by pk"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "starting local mode" ]] || false
+    [[ "$output" =~ "INSERT INTO \`table1\` (\`pk\`) VALUES (1);" ]] || false
+    [[ "$output" =~ "INSERT INTO \`table1\` (\`pk\`) VALUES (3);" ]] || false
+
+    start_sql_server altDB
+    run dolt --verbose-engine-setup --user dolt --password "" sql -r insert -q "select pk from table1 order by pk"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "starting remote mode" ]] || false
+    [[ "$output" =~ "INSERT INTO \`result\` (\`pk\`) VALUES ('1');" ]] || false
+    [[ "$output" =~ "INSERT INTO \`result\` (\`pk\`) VALUES ('3');" ]] || false
+
+    run dolt --user dolt --password "" sql -r markdown -q "select pk from table1 order by pk"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| pk |" ]] || false
+    [[ "$output" =~ "| 2 |" ]] || false
+
+    run dolt --user dolt --password "" sql -r ndjson -q "select pk from table1 order by pk"
+    [ "$status" -eq 0 ]
+    [[ "${lines[0]}" = '{"pk":"1"}' ]] || false
+}
+
 @test "sql-local-remote: verify simple dolt checkout behavior." {
     skip # currently checkout with a server is not supported
     start_sql_server altDB
@@ -75,6 +75,7 @@ mike_blocked_check() {
     mike_blocked_check "dolt_purge_dropped_databases()"
     mike_blocked_check "dolt_remote('add','origin1','Dolthub/museum-collections')"
     mike_blocked_check "dolt_undrop('foo')"
+    mike_blocked_check "dolt_user_limit('mike','max_rows','10')"
 
     # Verify non-admin procedures are executable, not an exhaustive list tho.
     dolt -u mike -p pwd sql -q "call dolt_branch('br1')"
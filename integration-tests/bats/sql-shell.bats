@@ -18,6 +18,7 @@ SQL
 
 teardown() {
     assert_feature_version
+    stop_sql_server 1
     teardown_common
 }
 
@@ -90,6 +91,72 @@ teardown() {
     [ "$status" -eq 0 ]
 }
 
+# bats test_tags=no_lambda
+@test "sql-shell: sql shell stashes changes with the stash slash command" {
+    skiponwindows "Need to install expect and make this script work on windows."
+    run $BATS_TEST_DIRNAME/sql-shell-slash-stash.expect
+    echo "$output"
+
+    [ "$status" -eq 0 ]
+}
+
+# bats test_tags=no_lambda
+@test "sql-shell: sql shell stashes changes with the stash slash command over --host" {
+    skiponwindows "Need to install expect and make this script work on windows."
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+      skip "This test starts its own server and connects to it with --host."
+    fi
+    db=$(basename "$PWD")
+    start_sql_server "$db"
+
+    # run the shell from a directory without a database, so that it can only connect through --host
+    mkdir nowhere
+    cd nowhere
+    run $BATS_TEST_DIRNAME/sql-shell-slash-stash.expect --host localhost --port $PORT --no-tls -u dolt -p "" --use-db "$db"
+    echo "$output"
+
+    [ "$status" -eq 0 ]
+}
+
+# bats test_tags=no_lambda
+@test "sql-shell: sql shell resolves conflicts with the conflicts slash command" {
+    skiponwindows "Need to install expect and make this script work on windows."
+    run $BATS_TEST_DIRNAME/sql-shell-slash-conflicts.expect
+    echo "$output"
+
+    [ "$status" -eq 0 ]
+}
+
+# bats test_tags=no_lambda
+@test "sql-shell: sql shell resolves conflicts with the conflicts slash command over --host" {
+    skiponwindows "Need to install expect and make this script work on windows."
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+      skip "This test starts its own server and connects to it with --host."
+    fi
+    db=$(basename "$PWD")
+    start_sql_server "$db"
+
+    # run the shell from a directory without a database, so that it can only connect through --host
+    mkdir nowhere
+    cd nowhere
+    run $BATS_TEST_DIRNAME/sql-shell-slash-conflicts.expect --host localhost --port $PORT --no-tls -u dolt -p "" --use-db "$db"
+    echo "$output"
+
+    [ "$status" -eq 0 ]
+}
+
+# bats test_tags=no_lambda
+@test "sql-shell: sql shell completes tables, columns and branches" {
+    skiponwindows "Need to install expect and make this script work on windows."
+    if [ "$SQL_ENGINE" = "remote-engine" ]; then
+      skip "Current test setup results in remote calls having a clean branch, where this expect script expects dirty."
+    fi
+    run $BATS_TEST_DIRNAME/sql-shell-completion.expect
+    echo "$output"
+
+    [ "$status" -eq 0 ]
+}
+
 # bats test_tags=no_lambda
 @test "sql-shell: sql shell prompt updates" {
     skiponwindows "Need to install expect and make this script work on windows."
@@ -2701,6 +2701,51 @@ SQL
     [[ "$output" =~ "*************************** 14. row ***************************" ]] || false
 }
 
+@test "sql: sql -q query markdown, html, ndjson, xml and insert formats" {
+    dolt sql -q "INSERT INTO one_pk (pk,c1,c2,c3,c4,c5) VALUES (4,40,NULL,40,40,40)"
+
+    run dolt sql -r markdown -q "SELECT pk, c1, c2 FROM one_pk WHERE pk = 4"
+    [ "$status" -eq 0 ]
+    [ "${lines[0]}" = "| pk | c1 | c2 |" ]
+    [ "${lines[1]}" = "| --- | --- | --- |" ]
+    [ "${lines[2]}" = "| 4 | 40 | NULL |" ]
+
+    run dolt sql -r html -q "SELECT pk, c1, c2 FROM one_pk WHERE pk = 4"
+    [ "$status" -eq 0 ]
+    [ "${lines[0]}" = "<table>" ]
+    [ "${lines[1]}" = "<tr><th>pk</th><th>c1</th><th>c2</th></tr>" ]
+    [ "${lines[2]}" = "<tr><td>4</td><td>40</td><td>NULL</td></tr>" ]
+    [ "${lines[3]}" = "</table>" ]
+
+    run dolt sql -r ndjson -q "SELECT pk, c1 FROM one_pk WHERE pk >= 3 ORDER BY pk"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [ "${lines[0]}" = '{"c1":30,"pk":3}' ]
+    [ "${lines[1]}" = '{"c1":40,"pk":4}' ]
+
+    run dolt sql -r xml -q "SELECT pk, c2 FROM one_pk WHERE pk = 4"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '<field name="pk">4</field>' ]] || false
+    [[ "$output" =~ '<field name="c2" xsi:nil="true" />' ]] || false
+    [[ "$output" =~ "</resultset>" ]] || false
+
+    run dolt sql -r insert -q "SELECT pk, c1, c2 FROM one_pk WHERE pk = 4"
+    [ "$status" -eq 0 ]
+    [ "$output" = 'INSERT INTO `one_pk` (`pk`,`c1`,`c2`) VALUES (4,40,NULL);' ]
+
+    # statements from the insert format can be run as they are
+    dolt sql -r insert -q "SELECT * FROM one_pk WHERE pk = 4" > inserts.sql
+    dolt sql -q "DELETE FROM one_pk WHERE pk = 4"
+    dolt sql < inserts.sql
+    run dolt sql -r csv -q "SELECT pk, c1 FROM one_pk WHERE pk = 4"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "4,40" ]] || false
+
+    run dolt sql -r insert -q "SELECT one_pk.pk, two_pk.c1 FROM one_pk JOIN two_pk ON one_pk.pk = two_pk.pk1 LIMIT 1"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'INSERT INTO `result`' ]] || false
+}
+
 # bats test_tags=no_lambda
 @test "sql: vertical query format in sql shell" {
     skiponwindows "Need to install expect and make this script work on windows."
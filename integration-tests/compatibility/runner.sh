@@ -90,6 +90,7 @@ function test_forward_compatibility() {
       dolt push file-remote init
       dolt push file-remote no-data
       dolt push file-remote other
+      dolt push file-remote refs/notes/commits
       cd ../../
   fi
   REMOTE="`pwd`"/repos/HEAD/file-remote
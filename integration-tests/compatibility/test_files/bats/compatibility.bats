@@ -206,3 +206,19 @@ EOF
 
     dolt sql -q 'drop table abc2'
 }
+
+@test "dolt fetch from a remote with notes" {
+    # repositories cloned from a remote created at HEAD have notes on the remote, which clients that predate notes
+    # must skip when they list the remote's refs
+    if ! dolt remote -v | grep -q origin; then
+        skip "repository has no remote"
+    fi
+
+    run dolt fetch origin
+    [ "$status" -eq 0 ]
+
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "remotes/origin/other" ]] || false
+    [[ ! "$output" =~ "notes" ]] || false
+}
@@ -67,6 +67,12 @@ dolt add .
 dolt commit -m "made changes to other"
 
 dolt checkout "$DEFAULT_BRANCH"
+
+# releases that predate commit notes don't have the notes command
+if dolt notes list > /dev/null 2>&1; then
+  dolt notes add -m "note on $DEFAULT_BRANCH"
+fi
+
 dolt table export abc abc.csv
 dolt schema export abc abc_schema.json
 